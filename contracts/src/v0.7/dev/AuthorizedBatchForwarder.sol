@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.7.0;
+pragma experimental ABIEncoderV2;
+
+import "../ConfirmedOwner.sol";
+import "../AuthorizedReceiver.sol";
+import "../vendor/Address.sol";
+
+/**
+ * @title AuthorizedBatchForwarder
+ * @notice Forwards batches of calls from the keys of a Chainlink node, so that
+ * many small transactions can be sent as one. Unlike a public multicall
+ * contract, only authorized senders can forward calls, so the forwarder can be
+ * authorized on operator contracts in place of the node's keys. Batches are
+ * made with the same aggregate3 function as Multicall3.
+ */
+contract AuthorizedBatchForwarder is ConfirmedOwner, AuthorizedReceiver {
+  using Address for address;
+
+  struct Call3 {
+    address target;
+    bool allowFailure;
+    bytes callData;
+  }
+
+  struct Result {
+    bool success;
+    bytes returnData;
+  }
+
+  address public immutable getChainlinkToken;
+
+  constructor(address link, address owner) ConfirmedOwner(owner) {
+    getChainlinkToken = link;
+  }
+
+  /**
+   * @notice The type and version of this contract
+   * @return Type and version string
+   */
+  function typeAndVersion() external pure virtual returns (string memory) {
+    return "AuthorizedBatchForwarder 1.0.0";
+  }
+
+  /**
+   * @notice Forward a batch of calls to other contracts
+   * @dev Only callable by an authorized sender. Reverts if a call that is not
+   * allowed to fail does.
+   * @param calls to forward
+   * @return returnData of each call
+   */
+  function aggregate3(Call3[] calldata calls) external validateAuthorizedSender returns (Result[] memory returnData) {
+    returnData = new Result[](calls.length);
+    for (uint256 i = 0; i < calls.length; i++) {
+      Call3 calldata call = calls[i];
+      require(call.target != getChainlinkToken, "Cannot forward to Link token");
+      require(call.target.isContract(), "Must forward to a contract");
+      (bool success, bytes memory data) = call.target.call(call.callData);
+      require(success || call.allowFailure, "Forwarded call failed");
+      returnData[i] = Result(success, data);
+    }
+  }
+
+  /**
+   * @notice concrete implementation of AuthorizedReceiver
+   * @return bool of whether sender is authorized
+   */
+  function _canSetAuthorizedSenders() internal view override returns (bool) {
+    return owner() == msg.sender;
+  }
+}
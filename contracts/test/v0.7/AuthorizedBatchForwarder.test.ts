@@ -0,0 +1,177 @@
+import { ethers } from 'hardhat'
+import { publicAbi } from '../test-helpers/helpers'
+import { assert, expect } from 'chai'
+import { Contract, ContractFactory } from 'ethers'
+import { getUsers, Roles } from '../test-helpers/setup'
+import { evmRevert } from '../test-helpers/matchers'
+
+let getterSetterFactory: ContractFactory
+let forwarderFactory: ContractFactory
+let linkTokenFactory: ContractFactory
+
+let roles: Roles
+
+before(async () => {
+  const users = await getUsers()
+
+  roles = users.roles
+  getterSetterFactory = await ethers.getContractFactory(
+    'src/v0.4/tests/GetterSetter.sol:GetterSetter',
+    roles.defaultAccount,
+  )
+  forwarderFactory = await ethers.getContractFactory(
+    'src/v0.7/dev/AuthorizedBatchForwarder.sol:AuthorizedBatchForwarder',
+    roles.defaultAccount,
+  )
+  linkTokenFactory = await ethers.getContractFactory(
+    'src/v0.4/LinkToken.sol:LinkToken',
+    roles.defaultAccount,
+  )
+})
+
+describe('AuthorizedBatchForwarder', () => {
+  let link: Contract
+  let forwarder: Contract
+
+  beforeEach(async () => {
+    link = await linkTokenFactory.connect(roles.defaultAccount).deploy()
+    forwarder = await forwarderFactory
+      .connect(roles.defaultAccount)
+      .deploy(link.address, await roles.defaultAccount.getAddress())
+  })
+
+  it('has a limited public interface [ @skip-coverage ]', () => {
+    publicAbi(forwarder, [
+      'aggregate3',
+      'getAuthorizedSenders',
+      'getChainlinkToken',
+      'isAuthorizedSender',
+      'setAuthorizedSenders',
+      'typeAndVersion',
+      // ConfirmedOwner
+      'transferOwnership',
+      'acceptOwnership',
+      'owner',
+    ])
+  })
+
+  describe('#typeAndVersion', () => {
+    it('describes the authorized batch forwarder', async () => {
+      assert.equal(
+        await forwarder.typeAndVersion(),
+        'AuthorizedBatchForwarder 1.0.0',
+      )
+    })
+  })
+
+  describe('#setAuthorizedSenders', () => {
+    describe('when called by a non-owner', () => {
+      it('cannot add an authorized node', async () => {
+        await evmRevert(
+          forwarder
+            .connect(roles.stranger)
+            .setAuthorizedSenders([await roles.stranger.getAddress()]),
+          'Cannot set authorized senders',
+        )
+      })
+    })
+  })
+
+  describe('#aggregate3', () => {
+    let bytes1: string
+    let bytes2: string
+    let mock1: Contract
+    let mock2: Contract
+
+    const setBytes = (bytes: string) =>
+      getterSetterFactory.interface.encodeFunctionData(
+        getterSetterFactory.interface.getFunction('setBytes'),
+        [bytes],
+      )
+
+    beforeEach(async () => {
+      mock1 = await getterSetterFactory.connect(roles.defaultAccount).deploy()
+      mock2 = await getterSetterFactory.connect(roles.defaultAccount).deploy()
+      bytes1 = ethers.utils.hexlify(ethers.utils.randomBytes(100))
+      bytes2 = ethers.utils.hexlify(ethers.utils.randomBytes(100))
+    })
+
+    describe('when called by an unauthorized node', () => {
+      it('reverts', async () => {
+        await evmRevert(
+          forwarder
+            .connect(roles.stranger)
+            .aggregate3([[mock1.address, false, setBytes(bytes1)]]),
+          'Not authorized sender',
+        )
+      })
+    })
+
+    describe('when called by an authorized node', () => {
+      beforeEach(async () => {
+        await forwarder
+          .connect(roles.defaultAccount)
+          .setAuthorizedSenders([await roles.oracleNode.getAddress()])
+      })
+
+      it('forwards every call', async () => {
+        const tx = await forwarder.connect(roles.oracleNode).aggregate3([
+          [mock1.address, false, setBytes(bytes1)],
+          [mock2.address, false, setBytes(bytes2)],
+        ])
+        await tx.wait()
+        assert.equal(await mock1.getBytes(), bytes1)
+        assert.equal(await mock2.getBytes(), bytes2)
+      })
+
+      it('perceives the message is sent by the AuthorizedBatchForwarder', async () => {
+        const tx = await forwarder
+          .connect(roles.oracleNode)
+          .aggregate3([[mock1.address, false, setBytes(bytes1)]])
+        await expect(tx)
+          .to.emit(mock1, 'SetBytes')
+          .withArgs(forwarder.address, bytes1)
+      })
+
+      it('reverts if a call that may not fail does', async () => {
+        await evmRevert(
+          forwarder.connect(roles.oracleNode).aggregate3([
+            [mock1.address, false, setBytes(bytes1)],
+            [mock2.address, false, '0xdeadbeef'],
+          ]),
+          'Forwarded call failed',
+        )
+      })
+
+      it('continues if a call that may fail does', async () => {
+        const tx = await forwarder.connect(roles.oracleNode).aggregate3([
+          [mock1.address, false, setBytes(bytes1)],
+          [mock2.address, true, '0xdeadbeef'],
+        ])
+        await tx.wait()
+        assert.equal(await mock1.getBytes(), bytes1)
+      })
+
+      it('reverts when forwarding to the link token', async () => {
+        const sighash = linkTokenFactory.interface.getSighash('name')
+        await evmRevert(
+          forwarder
+            .connect(roles.oracleNode)
+            .aggregate3([[link.address, false, sighash]]),
+          'Cannot forward to Link token',
+        )
+      })
+
+      it('reverts when forwarding to a non-contract address', async () => {
+        await evmRevert(
+          forwarder
+            .connect(roles.oracleNode)
+            .aggregate3([
+              [await roles.stranger.getAddress(), false, setBytes(bytes1)],
+            ]),
+          'Must forward to a contract',
+        )
+      })
+    })
+  })
+})
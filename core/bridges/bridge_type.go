@@ -21,6 +21,26 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	BridgeLimits
+}
+
+// BridgeLimits protect an external adapter from being overloaded by bridge
+// tasks. The zero value of each field disables it.
+type BridgeLimits struct {
+	// MaxConcurrency is the maximum number of requests in flight at once
+	MaxConcurrency uint32 `json:"maxConcurrency"`
+	// RequestsPerSecond is the maximum rate at which requests are sent
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	// CacheTTL is how long a response is reused for identical requests
+	CacheTTL models.Interval `json:"cacheTTL" gorm:"column:cache_ttl" db:"cache_ttl"`
+	// CircuitBreakerThreshold is the number of consecutive failed requests
+	// after which requests fail fast, without being sent, for
+	// CircuitBreakerTimeout
+	CircuitBreakerThreshold uint32          `json:"circuitBreakerThreshold"`
+	CircuitBreakerTimeout   models.Interval `json:"circuitBreakerTimeout"`
+	// MaxStaleness is how old a cached response may be to be served in place
+	// of a failed request, or while the circuit breaker is open
+	MaxStaleness models.Interval `json:"maxStaleness"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -60,8 +80,9 @@ type BridgeType struct {
 	Salt                   string
 	OutgoingToken          string
 	MinimumContractPayment *assets.Link `gorm:"type:varchar(255)"`
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+	BridgeLimits
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 // NewBridgeType returns a bridge bridge type authentication (with plaintext
@@ -92,6 +113,7 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			BridgeLimits:           btr.BridgeLimits,
 		}, nil
 }
 
@@ -72,8 +72,10 @@ func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count in
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(bt *BridgeType) error {
-	sql := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	sql := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment,
+		max_concurrency, requests_per_second, cache_ttl, circuit_breaker_threshold, circuit_breaker_timeout, max_staleness, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment,
+		:max_concurrency, :requests_per_second, :cache_ttl, :circuit_breaker_threshold, :circuit_breaker_timeout, :max_staleness, now(), now())
 	RETURNING *;`
 	stmt, err := o.db.PrepareNamed(sql)
 	if err != nil {
@@ -84,8 +86,12 @@ func (o *orm) CreateBridgeType(bt *BridgeType) error {
 
 // UpdateBridgeType updates the bridge type.
 func (o *orm) UpdateBridgeType(bt *BridgeType, btr *BridgeTypeRequest) error {
-	sql := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	return o.db.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	sql := `UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3,
+	max_concurrency = $4, requests_per_second = $5, cache_ttl = $6, circuit_breaker_threshold = $7, circuit_breaker_timeout = $8, max_staleness = $9
+	WHERE name = $10 RETURNING *`
+	return o.db.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment,
+		btr.MaxConcurrency, btr.RequestsPerSecond, btr.CacheTTL, btr.CircuitBreakerThreshold, btr.CircuitBreakerTimeout, btr.MaxStaleness,
+		bt.Name)
 }
 
 // --- External Initiator
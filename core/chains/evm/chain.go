@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/funding"
 	"github.com/smartcontractkit/chainlink/core/services/headtracker"
 	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
@@ -36,6 +37,8 @@ type Chain interface {
 	HeadBroadcaster() httypes.HeadBroadcaster
 	TxManager() bulletprooftxmanager.TxManager
 	HeadTracker() httypes.Tracker
+	// BalanceMonitor is nil if balances are not monitored
+	BalanceMonitor() services.BalanceMonitor
 	Logger() logger.Logger
 }
 
@@ -52,6 +55,7 @@ type chain struct {
 	headTracker     httypes.Tracker
 	logBroadcaster  log.Broadcaster
 	balanceMonitor  services.BalanceMonitor
+	fundingManager  funding.Manager
 	keyStore        keystore.Eth
 }
 
@@ -80,7 +84,7 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 		client = eth.NewNullClient(chainID, l)
 	} else if opts.GenEthClient == nil {
 		var err2 error
-		client, err2 = newEthClientFromChain(l, dbchain)
+		client, err2 = newEthClientFromChain(l, cfg, dbchain)
 		if err2 != nil {
 			return nil, errors.Wrapf(err2, "failed to instantiate eth client for chain with ID %s", dbchain.ID.String())
 		}
@@ -125,9 +129,13 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 	}
 
 	var balanceMonitor services.BalanceMonitor
+	var fundingManager funding.Manager
 	if !cfg.EthereumDisabled() && cfg.BalanceMonitorEnabled() {
 		balanceMonitor = services.NewBalanceMonitor(db, client, opts.KeyStore, l)
 		headBroadcaster.Subscribe(balanceMonitor)
+		// Top-ups rely on the balances of the balance monitor
+		fundingManager = funding.NewManager(*chainID, funding.NewORM(db), cfg, opts.KeyStore, balanceMonitor, client, txm, l)
+		headBroadcaster.Subscribe(fundingManager)
 	}
 
 	var logBroadcaster log.Broadcaster
@@ -156,6 +164,7 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 		headTracker,
 		logBroadcaster,
 		balanceMonitor,
+		fundingManager,
 		opts.KeyStore,
 	}
 	return &c, nil
@@ -180,6 +189,9 @@ func (c *chain) Start() error {
 		if c.balanceMonitor != nil {
 			merr = multierr.Combine(merr, c.balanceMonitor.Start())
 		}
+		if c.fundingManager != nil {
+			merr = multierr.Combine(merr, c.fundingManager.Start())
+		}
 
 		if merr != nil {
 			return merr
@@ -229,9 +241,13 @@ func (c *chain) Close() error {
 	return c.StopOnce("Chain", func() (merr error) {
 		c.logger.Debug("Chain: stopping")
 
+		if c.fundingManager != nil {
+			c.logger.Debug("Chain: stopping funding manager")
+			merr = c.fundingManager.Close()
+		}
 		if c.balanceMonitor != nil {
 			c.logger.Debug("Chain: stopping balance monitor")
-			merr = c.balanceMonitor.Close()
+			merr = multierr.Combine(merr, c.balanceMonitor.Close())
 		}
 		c.logger.Debug("Chain: stopping logBroadcaster")
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
@@ -259,6 +275,9 @@ func (c *chain) Ready() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.fundingManager != nil {
+		merr = multierr.Combine(merr, c.fundingManager.Ready())
+	}
 	return
 }
 
@@ -273,6 +292,9 @@ func (c *chain) Healthy() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Healthy())
 	}
+	if c.fundingManager != nil {
+		merr = multierr.Combine(merr, c.fundingManager.Healthy())
+	}
 	return
 }
 
@@ -283,11 +305,12 @@ func (c *chain) LogBroadcaster() log.Broadcaster           { return c.logBroadca
 func (c *chain) HeadBroadcaster() httypes.HeadBroadcaster  { return c.headBroadcaster }
 func (c *chain) TxManager() bulletprooftxmanager.TxManager { return c.txm }
 func (c *chain) HeadTracker() httypes.Tracker              { return c.headTracker }
+func (c *chain) BalanceMonitor() services.BalanceMonitor   { return c.balanceMonitor }
 func (c *chain) Logger() logger.Logger                     { return c.logger }
 
 var ErrNoPrimaryNode = errors.New("no primary node found")
 
-func newEthClientFromChain(lggr logger.Logger, chain types.Chain) (eth.Client, error) {
+func newEthClientFromChain(lggr logger.Logger, cfg evmconfig.ChainScopedConfig, chain types.Chain) (eth.Client, error) {
 	nodes := chain.Nodes
 	chainID := big.Int(chain.ID)
 	var primaries []eth.Node
@@ -300,7 +323,7 @@ func newEthClientFromChain(lggr logger.Logger, chain types.Chain) (eth.Client, e
 			}
 			sendonlys = append(sendonlys, sendonly)
 		} else {
-			primary, err := newPrimary(lggr, node)
+			primary, err := newPrimary(lggr, cfg, node)
 			if err != nil {
 				return nil, err
 			}
@@ -313,16 +336,12 @@ func newEthClientFromChain(lggr logger.Logger, chain types.Chain) (eth.Client, e
 	return eth.NewClientWithNodes(lggr, primaries, sendonlys, &chainID)
 }
 
-func newPrimary(lggr logger.Logger, n types.Node) (eth.Node, error) {
+func newPrimary(lggr logger.Logger, cfg evmconfig.ChainScopedConfig, n types.Node) (eth.Node, error) {
 	if n.SendOnly {
 		return nil, errors.New("cannot cast send-only node to primary")
 	}
-	if !n.WSURL.Valid {
-		return nil, errors.New("primary node was missing WS url")
-	}
-	wsuri, err := url.Parse(n.WSURL.String)
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid websocket uri")
+	if !n.WSURL.Valid && !n.HTTPURL.Valid {
+		return nil, errors.New("primary node was missing both WS and HTTP url")
 	}
 	var httpuri *url.URL
 	if n.HTTPURL.Valid {
@@ -332,6 +351,14 @@ func newPrimary(lggr logger.Logger, n types.Node) (eth.Node, error) {
 		}
 		httpuri = u
 	}
+	if !n.WSURL.Valid {
+		lggr.Infow("Primary node has no websocket URL, heads and logs will be polled over HTTP", "nodeName", n.Name, "pollInterval", cfg.EvmHTTPPollInterval())
+		return eth.NewHTTPOnlyNode(lggr, *httpuri, n.Name, cfg.EvmHTTPPollInterval()), nil
+	}
+	wsuri, err := url.Parse(n.WSURL.String)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid websocket uri")
+	}
 
 	return eth.NewNode(lggr, *wsuri, httpuri, n.Name), nil
 }
@@ -31,6 +31,7 @@ type (
 		ethTxReaperInterval                        time.Duration
 		ethTxReaperThreshold                       time.Duration
 		ethTxResendAfterThreshold                  time.Duration
+		feeHistoryEstimatorBlockCount              uint16
 		finalityDepth                              uint32
 		flagsContractAddress                       string
 		gasBumpPercent                             uint16
@@ -47,12 +48,17 @@ type (
 		headTrackerHistoryDepth                    uint32
 		headTrackerMaxBufferSize                   uint32
 		headTrackerSamplingInterval                time.Duration
+		httpPollInterval                           time.Duration
 		linkContractAddress                        string
 		logBackfillBatchSize                       uint32
+		logPollInterval                            time.Duration
+		logPollerEnabled                           bool
 		maxGasPriceWei                             big.Int
 		maxInFlightTransactions                    uint32
 		maxQueuedTransactions                      uint64
+		maxTxBatchSize                             uint32
 		minGasPriceWei                             big.Int
+		minSendingKeyBalanceWei                    big.Int
 		minIncomingConfirmations                   uint32
 		minRequiredOutgoingConfirmations           uint64
 		minimumContractPayment                     *assets.Link
@@ -97,6 +103,7 @@ func setChainSpecificConfigDefaultSets() {
 		ethTxReaperInterval:              1 * time.Hour,
 		ethTxReaperThreshold:             168 * time.Hour,
 		ethTxResendAfterThreshold:        1 * time.Minute,
+		feeHistoryEstimatorBlockCount:    20,
 		finalityDepth:                    50,
 		gasBumpPercent:                   20,
 		gasBumpThreshold:                 3,
@@ -112,12 +119,17 @@ func setChainSpecificConfigDefaultSets() {
 		headTrackerHistoryDepth:          100,
 		headTrackerMaxBufferSize:         3,
 		headTrackerSamplingInterval:      1 * time.Second,
+		httpPollInterval:                 5 * time.Second,
 		linkContractAddress:              "",
 		logBackfillBatchSize:             100,
+		logPollInterval:                  15 * time.Second,
+		logPollerEnabled:                 false,
 		maxGasPriceWei:                   *assets.GWei(5000),
 		maxInFlightTransactions:          16,
 		maxQueuedTransactions:            250,
+		maxTxBatchSize:                   50,
 		minGasPriceWei:                   *assets.GWei(1),
+		minSendingKeyBalanceWei:          *big.NewInt(0),
 		minIncomingConfirmations:         3,
 		minRequiredOutgoingConfirmations: 12,
 		minimumContractPayment:           DefaultMinimumContractPayment,
@@ -50,16 +50,26 @@ type ChainScopedOnlyConfig interface {
 	EvmHeadTrackerHistoryDepth() uint32
 	EvmHeadTrackerMaxBufferSize() uint32
 	EvmHeadTrackerSamplingInterval() time.Duration
+	EvmHTTPPollInterval() time.Duration
 	EvmLogBackfillBatchSize() uint32
+	EvmLogPollInterval() time.Duration
+	EvmLogPollerEnabled() bool
 	EvmMaxGasPriceWei() *big.Int
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
+	EvmMaxTxBatchSize() uint32
 	EvmMinGasPriceWei() *big.Int
+	EvmMinSendingKeyBalanceWei() *big.Int
 	EvmNonceAutoSync() bool
 	EvmRPCDefaultBatchSize() uint32
 	FlagsContractAddress() string
+	FeeHistoryEstimatorBlockCount() uint16
+	FundingDailyCapWei() *big.Int
+	FundingTreasuryAddress() string
 	GasEstimatorMode() string
 	ChainType() chains.ChainType
+	KeySpecificFundingMinBalanceWei(addr gethcommon.Address) *big.Int
+	KeySpecificFundingTopUpAmountWei(addr gethcommon.Address) *big.Int
 	KeySpecificMaxGasPriceWei(addr gethcommon.Address) *big.Int
 	LinkContractAddress() string
 	MinIncomingConfirmations() uint32
@@ -140,12 +150,25 @@ func (c *chainScopedConfig) validate() (err error) {
 	if c.GasEstimatorMode() == "BlockHistory" && c.BlockHistoryEstimatorBlockHistorySize() <= 0 {
 		err = multierr.Combine(err, errors.New("BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE must be greater than or equal to 1 if block history estimator is enabled"))
 	}
+	if c.GasEstimatorMode() == "FeeHistory" && c.FeeHistoryEstimatorBlockCount() <= 0 {
+		err = multierr.Combine(err, errors.New("FEE_HISTORY_ESTIMATOR_BLOCK_COUNT must be greater than or equal to 1 if fee history estimator is enabled"))
+	}
 	if c.EvmFinalityDepth() < 1 {
 		err = multierr.Combine(err, errors.New("ETH_FINALITY_DEPTH must be greater than or equal to 1"))
 	}
 	if c.MinIncomingConfirmations() < 1 {
 		err = multierr.Combine(err, errors.New("MIN_INCOMING_CONFIRMATIONS must be greater than or equal to 1"))
 	}
+	if treasury := c.FundingTreasuryAddress(); treasury != "" {
+		if !gethcommon.IsHexAddress(treasury) {
+			err = multierr.Combine(err, errors.Errorf("FundingTreasuryAddress %q is not a valid address", treasury))
+		}
+		// Without a cap, a misconfigured or compromised key could drain the
+		// treasury through top-ups
+		if c.FundingDailyCapWei() == nil {
+			err = multierr.Combine(err, errors.New("FundingDailyCapWei must be set if FundingTreasuryAddress is set"))
+		}
+	}
 	lc := ocrtypes.LocalConfig{
 		BlockchainTimeout:                      c.OCRBlockchainTimeout(),
 		ContractConfigConfirmations:            c.OCRContractConfirmations(),
@@ -320,6 +343,17 @@ func (c *chainScopedConfig) EvmMaxQueuedTransactions() uint64 {
 	return c.defaultSet.maxQueuedTransactions
 }
 
+// EvmMaxTxBatchSize is the maximum number of queued transactions sent using
+// a batching strategy that are merged into a single multicall transaction
+func (c *chainScopedConfig) EvmMaxTxBatchSize() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmMaxTxBatchSize()
+	if ok {
+		c.logEnvOverrideOnce("EvmMaxTxBatchSize", val)
+		return val
+	}
+	return c.defaultSet.maxTxBatchSize
+}
+
 // EvmMinGasPriceWei is the minimum amount in Wei that a transaction may be priced.
 // Chainlink will never send a transaction priced below this amount.
 func (c *chainScopedConfig) EvmMinGasPriceWei() *big.Int {
@@ -332,6 +366,18 @@ func (c *chainScopedConfig) EvmMinGasPriceWei() *big.Int {
 	return &n
 }
 
+// EvmMinSendingKeyBalanceWei is the balance below which a sending key is
+// skipped when choosing between several keys to send a transaction from
+func (c *chainScopedConfig) EvmMinSendingKeyBalanceWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalEvmMinSendingKeyBalanceWei()
+	if ok {
+		c.logEnvOverrideOnce("EvmMinSendingKeyBalanceWei", val)
+		return val
+	}
+	n := c.defaultSet.minSendingKeyBalanceWei
+	return &n
+}
+
 // EvmGasLimitDefault sets the default gas limit for outgoing transactions.
 func (c *chainScopedConfig) EvmGasLimitDefault() uint64 {
 	val, ok := c.GeneralConfig.GlobalEvmGasLimitDefault()
@@ -462,6 +508,21 @@ func (c *chainScopedConfig) EvmHeadTrackerSamplingInterval() time.Duration {
 	return c.defaultSet.headTrackerSamplingInterval
 }
 
+// EvmHTTPPollInterval is how often primary nodes that have no websocket URL
+// are polled for new heads and logs, in place of websocket subscriptions
+func (c *chainScopedConfig) EvmHTTPPollInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmHTTPPollInterval()
+	if ok {
+		c.logEnvOverrideOnce("EvmHTTPPollInterval", val)
+		return val
+	}
+	if c.persistedCfg.EvmHTTPPollInterval != nil {
+		c.logPersistedOverrideOnce("EvmHTTPPollInterval", c.persistedCfg.EvmHTTPPollInterval.Duration())
+		return c.persistedCfg.EvmHTTPPollInterval.Duration()
+	}
+	return c.defaultSet.httpPollInterval
+}
+
 // BlockEmissionIdleWarningThreshold is the duration of time since last received head
 // to print a warning log message indicating not receiving heads
 func (c *chainScopedConfig) BlockEmissionIdleWarningThreshold() time.Duration {
@@ -581,6 +642,18 @@ func (c *chainScopedConfig) BlockHistoryEstimatorTransactionPercentile() uint16
 	return c.defaultSet.blockHistoryEstimatorTransactionPercentile
 }
 
+// FeeHistoryEstimatorBlockCount is the number of recent blocks the fee history
+// estimator requests from eth_feeHistory to derive base fee trends and tip
+// percentiles
+func (c *chainScopedConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorBlockCount()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorBlockCount", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryEstimatorBlockCount
+}
+
 // GasEstimatorMode controls what type of gas estimator is used
 func (c *chainScopedConfig) GasEstimatorMode() string {
 	val, ok := c.GeneralConfig.GlobalGasEstimatorMode()
@@ -619,6 +692,58 @@ func (c *chainScopedConfig) KeySpecificMaxGasPriceWei(addr gethcommon.Address) *
 	return c.EvmMaxGasPriceWei()
 }
 
+// FundingTreasuryAddress is the key that tops up the other sending keys on
+// the chain when their balance drops below their funding minimum balance.
+// Automatic top-ups are disabled if it is empty. The key is reserved for
+// top-ups and must not be used by jobs.
+func (c *chainScopedConfig) FundingTreasuryAddress() string {
+	if c.persistedCfg.FundingTreasuryAddress.Valid {
+		c.logPersistedOverrideOnce("FundingTreasuryAddress", c.persistedCfg.FundingTreasuryAddress.String)
+		return c.persistedCfg.FundingTreasuryAddress.String
+	}
+	return ""
+}
+
+// FundingDailyCapWei is the most the treasury key may send in top-ups over
+// any 24 hours. It must be set if FundingTreasuryAddress is.
+func (c *chainScopedConfig) FundingDailyCapWei() *big.Int {
+	if c.persistedCfg.FundingDailyCapWei != nil {
+		c.logPersistedOverrideOnce("FundingDailyCapWei", c.persistedCfg.FundingDailyCapWei)
+		return c.persistedCfg.FundingDailyCapWei.ToInt()
+	}
+	return nil
+}
+
+// KeySpecificFundingMinBalanceWei is the balance below which the key is
+// topped up by the treasury key, or nil if it is never topped up
+func (c *chainScopedConfig) KeySpecificFundingMinBalanceWei(addr gethcommon.Address) *big.Int {
+	keySpecific := c.persistedCfg.KeySpecific[addr.Hex()].FundingMinBalanceWei
+	if keySpecific != nil {
+		c.logKeySpecificOverrideOnce("FundingMinBalanceWei", addr, keySpecific)
+		return keySpecific.ToInt()
+	}
+	if c.persistedCfg.FundingMinBalanceWei != nil {
+		c.logPersistedOverrideOnce("FundingMinBalanceWei", c.persistedCfg.FundingMinBalanceWei)
+		return c.persistedCfg.FundingMinBalanceWei.ToInt()
+	}
+	return nil
+}
+
+// KeySpecificFundingTopUpAmountWei is the amount sent to the key by the
+// treasury key each time it is topped up, or nil if it is never topped up
+func (c *chainScopedConfig) KeySpecificFundingTopUpAmountWei(addr gethcommon.Address) *big.Int {
+	keySpecific := c.persistedCfg.KeySpecific[addr.Hex()].FundingTopUpAmountWei
+	if keySpecific != nil {
+		c.logKeySpecificOverrideOnce("FundingTopUpAmountWei", addr, keySpecific)
+		return keySpecific.ToInt()
+	}
+	if c.persistedCfg.FundingTopUpAmountWei != nil {
+		c.logPersistedOverrideOnce("FundingTopUpAmountWei", c.persistedCfg.FundingTopUpAmountWei)
+		return c.persistedCfg.FundingTopUpAmountWei.ToInt()
+	}
+	return nil
+}
+
 func (c *chainScopedConfig) ChainType() chains.ChainType {
 	val, ok := c.GeneralConfig.GlobalChainType()
 	if ok {
@@ -841,6 +966,36 @@ func (c *chainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	return c.defaultSet.logBackfillBatchSize
 }
 
+// EvmLogPollInterval is how often the log poller calls eth_getLogs for new
+// blocks, when ETH_LOG_POLLER_ENABLED is set
+func (c *chainScopedConfig) EvmLogPollInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmLogPollInterval()
+	if ok {
+		c.logEnvOverrideOnce("EvmLogPollInterval", val)
+		return val
+	}
+	if c.persistedCfg.EvmLogPollInterval != nil {
+		c.logPersistedOverrideOnce("EvmLogPollInterval", c.persistedCfg.EvmLogPollInterval.Duration())
+		return c.persistedCfg.EvmLogPollInterval.Duration()
+	}
+	return c.defaultSet.logPollInterval
+}
+
+// EvmLogPollerEnabled makes the log broadcaster poll eth_getLogs for new logs
+// instead of subscribing to them over the websocket
+func (c *chainScopedConfig) EvmLogPollerEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmLogPollerEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmLogPollerEnabled", val)
+		return val
+	}
+	if c.persistedCfg.EvmLogPollerEnabled.Valid {
+		c.logPersistedOverrideOnce("EvmLogPollerEnabled", c.persistedCfg.EvmLogPollerEnabled.Bool)
+		return c.persistedCfg.EvmLogPollerEnabled.Bool
+	}
+	return c.defaultSet.logPollerEnabled
+}
+
 // EvmRPCDefaultBatchSize controls the number of receipts fetched in each
 // request in the EthConfirmer
 func (c *chainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
@@ -171,6 +171,22 @@ func Test_chainScopedConfig_Validate(t *testing.T) {
 		})
 	})
 
+	t.Run("funding treasury without a daily cap", func(t *testing.T) {
+		gcfg := cltest.NewTestGeneralConfig(t)
+		lggr := logger.TestLogger(t)
+		treasury := null.StringFrom("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+		cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{
+			FundingTreasuryAddress: treasury,
+		}, nil, lggr, gcfg)
+		assert.EqualError(t, cfg.Validate(), "FundingDailyCapWei must be set if FundingTreasuryAddress is set")
+
+		cfg = evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{
+			FundingTreasuryAddress: treasury,
+			FundingDailyCapWei:     utils.NewBigI(1e18),
+		}, nil, lggr, gcfg)
+		assert.NoError(t, cfg.Validate())
+	})
+
 	t.Run("optimism-estimator", func(t *testing.T) {
 		t.Run("custom", func(t *testing.T) {
 			gcfg := cltest.NewTestGeneralConfig(t)
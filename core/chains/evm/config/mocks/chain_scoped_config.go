@@ -533,6 +533,36 @@ func (_m *ChainScopedConfig) EVMDisabled() bool {
 	return r0
 }
 
+// EthExternalSignerTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthExternalSignerTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthExternalSignerURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthExternalSignerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // EthTxReaperInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -841,6 +871,20 @@ func (_m *ChainScopedConfig) EvmGasTipCapMinimum() *big.Int {
 	return r0
 }
 
+// EvmHTTPPollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHTTPPollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmHeadTrackerHistoryDepth provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmHeadTrackerHistoryDepth() uint32 {
 	ret := _m.Called()
@@ -897,6 +941,34 @@ func (_m *ChainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	return r0
 }
 
+// EvmLogPollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogPollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmLogPollerEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogPollerEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmMaxGasPriceWei provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmMaxGasPriceWei() *big.Int {
 	ret := _m.Called()
@@ -941,6 +1013,20 @@ func (_m *ChainScopedConfig) EvmMaxQueuedTransactions() uint64 {
 	return r0
 }
 
+// EvmMaxTxBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMaxTxBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // EvmMinGasPriceWei provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmMinGasPriceWei() *big.Int {
 	ret := _m.Called()
@@ -957,6 +1043,22 @@ func (_m *ChainScopedConfig) EvmMinGasPriceWei() *big.Int {
 	return r0
 }
 
+// EvmMinSendingKeyBalanceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmMinSendingKeyBalanceWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // EvmNonceAutoSync provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmNonceAutoSync() bool {
 	ret := _m.Called()
@@ -1113,6 +1215,20 @@ func (_m *ChainScopedConfig) FeatureUIFeedsManager() bool {
 	return r0
 }
 
+// FeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // FlagsContractAddress provides a mock function with given fields:
 func (_m *ChainScopedConfig) FlagsContractAddress() string {
 	ret := _m.Called()
@@ -1127,6 +1243,36 @@ func (_m *ChainScopedConfig) FlagsContractAddress() string {
 	return r0
 }
 
+// FundingDailyCapWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) FundingDailyCapWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// FundingTreasuryAddress provides a mock function with given fields:
+func (_m *ChainScopedConfig) FundingTreasuryAddress() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GasEstimatorMode provides a mock function with given fields:
 func (_m *ChainScopedConfig) GasEstimatorMode() string {
 	ret := _m.Called()
@@ -1660,6 +1806,27 @@ func (_m *ChainScopedConfig) GlobalEvmGasTipCapMinimum() (*big.Int, bool) {
 	return r0, r1
 }
 
+// GlobalEvmHTTPPollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmHTTPPollInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmHeadTrackerHistoryDepth provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	ret := _m.Called()
@@ -1744,6 +1911,48 @@ func (_m *ChainScopedConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmLogPollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmLogPollerEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmLogPollerEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmMaxGasPriceWei provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	ret := _m.Called()
@@ -1809,6 +2018,27 @@ func (_m *ChainScopedConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 	return r0, r1
 }
 
+// GlobalEvmMaxTxBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMaxTxBatchSize() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmMinGasPriceWei provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	ret := _m.Called()
@@ -1832,6 +2062,29 @@ func (_m *ChainScopedConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	return r0, r1
 }
 
+// GlobalEvmMinSendingKeyBalanceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMinSendingKeyBalanceWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmNonceAutoSync provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	ret := _m.Called()
@@ -1874,6 +2127,27 @@ func (_m *ChainScopedConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalFeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalFeeHistoryEstimatorBlockCount() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalFlagsContractAddress provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalFlagsContractAddress() (string, bool) {
 	ret := _m.Called()
@@ -2093,6 +2367,20 @@ func (_m *ChainScopedConfig) JSONConsole() bool {
 	return r0
 }
 
+// JobPipelineArchiveDir provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineArchiveDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *ChainScopedConfig) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -2107,6 +2395,20 @@ func (_m *ChainScopedConfig) JobPipelineMaxRunDuration() time.Duration {
 	return r0
 }
 
+// JobPipelineReaperBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineReaperBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) JobPipelineReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -2289,6 +2591,52 @@ func (_m *ChainScopedConfig) KeyFile() string {
 	return r0
 }
 
+// KeyRotationGracePeriod provides a mock function with given fields:
+func (_m *ChainScopedConfig) KeyRotationGracePeriod() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// KeySpecificFundingMinBalanceWei provides a mock function with given fields: addr
+func (_m *ChainScopedConfig) KeySpecificFundingMinBalanceWei(addr common.Address) *big.Int {
+	ret := _m.Called(addr)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(common.Address) *big.Int); ok {
+		r0 = rf(addr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
+// KeySpecificFundingTopUpAmountWei provides a mock function with given fields: addr
+func (_m *ChainScopedConfig) KeySpecificFundingTopUpAmountWei(addr common.Address) *big.Int {
+	ret := _m.Called(addr)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(common.Address) *big.Int); ok {
+		r0 = rf(addr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // KeySpecificMaxGasPriceWei provides a mock function with given fields: addr
 func (_m *ChainScopedConfig) KeySpecificMaxGasPriceWei(addr common.Address) *big.Int {
 	ret := _m.Called(addr)
@@ -3329,6 +3677,20 @@ func (_m *ChainScopedConfig) TLSRedirect() bool {
 	return r0
 }
 
+// TelemetryIngressBufferSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryIngressBufferSize() uint {
+	ret := _m.Called()
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func() uint); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	return r0
+}
+
 // TelemetryIngressLogging provides a mock function with given fields:
 func (_m *ChainScopedConfig) TelemetryIngressLogging() bool {
 	ret := _m.Called()
@@ -3343,6 +3705,34 @@ func (_m *ChainScopedConfig) TelemetryIngressLogging() bool {
 	return r0
 }
 
+// TelemetryIngressMaxBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryIngressMaxBatchSize() uint {
+	ret := _m.Called()
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func() uint); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	return r0
+}
+
+// TelemetryIngressSendInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryIngressSendInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // TelemetryIngressServerPubKey provides a mock function with given fields:
 func (_m *ChainScopedConfig) TelemetryIngressServerPubKey() string {
 	ret := _m.Called()
@@ -3357,6 +3747,34 @@ func (_m *ChainScopedConfig) TelemetryIngressServerPubKey() string {
 	return r0
 }
 
+// TelemetryIngressSpoolDir provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryIngressSpoolDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryIngressSpoolMaxSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryIngressSpoolMaxSize() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // TelemetryIngressURL provides a mock function with given fields:
 func (_m *ChainScopedConfig) TelemetryIngressURL() *url.URL {
 	ret := _m.Called()
@@ -3373,6 +3791,48 @@ func (_m *ChainScopedConfig) TelemetryIngressURL() *url.URL {
 	return r0
 }
 
+// TelemetryIngressUseBatchSend provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryIngressUseBatchSend() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TelemetryLocalEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryLocalEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TelemetryLocalRetention provides a mock function with given fields:
+func (_m *ChainScopedConfig) TelemetryLocalRetention() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
@@ -36,14 +36,22 @@ func ClobberDBFromEnv(db *gorm.DB, config LegacyEthNodeConfig) error {
 	}
 
 	stmt := `INSERT INTO nodes (name, evm_chain_id, ws_url, http_url, send_only, created_at, updated_at) VALUES (?,?,?,?,?,NOW(),NOW())`
-	primaryWS := config.EthereumURL()
-	if primaryWS == "" {
+	primaryURL := config.EthereumURL()
+	if primaryURL == "" {
 		return errors.New("ETH_URL must be specified (or set USE_LEGACY_ETH_ENV_VARS=false)")
 	}
+	primaryWS := null.StringFrom(primaryURL)
 	var primaryHTTP null.String
 	if config.EthereumHTTPURL() != nil {
 		primaryHTTP = null.StringFrom(config.EthereumHTTPURL().String())
 	}
+	// An http(s) ETH_URL makes the primary HTTP-only
+	if u, err := url.Parse(primaryURL); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		if primaryHTTP.Valid {
+			return errors.New("ETH_HTTP_URL must not be set if ETH_URL is an HTTP URL")
+		}
+		primaryWS, primaryHTTP = null.String{}, primaryWS
+	}
 	if err := db.Exec(stmt, fmt.Sprintf("primary-0-%s", ethChainID), ethChainID, primaryWS, primaryHTTP, false, ethChainID, primaryWS, primaryHTTP).Error; err != nil {
 		return errors.Wrap(err, "failed to upsert primary-0")
 	}
@@ -6,6 +6,7 @@ import (
 	big "math/big"
 
 	config "github.com/smartcontractkit/chainlink/core/chains/evm/config"
+	services "github.com/smartcontractkit/chainlink/core/services"
 	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 
 	eth "github.com/smartcontractkit/chainlink/core/services/eth"
@@ -24,6 +25,22 @@ type Chain struct {
 	mock.Mock
 }
 
+// BalanceMonitor provides a mock function with given fields:
+func (_m *Chain) BalanceMonitor() services.BalanceMonitor {
+	ret := _m.Called()
+
+	var r0 services.BalanceMonitor
+	if rf, ok := ret.Get(0).(func() services.BalanceMonitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(services.BalanceMonitor)
+		}
+	}
+
+	return r0
+}
+
 // Client provides a mock function with given fields:
 func (_m *Chain) Client() eth.Client {
 	ret := _m.Called()
@@ -59,11 +59,18 @@ type ChainCfg struct {
 	EvmHeadTrackerHistoryDepth            null.Int
 	EvmHeadTrackerMaxBufferSize           null.Int
 	EvmHeadTrackerSamplingInterval        *models.Duration
+	EvmHTTPPollInterval                   *models.Duration
 	EvmLogBackfillBatchSize               null.Int
+	EvmLogPollInterval                    *models.Duration
+	EvmLogPollerEnabled                   null.Bool
 	EvmMaxGasPriceWei                     *utils.Big
 	EvmNonceAutoSync                      null.Bool
 	EvmRPCDefaultBatchSize                null.Int
 	FlagsContractAddress                  null.String
+	FundingDailyCapWei                    *utils.Big
+	FundingMinBalanceWei                  *utils.Big
+	FundingTopUpAmountWei                 *utils.Big
+	FundingTreasuryAddress                null.String
 	GasEstimatorMode                      null.String
 	ChainType                             null.String
 	MinIncomingConfirmations              null.Int
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type UserPresenter struct {
+	JAID
+	presenters.UserResource
+}
+
+var userHeaders = []string{"Email", "Role", "Has API token", "Created at", "Updated at"}
+
+// RenderTable implements TableRenderer
+func (p *UserPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	if _, err := rt.Write([]byte("👤 User\n")); err != nil {
+		return err
+	}
+	renderList(userHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+func (p *UserPresenter) ToRow() []string {
+	return []string{
+		p.Email,
+		string(p.Role),
+		strconv.FormatBool(p.HasActiveAPIToken),
+		p.CreatedAt.String(),
+		p.UpdatedAt.String(),
+	}
+}
+
+type UserPresenters []UserPresenter
+
+// RenderTable implements TableRenderer
+func (ps UserPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("👤 Users\n")); err != nil {
+		return err
+	}
+	renderList(userHeaders, rows, rt.Writer)
+
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListUsers renders all API users and their roles
+func (cli *Client) ListUsers(c *clipkg.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/users", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &UserPresenters{})
+}
+
+// CreateUser creates a new API user with the given email and role. The
+// password is prompted for.
+func (cli *Client) CreateUser(c *clipkg.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return cli.errorOut(errors.New("must specify an --email"))
+	}
+	role, err := sessions.GetUserRole(c.String("role"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Println("Enter the password for the new user")
+	request := web.CreateUserRequest{
+		Email:    email,
+		Role:     string(role),
+		Password: cli.PasswordPrompter.Prompt(),
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/users", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &UserPresenter{}, "Successfully created new API user")
+}
+
+// ChangeRole changes the role of an existing API user
+func (cli *Client) ChangeRole(c *clipkg.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return cli.errorOut(errors.New("must specify an --email"))
+	}
+	role, err := sessions.GetUserRole(c.String("newrole"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request := web.UpdateRoleRequest{
+		Email:   email,
+		NewRole: string(role),
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/users", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &UserPresenter{}, "Successfully updated API user")
+}
+
+// RemoveUser deletes an API user and their sessions
+func (cli *Client) RemoveUser(c *clipkg.Context) (err error) {
+	email := c.String("email")
+	if email == "" {
+		return cli.errorOut(errors.New("must specify an --email"))
+	}
+
+	resp, err := cli.HTTP.Delete("/v2/users/" + url.PathEscape(email))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(resp); err != nil {
+		return err
+	}
+	fmt.Printf("Deleted API user %s\n", email)
+	return nil
+}
@@ -0,0 +1,83 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestUserPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		email  = "viewer@chainlink.test"
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.UserPresenter{
+		JAID: cmd.JAID{ID: email},
+		UserResource: presenters.UserResource{
+			JAID:  presenters.NewJAID(email),
+			Email: email,
+			Role:  sessions.UserRoleView,
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, email)
+	assert.Contains(t, output, "view")
+
+	buffer.Reset()
+	ps := cmd.UserPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+	output = buffer.String()
+	assert.Contains(t, output, email)
+}
+
+func TestClient_ManageUsers(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{Password: cltest.Password}
+
+	set := flag.NewFlagSet("test", 0)
+	set.String("email", "runner@chainlink.test", "")
+	set.String("role", "run", "")
+	require.NoError(t, client.CreateUser(cli.NewContext(nil, set, nil)))
+
+	user, err := app.SessionORM().FindUser("runner@chainlink.test")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleRun, user.Role)
+
+	require.NoError(t, client.ListUsers(cltest.EmptyCLIContext()))
+	users := *r.Renders[len(r.Renders)-1].(*cmd.UserPresenters)
+	assert.Len(t, users, 2)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("email", "runner@chainlink.test", "")
+	set.String("newrole", "edit", "")
+	require.NoError(t, client.ChangeRole(cli.NewContext(nil, set, nil)))
+
+	user, err = app.SessionORM().FindUser("runner@chainlink.test")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, user.Role)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("email", "runner@chainlink.test", "")
+	require.NoError(t, client.RemoveUser(cli.NewContext(nil, set, nil)))
+
+	_, err = app.SessionORM().FindUser("runner@chainlink.test")
+	require.Error(t, err)
+}
@@ -60,6 +60,58 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:  "users",
+					Usage: "Create, edit permissions, or delete API users",
+					Subcommands: []cli.Command{
+						{
+							Name:   "list",
+							Usage:  "Lists all API users and their roles",
+							Action: client.ListUsers,
+						},
+						{
+							Name:   "create",
+							Usage:  "Create a new API user, prompting for their password",
+							Action: client.CreateUser,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "email",
+									Usage: "email of the new user",
+								},
+								cli.StringFlag{
+									Name:  "role",
+									Usage: "role of the new user. Options: 'admin', 'edit', 'run', 'view'.",
+								},
+							},
+						},
+						{
+							Name:   "chrole",
+							Usage:  "Changes an API user's role",
+							Action: client.ChangeRole,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "email",
+									Usage: "email of the user to edit",
+								},
+								cli.StringFlag{
+									Name:  "newrole",
+									Usage: "new role of the user. Options: 'admin', 'edit', 'run', 'view'.",
+								},
+							},
+						},
+						{
+							Name:   "delete",
+							Usage:  "Delete an API user and their sessions",
+							Action: client.RemoveUser,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "email",
+									Usage: "email of the user to delete, optional if there is only one user",
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 
@@ -89,15 +141,40 @@ func NewApp(client *Client) *cli.App {
 			Subcommands: []cli.Command{
 				{
 					Name:   "replay",
-					Usage:  "Replays block data from the given number",
+					Usage:  "Replays block data from the given number, optionally only to the listeners of a job or contract",
 					Action: client.ReplayFromBlock,
 					Flags: []cli.Flag{
 						cli.IntFlag{
 							Name:  "block-number",
 							Usage: "Block number to replay from",
 						},
+						cli.IntFlag{
+							Name:  "job-id",
+							Usage: "(optional) only replay logs to the listeners of this job",
+						},
+						cli.StringFlag{
+							Name:  "contract",
+							Usage: "(optional) only replay logs of this contract address",
+						},
+						cli.Int64Flag{
+							Name:  "to-block",
+							Usage: "(optional) block number to replay to, requires --job-id or --contract. Defaults to the latest block",
+						},
+						cli.BoolFlag{
+							Name:  "dry-run",
+							Usage: "(optional) list the logs that would be replayed without replaying them, requires --job-id or --contract",
+						},
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "(optional) specify the chain ID to replay",
+						},
 					},
 				},
+				{
+					Name:   "replay-status",
+					Usage:  "Shows the progress of a replay to a job or contract, and the logs a dry run would replay",
+					Action: client.ShowLogReplay,
+				},
 			},
 		},
 
@@ -232,11 +309,96 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Delete a job",
 					Action: client.DeleteJob,
 				},
+				{
+					Name:   "update",
+					Usage:  "Replace the spec of a job, keeping its ID and run history",
+					Action: client.UpdateJob,
+				},
+				{
+					Name:   "pause",
+					Usage:  "Stop a job's services without deleting it",
+					Action: client.PauseJob,
+				},
+				{
+					Name:   "resume",
+					Usage:  "Restart the services of a paused job",
+					Action: client.UnpauseJob,
+				},
 				{
 					Name:   "run",
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:   "simulate",
+					Usage:  "Run a job's pipeline on the node without creating the job or saving the run; ethtx tasks are never run",
+					Action: client.SimulateJob,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "dot",
+							Usage: "treat the input as a bare DOT pipeline instead of a TOML job spec",
+						},
+						cli.StringFlag{
+							Name:  "vars",
+							Usage: `input variables of the run as JSON, e.g. {"jobRun":{"requestBody":"{}"}}`,
+						},
+						cli.BoolFlag{
+							Name:  "stub-network",
+							Usage: "stub bridge and http tasks so that no requests are made",
+						},
+						cli.StringFlag{
+							Name:  "stubs",
+							Usage: `values to return from stubbed tasks as JSON, keyed by task name, e.g. {"ds1":"{\"price\":100}"}`,
+						},
+					},
+				},
+				{
+					Name:  "retention",
+					Usage: "Commands for managing how long job runs are kept",
+					Subcommands: []cli.Command{
+						{
+							Name:   "list",
+							Usage:  "List all run retention policies",
+							Action: client.ListRetentionPolicies,
+						},
+						{
+							Name:   "set",
+							Usage:  "Set the run retention policy of a job or job type, replacing any existing policy for it",
+							Action: client.SetRetentionPolicy,
+							Flags: []cli.Flag{
+								cli.Int64Flag{
+									Name:  "job",
+									Usage: "ID of the job the policy applies to",
+								},
+								cli.StringFlag{
+									Name:  "type",
+									Usage: "type of the jobs the policy applies to, e.g. fluxmonitor",
+								},
+								cli.Int64Flag{
+									Name:  "keep-last",
+									Usage: "number of most recent runs that are never deleted",
+								},
+								cli.StringFlag{
+									Name:  "max-age",
+									Usage: "how long finished runs are kept, e.g. 72h",
+								},
+								cli.StringFlag{
+									Name:  "errored-max-age",
+									Usage: "how long errored runs are kept; defaults to max-age",
+								},
+								cli.BoolFlag{
+									Name:  "archive",
+									Usage: "write runs to JOB_PIPELINE_ARCHIVE_DIR before deleting them",
+								},
+							},
+						},
+						{
+							Name:   "delete",
+							Usage:  "Delete a run retention policy",
+							Action: client.DeleteRetentionPolicy,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -256,6 +418,10 @@ func NewApp(client *Client) *cli.App {
 									Name:  "evmChainID",
 									Usage: "Chain ID for the key. If left blank, default chain will be used.",
 								},
+								cli.StringFlag{
+									Name:  "externalAddress",
+									Usage: "Address of a key held by the external signer set with ETH_EXTERNAL_SIGNER_URL. If set, no key is created and the node only stores the address.",
+								},
 							},
 						},
 						{
@@ -311,6 +477,21 @@ func NewApp(client *Client) *cli.App {
 					},
 				},
 
+				{
+					Name:   "rotate-password",
+					Usage:  "Re-encrypt every key of the keystore with a new password",
+					Action: client.RotateKeystorePassword,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "oldpassword",
+							Usage: "`FILE` containing the current password of the keystore",
+						},
+						cli.StringFlag{
+							Name:  "newpassword",
+							Usage: "`FILE` containing the new password of the keystore",
+						},
+					},
+				},
 				{
 					Name:  "p2p",
 					Usage: "Remote commands for administering the node's p2p keys",
@@ -366,6 +547,17 @@ func NewApp(client *Client) *cli.App {
 							},
 							Action: client.ExportP2PKey,
 						},
+						{
+							Name:  "rotate",
+							Usage: format(`Replaces the P2P key matching the given ID by a new key, and updates the jobs using it`),
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "yes, y",
+									Usage: "skip the confirmation prompt",
+								},
+							},
+							Action: client.RotateP2PKey,
+						},
 					},
 				},
 
@@ -383,6 +575,17 @@ func NewApp(client *Client) *cli.App {
 							Usage:  format(`List available CSA keys`),
 							Action: client.ListCSAKeys,
 						},
+						{
+							Name:  "rotate",
+							Usage: format(`Replaces the CSA key matching the given ID by a new key, and reconnects to the feeds managers with it`),
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "yes, y",
+									Usage: "skip the confirmation prompt",
+								},
+							},
+							Action: client.RotateCSAKey,
+						},
 					},
 				},
 
@@ -441,6 +644,17 @@ func NewApp(client *Client) *cli.App {
 							},
 							Action: client.ExportOCRKey,
 						},
+						{
+							Name:  "rotate",
+							Usage: format(`Replaces the OCR key bundle matching the given ID by a new bundle, and updates the jobs using it`),
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "yes, y",
+									Usage: "skip the confirmation prompt",
+								},
+							},
+							Action: client.RotateOCRKeyBundle,
+						},
 					},
 				},
 
@@ -512,9 +726,15 @@ func NewApp(client *Client) *cli.App {
 			Subcommands: []cli.Command{
 				{
 					Name:        "deleteuser",
-					Usage:       "Erase the *local node's* user and corresponding session to force recreation on next node launch.",
+					Usage:       "Erase a user of the *local node* and their sessions. If it is the last user, one will be created on next node launch.",
 					Description: "Does not work remotely over API.",
 					Action:      client.DeleteUser,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "email",
+							Usage: "email of the user to delete, optional if there is only one user",
+						},
+					},
 				},
 				{
 					Name:   "setnextnonce",
@@ -590,6 +810,17 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:   "restore-runs",
+					Usage:  "Restore job runs from an archive written by the run reaper",
+					Action: client.RestoreRuns,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "file, f",
+							Usage: "path to the archive",
+						},
+					},
+				},
 				{
 					Name:   "status",
 					Usage:  "Displays the health of various services running inside the node.",
@@ -709,6 +940,55 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "get information on a specific Ethereum Transaction",
 					Action: client.ShowTransaction,
 				},
+				{
+					Name:   "cancel",
+					Usage:  "Replace an unconfirmed Ethereum Transaction with a zero-value send to itself at the same nonce",
+					Action: client.CancelTransaction,
+				},
+				{
+					Name:   "bump",
+					Usage:  "Send a new attempt of an unconfirmed Ethereum Transaction with a higher gas price now",
+					Action: client.BumpTransaction,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "gas-price-wei",
+							Usage: "gas price to use, legacy transactions only. Defaults to bumping the gas price as the node would",
+						},
+					},
+				},
+				{
+					Name:   "abandon",
+					Usage:  "Mark an Ethereum Transaction stuck at its nonce as fatally errored, so the nonce is reused by the next transaction",
+					Action: client.AbandonTransaction,
+				},
+				{
+					Name:   "gas-spend",
+					Usage:  "Report the fees paid by confirmed Ethereum Transactions per job, key or day",
+					Action: client.ShowGasSpend,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "group-by",
+							Usage: "break down the fees by job, key or day",
+							Value: "job",
+						},
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "only report the fees paid on this chain",
+						},
+						cli.IntFlag{
+							Name:  "job-id",
+							Usage: "only report the fees paid by this job",
+						},
+						cli.StringFlag{
+							Name:  "from",
+							Usage: "start of the report, as an RFC3339 time or a date. Defaults to 30 days before the end",
+						},
+						cli.StringFlag{
+							Name:  "to",
+							Usage: "end of the report, as an RFC3339 time or a date. Defaults to now",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -770,11 +1050,11 @@ func NewApp(client *Client) *cli.App {
 						},
 						cli.StringFlag{
 							Name:  "ws-url",
-							Usage: "Websocket URL",
+							Usage: "Websocket URL, optional for primary nodes that have an HTTP URL",
 						},
 						cli.StringFlag{
 							Name:  "http-url",
-							Usage: "HTTP URL, optional",
+							Usage: "HTTP URL, optional for primary nodes that have a websocket URL",
 						},
 						cli.Int64Flag{
 							Name:  "chain-id",
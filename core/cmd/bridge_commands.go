@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -28,9 +29,31 @@ func (p *BridgePresenter) RenderTable(rt RendererTable) error {
 		p.OutgoingToken,
 	})
 	render("Bridge", table)
+
+	limits := rt.newTable([]string{"Max Concurrency", "Requests Per Second", "Cache TTL", "Circuit Breaker", "Max Staleness"})
+	limits.Append(p.FriendlyLimits())
+	render("Limits", limits)
 	return nil
 }
 
+// FriendlyLimits converts the bridge limits to strings, showing the ones
+// that are not set as "none"
+func (p *BridgePresenter) FriendlyLimits() []string {
+	orNone := func(isSet bool, s string) string {
+		if !isSet {
+			return "none"
+		}
+		return s
+	}
+	return []string{
+		orNone(p.MaxConcurrency > 0, strconv.FormatUint(uint64(p.MaxConcurrency), 10)),
+		orNone(p.RequestsPerSecond > 0, strconv.FormatFloat(p.RequestsPerSecond, 'f', -1, 64)),
+		orNone(p.CacheTTL > 0, p.CacheTTL.Duration().String()),
+		orNone(p.CircuitBreakerThreshold > 0, fmt.Sprintf("open for %s after %d failures", p.CircuitBreakerTimeout.Duration(), p.CircuitBreakerThreshold)),
+		orNone(p.MaxStaleness > 0, p.MaxStaleness.Duration().String()),
+	}
+}
+
 type BridgePresenters []BridgePresenter
 
 // RenderTable implements TableRenderer
@@ -9,6 +9,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -34,7 +35,12 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 			URL:           url,
 			Confirmations: 10,
 			OutgoingToken: outgoingToken,
-			CreatedAt:     createdAt,
+			BridgeLimits: bridges.BridgeLimits{
+				RequestsPerSecond:       2.5,
+				CircuitBreakerThreshold: 3,
+				CircuitBreakerTimeout:   models.Interval(time.Minute),
+			},
+			CreatedAt: createdAt,
 		},
 	}
 
@@ -46,6 +52,9 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "2.5")
+	assert.Contains(t, output, "open for 1m0s after 3 failures")
+	assert.Contains(t, output, "none")
 
 	// Render many resources
 	buffer.Reset()
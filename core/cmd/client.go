@@ -171,6 +171,13 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig) (chainlink
 	}
 
 	keyStore := keystore.New(gormDB, utils.GetScryptParams(cfg), globalLogger)
+	if cfg.EthExternalSignerURL() != nil {
+		externalSigner, err := keystore.NewJSONRPCSigner(*cfg.EthExternalSignerURL(), cfg.EthExternalSignerTimeout())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create external signer")
+		}
+		keyStore.Eth().SetExternalSigner(externalSigner)
+	}
 	cfg.SetDB(gormDB)
 
 	// Set up the versioning ORM
@@ -625,10 +632,15 @@ func NewPromptingAPIInitializer(prompter Prompter) APIInitializer {
 	return &promptingAPIInitializer{prompter: prompter}
 }
 
-// Initialize uses the terminal to get credentials that it then saves in the store.
+// Initialize uses the terminal to get credentials for an initial admin user
+// that it then saves in the store, unless the node already has users.
 func (t *promptingAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, error) {
-	if user, err := orm.FindUser(); err == nil {
-		return user, err
+	users, err := orm.ListUsers()
+	if err != nil {
+		return sessions.User{}, errors.Wrap(err, "failed to list API users")
+	}
+	if len(users) > 0 {
+		return firstAdmin(users), nil
 	}
 
 	if !t.prompter.IsTerminal() {
@@ -638,7 +650,7 @@ func (t *promptingAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, e
 	for {
 		email := t.prompter.Prompt("Enter API Email: ")
 		pwd := t.prompter.PasswordPrompt("Enter API Password: ")
-		user, err := sessions.NewUser(email, pwd)
+		user, err := sessions.NewUser(email, pwd, sessions.UserRoleAdmin)
 		if err != nil {
 			fmt.Println("Error creating API user: ", err)
 			continue
@@ -660,9 +672,15 @@ func NewFileAPIInitializer(file string) APIInitializer {
 	return fileAPIInitializer{file: file}
 }
 
+// Initialize creates an initial admin user from the credentials file, unless
+// the node already has users.
 func (f fileAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, error) {
-	if user, err := orm.FindUser(); err == nil {
-		return user, err
+	users, err := orm.ListUsers()
+	if err != nil {
+		return sessions.User{}, errors.Wrap(err, "failed to list API users")
+	}
+	if len(users) > 0 {
+		return firstAdmin(users), nil
 	}
 
 	request, err := credentialsFromFile(f.file)
@@ -670,13 +688,24 @@ func (f fileAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, error)
 		return sessions.User{}, err
 	}
 
-	user, err := sessions.NewUser(request.Email, request.Password)
+	user, err := sessions.NewUser(request.Email, request.Password, sessions.UserRoleAdmin)
 	if err != nil {
 		return user, err
 	}
 	return user, orm.CreateUser(&user)
 }
 
+// firstAdmin returns the first admin in users, or the first user if there are
+// no admins.
+func firstAdmin(users []sessions.User) sessions.User {
+	for _, user := range users {
+		if user.Role == sessions.UserRoleAdmin {
+			return user
+		}
+	}
+	return users[0]
+}
+
 var ErrNoCredentialFile = errors.New("no API user credential file was passed")
 
 func credentialsFromFile(file string) (sessions.SessionRequest, error) {
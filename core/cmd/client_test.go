@@ -144,7 +144,7 @@ func TestTerminalAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			tai := cmd.NewPromptingAPIInitializer(mock)
 
 			// Remove fixture user
-			err := orm.DeleteUser()
+			err := orm.DeleteUser(cltest.APIEmail)
 			require.NoError(t, err)
 
 			user, err := tai.Initialize(orm)
@@ -154,11 +154,12 @@ func TestTerminalAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, len(test.enteredStrings), mock.Count)
 
-				persistedUser, err := orm.FindUser()
+				persistedUser, err := orm.FindUser(user.Email)
 				assert.NoError(t, err)
 
 				assert.Equal(t, user.Email, persistedUser.Email)
 				assert.Equal(t, user.HashedPassword, persistedUser.HashedPassword)
+				assert.Equal(t, sessions.UserRoleAdmin, persistedUser.Role)
 			}
 		})
 	}
@@ -170,6 +171,8 @@ func TestTerminalAPIInitializer_InitializeWithExistingAPIUser(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	orm := sessions.NewORM(db, time.Minute)
 
+	// Replace the fixture user
+	require.NoError(t, orm.DeleteUser(cltest.APIEmail))
 	initialUser := cltest.MustRandomUser(t)
 	require.NoError(t, orm.CreateUser(&initialUser))
 
@@ -199,7 +202,7 @@ func TestFileAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			db := pgtest.NewSqlxDB(t)
 			orm := sessions.NewORM(db, time.Minute)
 			// Clear out fixture user
-			orm.DeleteUser()
+			orm.DeleteUser(cltest.APIEmail)
 
 			tfi := cmd.NewFileAPIInitializer(test.file)
 			user, err := tfi.Initialize(orm)
@@ -208,9 +211,10 @@ func TestFileAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, cltest.APIEmail, user.Email)
-				persistedUser, err := orm.FindUser()
+				persistedUser, err := orm.FindUser(cltest.APIEmail)
 				assert.NoError(t, err)
 				assert.Equal(t, persistedUser.Email, user.Email)
+				assert.Equal(t, sessions.UserRoleAdmin, persistedUser.Role)
 			}
 		})
 	}
@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"fmt"
+
+	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/urfave/cli"
@@ -80,3 +83,28 @@ func (cli *Client) CreateCSAKey(c *cli.Context) (err error) {
 
 	return cli.renderAPIResponse(resp, &CSAKeyPresenter{}, "Created CSA key")
 }
+
+// RotateCSAKey replaces a CSA key by a new key, and updates the jobs using it,
+// key ID must be passed
+func (cli *Client) RotateCSAKey(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the key ID to be rotated"))
+	}
+	id := c.Args().Get(0)
+
+	if !confirmAction(c) {
+		return nil
+	}
+
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/keys/csa/rotate/%s", id), nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &CSAKeyPresenter{}, "Rotated CSA key")
+}
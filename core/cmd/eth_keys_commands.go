@@ -27,6 +27,7 @@ func (p *EthKeyPresenter) ToRow() []string {
 		p.EthBalance.String(),
 		p.LinkBalance.String(),
 		fmt.Sprintf("%v", p.IsFunding),
+		fmt.Sprintf("%v", p.IsExternal),
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 	}
@@ -34,7 +35,7 @@ func (p *EthKeyPresenter) ToRow() []string {
 
 // RenderTable implements TableRenderer
 func (p *EthKeyPresenter) RenderTable(rt RendererTable) error {
-	headers := []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Created", "Updated"}
+	headers := []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Is external", "Created", "Updated"}
 	rows := [][]string{p.ToRow()}
 
 	renderList(headers, rows, rt.Writer)
@@ -46,7 +47,7 @@ type EthKeyPresenters []EthKeyPresenter
 
 // RenderTable implements TableRenderer
 func (ps EthKeyPresenters) RenderTable(rt RendererTable) error {
-	headers := []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Created", "Updated"}
+	headers := []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Is external", "Created", "Updated"}
 	rows := [][]string{}
 
 	for _, p := range ps {
@@ -80,6 +81,9 @@ func (cli *Client) CreateETHKey(c *cli.Context) (err error) {
 	if c.IsSet("evmChainID") {
 		query.Set("evmChainID", c.String("evmChainID"))
 	}
+	if c.IsSet("externalAddress") {
+		query.Set("externalAddress", c.String("externalAddress"))
+	}
 	resp, err := cli.HTTP.Post("/v2/keys/eth?"+query.Encode(), nil)
 	if err != nil {
 		return cli.errorOut(err)
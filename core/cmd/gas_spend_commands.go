@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type GasSpendPresenter struct {
+	JAID
+	presenters.GasSpendResource
+}
+
+func (p *GasSpendPresenter) ToRow() []string {
+	jobID := ""
+	if p.JobID.Valid {
+		jobID = strconv.FormatInt(p.JobID.Int64, 10)
+	}
+	address := ""
+	if p.Address != nil {
+		address = p.Address.Hex()
+	}
+	day := ""
+	if p.Day.Valid {
+		day = p.Day.Time.Format("2006-01-02")
+	}
+	return []string{
+		p.EVMChainID.String(),
+		jobID,
+		address,
+		day,
+		strconv.FormatInt(p.TxCount, 10),
+		strconv.FormatInt(p.GasUsed, 10),
+		p.TotalFeeEth,
+	}
+}
+
+type GasSpendPresenters []GasSpendPresenter
+
+// RenderTable implements TableRenderer
+func (ps GasSpendPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"EVM Chain ID", "Job ID", "Address", "Day", "Transactions", "Gas used", "Total fee (ETH)"}
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(headers, rows, rt.Writer)
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ShowGasSpend reports the fees paid by confirmed transactions per job, key
+// or day
+func (cli *Client) ShowGasSpend(c *clipkg.Context) (err error) {
+	query := url.Values{}
+	query.Set("groupBy", c.String("group-by"))
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+	if c.IsSet("job-id") {
+		query.Set("jobID", c.String("job-id"))
+	}
+	if c.IsSet("from") {
+		query.Set("from", c.String("from"))
+	}
+	if c.IsSet("to") {
+		query.Set("to", c.String("to"))
+	}
+
+	resp, err := cli.HTTP.Get(fmt.Sprintf("/v2/gas_spend?%s", query.Encode()))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &GasSpendPresenters{}, "Gas spend")
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -231,6 +233,74 @@ func (cli *Client) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// UpdateJob replaces the spec of an existing job
+// Valid input is the job ID followed by a TOML string or a path to TOML file
+func (cli *Client) UpdateJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass in the job id and TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.UpdateJobRequest{
+		TOML: tomlString,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/jobs/"+c.Args().First(), bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job updated")
+}
+
+// PauseJob stops a job's services without deleting it
+func (cli *Client) PauseJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id to be paused"))
+	}
+	resp, err := cli.HTTP.Post("/v2/jobs/"+c.Args().First()+"/pause", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job paused")
+}
+
+// UnpauseJob restarts the services of a paused job
+func (cli *Client) UnpauseJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id to be resumed"))
+	}
+	resp, err := cli.HTTP.Post("/v2/jobs/"+c.Args().First()+"/resume", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job resumed")
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -250,3 +320,90 @@ func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineSimulationPresenter wraps the JSONAPI PipelineSimulation resource
+// and adds rendering functionality
+type PipelineSimulationPresenter struct {
+	JAID
+	presenters.PipelineSimulationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineSimulationPresenter) RenderTable(rt RendererTable) error {
+	deref := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	table := rt.newTable([]string{"Task", "Type", "Inputs", "Stubbed", "Output", "Error"})
+	for _, tr := range p.TaskRuns {
+		table.Append([]string{
+			tr.DotID,
+			string(tr.Type),
+			strings.Join(tr.Upstream, ", "),
+			strconv.FormatBool(tr.Stubbed),
+			deref(tr.Output),
+			deref(tr.Error),
+		})
+	}
+	render(fmt.Sprintf("Simulated run (%s)", p.State), table)
+
+	table = rt.newTable([]string{"Output", "Error"})
+	for i, out := range p.Outputs {
+		var fatalErr *string
+		if i < len(p.FatalErrors) {
+			fatalErr = p.FatalErrors[i]
+		}
+		table.Append([]string{deref(out), deref(fatalErr)})
+	}
+	render("Final results", table)
+	return nil
+}
+
+// SimulateJob runs the pipeline of a job spec in memory on the node, without
+// creating the job or persisting the run. ethtx tasks are always stubbed.
+// Valid input is a TOML string or a path to TOML file, or a DOT pipeline or a
+// path to a DOT file if --dot is given.
+func (cli *Client) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in TOML, DOT or filepath"))
+	}
+
+	request := web.SimulatePipelineRequest{StubNetwork: c.Bool("stub-network")}
+	if c.Bool("dot") {
+		request.DOT = c.Args().First()
+		if buf, ferr := fromFile(request.DOT); ferr == nil {
+			request.DOT = buf.String()
+		}
+	} else if request.TOML, err = getTOMLString(c.Args().First()); err != nil {
+		return cli.errorOut(err)
+	}
+	if vars := c.String("vars"); vars != "" {
+		if err = json.Unmarshal([]byte(vars), &request.Vars); err != nil {
+			return cli.errorOut(errors.Wrap(err, "invalid --vars"))
+		}
+	}
+	if stubs := c.String("stubs"); stubs != "" {
+		if err = json.Unmarshal([]byte(stubs), &request.Stubs); err != nil {
+			return cli.errorOut(errors.Wrap(err, "invalid --stubs"))
+		}
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/pipeline/simulate", bytes.NewReader(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &PipelineSimulationPresenter{})
+}
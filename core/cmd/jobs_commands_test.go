@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/stretchr/testify/assert"
@@ -387,3 +388,41 @@ func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	require.NoError(t, err)
 	require.Len(t, jobs, expected)
 }
+
+func TestPipelineSimulationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+		output = "150"
+		fatal  = "task inputs: too many errors"
+	)
+
+	p := cmd.PipelineSimulationPresenter{
+		PipelineSimulationResource: presenters.PipelineSimulationResource{
+			State:       pipeline.RunStatusErrored,
+			Outputs:     []*string{nil},
+			FatalErrors: []*string{&fatal},
+			TaskRuns: []presenters.SimulatedTaskRunResource{
+				{
+					PipelineTaskRunResource: presenters.PipelineTaskRunResource{Type: pipeline.TaskTypeMultiply, DotID: "multiply", Output: &output},
+					Upstream:                []string{"parse"},
+				},
+				{
+					PipelineTaskRunResource: presenters.PipelineTaskRunResource{Type: pipeline.TaskTypeETHTx, DotID: "submit", Error: &fatal},
+					Upstream:                []string{"multiply"},
+					Stubbed:                 true,
+				},
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	rendered := buffer.String()
+	assert.Contains(t, rendered, "multiply")
+	assert.Contains(t, rendered, "150")
+	assert.Contains(t, rendered, "submit")
+	assert.Contains(t, rendered, "true")
+	assert.Contains(t, rendered, fatal)
+}
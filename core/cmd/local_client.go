@@ -31,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/health"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/static"
@@ -614,7 +615,8 @@ func insertFixtures(config config.GeneralConfig) (err error) {
 	return err
 }
 
-// DeleteUser is run locally to remove the User row from the node's database.
+// DeleteUser is run locally to remove a User row and their sessions from the
+// node's database.
 func (cli *Client) DeleteUser(c *clipkg.Context) (err error) {
 	app, err := cli.AppFactory.NewApplication(cli.Config)
 	if err != nil {
@@ -626,12 +628,25 @@ func (cli *Client) DeleteUser(c *clipkg.Context) (err error) {
 		}
 	}()
 	orm := app.SessionORM()
-	user, err := orm.FindUser()
-	if err == nil {
-		app.GetLogger().Info("No such API user ", user.Email)
+	email := c.String("email")
+	if email == "" {
+		// Before there were several users, deleteuser took no arguments, so
+		// default to the only user to keep existing scripts working
+		users, lerr := orm.ListUsers()
+		if lerr != nil {
+			return cli.errorOut(errors.Wrap(lerr, "failed to list users"))
+		}
+		if len(users) != 1 {
+			return cli.errorOut(errors.New("must specify the --email of the user to delete when there is more than one user"))
+		}
+		email = users[0].Email
+	}
+	user, err := orm.FindUser(email)
+	if err != nil {
+		app.GetLogger().Info("No such API user ", email)
 		return err
 	}
-	err = orm.DeleteUser()
+	err = orm.DeleteUser(user.Email)
 	if err == nil {
 		app.GetLogger().Info("Deleted API user ", user.Email)
 	}
@@ -665,3 +680,37 @@ func (cli *Client) SetNextNonce(c *clipkg.Context) error {
 	}
 	return nil
 }
+
+// RestoreRuns is run locally to re-insert pipeline runs from an archive
+// written by the run reaper. Restored runs are exempt from reaping.
+func (cli *Client) RestoreRuns(c *clipkg.Context) (err error) {
+	archivePath := c.String("file")
+	if archivePath == "" {
+		return cli.errorOut(errors.New("must specify the --file of the archive to restore"))
+	}
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer cli.Logger().ErrorIfCalling(f.Close)
+	runs, err := pipeline.ReadRunArchive(f)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	app, err := cli.AppFactory.NewApplication(cli.Config)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "creating application"))
+	}
+	defer func() {
+		if serr := app.Stop(); serr != nil {
+			err = multierr.Append(err, serr)
+		}
+	}()
+	restored, err := app.PipelineORM().RestoreRuns(runs, filepath.Base(archivePath))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	app.GetLogger().Infof("Restored %d of %d runs from %s", restored, len(runs), archivePath)
+	return nil
+}
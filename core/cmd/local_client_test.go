@@ -111,7 +111,7 @@ func TestClient_RunNodeWithPasswords(t *testing.T) {
 			keyStore := cltest.NewKeyStore(t, db)
 			sessionORM := sessions.NewORM(postgres.UnwrapGormDB(db), time.Minute)
 			// Clear out fixture
-			err := sessionORM.DeleteUser()
+			err := sessionORM.DeleteUser(cltest.APIEmail)
 			require.NoError(t, err)
 
 			app := new(mocks.Application)
@@ -215,7 +215,7 @@ func TestClient_RunNodeWithAPICredentialsFile(t *testing.T) {
 			db := pgtest.NewGormDB(t)
 			sessionORM := sessions.NewORM(postgres.UnwrapGormDB(db), time.Minute)
 			// Clear out fixture
-			err := sessionORM.DeleteUser()
+			err := sessionORM.DeleteUser(cltest.APIEmail)
 			require.NoError(t, err)
 			keyStore := cltest.NewKeyStore(t, db)
 			_, err = keyStore.Eth().Create(&cltest.FixtureChainID)
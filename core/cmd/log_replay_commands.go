@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type LogReplayPresenter struct {
+	JAID
+	presenters.LogReplayResource
+}
+
+var logReplayHeaders = []string{"ID", "EVM Chain ID", "Job ID", "Contract", "From block", "To block", "Last block", "Delivered", "Dry run", "State", "Error"}
+
+// RenderTable implements TableRenderer
+func (p *LogReplayPresenter) RenderTable(rt RendererTable) error {
+	renderList(logReplayHeaders, [][]string{p.ToRow()}, rt.Writer)
+	if len(p.Logs) > 0 {
+		rows := [][]string{}
+		for _, l := range p.Logs {
+			rows = append(rows, []string{
+				strconv.Itoa(int(l.JobID)),
+				l.Address.Hex(),
+				strconv.FormatUint(l.BlockNumber, 10),
+				l.TxHash.Hex(),
+				strconv.FormatUint(uint64(l.LogIndex), 10),
+			})
+		}
+		renderList([]string{"Job ID", "Contract", "Block", "Transaction hash", "Log index"}, rows, rt.Writer)
+	}
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+func (p *LogReplayPresenter) ToRow() []string {
+	optionalBlock := func(n null.Int64) string {
+		if !n.Valid {
+			return ""
+		}
+		return strconv.FormatInt(n.Int64, 10)
+	}
+	jobID := ""
+	if p.JobID != nil {
+		jobID = strconv.Itoa(int(*p.JobID))
+	}
+	contract := ""
+	if p.Contract != nil {
+		contract = p.Contract.Hex()
+	}
+	return []string{
+		p.GetID(),
+		p.EVMChainID.String(),
+		jobID,
+		contract,
+		strconv.FormatInt(p.FromBlock, 10),
+		optionalBlock(p.ToBlock),
+		optionalBlock(p.LastBlock),
+		strconv.Itoa(p.Delivered),
+		fmt.Sprintf("%v", p.DryRun),
+		string(p.State),
+		p.Error,
+	}
+}
+
+// replayLogs starts a replay scoped to the job or contract given by the flags
+// of the replay command. Its progress can be followed with ShowLogReplay.
+func (cli *Client) replayLogs(c *clipkg.Context) (err error) {
+	req := log.ReplayRequest{
+		FromBlock: c.Int64("block-number"),
+		DryRun:    c.Bool("dry-run"),
+	}
+	if c.IsSet("job-id") {
+		jobID := int32(c.Int("job-id"))
+		req.JobID = &jobID
+	}
+	if c.IsSet("contract") {
+		if !common.IsHexAddress(c.String("contract")) {
+			return cli.errorOut(errors.Errorf("invalid contract address: %s", c.String("contract")))
+		}
+		contract := common.HexToAddress(c.String("contract"))
+		req.Contract = &contract
+	}
+	if c.IsSet("to-block") {
+		req.ToBlock = null.Int64From(c.Int64("to-block"))
+	}
+	if err = req.Validate(); err != nil {
+		return cli.errorOut(err)
+	}
+
+	requestData, err := json.Marshal(req)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	query := url.Values{}
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+	resp, err := cli.HTTP.Post("/v2/replays?"+query.Encode(), bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &LogReplayPresenter{}, "Replay started")
+}
+
+// ShowLogReplay shows the progress of a replay scoped to a job or contract,
+// and on a dry run the logs it would deliver
+func (cli *Client) ShowLogReplay(c *clipkg.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the ID of the replay"))
+	}
+	resp, err := cli.HTTP.Get("/v2/replays/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &LogReplayPresenter{})
+}
@@ -79,8 +79,8 @@ func (cli *Client) CreateNode(c *cli.Context) (err error) {
 	if t != "primary" && t != "sendonly" {
 		return cli.errorOut(errors.New("invalid or unspecified --type, must be either primary or sendonly"))
 	}
-	if t == "primary" && ws == "" {
-		return cli.errorOut(errors.New("missing --ws-url"))
+	if t == "primary" && ws == "" && httpURLStr == "" {
+		return cli.errorOut(errors.New("missing --ws-url or --http-url"))
 	}
 	var httpURL = null.NewString(httpURLStr, true)
 	if httpURLStr == "" {
@@ -220,3 +220,28 @@ func (cli *Client) ExportOCRKey(c *cli.Context) (err error) {
 
 	return nil
 }
+
+// RotateOCRKeyBundle replaces an OCR key bundle by a new bundle, and updates the jobs using it,
+// key ID must be passed
+func (cli *Client) RotateOCRKeyBundle(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the key ID to be rotated"))
+	}
+	id := c.Args().Get(0)
+
+	if !confirmAction(c) {
+		return nil
+	}
+
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/keys/ocr/rotate/%s", id), nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &OCRKeyBundlePresenter{}, "Rotated OCR key bundle")
+}
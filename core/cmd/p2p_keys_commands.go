@@ -212,3 +212,28 @@ func (cli *Client) ExportP2PKey(c *cli.Context) (err error) {
 
 	return nil
 }
+
+// RotateP2PKey replaces a P2P key by a new key, and updates the jobs using it,
+// key ID must be passed
+func (cli *Client) RotateP2PKey(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the key ID to be rotated"))
+	}
+	id := c.Args().Get(0)
+
+	if !confirmAction(c) {
+		return nil
+	}
+
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/keys/p2p/rotate/%s", id), nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &P2PKeyPresenter{}, "Rotated P2P key")
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type RetentionPolicyPresenter struct {
+	JAID
+	presenters.RetentionPolicyResource
+}
+
+var retentionPolicyHeaders = []string{"ID", "Job ID", "Job type", "Keep last", "Max age", "Errored max age", "Archive"}
+
+// RenderTable implements TableRenderer
+func (p *RetentionPolicyPresenter) RenderTable(rt RendererTable) error {
+	renderList(retentionPolicyHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+func (p *RetentionPolicyPresenter) ToRow() []string {
+	interval := func(i *models.Interval) string {
+		if i == nil {
+			return "default"
+		}
+		return i.Duration().String()
+	}
+	jobID := ""
+	if p.JobID.Valid {
+		jobID = fmt.Sprintf("%d", p.JobID.Int64)
+	}
+	keepLast := ""
+	if p.KeepLast.Valid {
+		keepLast = fmt.Sprintf("%d", p.KeepLast.Int64)
+	}
+	return []string{
+		p.GetID(),
+		jobID,
+		p.JobType.String,
+		keepLast,
+		interval(p.MaxAge),
+		interval(p.ErroredMaxAge),
+		fmt.Sprintf("%v", p.Archive),
+	}
+}
+
+type RetentionPolicyPresenters []RetentionPolicyPresenter
+
+// RenderTable implements TableRenderer
+func (ps RetentionPolicyPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(retentionPolicyHeaders, rows, rt.Writer)
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListRetentionPolicies renders all pipeline run retention policies
+func (cli *Client) ListRetentionPolicies(c *clipkg.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/pipeline/retention_policies", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &RetentionPolicyPresenters{})
+}
+
+// SetRetentionPolicy sets the run retention policy of a job or job type
+func (cli *Client) SetRetentionPolicy(c *clipkg.Context) (err error) {
+	policy := pipeline.RetentionPolicy{Archive: c.Bool("archive")}
+	if c.IsSet("job") {
+		policy.JobID = null.IntFrom(c.Int64("job"))
+	}
+	if c.IsSet("type") {
+		policy.JobType = null.StringFrom(c.String("type"))
+	}
+	if c.IsSet("keep-last") {
+		policy.KeepLast = null.IntFrom(c.Int64("keep-last"))
+	}
+	if policy.MaxAge, err = parseIntervalFlag(c, "max-age"); err != nil {
+		return cli.errorOut(err)
+	}
+	if policy.ErroredMaxAge, err = parseIntervalFlag(c, "errored-max-age"); err != nil {
+		return cli.errorOut(err)
+	}
+	if err = policy.Validate(); err != nil {
+		return cli.errorOut(err)
+	}
+
+	requestData, err := json.Marshal(policy)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err := cli.HTTP.Post("/v2/pipeline/retention_policies", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &RetentionPolicyPresenter{}, "Retention policy set")
+}
+
+// DeleteRetentionPolicy removes a run retention policy
+func (cli *Client) DeleteRetentionPolicy(c *clipkg.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the retention policy id to be deleted"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/pipeline/retention_policies/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = cli.parseResponse(resp); err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Retention policy %v deleted\n", c.Args().First())
+	return nil
+}
+
+func parseIntervalFlag(c *clipkg.Context, name string) (*models.Interval, error) {
+	if !c.IsSet(name) {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(c.String(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid --%s", name)
+	}
+	i := models.Interval(d)
+	return &i, nil
+}
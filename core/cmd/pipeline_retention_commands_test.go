@@ -0,0 +1,49 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestRetentionPolicyPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+		maxAge = models.Interval(72 * time.Hour)
+	)
+
+	p := cmd.RetentionPolicyPresenter{
+		JAID: cmd.JAID{ID: "7"},
+		RetentionPolicyResource: presenters.RetentionPolicyResource{
+			JAID:     presenters.NewJAID("7"),
+			JobType:  null.StringFrom("fluxmonitor"),
+			KeepLast: null.IntFrom(25),
+			MaxAge:   &maxAge,
+			Archive:  true,
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "fluxmonitor")
+	assert.Contains(t, output, "25")
+	assert.Contains(t, output, "72h0m0s")
+	assert.Contains(t, output, "default")
+	assert.Contains(t, output, "true")
+
+	buffer.Reset()
+	ps := cmd.RetentionPolicyPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+	assert.Contains(t, buffer.String(), "fluxmonitor")
+}
@@ -119,7 +119,8 @@ func (cli *Client) getPage(requestURI string, page int, model interface{}) (err
 	return err
 }
 
-// ReplayFromBlock replays chain data from the given block number until the most recent
+// ReplayFromBlock replays chain data from the given block number until the most recent.
+// If a job ID or contract is given, the logs are only replayed to its listeners.
 func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 
 	blockNumber := c.Int64("block-number")
@@ -127,9 +128,19 @@ func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 		return cli.errorOut(errors.New("Must pass a positive value in '--block-number' parameter"))
 	}
 
+	if c.IsSet("job-id") || c.IsSet("contract") {
+		return cli.replayLogs(c)
+	} else if c.IsSet("to-block") || c.Bool("dry-run") {
+		return cli.errorOut(errors.New("'--to-block' and '--dry-run' require '--job-id' or '--contract'"))
+	}
+
 	buf := bytes.NewBufferString("{}")
 
-	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/replay_from_block/%v", blockNumber), buf)
+	query := url.Values{}
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/replay_from_block/%v?%s", blockNumber, query.Encode()), buf)
 	if err != nil {
 		return cli.errorOut(err)
 	}
@@ -197,6 +208,46 @@ func (cli *Client) ChangePassword(c *clipkg.Context) (err error) {
 	return nil
 }
 
+// RotateKeystorePassword re-encrypts every key of the keystore with a new
+// password
+func (cli *Client) RotateKeystorePassword(c *clipkg.Context) (err error) {
+	if !c.IsSet("oldpassword") || !c.IsSet("newpassword") {
+		return cli.errorOut(errors.New("Must specify --oldpassword and --newpassword flags"))
+	}
+	oldPassword, err := passwordFromFile(c.String("oldpassword"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read old password file"))
+	}
+	newPassword, err := passwordFromFile(c.String("newpassword"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "Could not read new password file"))
+	}
+
+	requestData, err := json.Marshal(web.RotatePasswordRequest{
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/keys/rotate_password", bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return cli.printResponseBody(resp)
+	}
+	fmt.Println("Keystore password rotated. Update the password file the node is started with before restarting it.")
+	return nil
+}
+
 func (cli *Client) buildSessionRequest(flag string) (sessions.SessionRequest, error) {
 	if len(flag) > 0 {
 		return cli.FileSessionRequestBuilder.Build(flag)
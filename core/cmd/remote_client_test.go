@@ -133,6 +133,19 @@ func TestClient_ReplayBlocks(t *testing.T) {
 	set.Int64("block-number", 42, "")
 	c := cli.NewContext(nil, set, nil)
 	assert.NoError(t, client.ReplayFromBlock(c))
+
+	// A dry run must be scoped to a job or contract
+	set = flag.NewFlagSet("flagset", 0)
+	set.Int64("block-number", 42, "")
+	set.Bool("dry-run", false, "")
+	set.Int("job-id", 0, "")
+	require.NoError(t, set.Set("dry-run", "true"))
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ReplayFromBlock(c))
+
+	// No listeners are registered for the job
+	require.NoError(t, set.Set("job-id", "1"))
+	assert.Error(t, client.ReplayFromBlock(c))
 }
 
 func TestClient_CreateExternalInitiator(t *testing.T) {
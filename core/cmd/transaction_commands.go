@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -79,6 +81,57 @@ func (cli *Client) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction replaces the unconfirmed transaction with the given hash
+// with a zero-value send to itself
+func (cli *Client) CancelTransaction(c *cli.Context) (err error) {
+	return cli.modifyTransaction(c, "cancel", nil)
+}
+
+// BumpTransaction sends a new attempt of the unconfirmed transaction with the
+// given hash with a higher gas price
+func (cli *Client) BumpTransaction(c *cli.Context) (err error) {
+	req := web.BumpTransactionRequest{}
+	if c.IsSet("gas-price-wei") {
+		gasPrice, ok := new(big.Int).SetString(c.String("gas-price-wei"), 10)
+		if !ok {
+			return cli.errorOut(fmt.Errorf("invalid gas price: %s", c.String("gas-price-wei")))
+		}
+		req.GasPriceWei = utils.NewBig(gasPrice)
+	}
+	return cli.modifyTransaction(c, "bump", req)
+}
+
+// AbandonTransaction marks the transaction with the given hash as fatally
+// errored, releasing its nonce
+func (cli *Client) AbandonTransaction(c *cli.Context) (err error) {
+	return cli.modifyTransaction(c, "abandon", nil)
+}
+
+func (cli *Client) modifyTransaction(c *cli.Context, action string, body interface{}) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the hash of the transaction"))
+	}
+	hash := c.Args().First()
+
+	var buf bytes.Buffer
+	if body != nil {
+		if err = json.NewEncoder(&buf).Encode(body); err != nil {
+			return cli.errorOut(err)
+		}
+	}
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/transactions/%s/%s", hash, action), &buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthTxPresenter{})
+}
+
 // IndexTxAttempts returns the list of transactions in descending order,
 // taking an optional page parameter
 func (cli *Client) IndexTxAttempts(c *cli.Context) error {
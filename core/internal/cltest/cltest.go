@@ -546,8 +546,13 @@ func (ta *TestApplication) Stop() error {
 	return nil
 }
 
-func (ta *TestApplication) MustSeedNewSession() string {
+// MustSeedNewSession creates a session for the fixture API user, or for the
+// user with the given email
+func (ta *TestApplication) MustSeedNewSession(email ...string) string {
 	session := NewSession()
+	if len(email) > 0 {
+		session.Email = email[0]
+	}
 	require.NoError(ta.t, ta.GetDB().Save(&session).Error)
 	return session.ID
 }
@@ -570,6 +575,21 @@ func (ta *TestApplication) NewHTTPClient() HTTPClientCleaner {
 	}
 }
 
+// NewHTTPClientWithRole creates a new user with the given role and returns an
+// HTTP client authenticated as that user
+func (ta *TestApplication) NewHTTPClientWithRole(role clsessions.UserRole) HTTPClientCleaner {
+	ta.t.Helper()
+
+	user := MustRandomUser(ta.t, role)
+	require.NoError(ta.t, ta.SessionORM().CreateUser(&user))
+	sessionID := ta.MustSeedNewSession(user.Email)
+
+	return HTTPClientCleaner{
+		HTTPClient: NewMockAuthenticatedHTTPClient(ta.Config, sessionID),
+		t:          ta.t,
+	}
+}
+
 // NewClientAndRenderer creates a new cmd.Client for the test application
 func (ta *TestApplication) NewClientAndRenderer() (*cmd.Client, *RendererMock) {
 	sessionID := ta.MustSeedNewSession()
@@ -1154,8 +1174,9 @@ func MustParseDuration(t testing.TB, durationStr string) time.Duration {
 	return duration
 }
 
+// NewSession returns a session for the fixture API user
 func NewSession(optionalSessionID ...string) clsessions.Session {
-	session := clsessions.NewSession()
+	session := clsessions.NewSession(APIEmail)
 	if len(optionalSessionID) > 0 {
 		session.ID = optionalSessionID[0]
 	}
@@ -367,23 +367,32 @@ func (ns NeverSleeper) After() time.Duration { return 0 * time.Microsecond }
 // Duration returns a duration
 func (ns NeverSleeper) Duration() time.Duration { return 0 * time.Microsecond }
 
-func MustRandomUser(t testing.TB) sessions.User {
+// MustRandomUser returns a new user with a random email and the given role,
+// or the admin role if none is given
+func MustRandomUser(t testing.TB, role ...sessions.UserRole) sessions.User {
 	email := fmt.Sprintf("user-%v@chainlink.test", NewRandomInt64())
-	r, err := sessions.NewUser(email, Password)
+	r, err := sessions.NewUser(email, Password, userRole(role))
 	if err != nil {
 		logger.TestLogger(t).Panic(err)
 	}
 	return r
 }
 
-func MustNewUser(t *testing.T, email, password string) sessions.User {
-	r, err := sessions.NewUser(email, password)
+func MustNewUser(t *testing.T, email, password string, role ...sessions.UserRole) sessions.User {
+	r, err := sessions.NewUser(email, password, userRole(role))
 	if err != nil {
 		t.Fatal(err)
 	}
 	return r
 }
 
+func userRole(role []sessions.UserRole) sessions.UserRole {
+	if len(role) > 0 {
+		return role[0]
+	}
+	return sessions.UserRoleAdmin
+}
+
 type MockAPIInitializer struct {
 	t     testing.TB
 	Count int
@@ -394,8 +403,8 @@ func NewMockAPIInitializer(t testing.TB) *MockAPIInitializer {
 }
 
 func (m *MockAPIInitializer) Initialize(orm sessions.ORM) (sessions.User, error) {
-	if user, err := orm.FindUser(); err == nil {
-		return user, err
+	if users, err := orm.ListUsers(); err == nil && len(users) > 0 {
+		return users[0], nil
 	}
 	m.Count++
 	user := MustRandomUser(m.t)
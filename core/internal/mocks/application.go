@@ -22,10 +22,14 @@ import (
 
 	job "github.com/smartcontractkit/chainlink/core/services/job"
 
+	keyrotation "github.com/smartcontractkit/chainlink/core/services/keyrotation"
+
 	keystore "github.com/smartcontractkit/chainlink/core/services/keystore"
 
 	logger "github.com/smartcontractkit/chainlink/core/logger"
 
+	log "github.com/smartcontractkit/chainlink/core/services/log"
+
 	mock "github.com/stretchr/testify/mock"
 
 	null "gopkg.in/guregu/null.v4"
@@ -38,6 +42,8 @@ import (
 
 	sessions "github.com/smartcontractkit/chainlink/core/sessions"
 
+	telemetry "github.com/smartcontractkit/chainlink/core/services/telemetry"
+
 	types "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 
 	uuid "github.com/satori/go.uuid"
@@ -247,6 +253,22 @@ func (_m *Application) GetHealthChecker() health.Checker {
 	return r0
 }
 
+// GetKeyRotator provides a mock function with given fields:
+func (_m *Application) GetKeyRotator() keyrotation.Rotator {
+	ret := _m.Called()
+
+	var r0 keyrotation.Rotator
+	if rf, ok := ret.Get(0).(func() keyrotation.Rotator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keyrotation.Rotator)
+		}
+	}
+
+	return r0
+}
+
 // GetKeyStore provides a mock function with given fields:
 func (_m *Application) GetKeyStore() keystore.Master {
 	ret := _m.Called()
@@ -263,6 +285,27 @@ func (_m *Application) GetKeyStore() keystore.Master {
 	return r0
 }
 
+// GetLogReplay provides a mock function with given fields: id
+func (_m *Application) GetLogReplay(id string) (log.Replay, error) {
+	ret := _m.Called(id)
+
+	var r0 log.Replay
+	if rf, ok := ret.Get(0).(func(string) log.Replay); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(log.Replay)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLogger provides a mock function with given fields:
 func (_m *Application) GetLogger() logger.Logger {
 	ret := _m.Called()
@@ -339,6 +382,20 @@ func (_m *Application) NewBox() packr.Box {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -469,6 +526,34 @@ func (_m *Application) SetServiceLogLevel(ctx context.Context, service string, l
 	return r0
 }
 
+// SimulatePipelineRun provides a mock function with given fields: ctx, spec, vars, opts
+func (_m *Application) SimulatePipelineRun(ctx context.Context, spec pipeline.Spec, vars map[string]interface{}, opts pipeline.SimulationOptions) (pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, vars, opts)
+
+	var r0 pipeline.Run
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, map[string]interface{}, pipeline.SimulationOptions) pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, opts)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	var r1 pipeline.TaskRunResults
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, map[string]interface{}, pipeline.SimulationOptions) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, opts)
+	} else {
+		r1 = ret.Get(1).(pipeline.TaskRunResults)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, map[string]interface{}, pipeline.SimulationOptions) error); ok {
+		r2 = rf(ctx, spec, vars, opts)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Start provides a mock function with given fields:
 func (_m *Application) Start() error {
 	ret := _m.Called()
@@ -483,6 +568,27 @@ func (_m *Application) Start() error {
 	return r0
 }
 
+// StartLogReplay provides a mock function with given fields: chainID, req
+func (_m *Application) StartLogReplay(chainID *big.Int, req log.ReplayRequest) (log.Replay, error) {
+	ret := _m.Called(chainID, req)
+
+	var r0 log.Replay
+	if rf, ok := ret.Get(0).(func(*big.Int, log.ReplayRequest) log.Replay); ok {
+		r0 = rf(chainID, req)
+	} else {
+		r0 = ret.Get(0).(log.Replay)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int, log.ReplayRequest) error); ok {
+		r1 = rf(chainID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Stop provides a mock function with given fields:
 func (_m *Application) Stop() error {
 	ret := _m.Called()
@@ -497,6 +603,57 @@ func (_m *Application) Stop() error {
 	return r0
 }
 
+// TelemetryORM provides a mock function with given fields:
+func (_m *Application) TelemetryORM() telemetry.ORM {
+	ret := _m.Called()
+
+	var r0 telemetry.ORM
+	if rf, ok := ret.Get(0).(func() telemetry.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(telemetry.ORM)
+		}
+	}
+
+	return r0
+}
+
+// UnpauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) UnpauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateJob provides a mock function with given fields: ctx, jobID, _a2
+func (_m *Application) UpdateJob(ctx context.Context, jobID int32, _a2 job.Job) (job.Job, error) {
+	ret := _m.Called(ctx, jobID, _a2)
+
+	var r0 job.Job
+	if rf, ok := ret.Get(0).(func(context.Context, int32, job.Job) job.Job); ok {
+		r0 = rf(ctx, jobID, _a2)
+	} else {
+		r0 = ret.Get(0).(job.Job)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32, job.Job) error); ok {
+		r1 = rf(ctx, jobID, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
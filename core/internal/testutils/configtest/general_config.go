@@ -71,6 +71,7 @@ type GeneralConfigOverrides struct {
 	GlobalEvmHeadTrackerMaxBufferSize         null.Int
 	GlobalEvmHeadTrackerSamplingInterval      *time.Duration
 	GlobalEvmLogBackfillBatchSize             null.Int
+	GlobalEvmLogPollerEnabled                 null.Bool
 	GlobalEvmMaxGasPriceWei                   *big.Int
 	GlobalEvmMinGasPriceWei                   *big.Int
 	GlobalEvmNonceAutoSync                    null.Bool
@@ -515,6 +516,13 @@ func (c *TestGeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return c.GeneralConfig.GlobalEvmLogBackfillBatchSize()
 }
 
+func (c *TestGeneralConfig) GlobalEvmLogPollerEnabled() (bool, bool) {
+	if c.Overrides.GlobalEvmLogPollerEnabled.Valid {
+		return c.Overrides.GlobalEvmLogPollerEnabled.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmLogPollerEnabled()
+}
+
 func (c *TestGeneralConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	if c.Overrides.GlobalEvmMaxGasPriceWei != nil {
 		return c.Overrides.GlobalEvmMaxGasPriceWei, true
@@ -0,0 +1,328 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"math/big"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+// The admin operations let a node operator intervene in stuck transactions
+// while the node is running. Each of them runs in the goroutine that owns the
+// state it changes (the EthConfirmer's run loop, and for abandoning, also the
+// EthBroadcaster's monitor for the transaction's key) so they never race with
+// the normal processing of heads and unstarted transactions.
+
+var (
+	// ErrEthTxNotFound is returned by the admin operations if there is no
+	// eth_tx with the given ID on the chain
+	ErrEthTxNotFound = errors.New("eth_tx not found")
+	// ErrEthTxNotModifiable is returned by the admin operations if the eth_tx
+	// is not in a state the operation applies to
+	ErrEthTxNotModifiable = errors.New("eth_tx cannot be modified")
+)
+
+// AbandonedEthTxError is saved as the error of an abandoned eth_tx
+const AbandonedEthTxError = "abandoned by node operator"
+
+// adminRequest runs fn in the goroutine it is sent to
+type adminRequest struct {
+	fn    func(ctx context.Context) error
+	chErr chan error
+}
+
+func runAdminRequest(ctx context.Context, ch chan<- adminRequest, chDone <-chan struct{}, fn func(ctx context.Context) error) error {
+	req := adminRequest{fn: fn, chErr: make(chan error, 1)}
+	select {
+	case ch <- req:
+	case <-chDone:
+		return errors.New("transaction manager is stopped or reloading keys, try again")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	// The request is always answered once it has been picked up
+	return <-req.chErr
+}
+
+// CancelEthTx replaces an unconfirmed transaction with a zero-value send to
+// itself at the same nonce, with a bumped gas price so it is accepted as a
+// replacement. If the original transaction is mined first, it is confirmed
+// as normal. A pipeline run waiting for the transaction is resumed with an
+// error.
+func (b *BulletproofTxManager) CancelEthTx(ctx context.Context, id int64) (etx EthTx, err error) {
+	_, ec, err := b.services()
+	if err != nil {
+		return etx, err
+	}
+	err = ec.runAdmin(ctx, func(ctx context.Context) error {
+		return ec.cancelEthTx(ctx, id)
+	})
+	if err != nil {
+		return etx, err
+	}
+	return findEthTxWithAttempts(b.db, id, b.chainID)
+}
+
+// BumpEthTxGas sends a new attempt of an unconfirmed transaction now, rather
+// than waiting for EvmGasBumpThreshold blocks. The gas price is bumped as it
+// would be by the EthConfirmer, unless gasPriceWei is given, which is only
+// supported for legacy transactions.
+func (b *BulletproofTxManager) BumpEthTxGas(ctx context.Context, id int64, gasPriceWei *big.Int) (etx EthTx, err error) {
+	_, ec, err := b.services()
+	if err != nil {
+		return etx, err
+	}
+	err = ec.runAdmin(ctx, func(ctx context.Context) error {
+		return ec.bumpEthTxGas(ctx, id, gasPriceWei)
+	})
+	if err != nil {
+		return etx, err
+	}
+	return findEthTxWithAttempts(b.db, id, b.chainID)
+}
+
+// AbandonEthTx gives up on a transaction whose nonce is stuck, marking it
+// fatally errored and releasing its nonce to the next transaction of the key.
+// Only in_progress transactions, and unconfirmed transactions with the
+// highest nonce of their key, can be abandoned; CancelEthTx should be used
+// for the others. If an attempt of an abandoned unconfirmed transaction is
+// mined anyway, the next transaction will be rejected with nonce too low and
+// must be fixed up by the operator.
+func (b *BulletproofTxManager) AbandonEthTx(ctx context.Context, id int64) (etx EthTx, err error) {
+	eb, ec, err := b.services()
+	if err != nil {
+		return etx, err
+	}
+	etx, err = findEthTxWithAttempts(b.db, id, b.chainID)
+	if err != nil {
+		return etx, err
+	}
+	err = ec.runAdmin(ctx, func(ctx context.Context) error {
+		return eb.runAdmin(ctx, etx.FromAddress, func(context.Context) error {
+			return eb.abandonEthTx(id)
+		})
+	})
+	if err != nil {
+		return etx, err
+	}
+	return findEthTxWithAttempts(b.db, id, b.chainID)
+}
+
+func (b *BulletproofTxManager) services() (*EthBroadcaster, *EthConfirmer, error) {
+	b.servicesMu.RLock()
+	defer b.servicesMu.RUnlock()
+	if b.eb == nil || b.ec == nil {
+		return nil, nil, errors.New("transaction manager is not started")
+	}
+	return b.eb, b.ec, nil
+}
+
+func (b *BulletproofTxManager) setServices(eb *EthBroadcaster, ec *EthConfirmer) {
+	b.servicesMu.Lock()
+	defer b.servicesMu.Unlock()
+	b.eb, b.ec = eb, ec
+}
+
+// findEthTxWithAttempts returns the eth_tx with its attempts, highest priced
+// first
+func findEthTxWithAttempts(db *gorm.DB, id int64, chainID big.Int) (etx EthTx, err error) {
+	err = db.
+		Preload("EthTxAttempts", func(db *gorm.DB) *gorm.DB {
+			return db.Order("eth_tx_attempts.gas_price DESC, eth_tx_attempts.gas_tip_cap DESC")
+		}).
+		First(&etx, "id = ? AND evm_chain_id = ?", id, chainID.String()).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return etx, errors.Wrapf(ErrEthTxNotFound, "eth_tx %v on chain %s", id, chainID.String())
+	}
+	return etx, errors.Wrap(err, "findEthTxWithAttempts failed")
+}
+
+// findUnconfirmedEthTx returns the eth_tx if it is unconfirmed and its
+// highest priced attempt can be replaced
+func findUnconfirmedEthTx(db *gorm.DB, id int64, chainID big.Int) (etx EthTx, err error) {
+	etx, err = findEthTxWithAttempts(db, id, chainID)
+	if err != nil {
+		return etx, err
+	}
+	if etx.State != EthTxUnconfirmed {
+		return etx, errors.Wrapf(ErrEthTxNotModifiable, "eth_tx %v is %s, only unconfirmed transactions can be replaced", id, etx.State)
+	}
+	if len(etx.EthTxAttempts) == 0 {
+		return etx, errors.Errorf("invariant violation: unconfirmed eth_tx %v has no attempts", id)
+	}
+	for _, attempt := range etx.EthTxAttempts {
+		if attempt.State == EthTxAttemptInProgress {
+			return etx, errors.Wrapf(ErrEthTxNotModifiable, "eth_tx %v has an attempt in progress, try again after the next head", id)
+		}
+	}
+	return etx, nil
+}
+
+func (ec *EthConfirmer) runAdmin(ctx context.Context, fn func(ctx context.Context) error) error {
+	return runAdminRequest(ctx, ec.chAdmin, ec.ctx.Done(), fn)
+}
+
+func (ec *EthConfirmer) cancelEthTx(ctx context.Context, id int64) error {
+	etx, err := findUnconfirmedEthTx(ec.db, id, ec.chainID)
+	if err != nil {
+		return err
+	}
+
+	previousAttempt := etx.EthTxAttempts[0]
+	etx.ToAddress = etx.FromAddress
+	etx.EncodedPayload = []byte{}
+	etx.Value = assets.NewEthValue(0)
+	etx.GasLimit = ec.config.EvmGasLimitDefault()
+	etx.AccessList = NullableEIP2930AccessList{}
+	pipelineTaskRunID := etx.PipelineTaskRunID
+	etx.PipelineTaskRunID = uuid.NullUUID{}
+	previousAttempt.EthTx = etx
+
+	attempt, err := ec.bumpGas(previousAttempt)
+	if err != nil {
+		return errors.Wrap(err, "could not bump gas to replace the transaction")
+	}
+
+	// The pipeline runs waiting for the transaction are only resumed once the
+	// cancellation is saved, since the transaction may still be mined otherwise
+	runIDs, err := waitingTaskRunIDs(ec.db, etx.ID, pipelineTaskRunID)
+	if err != nil {
+		return err
+	}
+
+	err = postgres.GormTransactionWithDefaultContext(ec.db, func(tx *gorm.DB) error {
+		if err = failBatchedEthTxes(tx, etx.ID, "cancelled by node operator"); err != nil {
+			return err
+		}
+		if err = tx.Save(&etx).Error; err != nil {
+			return errors.Wrap(err, "cancelEthTx failed to save eth_tx")
+		}
+		return errors.Wrap(tx.Create(&attempt).Error, "cancelEthTx failed to save eth_tx_attempt")
+	})
+	if err != nil {
+		return err
+	}
+	resumeErr := resumeTaskRuns(ec.resumeCallback, ec.logger, runIDs, errors.New("transaction cancelled by node operator"))
+
+	ec.logger.Infow("EthConfirmer: Cancelling transaction with a self-send at the same nonce", "ethTxID", etx.ID, "nonce", etx.Nonce,
+		"txHash", attempt.Hash, "gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
+	return multierr.Combine(ec.handleInProgressAttempt(ctx, etx, attempt, 0), resumeErr)
+}
+
+func (ec *EthConfirmer) bumpEthTxGas(ctx context.Context, id int64, gasPriceWei *big.Int) error {
+	etx, err := findUnconfirmedEthTx(ec.db, id, ec.chainID)
+	if err != nil {
+		return err
+	}
+
+	previousAttempt := etx.EthTxAttempts[0]
+	previousAttempt.EthTx = etx
+	var attempt EthTxAttempt
+	if gasPriceWei == nil {
+		attempt, err = ec.bumpGas(previousAttempt)
+	} else if previousAttempt.TxType != 0x0 {
+		return errors.Wrapf(ErrEthTxNotModifiable, "eth_tx %v is an EIP-1559 transaction, a gas price can only be given for legacy transactions", id)
+	} else if gasPriceWei.Cmp(previousAttempt.GasPrice.ToInt()) <= 0 {
+		return errors.Wrapf(ErrEthTxNotModifiable, "gas price %s wei must be higher than the current gas price of %s wei", gasPriceWei.String(), previousAttempt.GasPrice.String())
+	} else {
+		attempt, err = ec.NewLegacyAttempt(etx, gasPriceWei, previousAttempt.ChainSpecificGasLimit)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not bump gas")
+	}
+	if err = ec.saveInProgressAttempt(&attempt); err != nil {
+		return err
+	}
+
+	ec.logger.Infow("EthConfirmer: Bumping gas of transaction on request", "ethTxID", etx.ID, "nonce", etx.Nonce,
+		"txHash", attempt.Hash, "gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
+	return ec.handleInProgressAttempt(ctx, etx, attempt, 0)
+}
+
+func (eb *EthBroadcaster) runAdmin(ctx context.Context, address gethCommon.Address, fn func(ctx context.Context) error) error {
+	ch, exists := eb.adminChs[address]
+	if !exists {
+		return errors.Errorf("no enabled key with address %s on this chain", address.Hex())
+	}
+	return runAdminRequest(ctx, ch, eb.chStop, fn)
+}
+
+func (eb *EthBroadcaster) abandonEthTx(id int64) error {
+	etx, err := findEthTxWithAttempts(eb.db, id, eb.chainID)
+	if err != nil {
+		return err
+	}
+	switch etx.State {
+	case EthTxInProgress:
+		// The key's next nonce is only incremented once the transaction is
+		// broadcast, so the nonce is released as is
+	case EthTxUnconfirmed:
+		var count int64
+		err = eb.db.Raw(`SELECT count(*) FROM eth_txes WHERE from_address = ? AND evm_chain_id = ? AND nonce > ?`,
+			etx.FromAddress, eb.chainID.String(), *etx.Nonce).Scan(&count).Error
+		if err != nil {
+			return errors.Wrap(err, "abandonEthTx failed to count later transactions")
+		}
+		if count > 0 {
+			return errors.Wrapf(ErrEthTxNotModifiable, "eth_tx %v is followed by %d transactions with higher nonces, cancel it instead", id, count)
+		}
+	default:
+		return errors.Wrapf(ErrEthTxNotModifiable, "eth_tx %v is %s, only in_progress and unconfirmed transactions can be abandoned", id, etx.State)
+	}
+
+	runIDs, err := waitingTaskRunIDs(eb.db, etx.ID, etx.PipelineTaskRunID)
+	if err != nil {
+		return err
+	}
+
+	eb.logger.Warnw("Abandoning transaction on request, its nonce will be reused", "ethTxID", etx.ID, "nonce", *etx.Nonce, "state", etx.State)
+	state, nonce := etx.State, *etx.Nonce
+	etx.State = EthTxFatalError
+	etx.Nonce = nil
+	etx.BroadcastAt = nil
+	etx.Error = null.StringFrom(AbandonedEthTxError)
+	err = postgres.GormTransactionWithDefaultContext(eb.db, func(tx *gorm.DB) error {
+		if err := tx.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id = ?`, etx.ID).Error; err != nil {
+			return errors.Wrap(err, "abandonEthTx failed to delete eth_tx_attempts")
+		}
+		if err := failBatchedEthTxes(tx, etx.ID, AbandonedEthTxError); err != nil {
+			return err
+		}
+		if state == EthTxUnconfirmed {
+			res := tx.Exec(`UPDATE eth_key_states SET next_nonce = ?, updated_at = NOW() WHERE address = ? AND evm_chain_id = ? AND next_nonce = ?`,
+				nonce, etx.FromAddress, eb.chainID.String(), nonce+1)
+			if res.Error != nil {
+				return errors.Wrap(res.Error, "abandonEthTx failed to update next nonce")
+			} else if res.RowsAffected == 0 {
+				return errors.Errorf("next nonce of key %s is not %v, cannot abandon eth_tx %v", etx.FromAddress.Hex(), nonce+1, etx.ID)
+			}
+		}
+		return errors.Wrap(tx.Save(&etx).Error, "abandonEthTx failed to save eth_tx")
+	})
+	if err != nil {
+		return err
+	}
+	return resumeTaskRuns(eb.resumeCallback, eb.logger, runIDs, errors.New("transaction abandoned by node operator"))
+}
+
+// waitingTaskRunIDs returns the pipeline task runs waiting for the eth_tx,
+// including those waiting for the eth_txes batched into it
+func waitingTaskRunIDs(db *gorm.DB, ethTxID int64, pipelineTaskRunID uuid.NullUUID) ([]uuid.UUID, error) {
+	runIDs, err := batchedTaskRunIDs(db, ethTxID)
+	if err != nil {
+		return nil, err
+	}
+	if pipelineTaskRunID.Valid {
+		runIDs = append([]uuid.UUID{pipelineTaskRunID.UUID}, runIDs...)
+	}
+	return runIDs, nil
+}
@@ -0,0 +1,187 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+func TestEthConfirmer_CancelEthTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	evmcfg := evmtest.NewChainScopedConfig(t, configtest.NewTestGeneralConfig(t))
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	var resumedID uuid.UUID
+	var resumedErr error
+	var resumedBeforeSave bool
+	ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, func(id uuid.UUID, _ interface{}, err error) error {
+		resumedID, resumedErr = id, err
+		// The cancelled eth_tx no longer references the run once it is saved
+		return db.Raw(`SELECT EXISTS (SELECT 1 FROM eth_txes WHERE pipeline_task_run_id = ?)`, id).Scan(&resumedBeforeSave).Error
+	})
+
+	t.Run("fails if the transaction is not unconfirmed", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 0, 1, fromAddress)
+		err := bulletprooftxmanager.CancelEthTxOnEthConfirmer(context.Background(), ec, etx.ID)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrEthTxNotModifiable))
+	})
+
+	t.Run("fails if the transaction does not exist", func(t *testing.T) {
+		err := bulletprooftxmanager.CancelEthTxOnEthConfirmer(context.Background(), ec, 424242)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrEthTxNotFound))
+	})
+
+	t.Run("replaces the transaction with a self-send at the same nonce", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 1, fromAddress)
+		runID := uuid.NewV4()
+		require.NoError(t, db.Exec(`UPDATE eth_txes SET pipeline_task_run_id = ? WHERE id = ?`, runID, etx.ID).Error)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == 1 && *tx.To() == fromAddress && tx.Value().Sign() == 0 && len(tx.Data()) == 0 &&
+				tx.GasPrice().Cmp(etx.EthTxAttempts[0].GasPrice.ToInt()) > 0
+		})).Return(nil).Once()
+
+		require.NoError(t, bulletprooftxmanager.CancelEthTxOnEthConfirmer(context.Background(), ec, etx.ID))
+
+		assert.Equal(t, runID, resumedID)
+		require.Error(t, resumedErr)
+		assert.Contains(t, resumedErr.Error(), "cancelled")
+		assert.False(t, resumedBeforeSave, "run was resumed before the cancellation was saved")
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+		assert.Equal(t, fromAddress, etx.ToAddress)
+		assert.Len(t, etx.EncodedPayload, 0)
+		assert.False(t, etx.PipelineTaskRunID.Valid)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, etx.EthTxAttempts[1].State)
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthConfirmer_BumpEthTxGas(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	evmcfg := evmtest.NewChainScopedConfig(t, configtest.NewTestGeneralConfig(t))
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, []ethkey.State{state}, nil)
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 0, fromAddress)
+
+	t.Run("fails if the gas price is not higher than the current one", func(t *testing.T) {
+		err := bulletprooftxmanager.BumpEthTxGasOnEthConfirmer(context.Background(), ec, etx.ID, big.NewInt(1))
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrEthTxNotModifiable))
+	})
+
+	t.Run("sends a new attempt with the given gas price", func(t *testing.T) {
+		gasPrice := big.NewInt(42)
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == 0 && tx.GasPrice().Cmp(gasPrice) == 0
+		})).Return(nil).Once()
+
+		require.NoError(t, bulletprooftxmanager.BumpEthTxGasOnEthConfirmer(context.Background(), ec, etx.ID, gasPrice))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+		assert.Equal(t, gasPrice, etx.EthTxAttempts[1].GasPrice.ToInt())
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptBroadcast, etx.EthTxAttempts[1].State)
+	})
+
+	t.Run("bumps the gas price as the node would without one", func(t *testing.T) {
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == 0 && tx.GasPrice().Cmp(big.NewInt(42)) > 0
+		})).Return(nil).Once()
+
+		require.NoError(t, bulletprooftxmanager.BumpEthTxGasOnEthConfirmer(context.Background(), ec, etx.ID, nil))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 3)
+	})
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthBroadcaster_AbandonEthTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	evmcfg := evmtest.NewChainScopedConfig(t, configtest.NewTestGeneralConfig(t))
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{state})
+
+	nextNonce := func() int64 {
+		var n int64
+		require.NoError(t, db.Raw(`SELECT next_nonce FROM eth_key_states WHERE address = ?`, fromAddress).Scan(&n).Error)
+		return n
+	}
+
+	etx0 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 0, fromAddress)
+	etx1 := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 1, fromAddress)
+	require.NoError(t, db.Exec(`UPDATE eth_key_states SET next_nonce = 2 WHERE address = ?`, fromAddress).Error)
+
+	t.Run("fails if a later nonce has been used", func(t *testing.T) {
+		err := bulletprooftxmanager.AbandonEthTxOnEthBroadcaster(eb, etx0.ID)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrEthTxNotModifiable))
+	})
+
+	t.Run("abandons the unconfirmed transaction with the highest nonce and releases its nonce", func(t *testing.T) {
+		require.NoError(t, bulletprooftxmanager.AbandonEthTxOnEthBroadcaster(eb, etx1.ID))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+		assert.Nil(t, etx.Nonce)
+		assert.Equal(t, bulletprooftxmanager.AbandonedEthTxError, etx.Error.String)
+		assert.Len(t, etx.EthTxAttempts, 0)
+		assert.Equal(t, int64(1), nextNonce())
+	})
+
+	t.Run("abandons an in_progress transaction", func(t *testing.T) {
+		require.NoError(t, db.Exec(`DELETE FROM eth_txes WHERE id = ?`, etx0.ID).Error)
+		etx := cltest.MustInsertInProgressEthTxWithAttempt(t, db, 1, fromAddress)
+
+		require.NoError(t, bulletprooftxmanager.AbandonEthTxOnEthBroadcaster(eb, etx.ID))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+		assert.Equal(t, int64(1), nextNonce())
+	})
+
+	t.Run("fails if the transaction is already fatally errored", func(t *testing.T) {
+		err := bulletprooftxmanager.AbandonEthTxOnEthBroadcaster(eb, etx1.ID)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, bulletprooftxmanager.ErrEthTxNotModifiable))
+	})
+}
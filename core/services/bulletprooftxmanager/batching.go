@@ -0,0 +1,347 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+// batchForwarderABI is the subset of the AuthorizedBatchForwarder ABI used to
+// batch txes. aggregate3 is the same function as in Multicall3, see
+// https://github.com/mds1/multicall, but only callable by authorized senders.
+const batchForwarderABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct AuthorizedBatchForwarder.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct AuthorizedBatchForwarder.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"sender","type":"address"}],"name":"isAuthorizedSender","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"}]`
+
+var batchForwarder = eth.MustGetABI(batchForwarderABI)
+
+// PublicMulticall3Address is where Multicall3 is deployed on most chains.
+// Anyone can make calls through it, so it must never be used to batch txes:
+// target contracts authorizing it would accept calls from anyone.
+var PublicMulticall3Address = gethCommon.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// MulticallCallGasOverhead is the gas added to the gas limit of a batch for
+// each call it makes, covering the cost of the multicall contract itself
+const MulticallCallGasOverhead = 5000
+
+// multicall3Call is a Multicall3.Call3
+type multicall3Call struct {
+	Target       gethCommon.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// encodeAggregate3 encodes a call to aggregate3 making the calls of the given
+// eth_txes. No call is allowed to fail, so that the status of the batch is the
+// status of every eth_tx in it.
+func encodeAggregate3(etxes []EthTx) ([]byte, error) {
+	calls := make([]multicall3Call, len(etxes))
+	for i, etx := range etxes {
+		calls[i] = multicall3Call{Target: etx.ToAddress, AllowFailure: false, CallData: etx.EncodedPayload}
+	}
+	return batchForwarder.Pack("aggregate3", calls)
+}
+
+// batchGasPriority is the most urgent priority of the eth_txes in a batch
+func batchGasPriority(etxes []EthTx) gas.Priority {
+	priority := gas.PrioritySlow
+	for _, etx := range etxes {
+		switch etx.GasPriority {
+		case gas.PriorityUrgent:
+			return gas.PriorityUrgent
+		case gas.PrioritySlow:
+		default:
+			priority = gas.PriorityStandard
+		}
+	}
+	return priority
+}
+
+// batchUnstartedEthTxes merges etx with the other unstarted eth_txes from the
+// same sender that go through the same multicall contract into a single
+// carrier eth_tx calling aggregate3. Merged eth_txes are moved to batched and
+// follow the state of the carrier from then on. Returns nil if none of the
+// eth_txes could be batched because they all reverted during simulation.
+func (eb *EthBroadcaster) batchUnstartedEthTxes(etx EthTx) (*EthTx, error) {
+	limit := int(eb.config.EvmMaxTxBatchSize())
+	if limit < 1 {
+		limit = 1
+	}
+	var etxes []EthTx
+	err := eb.db.
+		Where("from_address = ? AND state = 'unstarted' AND evm_chain_id = ? AND multicall_address = ?", etx.FromAddress, eb.chainID.String(), *etx.MulticallAddress).
+		Order("value ASC, created_at ASC, id ASC").
+		Limit(limit).
+		Find(&etxes).
+		Error
+	if err != nil {
+		return nil, errors.Wrap(err, "batchUnstartedEthTxes failed to load eth_txes")
+	}
+
+	if err = eb.checkBatchForwarder(etx.FromAddress, *etx.MulticallAddress); err != nil {
+		var notForwarder *notBatchForwarderError
+		if !errors.As(err, &notForwarder) {
+			return nil, err
+		}
+		eb.logger.Errorw("Refusing to batch transactions through a contract that does not only accept them from the sending key",
+			"multicallAddress", etx.MulticallAddress, "fromAddress", etx.FromAddress, "err", err)
+		for i := range etxes {
+			etxes[i].Error = null.StringFrom(err.Error())
+			if err = eb.saveFatallyErroredUnstartedTransaction(&etxes[i]); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+
+	etxes, err = eb.simulateBatchedEthTxes(etxes, *etx.MulticallAddress)
+	if err != nil {
+		return nil, err
+	}
+	if len(etxes) == 0 {
+		return nil, nil
+	}
+
+	payload, err := encodeAggregate3(etxes)
+	if err != nil {
+		return nil, errors.Wrap(err, "batchUnstartedEthTxes failed to encode aggregate3 call")
+	}
+	var gasLimit uint64
+	ids := make([]int64, len(etxes))
+	for i, e := range etxes {
+		gasLimit += e.GasLimit + MulticallCallGasOverhead
+		ids[i] = e.ID
+	}
+
+	carrier := EthTx{
+		FromAddress:    etx.FromAddress,
+		ToAddress:      *etx.MulticallAddress,
+		EncodedPayload: payload,
+		Value:          assets.NewEthValue(0),
+		GasLimit:       gasLimit,
+		State:          EthTxUnstarted,
+		EVMChainID:     etx.EVMChainID,
+		GasPriority:    batchGasPriority(etxes),
+	}
+	err = postgres.GormTransactionWithDefaultContext(eb.db, func(tx *gorm.DB) error {
+		if err = tx.Create(&carrier).Error; err != nil {
+			return errors.Wrap(err, "batchUnstartedEthTxes failed to create carrier eth_tx")
+		}
+		res := tx.Exec(`UPDATE eth_txes SET state = 'batched', batch_eth_tx_id = ? WHERE id IN (?) AND state = 'unstarted'`, carrier.ID, ids)
+		if res.Error != nil {
+			return errors.Wrap(res.Error, "batchUnstartedEthTxes failed to update batched eth_txes")
+		} else if res.RowsAffected != int64(len(ids)) {
+			return errors.Errorf("expected to batch %d eth_txes, but only %d were still unstarted", len(ids), res.RowsAffected)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The carrier takes the nonce that was assigned to etx
+	carrier.Nonce = etx.Nonce
+	eb.logger.Debugw("Batched eth_txes into a single multicall transaction", "ethTxID", carrier.ID, "batchedEthTxIDs", ids,
+		"multicallAddress", carrier.ToAddress, "gasLimit", carrier.GasLimit)
+	return &carrier, nil
+}
+
+// notBatchForwarderError is returned by checkBatchForwarder if the contract
+// is not a batch forwarder that authorizes the sender
+type notBatchForwarderError struct {
+	forwarder gethCommon.Address
+	sender    gethCommon.Address
+	reason    string
+}
+
+func (e *notBatchForwarderError) Error() string {
+	return fmt.Sprintf("%s is not an authorized batch forwarder for %s: %s", e.forwarder.Hex(), e.sender.Hex(), e.reason)
+}
+
+// checkBatchForwarder makes sure that txes are only batched through a
+// forwarder that only accepts calls from authorized senders, and that the
+// sender is one of them. A public multicall contract would let anyone send
+// calls as if they came from the node, to every contract that authorizes it.
+func (eb *EthBroadcaster) checkBatchForwarder(sender, forwarder gethCommon.Address) error {
+	if forwarder == PublicMulticall3Address {
+		return &notBatchForwarderError{forwarder, sender, "it is the public Multicall3 contract"}
+	}
+	data, err := batchForwarder.Pack("isAuthorizedSender", sender)
+	if err != nil {
+		return errors.Wrap(err, "checkBatchForwarder failed to encode isAuthorizedSender call")
+	}
+	ctx, cancel := eth.DefaultQueryCtx()
+	defer cancel()
+	ret, err := eb.ethClient.CallContract(ctx, ethereum.CallMsg{To: &forwarder, Data: data}, nil)
+	if err != nil {
+		if jErr := eth.ExtractRPCError(err); jErr != nil {
+			return &notBatchForwarderError{forwarder, sender, fmt.Sprintf("isAuthorizedSender reverted: %s", jErr.String())}
+		}
+		return errors.Wrap(err, "checkBatchForwarder failed to call isAuthorizedSender")
+	}
+	var authorized bool
+	if err = batchForwarder.UnpackIntoInterface(&authorized, "isAuthorizedSender", ret); err != nil {
+		return &notBatchForwarderError{forwarder, sender, "it does not implement isAuthorizedSender"}
+	}
+	if !authorized {
+		return &notBatchForwarderError{forwarder, sender, "the sender is not authorized"}
+	}
+	return nil
+}
+
+// simulateBatchedEthTxes simulates the eth_txes that should be simulated as
+// calls from the multicall contract, in a single batch request. Eth_txes that
+// revert are fatally errored and left out of the batch.
+func (eb *EthBroadcaster) simulateBatchedEthTxes(etxes []EthTx, multicallAddress gethCommon.Address) ([]EthTx, error) {
+	var reqs []rpc.BatchElem
+	var simulated []int
+	for i, etx := range etxes {
+		if !etx.Simulate {
+			continue
+		}
+		callArg := map[string]interface{}{
+			"from":  multicallAddress,
+			"to":    etx.ToAddress,
+			"gas":   hexutil.Uint64(etx.GasLimit),
+			"value": (*hexutil.Big)(etx.Value.ToInt()),
+			"data":  hexutil.Bytes(etx.EncodedPayload),
+		}
+		reqs = append(reqs, rpc.BatchElem{
+			Method: "eth_call",
+			Args:   []interface{}{callArg, eth.ToBlockNumArg(nil)},
+			Result: &hexutil.Bytes{},
+		})
+		simulated = append(simulated, i)
+	}
+	if len(reqs) == 0 {
+		return etxes, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), SimulationTimeout)
+	defer cancel()
+	if err := eb.ethClient.BatchCallContext(ctx, reqs); err != nil {
+		eb.logger.Warnw("Batched transaction simulation failed, will attempt to send anyway", "err", err)
+		return etxes, nil
+	}
+
+	reverted := make(map[int]struct{})
+	for j, req := range reqs {
+		etx := etxes[simulated[j]]
+		jErr := eth.ExtractRPCError(req.Error)
+		if jErr == nil {
+			if req.Error != nil {
+				eb.logger.Warnw("Transaction simulation failed, will attempt to send anyway", "ethTxID", etx.ID, "err", req.Error)
+			}
+			continue
+		}
+		eb.logger.Errorw("Transaction reverted during simulation", "ethTxID", etx.ID, "err", req.Error, "rpcErr", jErr.String())
+		etx.Error = null.StringFrom(fmt.Sprintf("transaction reverted during simulation: %s", jErr.String()))
+		if err := eb.saveFatallyErroredUnstartedTransaction(&etx); err != nil {
+			return nil, err
+		}
+		reverted[simulated[j]] = struct{}{}
+	}
+
+	remaining := etxes[:0]
+	for i, etx := range etxes {
+		if _, exists := reverted[i]; !exists {
+			remaining = append(remaining, etx)
+		}
+	}
+	return remaining, nil
+}
+
+// saveFatallyErroredUnstartedTransaction fatally errors an eth_tx that never
+// made it to in_progress, resuming its pipeline run first like
+// saveFatallyErroredTransaction
+func (eb *EthBroadcaster) saveFatallyErroredUnstartedTransaction(etx *EthTx) error {
+	if etx.PipelineTaskRunID.Valid && eb.resumeCallback != nil {
+		err := eb.resumeCallback(etx.PipelineTaskRunID.UUID, nil, errors.Errorf("fatal error while sending transaction: %s", etx.Error.String))
+		if errors.Is(err, sql.ErrNoRows) {
+			eb.logger.Debugw("callback missing or already resumed", "etxID", etx.ID)
+		} else if err != nil {
+			return errors.Wrap(err, "failed to resume pipeline")
+		}
+	}
+	etx.State = EthTxFatalError
+	return errors.Wrap(eb.db.Exec(`UPDATE eth_txes SET state = 'fatal_error', error = ? WHERE id = ? AND state = 'unstarted'`, etx.Error, etx.ID).Error,
+		"saveFatallyErroredUnstartedTransaction failed to save eth_tx")
+}
+
+// resumeBatchedEthTxes resumes the pipeline runs waiting on the eth_txes
+// batched into the given carrier with the carrier's fatal error. It must be
+// called before failBatchedEthTxes.
+func resumeBatchedEthTxes(db *gorm.DB, resumeCallback ResumeCallback, lggr logger.Logger, batchEthTxID int64, fatalErr error) error {
+	if resumeCallback == nil {
+		return nil
+	}
+	runIDs, err := batchedTaskRunIDs(db, batchEthTxID)
+	if err != nil {
+		return err
+	}
+	return resumeTaskRuns(resumeCallback, lggr, runIDs, fatalErr)
+}
+
+// batchedTaskRunIDs returns the pipeline task runs waiting on the eth_txes
+// batched into the given carrier
+func batchedTaskRunIDs(db *gorm.DB, batchEthTxID int64) (runIDs []uuid.UUID, err error) {
+	err = postgres.UnwrapGormDB(db).Select(&runIDs, `SELECT pipeline_task_run_id FROM eth_txes WHERE batch_eth_tx_id = $1 AND state = 'batched' AND pipeline_task_run_id IS NOT NULL`, batchEthTxID)
+	return runIDs, errors.Wrap(err, "batchedTaskRunIDs failed to load pipeline task runs")
+}
+
+// resumeTaskRuns resumes the given pipeline task runs with fatalErr
+func resumeTaskRuns(resumeCallback ResumeCallback, lggr logger.Logger, runIDs []uuid.UUID, fatalErr error) error {
+	if resumeCallback == nil {
+		return nil
+	}
+	for _, id := range runIDs {
+		err := resumeCallback(id, nil, fatalErr)
+		if errors.Is(err, sql.ErrNoRows) {
+			lggr.Debugw("callback missing or already resumed", "taskRunID", id)
+		} else if err != nil {
+			return errors.Wrap(err, "failed to resume pipeline")
+		}
+	}
+	return nil
+}
+
+// failBatchedEthTxes moves the eth_txes batched into the given carrier to
+// fatal_error along with it
+func failBatchedEthTxes(tx *gorm.DB, batchEthTxID int64, reason string) error {
+	return errors.Wrap(tx.Exec(`UPDATE eth_txes SET state = 'fatal_error', error = ? WHERE batch_eth_tx_id = ? AND state = 'batched'`, reason, batchEthTxID).Error,
+		"failBatchedEthTxes failed")
+}
+
+// syncBatchedEthTxes moves the eth_txes batched into carriers that were
+// confirmed, are missing a receipt or expired along with their carrier, and
+// back to batched if their carrier was re-orged out. Batched eth_txes keep a
+// null nonce, since the nonce used on-chain is their carrier's.
+func syncBatchedEthTxes(db *gorm.DB, chainID *big.Int) error {
+	return errors.Wrap(db.Exec(`
+UPDATE eth_txes SET
+	state = CASE WHEN carriers.state = 'unconfirmed' THEN 'batched'::eth_txes_state ELSE carriers.state END,
+	broadcast_at = CASE WHEN carriers.state IN ('confirmed', 'confirmed_missing_receipt') THEN carriers.broadcast_at END,
+	error = CASE WHEN carriers.state = 'fatal_error' THEN carriers.error END
+FROM eth_txes AS carriers
+WHERE eth_txes.batch_eth_tx_id = carriers.id
+AND eth_txes.state <> 'fatal_error'
+AND (
+	carriers.state IN ('confirmed', 'confirmed_missing_receipt', 'fatal_error') AND eth_txes.state <> carriers.state
+	OR carriers.state = 'unconfirmed' AND eth_txes.state <> 'batched'
+)
+AND carriers.evm_chain_id = ?
+`, chainID.String()).Error, "syncBatchedEthTxes failed")
+}
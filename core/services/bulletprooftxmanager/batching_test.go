@@ -0,0 +1,160 @@
+package bulletprooftxmanager_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Batching(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, configtest.NewTestGeneralConfig(t))
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	var resumed []uuid.UUID
+	bulletprooftxmanager.SetResumeCallbackOnEthBroadcaster(func(id uuid.UUID, _ interface{}, err error) error {
+		require.Error(t, err)
+		resumed = append(resumed, id)
+		return nil
+	}, eb)
+
+	multicall := cltest.NewAddress()
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	gasLimit := uint64(100000)
+	aggregate3Selector := hexutil.MustDecode("0x82ad56cb")
+	isAuthorizedSenderSelector := hexutil.MustDecode("0xfa00763a")
+
+	insertEthTx := func(simulate bool) bulletprooftxmanager.EthTx {
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:       fromAddress,
+			ToAddress:         toAddress,
+			EncodedPayload:    []byte{1, 2, 3},
+			Value:             assets.NewEthValue(0),
+			GasLimit:          gasLimit,
+			State:             bulletprooftxmanager.EthTxUnstarted,
+			MulticallAddress:  &multicall,
+			Simulate:          simulate,
+			PipelineTaskRunID: uuid.NullUUID{UUID: uuid.NewV4(), Valid: true},
+		}
+		require.NoError(t, db.Save(&etx).Error)
+		return etx
+	}
+	// isAuthorizedSender(fromAddress) returns authorized
+	expectAuthorized := func(authorized bool) {
+		ret := make([]byte, 32)
+		if authorized {
+			ret[31] = 1
+		}
+		ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+			return *msg.To == multicall && bytes.HasPrefix(msg.Data, isAuthorizedSenderSelector) && bytes.HasSuffix(msg.Data, fromAddress.Bytes())
+		}), mock.Anything).Return(ret, nil).Once()
+	}
+	findEthTx := func(id int64) bulletprooftxmanager.EthTx {
+		etx, err := cltest.FindEthTxWithAttempts(db, id)
+		require.NoError(t, err)
+		return etx
+	}
+
+	t.Run("merges queued eth_txes into one multicall transaction, leaving out those that revert in simulation", func(t *testing.T) {
+		etx1 := insertEthTx(false)
+		etx2 := insertEthTx(true)
+		reverting := insertEthTx(true)
+		expectAuthorized(true)
+
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 2 && b[0].Method == "eth_call" && b[0].Args[0].(map[string]interface{})["from"] == multicall
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			elems[1].Error = &eth.JsonError{Code: 3, Message: "execution reverted"}
+		}).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0 && *tx.To() == multicall && tx.Gas() == 2*(gasLimit+bulletprooftxmanager.MulticallCallGasOverhead) &&
+				bytes.HasPrefix(tx.Data(), aggregate3Selector)
+		})).Return(nil).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		etx1 = findEthTx(etx1.ID)
+		etx2 = findEthTx(etx2.ID)
+		assert.Equal(t, bulletprooftxmanager.EthTxBatched, etx1.State)
+		assert.Equal(t, bulletprooftxmanager.EthTxBatched, etx2.State)
+		require.True(t, etx1.BatchEthTxID.Valid)
+		assert.Equal(t, etx1.BatchEthTxID, etx2.BatchEthTxID)
+
+		carrier := findEthTx(etx1.BatchEthTxID.Int64)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, carrier.State)
+		assert.Equal(t, multicall, carrier.ToAddress)
+		assert.Nil(t, carrier.MulticallAddress)
+		require.Len(t, carrier.EthTxAttempts, 1)
+
+		reverting = findEthTx(reverting.ID)
+		assert.Equal(t, bulletprooftxmanager.EthTxFatalError, reverting.State)
+		assert.Contains(t, reverting.Error.String, "transaction reverted during simulation")
+		assert.Equal(t, []uuid.UUID{reverting.PipelineTaskRunID.UUID}, resumed)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("fails every batched eth_tx if the multicall transaction fails", func(t *testing.T) {
+		resumed = nil
+		etx1 := insertEthTx(false)
+		etx2 := insertEthTx(false)
+		expectAuthorized(true)
+
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 1 && *tx.To() == multicall
+		})).Return(errors.New("exceeds block gas limit")).Once()
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		for _, etx := range []bulletprooftxmanager.EthTx{etx1, etx2} {
+			etx = findEthTx(etx.ID)
+			assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+			assert.Equal(t, "exceeds block gas limit", etx.Error.String)
+		}
+		assert.ElementsMatch(t, []uuid.UUID{etx1.PipelineTaskRunID.UUID, etx2.PipelineTaskRunID.UUID}, resumed)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("fails every eth_tx if the multicall contract does not authorize the sender", func(t *testing.T) {
+		resumed = nil
+		etx1 := insertEthTx(false)
+		etx2 := insertEthTx(false)
+		expectAuthorized(false)
+
+		require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+		for _, etx := range []bulletprooftxmanager.EthTx{etx1, etx2} {
+			etx = findEthTx(etx.ID)
+			assert.Equal(t, bulletprooftxmanager.EthTxFatalError, etx.State)
+			assert.Contains(t, etx.Error.String, "the sender is not authorized")
+		}
+		assert.ElementsMatch(t, []uuid.UUID{etx1.PipelineTaskRunID.UUID, etx2.PipelineTaskRunID.UUID}, resumed)
+
+		ethClient.AssertExpectations(t)
+	})
+}
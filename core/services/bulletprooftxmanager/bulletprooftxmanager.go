@@ -43,6 +43,7 @@ type Config interface {
 	EvmGasLimitDefault() uint64
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
+	EvmMaxTxBatchSize() uint32
 	EvmNonceAutoSync() bool
 	EvmRPCDefaultBatchSize() uint32
 	KeySpecificMaxGasPriceWei(addr common.Address) *big.Int
@@ -72,6 +73,9 @@ type TxManager interface {
 	CreateEthTransaction(db *gorm.DB, newTx NewTx) (etx EthTx, err error)
 	GetGasEstimator() gas.Estimator
 	RegisterResumeCallback(fn ResumeCallback)
+	CancelEthTx(ctx context.Context, id int64) (etx EthTx, err error)
+	BumpEthTxGas(ctx context.Context, id int64, gasPriceWei *big.Int) (etx EthTx, err error)
+	AbandonEthTx(ctx context.Context, id int64) (etx EthTx, err error)
 }
 
 type BulletproofTxManager struct {
@@ -96,6 +100,12 @@ type BulletproofTxManager struct {
 
 	reaper      *Reaper
 	ethResender *EthResender
+
+	// servicesMu guards the current EthBroadcaster and EthConfirmer, which
+	// are replaced when keys change, for the admin operations
+	servicesMu sync.RWMutex
+	eb         *EthBroadcaster
+	ec         *EthConfirmer
 }
 
 func (b *BulletproofTxManager) RegisterResumeCallback(fn ResumeCallback) {
@@ -158,6 +168,7 @@ func (b *BulletproofTxManager) Start() (merr error) {
 			return errors.Wrap(err, "BulletproofTxManager: Estimator failed to start")
 		}
 
+		b.setServices(eb, ec)
 		b.wg.Add(1)
 		go b.runLoop(eb, ec)
 		<-b.chSubbed
@@ -226,6 +237,7 @@ func (b *BulletproofTxManager) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 
 			b.logger.ErrorIfCalling(eb.Start)
 			b.logger.ErrorIfCalling(ec.Start)
+			b.setServices(eb, ec)
 		}
 	}
 }
@@ -267,6 +279,10 @@ type NewTx struct {
 	PipelineTaskRunID *uuid.UUID
 
 	Strategy TxStrategy
+
+	// GasPriority selects the urgency level used to estimate gas for this
+	// transaction. Empty means gas.PriorityStandard.
+	GasPriority gas.Priority
 }
 
 // CreateEthTransaction inserts a new transaction
@@ -277,6 +293,15 @@ func (b *BulletproofTxManager) CreateEthTransaction(db *gorm.DB, newTx NewTx) (e
 	}
 
 	value := 0
+	gasPriority := newTx.GasPriority
+	if gasPriority == "" {
+		gasPriority = gas.PriorityStandard
+	}
+	var multicallAddress *common.Address
+	if s, ok := newTx.Strategy.(BatchingTxStrategy); ok {
+		addr := s.MulticallAddress()
+		multicallAddress = &addr
+	}
 	err = postgres.GormTransactionWithDefaultContext(db, func(tx *gorm.DB) error {
 		if newTx.PipelineTaskRunID != nil {
 			err = tx.Raw(`SELECT * FROM eth_txes WHERE pipeline_task_run_id = ? AND evm_chain_id = ?`, newTx.PipelineTaskRunID, b.chainID.String()).Scan(&etx).Error
@@ -293,12 +318,12 @@ func (b *BulletproofTxManager) CreateEthTransaction(db *gorm.DB, newTx NewTx) (e
 			return err
 		}
 		res := tx.Raw(`
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, simulate)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, simulate, gas_priority, multicall_address)
 VALUES (
-?,?,?,?,?,'unstarted',NOW(),?,?,?,?,?,?
+?,?,?,?,?,'unstarted',NOW(),?,?,?,?,?,?,?,?
 )
 RETURNING "eth_txes".*
-`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Strategy.Simulate()).Scan(&etx)
+`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Strategy.Simulate(), string(gasPriority), multicallAddress).Scan(&etx)
 		err = res.Error
 		if err != nil {
 			return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction failed to insert eth_tx")
@@ -539,3 +564,12 @@ func (n *NullTxManager) Healthy() error                           { return nil }
 func (n *NullTxManager) Ready() error                             { return nil }
 func (n *NullTxManager) GetGasEstimator() gas.Estimator           { return nil }
 func (n *NullTxManager) RegisterResumeCallback(fn ResumeCallback) {}
+func (n *NullTxManager) CancelEthTx(context.Context, int64) (etx EthTx, err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) BumpEthTxGas(context.Context, int64, *big.Int) (etx EthTx, err error) {
+	return etx, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) AbandonEthTx(context.Context, int64) (etx EthTx, err error) {
+	return etx, errors.New(n.ErrMsg)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/static"
@@ -61,6 +62,9 @@ type EthBroadcaster struct {
 	// database early (before the next poll interval)
 	// Each key has its own trigger
 	triggers map[gethCommon.Address]chan struct{}
+	// adminChs run admin operations in the goroutine of each key, so they
+	// don't race with processing its transactions
+	adminChs map[gethCommon.Address]chan adminRequest
 
 	chStop chan struct{}
 	wg     sync.WaitGroup
@@ -85,9 +89,11 @@ func NewEthBroadcaster(db *gorm.DB, ethClient eth.Client, config Config, keystor
 			keystore: keystore,
 		},
 		estimator:        estimator,
+		resumeCallback:   resumeCallback,
 		eventBroadcaster: eventBroadcaster,
 		keyStates:        keyStates,
 		triggers:         triggers,
+		adminChs:         make(map[gethCommon.Address]chan adminRequest),
 		chStop:           make(chan struct{}),
 		wg:               sync.WaitGroup{},
 	}
@@ -115,8 +121,10 @@ func (eb *EthBroadcaster) Start() error {
 		eb.wg.Add(len(eb.keyStates))
 		for _, k := range eb.keyStates {
 			triggerCh := make(chan struct{}, 1)
+			adminCh := make(chan adminRequest)
 			eb.triggers[k.Address.Address()] = triggerCh
-			go eb.monitorEthTxs(k, triggerCh)
+			eb.adminChs[k.Address.Address()] = adminCh
+			go eb.monitorEthTxs(k, triggerCh, adminCh)
 		}
 
 		eb.wg.Add(1)
@@ -177,7 +185,7 @@ func (eb *EthBroadcaster) ethTxInsertTriggerer() {
 	}
 }
 
-func (eb *EthBroadcaster) monitorEthTxs(k ethkey.State, triggerCh chan struct{}) {
+func (eb *EthBroadcaster) monitorEthTxs(k ethkey.State, triggerCh chan struct{}, adminCh chan adminRequest) {
 	ctx, cancel := utils.CombinedContext(context.Background(), eb.chStop)
 	defer cancel()
 
@@ -202,6 +210,12 @@ func (eb *EthBroadcaster) monitorEthTxs(k ethkey.State, triggerCh chan struct{})
 				<-pollDBTimer.C
 			}
 			continue
+		case req := <-adminCh:
+			if !pollDBTimer.Stop() {
+				<-pollDBTimer.C
+			}
+			req.chErr <- req.fn(ctx)
+			continue
 		case <-pollDBTimer.C:
 			// DB poller timed out
 			continue
@@ -256,26 +270,41 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 		if etx == nil {
 			return nil
 		}
+		if etx.MulticallAddress != nil {
+			etx, err = eb.batchUnstartedEthTxes(*etx)
+			if err != nil {
+				return errors.Wrap(err, "processUnstartedEthTxs failed")
+			}
+			if etx == nil {
+				continue
+			}
+		}
 		n++
 		var a EthTxAttempt
+		var attemptErr error
 		if eb.config.EvmEIP1559DynamicFees() {
-			fee, gasLimit, err := eb.estimator.GetDynamicFee(etx.GasLimit)
+			fee, gasLimit, err := eb.estimator.GetDynamicFee(etx.GasLimit, etx.GasPriority.Opts()...)
 			if err != nil {
 				return errors.Wrap(err, "failed to get dynamic gas fee")
 			}
-			a, err = eb.NewDynamicFeeAttempt(*etx, fee, gasLimit)
-			if err != nil {
-				return errors.Wrap(err, "processUnstartedEthTxs failed")
-			}
+			a, attemptErr = eb.NewDynamicFeeAttempt(*etx, fee, gasLimit)
 		} else {
-			gasPrice, gasLimit, err := eb.estimator.GetLegacyGas(etx.EncodedPayload, etx.GasLimit)
+			gasPrice, gasLimit, err := eb.estimator.GetLegacyGas(etx.EncodedPayload, etx.GasLimit, etx.GasPriority.Opts()...)
 			if err != nil {
 				return errors.Wrap(err, "failed to estimate gas")
 			}
-			a, err = eb.NewLegacyAttempt(*etx, gasPrice, gasLimit)
-			if err != nil {
+			a, attemptErr = eb.NewLegacyAttempt(*etx, gasPrice, gasLimit)
+		}
+		if errors.Is(attemptErr, keystore.ErrExternalSignerRejected) {
+			// Signing will not succeed if retried, and the nonce has not been
+			// used yet so the eth_tx can be errored right away
+			eb.logger.Errorw("External signer rejected eth_tx", "ethTxID", etx.ID, "err", attemptErr)
+			if err = eb.saveSignRejectedTransaction(etx, attemptErr); err != nil {
 				return errors.Wrap(err, "processUnstartedEthTxs failed")
 			}
+			continue
+		} else if attemptErr != nil {
+			return errors.Wrap(attemptErr, "processUnstartedEthTxs failed")
 		}
 
 		if err := eb.saveInProgressTransaction(etx, &a); errors.Is(err, errEthTxRemoved) {
@@ -618,16 +647,36 @@ func (eb *EthBroadcaster) saveFatallyErroredTransaction(etx *EthTx) error {
 			return errors.Wrap(err, "failed to resume pipeline")
 		}
 	}
+	if err := resumeBatchedEthTxes(eb.db, eb.resumeCallback, eb.logger, etx.ID, errors.Errorf("fatal error while sending transaction: %s", etx.Error.String)); err != nil {
+		return err
+	}
 	etx.Nonce = nil
 	etx.State = EthTxFatalError
 	return postgres.GormTransactionWithDefaultContext(eb.db, func(tx *gorm.DB) error {
 		if err := tx.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id = ?`, etx.ID).Error; err != nil {
 			return errors.Wrapf(err, "saveFatallyErroredTransaction failed to delete eth_tx_attempt with eth_tx.ID %v", etx.ID)
 		}
+		if err := failBatchedEthTxes(tx, etx.ID, etx.Error.String); err != nil {
+			return err
+		}
 		return errors.Wrap(tx.Save(etx).Error, "saveFatallyErroredTransaction failed to save eth_tx")
 	})
 }
 
+// saveSignRejectedTransaction fatally errors an eth_tx that never made it to
+// in_progress because the external signer refused to sign it, along with the
+// eth_txes batched into it
+func (eb *EthBroadcaster) saveSignRejectedTransaction(etx *EthTx, signErr error) error {
+	etx.Error = null.StringFrom(signErr.Error())
+	if err := resumeBatchedEthTxes(eb.db, eb.resumeCallback, eb.logger, etx.ID, errors.Errorf("fatal error while sending transaction: %s", etx.Error.String)); err != nil {
+		return err
+	}
+	if err := failBatchedEthTxes(eb.db, etx.ID, etx.Error.String); err != nil {
+		return err
+	}
+	return eb.saveFatallyErroredUnstartedTransaction(etx)
+}
+
 // GetNextNonce returns keys.next_nonce for the given address
 func GetNextNonce(db *gorm.DB, address gethCommon.Address, chainID *big.Int) (int64, error) {
 	var nonce int64
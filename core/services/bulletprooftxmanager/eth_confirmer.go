@@ -24,6 +24,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/static"
@@ -95,6 +96,7 @@ type EthConfirmer struct {
 	keyStates []ethkey.State
 
 	mb        *utils.Mailbox
+	chAdmin   chan adminRequest
 	ctx       context.Context
 	ctxCancel context.CancelFunc
 	wg        sync.WaitGroup
@@ -121,6 +123,7 @@ func NewEthConfirmer(db *gorm.DB, ethClient eth.Client, config Config, keystore
 		resumeCallback,
 		keyStates,
 		utils.NewMailbox(1),
+		make(chan adminRequest),
 		context,
 		cancel,
 		sync.WaitGroup{},
@@ -175,6 +178,8 @@ func (ec *EthConfirmer) runLoop() {
 					continue
 				}
 			}
+		case req := <-ec.chAdmin:
+			req.chErr <- req.fn(ec.ctx)
 		case <-ec.ctx.Done():
 			return
 		}
@@ -294,7 +299,8 @@ func (ec *EthConfirmer) CheckForReceipts(ctx context.Context, blockNum int64) er
 	if err := ec.markOldTxesMissingReceiptAsErrored(blockNum); err != nil {
 		return errors.Wrap(err, "unable to confirm buried unconfirmed eth_txes")
 	}
-	return nil
+
+	return errors.Wrap(syncBatchedEthTxes(ec.db, &ec.chainID), "unable to update batched eth_txes")
 }
 
 func (ec *EthConfirmer) separateLikelyConfirmedAttempts(from gethCommon.Address, attempts []EthTxAttempt, latestBlockNonce uint64) []EthTxAttempt {
@@ -344,7 +350,7 @@ func (ec *EthConfirmer) fetchAndSaveReceipts(ctx context.Context, attempts []Eth
 		if err != nil {
 			return errors.Wrap(err, "batchFetchReceipts failed")
 		}
-		if err := ec.saveFetchedReceipts(receipts); err != nil {
+		if err := ec.saveFetchedReceipts(receipts, batch); err != nil {
 			return errors.Wrap(err, "saveFetchedReceipts failed")
 		}
 		promNumConfirmedTxs.WithLabelValues(ec.chainID.String()).Add(float64(len(receipts)))
@@ -451,7 +457,7 @@ func (ec *EthConfirmer) batchFetchReceipts(ctx context.Context, attempts []EthTx
 	return
 }
 
-func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
+func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt, attempts []EthTxAttempt) (err error) {
 	if len(receipts) == 0 {
 		return nil
 	}
@@ -482,6 +488,10 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 	// # EthTxes update
 	// Should be self-explanatory. If we got a receipt, the eth_tx is confirmed.
 	//
+	// # EthTxFees insert
+	// The fee paid is recorded in the same transaction, so every confirmed
+	// eth_tx has one.
+	//
 	var valueStrs []string
 	var valueArgs []interface{}
 	for _, r := range receipts {
@@ -524,11 +534,15 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 
 	stmt := fmt.Sprintf(sql, strings.Join(valueStrs, ","))
 
-	ctx, cancel := postgres.DefaultQueryCtx()
-	defer cancel()
-
-	err = ec.db.WithContext(ctx).Exec(stmt, valueArgs...).Error
-	return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
+	return postgres.GormTransactionWithDefaultContext(ec.db, func(tx *gorm.DB) error {
+		if err = tx.Exec(stmt, valueArgs...).Error; err != nil {
+			return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
+		}
+		if err = saveEthTxFees(tx, receipts, attempts); err != nil {
+			return err
+		}
+		return syncBatchedEthTxes(tx, &ec.chainID)
+	})
 }
 
 // markConfirmedMissingReceipt
@@ -856,6 +870,14 @@ func (ec *EthConfirmer) attemptForRebroadcast(ctx context.Context, etx EthTx) (a
 		}
 		attempt, err = ec.bumpGas(previousAttempt)
 
+		if errors.Is(err, keystore.ErrExternalSignerUnavailable) || errors.Is(err, keystore.ErrExternalSignerRejected) {
+			ec.logger.Errorw("Failed to sign bumped attempt with external signer", append(logFields, "err", err)...)
+			// The previous attempt is already signed, so keep resubmitting it
+			// until the external signer signs a bumped one
+			previousAttempt.BroadcastBeforeBlockNum = nil
+			previousAttempt.State = EthTxAttemptInProgress
+			return previousAttempt, nil
+		}
 		if gas.IsBumpErr(err) {
 			ec.logger.Errorw("Failed to bump gas", append(logFields, "err", err)...)
 			// Do not create a new attempt if bumping gas would put us over the limit or cause some other problem
@@ -1218,9 +1240,15 @@ func (ec *EthConfirmer) markForRebroadcast(etx EthTx, head eth.Head) error {
 		if err := deleteAllReceipts(tx, etx.ID); err != nil {
 			return errors.Wrapf(err, "deleteAllReceipts failed for etx %v", etx.ID)
 		}
+		if err := deleteEthTxFee(tx, etx.ID); err != nil {
+			return errors.Wrapf(err, "deleteEthTxFee failed for etx %v", etx.ID)
+		}
 		if err := unconfirmEthTx(tx, etx); err != nil {
 			return errors.Wrapf(err, "unconfirmEthTx failed for etx %v", etx.ID)
 		}
+		if err := syncBatchedEthTxes(tx, &ec.chainID); err != nil {
+			return err
+		}
 		return unbroadcastAttempt(tx, attempt)
 	})
 	return errors.Wrap(err, "markForRebroadcast failed")
@@ -1332,11 +1360,12 @@ func (ec *EthConfirmer) ResumePendingTaskRuns(ctx context.Context, head eth.Head
 	var receipts []x
 	// NOTE: we don't filter on eth_txes.state = 'confirmed', because a transaction with an attached receipt
 	// is guaranteed to be confirmed. This results in a slightly better query plan.
+	// Batched transactions are resumed with the receipt of the transaction carrying them.
 	if err := sqlxDB.Select(&receipts, `
 	SELECT pipeline_task_runs.id, eth_receipts.receipt FROM pipeline_task_runs
 	INNER JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
 	INNER JOIN eth_txes ON eth_txes.pipeline_task_run_id = pipeline_task_runs.id
-	INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id
+	INNER JOIN eth_tx_attempts ON COALESCE(eth_txes.batch_eth_tx_id, eth_txes.id) = eth_tx_attempts.eth_tx_id
 	INNER JOIN eth_receipts ON eth_tx_attempts.hash = eth_receipts.tx_hash
 	WHERE pipeline_runs.state = 'suspended' AND eth_receipts.block_number <= ($1 - eth_txes.min_confirmations) AND eth_txes.evm_chain_id = $2
 	`, head.Number, ec.chainID.String()); err != nil {
@@ -0,0 +1,139 @@
+package bulletprooftxmanager
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// EthTxFee is the fee paid for a confirmed eth_tx, attributed to the job that
+// sent it if it can be determined. Fees are kept when the eth_tx is reaped, so
+// spend can be reported over longer periods than EthTxReaperThreshold.
+type EthTxFee struct {
+	EthTxID           int64
+	EVMChainID        utils.Big `gorm:"column:evm_chain_id"`
+	JobID             null.Int
+	FromAddress       common.Address
+	TxHash            common.Hash
+	BlockNumber       int64
+	GasUsed           int64
+	EffectiveGasPrice utils.Big
+	L1Fee             utils.Big
+	Fee               utils.Big
+	ConfirmedAt       time.Time
+}
+
+// jobIDForEthTxSQL attributes an eth_tx to a job, from the job ID in its meta
+// if set, then from the job whose external job ID is its strategy's subject,
+// and finally from the job whose pipeline run is waiting for it
+const jobIDForEthTxSQL = `COALESCE(
+	NULLIF((eth_txes.meta->>'JobID')::integer, 0),
+	(SELECT jobs.id FROM jobs WHERE jobs.external_job_id = eth_txes.subject),
+	(SELECT pipeline_specs.job_id FROM pipeline_task_runs
+		JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+		JOIN pipeline_specs ON pipeline_specs.id = pipeline_runs.pipeline_spec_id
+		WHERE pipeline_task_runs.id = eth_txes.pipeline_task_run_id)
+)`
+
+// effectiveGasPrice returns the price per gas paid for the receipt's attempt.
+// Nodes that don't return it are either pre-EIP-1559, in which case the
+// legacy gas price is what was paid, or don't report it, in which case the
+// fee cap is the most that could have been paid.
+func effectiveGasPrice(receipt Receipt, attempt EthTxAttempt) *big.Int {
+	if receipt.EffectiveGasPrice != nil {
+		return receipt.EffectiveGasPrice
+	}
+	if attempt.GasPrice != nil {
+		return attempt.GasPrice.ToInt()
+	}
+	if attempt.GasFeeCap != nil {
+		return attempt.GasFeeCap.ToInt()
+	}
+	return big.NewInt(0)
+}
+
+// saveEthTxFees records the fee paid for each receipt's eth_tx, replacing
+// the fee of a previous receipt if the eth_tx was re-orged and confirmed again.
+// The fee of an eth_tx carrying a batch is charged to the eth_txes in the
+// batch instead, in proportion to their gas limits, since they are the ones
+// sent by jobs.
+func saveEthTxFees(db *gorm.DB, receipts []Receipt, attempts []EthTxAttempt) error {
+	attemptsByHash := make(map[common.Hash]EthTxAttempt, len(attempts))
+	for _, attempt := range attempts {
+		attemptsByHash[attempt.Hash] = attempt
+	}
+
+	var valueStrs []string
+	var valueArgs []interface{}
+	for _, r := range receipts {
+		attempt, exists := attemptsByHash[r.TxHash]
+		if !exists {
+			return errors.Errorf("invariant violation: no attempt with hash %s for receipt", r.TxHash.Hex())
+		}
+		price := effectiveGasPrice(r, attempt)
+		l1Fee := r.L1Fee
+		if l1Fee == nil {
+			l1Fee = big.NewInt(0)
+		}
+		fee := new(big.Int).Mul(price, new(big.Int).SetUint64(r.GasUsed))
+		fee.Add(fee, l1Fee)
+
+		valueStrs = append(valueStrs, "(?::bytea,?::bigint,?::bigint,?::numeric,?::numeric,?::numeric)")
+		valueArgs = append(valueArgs, r.TxHash, r.BlockNumber.Int64(), int64(r.GasUsed), price.String(), l1Fee.String(), fee.String())
+	}
+	if len(valueStrs) == 0 {
+		return nil
+	}
+
+	// Each charged eth_tx gets the share of the carrier's gas and fees between
+	// the running totals of the gas limits before and including its own, so
+	// that the shares add up exactly to what the carrier paid.
+	/* #nosec G201 */
+	sql := fmt.Sprintf(`
+	WITH v (tx_hash, block_number, gas_used, effective_gas_price, l1_fee, fee) AS (
+		VALUES %s
+	), charged AS (
+		SELECT v.*, eth_txes.id AS eth_tx_id, carriers.evm_chain_id, carriers.from_address, %s AS job_id, eth_txes.gas_limit,
+			SUM(eth_txes.gas_limit) OVER (PARTITION BY carriers.id ORDER BY eth_txes.id) AS cum_gas_limit,
+			SUM(eth_txes.gas_limit) OVER (PARTITION BY carriers.id) AS total_gas_limit
+		FROM v
+		JOIN eth_tx_attempts ON eth_tx_attempts.hash = v.tx_hash
+		JOIN eth_txes AS carriers ON carriers.id = eth_tx_attempts.eth_tx_id
+		JOIN eth_txes ON eth_txes.batch_eth_tx_id = carriers.id
+			OR (eth_txes.id = carriers.id AND NOT EXISTS (SELECT 1 FROM eth_txes AS batched WHERE batched.batch_eth_tx_id = carriers.id))
+	)
+	INSERT INTO eth_tx_fees (eth_tx_id, evm_chain_id, job_id, from_address, tx_hash, block_number, gas_used, effective_gas_price, l1_fee, fee, confirmed_at)
+	SELECT eth_tx_id, evm_chain_id, job_id, from_address, tx_hash, block_number,
+		(trunc(gas_used * cum_gas_limit / total_gas_limit) - trunc(gas_used * (cum_gas_limit - gas_limit) / total_gas_limit))::bigint,
+		effective_gas_price,
+		trunc(l1_fee * cum_gas_limit / total_gas_limit) - trunc(l1_fee * (cum_gas_limit - gas_limit) / total_gas_limit),
+		trunc(fee * cum_gas_limit / total_gas_limit) - trunc(fee * (cum_gas_limit - gas_limit) / total_gas_limit),
+		NOW()
+	FROM charged
+	ON CONFLICT (eth_tx_id) DO UPDATE SET
+		tx_hash = EXCLUDED.tx_hash,
+		block_number = EXCLUDED.block_number,
+		gas_used = EXCLUDED.gas_used,
+		effective_gas_price = EXCLUDED.effective_gas_price,
+		l1_fee = EXCLUDED.l1_fee,
+		fee = EXCLUDED.fee,
+		confirmed_at = EXCLUDED.confirmed_at
+	`, strings.Join(valueStrs, ","), jobIDForEthTxSQL)
+
+	return errors.Wrap(db.Exec(sql, valueArgs...).Error, "saveEthTxFees failed")
+}
+
+// deleteEthTxFee forgets the fee of an eth_tx whose receipt was re-orged out,
+// or of the eth_txes it carried
+func deleteEthTxFee(db *gorm.DB, etxID int64) error {
+	return errors.Wrap(db.Exec(`DELETE FROM eth_tx_fees WHERE eth_tx_id = ? OR eth_tx_id IN (SELECT id FROM eth_txes WHERE batch_eth_tx_id = ?)`, etxID, etxID).Error,
+		"deleteEthTxFee failed")
+}
@@ -0,0 +1,204 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestEthConfirmer_CheckForReceipts_SavesFee(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, newTestChainScopedConfig(t), ethKeyStore, []ethkey.State{state}, nil)
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 0, fromAddress)
+	attempt := etx.EthTxAttempts[0]
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET meta = '{"JobID": 42}' WHERE id = ?`, etx.ID).Error)
+
+	receipt := bulletprooftxmanager.Receipt{
+		TxHash:            attempt.Hash,
+		BlockHash:         utils.NewHash(),
+		BlockNumber:       big.NewInt(42),
+		TransactionIndex:  uint(1),
+		Status:            uint64(1),
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(100),
+		L1Fee:             big.NewInt(5),
+	}
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(10), nil)
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 1 && cltest.BatchElemMatchesHash(b[0], attempt.Hash)
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		elems[0].Result = &receipt
+	}).Once()
+
+	require.NoError(t, ec.CheckForReceipts(context.Background(), 42))
+
+	var fee bulletprooftxmanager.EthTxFee
+	require.NoError(t, db.Raw(`SELECT * FROM eth_tx_fees WHERE eth_tx_id = ?`, etx.ID).Scan(&fee).Error)
+	assert.Equal(t, int64(42), fee.JobID.Int64)
+	assert.Equal(t, fromAddress, fee.FromAddress)
+	assert.Equal(t, attempt.Hash, fee.TxHash)
+	assert.Equal(t, int64(21000), fee.GasUsed)
+	assert.Equal(t, big.NewInt(100), fee.EffectiveGasPrice.ToInt())
+	assert.Equal(t, big.NewInt(21000*100+5), fee.Fee.ToInt())
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestEthConfirmer_CheckForReceipts_BatchedEthTxes(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, newTestChainScopedConfig(t), ethKeyStore, []ethkey.State{state}, nil)
+
+	carrier := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 0, fromAddress)
+	attempt := carrier.EthTxAttempts[0]
+	insertBatched := func(jobID int32, gasLimit uint64) bulletprooftxmanager.EthTx {
+		etx := bulletprooftxmanager.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      cltest.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			Value:          assets.NewEthValue(0),
+			GasLimit:       gasLimit,
+			State:          bulletprooftxmanager.EthTxBatched,
+			BatchEthTxID:   null.IntFrom(carrier.ID),
+			EVMChainID:     carrier.EVMChainID,
+		}
+		require.NoError(t, db.Save(&etx).Error)
+		require.NoError(t, db.Exec(`UPDATE eth_txes SET meta = ? WHERE id = ?`, fmt.Sprintf(`{"JobID": %d}`, jobID), etx.ID).Error)
+		return etx
+	}
+	etx1 := insertBatched(1, 100000)
+	etx2 := insertBatched(2, 300000)
+
+	receipt := bulletprooftxmanager.Receipt{
+		TxHash:            attempt.Hash,
+		BlockHash:         utils.NewHash(),
+		BlockNumber:       big.NewInt(42),
+		TransactionIndex:  uint(1),
+		Status:            uint64(1),
+		GasUsed:           210001,
+		EffectiveGasPrice: big.NewInt(100),
+	}
+	ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(10), nil)
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 1 && cltest.BatchElemMatchesHash(b[0], attempt.Hash)
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		elems[0].Result = &receipt
+	}).Once()
+
+	require.NoError(t, ec.CheckForReceipts(context.Background(), 42))
+
+	// The batched eth_txes are confirmed along with their carrier
+	for _, etx := range []bulletprooftxmanager.EthTx{etx1, etx2} {
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxConfirmed, etx.State)
+		assert.Nil(t, etx.Nonce)
+		assert.NotNil(t, etx.BroadcastAt)
+	}
+
+	// and are charged the carrier's fee in proportion to their gas limits
+	var fees []bulletprooftxmanager.EthTxFee
+	require.NoError(t, db.Raw(`SELECT * FROM eth_tx_fees ORDER BY eth_tx_id`).Scan(&fees).Error)
+	require.Len(t, fees, 2)
+	assert.Equal(t, etx1.ID, fees[0].EthTxID)
+	assert.Equal(t, int64(1), fees[0].JobID.Int64)
+	assert.Equal(t, int64(52500), fees[0].GasUsed)
+	assert.Equal(t, big.NewInt(5250025), fees[0].Fee.ToInt())
+	assert.Equal(t, etx2.ID, fees[1].EthTxID)
+	assert.Equal(t, int64(2), fees[1].JobID.Int64)
+	assert.Equal(t, int64(157501), fees[1].GasUsed)
+	assert.Equal(t, big.NewInt(15750075), fees[1].Fee.ToInt())
+	for _, fee := range fees {
+		assert.Equal(t, attempt.Hash, fee.TxHash)
+	}
+
+	ethClient.AssertExpectations(t)
+}
+
+func TestORM_GasSpend(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	orm := bulletprooftxmanager.NewORM(postgres.UnwrapGormDB(db))
+
+	address1, address2 := cltest.NewAddress(), cltest.NewAddress()
+	day1 := time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	insertFee := func(etxID int64, jobID interface{}, from interface{}, fee int64, confirmedAt time.Time) {
+		require.NoError(t, db.Exec(`INSERT INTO eth_tx_fees (eth_tx_id, evm_chain_id, job_id, from_address, tx_hash, block_number, gas_used, effective_gas_price, fee, confirmed_at)
+			VALUES (?, 0, ?, ?, ?, 1, 21000, 1, ?, ?)`, etxID, jobID, from, utils.NewHash(), fee, confirmedAt).Error)
+	}
+	insertFee(1, 1, address1, 10, day1)
+	insertFee(2, 1, address2, 20, day2)
+	insertFee(3, 2, address1, 5, day2)
+	insertFee(4, nil, address1, 1, day2)
+
+	req := bulletprooftxmanager.GasSpendRequest{From: day1.Add(-time.Hour), To: day2.Add(time.Hour)}
+
+	t.Run("by job", func(t *testing.T) {
+		req.GroupBy = bulletprooftxmanager.GasSpendByJob
+		spend, err := orm.GasSpend(req)
+		require.NoError(t, err)
+		require.Len(t, spend, 3)
+		assert.Equal(t, int64(1), spend[0].JobID.Int64)
+		assert.Equal(t, int64(2), spend[0].TxCount)
+		assert.Equal(t, int64(42000), spend[0].GasUsed)
+		assert.Equal(t, big.NewInt(30), spend[0].TotalFee.ToInt())
+		assert.Equal(t, int64(2), spend[1].JobID.Int64)
+		assert.False(t, spend[2].JobID.Valid)
+		assert.Nil(t, spend[0].FromAddress)
+	})
+
+	t.Run("by key", func(t *testing.T) {
+		req.GroupBy = bulletprooftxmanager.GasSpendByKey
+		spend, err := orm.GasSpend(req)
+		require.NoError(t, err)
+		require.Len(t, spend, 2)
+		assert.Equal(t, address2, *spend[0].FromAddress)
+		assert.Equal(t, address1, *spend[1].FromAddress)
+		assert.Equal(t, big.NewInt(16), spend[1].TotalFee.ToInt())
+	})
+
+	t.Run("by day for a job", func(t *testing.T) {
+		req.GroupBy = bulletprooftxmanager.GasSpendByDay
+		jobID := int32(1)
+		req.JobID = &jobID
+		spend, err := orm.GasSpend(req)
+		require.NoError(t, err)
+		require.Len(t, spend, 2)
+		assert.Equal(t, big.NewInt(20), spend[0].TotalFee.ToInt())
+		assert.Equal(t, day2.Truncate(24*time.Hour), spend[0].Day.Time.UTC())
+	})
+
+	t.Run("invalid grouping", func(t *testing.T) {
+		req.GroupBy = "month"
+		_, err := orm.GasSpend(req)
+		require.Error(t, err)
+	})
+}
@@ -1,6 +1,9 @@
 package bulletprooftxmanager
 
 import (
+	"context"
+	"math/big"
+
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 )
 
@@ -11,3 +14,15 @@ func SetEthClientOnEthConfirmer(ethClient eth.Client, ethConfirmer *EthConfirmer
 func SetResumeCallbackOnEthBroadcaster(resumeCallback ResumeCallback, ethBroadcaster *EthBroadcaster) {
 	ethBroadcaster.resumeCallback = resumeCallback
 }
+
+func CancelEthTxOnEthConfirmer(ctx context.Context, ethConfirmer *EthConfirmer, id int64) error {
+	return ethConfirmer.cancelEthTx(ctx, id)
+}
+
+func BumpEthTxGasOnEthConfirmer(ctx context.Context, ethConfirmer *EthConfirmer, id int64, gasPriceWei *big.Int) error {
+	return ethConfirmer.bumpEthTxGas(ctx, id, gasPriceWei)
+}
+
+func AbandonEthTxOnEthBroadcaster(ethBroadcaster *EthBroadcaster, id int64) error {
+	return ethBroadcaster.abandonEthTx(id)
+}
@@ -353,6 +353,20 @@ func (_m *Config) EvmMaxQueuedTransactions() uint64 {
 	return r0
 }
 
+// EvmMaxTxBatchSize provides a mock function with given fields:
+func (_m *Config) EvmMaxTxBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // EvmMinGasPriceWei provides a mock function with given fields:
 func (_m *Config) EvmMinGasPriceWei() *big.Int {
 	ret := _m.Called()
@@ -397,6 +411,20 @@ func (_m *Config) EvmRPCDefaultBatchSize() uint32 {
 	return r0
 }
 
+// FeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBlockCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // GasEstimatorMode provides a mock function with given fields:
 func (_m *Config) GasEstimatorMode() string {
 	ret := _m.Called()
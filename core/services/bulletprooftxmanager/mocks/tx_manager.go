@@ -14,6 +14,8 @@ import (
 
 	gorm "gorm.io/gorm"
 
+	big "math/big"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,6 +24,69 @@ type TxManager struct {
 	mock.Mock
 }
 
+// AbandonEthTx provides a mock function with given fields: ctx, id
+func (_m *TxManager) AbandonEthTx(ctx context.Context, id int64) (bulletprooftxmanager.EthTx, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 bulletprooftxmanager.EthTx
+	if rf, ok := ret.Get(0).(func(context.Context, int64) bulletprooftxmanager.EthTx); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.EthTx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BumpEthTxGas provides a mock function with given fields: ctx, id, gasPriceWei
+func (_m *TxManager) BumpEthTxGas(ctx context.Context, id int64, gasPriceWei *big.Int) (bulletprooftxmanager.EthTx, error) {
+	ret := _m.Called(ctx, id, gasPriceWei)
+
+	var r0 bulletprooftxmanager.EthTx
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *big.Int) bulletprooftxmanager.EthTx); ok {
+		r0 = rf(ctx, id, gasPriceWei)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.EthTx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *big.Int) error); ok {
+		r1 = rf(ctx, id, gasPriceWei)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelEthTx provides a mock function with given fields: ctx, id
+func (_m *TxManager) CancelEthTx(ctx context.Context, id int64) (bulletprooftxmanager.EthTx, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 bulletprooftxmanager.EthTx
+	if rf, ok := ret.Get(0).(func(context.Context, int64) bulletprooftxmanager.EthTx); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.EthTx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxManager) Close() error {
 	ret := _m.Called()
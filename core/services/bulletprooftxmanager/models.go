@@ -40,6 +40,7 @@ type EthTxAttemptState string
 
 const (
 	EthTxUnstarted               = EthTxState("unstarted")
+	EthTxBatched                 = EthTxState("batched")
 	EthTxInProgress              = EthTxState("in_progress")
 	EthTxFatalError              = EthTxState("fatal_error")
 	EthTxUnconfirmed             = EthTxState("unconfirmed")
@@ -147,6 +148,18 @@ type EthTx struct {
 	// Simulate if set to true will cause this eth_tx to be simulated before
 	// initial send and aborted on revert
 	Simulate bool
+
+	// GasPriority is passed to the gas estimator when pricing the initial
+	// attempt. Estimators that do not support priority levels ignore it.
+	GasPriority gas.Priority `gorm:"default:standard"`
+
+	// MulticallAddress is set if this eth_tx may be merged with others from
+	// the same sender into a single transaction sent through this multicall
+	// contract (see BatchingStrategy)
+	MulticallAddress *common.Address
+	// BatchEthTxID is the eth_tx this one was merged into. A batched eth_tx
+	// follows the state of the eth_tx carrying it.
+	BatchEthTxID null.Int
 }
 
 func (e EthTx) GetError() error {
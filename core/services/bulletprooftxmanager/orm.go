@@ -1,14 +1,56 @@
 package bulletprooftxmanager
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 type ORM interface {
 	EthTransactionsWithAttempts(offset, limit int) ([]EthTx, int, error)
 	EthTxAttempts(offset, limit int) ([]EthTxAttempt, int, error)
 	FindEthTxAttempt(hash common.Hash) (*EthTxAttempt, error)
+	GasSpend(req GasSpendRequest) ([]GasSpend, error)
+}
+
+// GasSpendGroupBy is how a gas spend report is broken down
+type GasSpendGroupBy string
+
+const (
+	GasSpendByJob GasSpendGroupBy = "job"
+	GasSpendByKey GasSpendGroupBy = "key"
+	GasSpendByDay GasSpendGroupBy = "day"
+)
+
+// GasSpendRequest selects the fees of the transactions confirmed between
+// From and To, optionally of a single chain or job
+type GasSpendRequest struct {
+	GroupBy    GasSpendGroupBy
+	EVMChainID *utils.Big
+	JobID      *int32
+	From       time.Time
+	To         time.Time
+}
+
+// GasSpend is the total fee paid by a job, key or day on a chain. Only the
+// field of the report's grouping is set, a null JobID in a report by job is
+// the spend that could not be attributed to any job.
+type GasSpend struct {
+	EVMChainID  utils.Big
+	JobID       null.Int
+	FromAddress *common.Address
+	Day         null.Time
+	TxCount     int64
+	GasUsed     int64
+	// TotalFee is in wei
+	TotalFee utils.Big
 }
 
 type orm struct {
@@ -124,3 +166,41 @@ func (o *orm) FindEthTxAttempt(hash common.Hash) (*EthTxAttempt, error) {
 	err := o.preloadTxes(attempts)
 	return &attempts[0], err
 }
+
+// GasSpend reports the fees paid by confirmed transactions, per chain and the
+// request's grouping, most expensive first
+func (o *orm) GasSpend(req GasSpendRequest) (spend []GasSpend, err error) {
+	// The columns of the other groupings are selected as NULL
+	var group, columns string
+	switch req.GroupBy {
+	case GasSpendByJob:
+		group, columns = "job_id", "job_id, NULL AS from_address, NULL AS day"
+	case GasSpendByKey:
+		group, columns = "from_address", "NULL AS job_id, from_address, NULL AS day"
+	case GasSpendByDay:
+		group, columns = "date_trunc('day', confirmed_at)", "NULL AS job_id, NULL AS from_address, date_trunc('day', confirmed_at) AS day"
+	default:
+		return nil, errors.Errorf("cannot group gas spend by %q, must be one of: %s, %s, %s", req.GroupBy, GasSpendByJob, GasSpendByKey, GasSpendByDay)
+	}
+
+	conds := []string{"confirmed_at >= $1", "confirmed_at < $2"}
+	args := []interface{}{req.From, req.To}
+	if req.EVMChainID != nil {
+		args = append(args, req.EVMChainID)
+		conds = append(conds, fmt.Sprintf("evm_chain_id = $%d", len(args)))
+	}
+	if req.JobID != nil {
+		args = append(args, *req.JobID)
+		conds = append(conds, fmt.Sprintf("job_id = $%d", len(args)))
+	}
+
+	/* #nosec G201 */
+	sql := fmt.Sprintf(`SELECT evm_chain_id, %s, count(*) AS tx_count, sum(gas_used) AS gas_used, sum(fee) AS total_fee
+	FROM eth_tx_fees
+	WHERE %s
+	GROUP BY evm_chain_id, %s
+	ORDER BY total_fee DESC, evm_chain_id ASC`, columns, strings.Join(conds, " AND "), group)
+
+	err = o.db.Select(&spend, sql, args...)
+	return spend, errors.Wrap(err, "GasSpend failed")
+}
@@ -0,0 +1,66 @@
+package bulletprooftxmanager
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+// BalanceReader returns the last known ETH balance of a key, or nil if it is
+// not known yet, e.g. services.BalanceMonitor
+type BalanceReader interface {
+	GetEthBalance(address common.Address) *assets.Eth
+}
+
+// CountPendingTransactionsByAddress returns the number of eth_txes of each
+// key on the chain that are queued or sent but not yet confirmed
+func CountPendingTransactionsByAddress(db *gorm.DB, chainID big.Int) (map[common.Address]int64, error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	var rows []struct {
+		FromAddress common.Address
+		Count       int64
+	}
+	err := db.WithContext(ctx).Raw(`SELECT from_address, count(*) FROM eth_txes
+WHERE state IN ('unstarted', 'in_progress', 'unconfirmed') AND evm_chain_id = ?
+GROUP BY from_address`, chainID.String()).Scan(&rows).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "CountPendingTransactionsByAddress failed")
+	}
+	counts := make(map[common.Address]int64, len(rows))
+	for _, r := range rows {
+		counts[r.FromAddress] = r.Count
+	}
+	return counts, nil
+}
+
+// NewSendingKeyLoad returns the load of sending keys on the chain for
+// keystore.Eth#GetLoadBalancedAddress, which is the number of their pending
+// eth_txes. Keys whose last known balance is zero or below minBalance are not
+// usable, nor is the chain's funding treasury key. balances may be nil if
+// balances are not monitored, and treasury is the zero address if the chain
+// has no treasury.
+func NewSendingKeyLoad(db *gorm.DB, chainID big.Int, balances BalanceReader, minBalance *big.Int, treasury common.Address) (func(common.Address) (int64, bool), error) {
+	counts, err := CountPendingTransactionsByAddress(db, chainID)
+	if err != nil {
+		return nil, err
+	}
+	return func(address common.Address) (int64, bool) {
+		if treasury != (common.Address{}) && address == treasury {
+			return 0, false
+		}
+		if balances != nil {
+			if balance := balances.GetEthBalance(address); balance != nil {
+				if balance.ToInt().Sign() <= 0 || (minBalance != nil && balance.ToInt().Cmp(minBalance) < 0) {
+					return 0, false
+				}
+			}
+		}
+		return counts[address], true
+	}, nil
+}
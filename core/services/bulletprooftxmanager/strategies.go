@@ -1,6 +1,7 @@
 package bulletprooftxmanager
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	uuid "github.com/satori/go.uuid"
 	"gorm.io/gorm"
 )
@@ -20,6 +21,15 @@ type TxStrategy interface {
 	Simulate() bool
 }
 
+// BatchingTxStrategy is implemented by strategies whose txes may be merged
+// with other queued txes from the same sender into a single multicall tx
+type BatchingTxStrategy interface {
+	TxStrategy
+	// MulticallAddress will be saved to eth_txes.multicall_address. Only txes
+	// with the same multicall address are merged.
+	MulticallAddress() common.Address
+}
+
 var _ TxStrategy = SendEveryStrategy{}
 
 func NewQueueingTxStrategy(subject uuid.UUID, queueSize uint32, simulate bool) (strategy TxStrategy) {
@@ -81,3 +91,29 @@ id < (
 func (s DropOldestStrategy) Simulate() bool {
 	return s.simulate
 }
+
+var _ BatchingTxStrategy = BatchingStrategy{}
+
+// BatchingStrategy will always send the tx, but lets the eth broadcaster merge
+// it with other unstarted txes from the same sender into a single call to
+// aggregate3 on an AuthorizedBatchForwarder contract, up to EvmMaxTxBatchSize
+// at a time.
+//
+// The target contract sees the forwarder as msg.sender, so it must be
+// authorized to call it, e.g. as an authorized sender of an operator contract.
+// Txes are only batched if the forwarder reports the sender as authorized,
+// since a public multicall contract would let anyone call the target as if
+// they were the node. All calls in a batch succeed or revert together.
+type BatchingStrategy struct {
+	multicall common.Address
+	simulate  bool
+}
+
+func NewBatchingStrategy(multicall common.Address, simulate bool) BatchingStrategy {
+	return BatchingStrategy{multicall, simulate}
+}
+
+func (BatchingStrategy) Subject() uuid.NullUUID             { return uuid.NullUUID{} }
+func (BatchingStrategy) PruneQueue(*gorm.DB) (int64, error) { return 0, nil }
+func (s BatchingStrategy) Simulate() bool                   { return s.simulate }
+func (s BatchingStrategy) MulticallAddress() common.Address { return s.multicall }
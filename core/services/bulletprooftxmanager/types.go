@@ -30,6 +30,12 @@ type Receipt struct {
 	BlockHash         common.Hash     `json:"blockHash,omitempty"`
 	BlockNumber       *big.Int        `json:"blockNumber,omitempty"`
 	TransactionIndex  uint            `json:"transactionIndex"`
+	// EffectiveGasPrice is the price per gas actually paid, only returned by
+	// nodes that support EIP-1559
+	EffectiveGasPrice *big.Int `json:"effectiveGasPrice,omitempty"`
+	// L1Fee is the fee paid for posting the transaction to L1, only returned
+	// by Optimism
+	L1Fee *big.Int `json:"l1Fee,omitempty"`
 }
 
 // FromGethReceipt converts a gethTypes.Receipt to a Receipt
@@ -53,6 +59,8 @@ func FromGethReceipt(gr *gethTypes.Receipt) *Receipt {
 		gr.BlockHash,
 		gr.BlockNumber,
 		gr.TransactionIndex,
+		nil,
+		nil,
 	}
 }
 
@@ -86,6 +94,8 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 		BlockHash         common.Hash     `json:"blockHash,omitempty"`
 		BlockNumber       *hexutil.Big    `json:"blockNumber,omitempty"`
 		TransactionIndex  hexutil.Uint    `json:"transactionIndex"`
+		EffectiveGasPrice *hexutil.Big    `json:"effectiveGasPrice,omitempty"`
+		L1Fee             *hexutil.Big    `json:"l1Fee,omitempty"`
 	}
 	var enc Receipt
 	enc.PostState = r.PostState
@@ -99,6 +109,8 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 	enc.BlockHash = r.BlockHash
 	enc.BlockNumber = (*hexutil.Big)(r.BlockNumber)
 	enc.TransactionIndex = hexutil.Uint(r.TransactionIndex)
+	enc.EffectiveGasPrice = (*hexutil.Big)(r.EffectiveGasPrice)
+	enc.L1Fee = (*hexutil.Big)(r.L1Fee)
 	return json.Marshal(&enc)
 }
 
@@ -116,6 +128,8 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 		BlockHash         *common.Hash     `json:"blockHash,omitempty"`
 		BlockNumber       *hexutil.Big     `json:"blockNumber,omitempty"`
 		TransactionIndex  *hexutil.Uint    `json:"transactionIndex"`
+		EffectiveGasPrice *hexutil.Big     `json:"effectiveGasPrice,omitempty"`
+		L1Fee             *hexutil.Big     `json:"l1Fee,omitempty"`
 	}
 	var dec Receipt
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -152,6 +166,12 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 	if dec.TransactionIndex != nil {
 		r.TransactionIndex = uint(*dec.TransactionIndex)
 	}
+	if dec.EffectiveGasPrice != nil {
+		r.EffectiveGasPrice = (*big.Int)(dec.EffectiveGasPrice)
+	}
+	if dec.L1Fee != nil {
+		r.L1Fee = (*big.Int)(dec.L1Fee)
+	}
 	return nil
 }
 
@@ -38,7 +38,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/health"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/log"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
 	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -78,12 +80,17 @@ type Application interface {
 	EVMORM() evmtypes.ORM
 	PipelineORM() pipeline.ORM
 	BridgeORM() bridges.ORM
+	TelemetryORM() telemetry.ORM
 	SessionORM() sessions.ORM
 	BPTXMORM() bulletprooftxmanager.ORM
 	AddJobV2(ctx context.Context, job job.Job, name null.String) (job.Job, error)
 	DeleteJob(ctx context.Context, jobID int32) error
+	UpdateJob(ctx context.Context, jobID int32, job job.Job) (job.Job, error)
+	PauseJob(ctx context.Context, jobID int32) error
+	UnpauseJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	SimulatePipelineRun(ctx context.Context, spec pipeline.Spec, vars map[string]interface{}, opts pipeline.SimulationOptions) (pipeline.Run, pipeline.TaskRunResults, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 	SetServiceLogLevel(ctx context.Context, service string, level zapcore.Level) error
@@ -91,8 +98,15 @@ type Application interface {
 	// Feeds
 	GetFeedsService() feeds.Service
 
+	// GetKeyRotator returns the service rotating CSA, OCR and P2P keys
+	GetKeyRotator() keyrotation.Rotator
+
 	// ReplayFromBlock of blocks
 	ReplayFromBlock(chainID *big.Int, number uint64) error
+	// StartLogReplay replays logs to the listeners of a single job or contract
+	StartLogReplay(chainID *big.Int, req log.ReplayRequest) (log.Replay, error)
+	// GetLogReplay returns the progress of a log replay on any chain
+	GetLogReplay(id string) (log.Replay, error)
 }
 
 // ChainlinkApplication contains fields for the JobSubscriber, Scheduler,
@@ -107,9 +121,11 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	telemetryORM             telemetry.ORM
 	sessionORM               sessions.ORM
 	bptxmORM                 bulletprooftxmanager.ORM
 	FeedsService             feeds.Service
+	KeyRotator               keyrotation.Rotator
 	webhookJobRunner         webhook.JobRunner
 	Config                   config.GeneralConfig
 	KeyStore                 keystore.Master
@@ -173,11 +189,22 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 	// Use Explorer over TelemetryIngress if both URLs are set
 	if cfg.ExplorerURL() == nil && cfg.TelemetryIngressURL() != nil {
-		telemetryIngressClient = synchronization.NewTelemetryIngressClient(cfg.TelemetryIngressURL(), cfg.TelemetryIngressServerPubKey(), keyStore.CSA(), cfg.TelemetryIngressLogging())
+		telemetryIngressClient = synchronization.NewTelemetryIngressClient(cfg.TelemetryIngressURL(), cfg.TelemetryIngressServerPubKey(), keyStore.CSA(), cfg)
 		monitoringEndpointGen = telemetry.NewIngressAgentWrapper(telemetryIngressClient)
 	}
 	subservices = append(subservices, explorerClient, telemetryIngressClient)
 
+	telemetryORM := telemetry.NewORM(opts.SqlxDB)
+	if cfg.TelemetryLocalEnabled() {
+		localAgent := telemetry.NewLocalAgent(telemetryORM, cfg.TelemetryLocalRetention(), globalLogger)
+		if _, isNoop := monitoringEndpointGen.(*telemetry.NoopAgent); isNoop {
+			monitoringEndpointGen = localAgent
+		} else {
+			monitoringEndpointGen = telemetry.NewMultiAgent(monitoringEndpointGen, localAgent)
+		}
+		subservices = append(subservices, localAgent)
+	}
+
 	if cfg.DatabaseBackupMode() != config.DatabaseBackupModeNone && cfg.DatabaseBackupFrequency() > 0 {
 		globalLogger.Infow("DatabaseBackup: periodic database backups are enabled", "frequency", cfg.DatabaseBackupFrequency())
 
@@ -188,6 +215,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 
 	subservices = append(subservices, eventBroadcaster, chainSet)
+
 	promReporter := services.NewPromReporter(postgres.MustSQLDB(db))
 	subservices = append(subservices, promReporter)
 
@@ -252,8 +280,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		)
 	}
 
+	var concretePW *offchainreporting.SingletonPeerWrapper
 	if (cfg.Dev() && cfg.P2PListenPort() > 0) || cfg.FeatureOffchainReporting() {
-		concretePW := offchainreporting.NewSingletonPeerWrapper(keyStore, cfg, db, globalLogger)
+		concretePW = offchainreporting.NewSingletonPeerWrapper(keyStore, cfg, db, globalLogger)
 		subservices = append(subservices, concretePW)
 		delegates[job.OffchainReporting] = offchainreporting.NewDelegate(
 			db,
@@ -281,9 +310,12 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	if err != nil {
 		globalLogger.Warnw("Unable to load feeds service; no default chain available", "err", err)
 	} else {
-		feedsService = feeds.NewService(feedsORM, jobORM, gormTxm, jobSpawner, keyStore.CSA(), keyStore.Eth(), chain.Config(), chainSet, globalLogger, opts.Version)
+		feedsService = feeds.NewService(feedsORM, jobORM, gormTxm, jobSpawner, keyStore.CSA(), keyStore.Eth(), keyStore.OCR(), keyStore.P2P(), chain.Config(), chainSet, globalLogger, opts.Version)
 	}
 
+	keyRotator := keyrotation.NewRotator(cfg, keyStore, jobORM, jobSpawner, chainSet, concretePW, feedsService, globalLogger)
+	subservices = append(subservices, keyRotator)
+
 	app := &ChainlinkApplication{
 		ChainSet:                 chainSet,
 		EventBroadcaster:         eventBroadcaster,
@@ -292,9 +324,11 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		telemetryORM:             telemetryORM,
 		sessionORM:               sessionORM,
 		bptxmORM:                 bptxmORM,
 		FeedsService:             feedsService,
+		KeyRotator:               keyRotator,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
 		KeyStore:                 keyStore,
@@ -498,6 +532,10 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) TelemetryORM() telemetry.ORM {
+	return app.telemetryORM
+}
+
 func (app *ChainlinkApplication) SessionORM() sessions.ORM {
 	return app.sessionORM
 }
@@ -541,10 +579,43 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(ctx, jobID)
 }
 
+// UpdateJob replaces the spec of an existing job in place, keeping its ID and
+// run history
+func (app *ChainlinkApplication) UpdateJob(ctx context.Context, jobID int32, j job.Job) (job.Job, error) {
+	// Do not allow the job to be updated if it is managed by the Feeds Manager
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
+	if err != nil {
+		return job.Job{}, err
+	}
+
+	if isManaged {
+		return job.Job{}, errors.New("job must be updated in the feeds manager")
+	}
+
+	return app.jobSpawner.UpdateJob(ctx, jobID, j)
+}
+
+// PauseJob stops a job's services without deleting it
+func (app *ChainlinkApplication) PauseJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.PauseJob(ctx, jobID)
+}
+
+// UnpauseJob restarts the services of a paused job. Not to be confused with
+// ResumeJobV2, which resumes a suspended pipeline run.
+func (app *ChainlinkApplication) UnpauseJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.UnpauseJob(ctx, jobID)
+}
+
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
 	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
 }
 
+// SimulatePipelineRun executes the pipeline of spec in memory, stubbing tasks
+// with side effects. Nothing is persisted.
+func (app *ChainlinkApplication) SimulatePipelineRun(ctx context.Context, spec pipeline.Spec, vars map[string]interface{}, opts pipeline.SimulationOptions) (pipeline.Run, pipeline.TaskRunResults, error) {
+	return app.pipelineRunner.SimulateRun(ctx, spec, pipeline.NewVarsFrom(vars), opts, app.logger.Named("PipelineSimulation"))
+}
+
 // Only used for local testing, not supported by the UI.
 func (app *ChainlinkApplication) RunJobV2(
 	ctx context.Context,
@@ -626,6 +697,10 @@ func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
 
+func (app *ChainlinkApplication) GetKeyRotator() keyrotation.Rotator {
+	return app.KeyRotator
+}
+
 // NewBox returns the packr.Box instance that holds the static assets to
 // be delivered by the router.
 func (app *ChainlinkApplication) NewBox() packr.Box {
@@ -641,6 +716,24 @@ func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64
 	return nil
 }
 
+func (app *ChainlinkApplication) StartLogReplay(chainID *big.Int, req log.ReplayRequest) (log.Replay, error) {
+	chain, err := app.ChainSet.Get(chainID)
+	if err != nil {
+		return log.Replay{}, err
+	}
+	return chain.LogBroadcaster().StartReplay(req)
+}
+
+func (app *ChainlinkApplication) GetLogReplay(id string) (log.Replay, error) {
+	for _, chain := range app.ChainSet.Chains() {
+		replay, err := chain.LogBroadcaster().GetReplay(id)
+		if !errors.Is(err, log.ErrReplayNotFound) {
+			return replay, err
+		}
+	}
+	return log.Replay{}, log.ErrReplayNotFound
+}
+
 func (app *ChainlinkApplication) GetChainSet() evm.ChainSet {
 	return app.ChainSet
 }
@@ -346,6 +346,7 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 			"databaseID":    l.job.ID,
 			"externalJobID": l.job.ExternalJobID,
 			"name":          l.job.Name.ValueOrZero(),
+			"fromAddresses": []common.Address(l.job.DirectRequestSpec.FromAddresses),
 		},
 		"jobRun": map[string]interface{}{
 			"meta":           meta,
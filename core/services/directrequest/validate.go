@@ -14,6 +14,7 @@ type DirectRequestToml struct {
 	ContractAddress    ethkey.EIP55Address      `toml:"contractAddress"`
 	Requesters         models.AddressCollection `toml:"requesters"`
 	MinContractPayment *assets.Link             `toml:"minContractPaymentLinkJuels"`
+	FromAddresses      models.AddressCollection `toml:"fromAddresses"`
 }
 
 func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
@@ -35,6 +36,7 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 		ContractAddress:    spec.ContractAddress,
 		Requesters:         spec.Requesters,
 		MinContractPayment: spec.MinContractPayment,
+		FromAddresses:      spec.FromAddresses,
 	}
 
 	if jb.Type != job.DirectRequest {
@@ -0,0 +1,141 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// httpSubscription emulates a websocket subscription on an HTTP-only node by
+// calling poll every interval until it is unsubscribed, which closes chDone.
+// Polling errors are logged and retried on the next interval rather than
+// ending the subscription, so Err only closes on Unsubscribe.
+type httpSubscription struct {
+	chErr  chan error
+	chDone chan struct{}
+	wg     sync.WaitGroup
+	once   sync.Once
+}
+
+var _ ethereum.Subscription = (*httpSubscription)(nil)
+
+func newHTTPSubscription(interval time.Duration, poll func(ctx context.Context, chDone <-chan struct{})) *httpSubscription {
+	sub := &httpSubscription{
+		chErr:  make(chan error),
+		chDone: make(chan struct{}),
+	}
+	sub.wg.Add(1)
+	go func() {
+		defer sub.wg.Done()
+		ctx, cancel := utils.ContextFromChan(sub.chDone)
+		defer cancel()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			poll(ctx, sub.chDone)
+			select {
+			case <-ticker.C:
+			case <-sub.chDone:
+				return
+			}
+		}
+	}()
+	return sub
+}
+
+func (sub *httpSubscription) Err() <-chan error {
+	return sub.chErr
+}
+
+// Unsubscribe stops polling. Once it returns nothing more will be sent on the
+// subscription's channel, so the caller may close it.
+func (sub *httpSubscription) Unsubscribe() {
+	sub.once.Do(func() {
+		close(sub.chDone)
+		sub.wg.Wait()
+		close(sub.chErr)
+	})
+}
+
+// pollSubscribe emulates EthSubscribe. Only newHeads subscriptions are
+// supported, by polling for the latest block and sending it if it has
+// changed.
+func (n node) pollSubscribe(_ context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
+	if len(args) == 0 || args[0] != "newHeads" {
+		return nil, errors.Errorf("HTTP-only node %s only supports newHeads subscriptions, got %v", n.name, args)
+	}
+	ch, ok := channel.(chan<- *Head)
+	if !ok {
+		return nil, errors.Errorf("newHeads subscription requires a chan<- *Head, got %T", channel)
+	}
+
+	var latest *Head
+	return newHTTPSubscription(n.pollInterval, func(ctx context.Context, chDone <-chan struct{}) {
+		ctx, cancel := DefaultQueryCtx(ctx)
+		defer cancel()
+
+		var head *Head
+		err := n.http.rpc.CallContext(ctx, &head, "eth_getBlockByNumber", "latest", false)
+		if err != nil {
+			n.log.Warnw("eth.Client: Failed to poll for latest head", "err", n.wrapHTTP(err))
+			return
+		}
+		if head == nil || (latest != nil && head.Hash == latest.Hash) {
+			return
+		}
+		latest = head
+		select {
+		case ch <- head:
+		case <-chDone:
+		}
+	}), nil
+}
+
+// pollFilterLogs emulates SubscribeFilterLogs by fetching the logs of the
+// blocks mined since the previous poll. Unlike a websocket subscription, logs
+// of blocks that are reorged out are not sent again as removed.
+func (n node) pollFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	latest, err := n.http.geth.BlockNumber(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch latest block number")
+	}
+	next := latest + 1
+
+	return newHTTPSubscription(n.pollInterval, func(ctx context.Context, chDone <-chan struct{}) {
+		ctx, cancel := DefaultQueryCtx(ctx)
+		defer cancel()
+
+		latest, err := n.http.geth.BlockNumber(ctx)
+		if err != nil {
+			n.log.Warnw("eth.Client: Failed to poll for latest block number", "err", n.wrapHTTP(err))
+			return
+		}
+		if latest < next {
+			return
+		}
+		query := q
+		query.FromBlock = new(big.Int).SetUint64(next)
+		query.ToBlock = new(big.Int).SetUint64(latest)
+		logs, err := n.http.geth.FilterLogs(ctx, query)
+		if err != nil {
+			n.log.Warnw("eth.Client: Failed to poll for logs", "err", n.wrapHTTP(err), "fromBlock", next, "toBlock", latest)
+			return
+		}
+		for _, log := range logs {
+			select {
+			case ch <- log:
+			case <-chDone:
+				return
+			}
+		}
+		next = latest + 1
+	}), nil
+}
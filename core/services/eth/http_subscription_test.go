@@ -0,0 +1,127 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// newRPCServer returns an HTTP JSON-RPC server that answers each call with
+// the result of handle
+func newRPCServer(t *testing.T, handle func(method string, params []json.RawMessage) interface{}) *url.URL {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		result, err := json.Marshal(handle(req.Method, req.Params))
+		assert.NoError(t, err)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, result)
+	}))
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestHTTPOnlyNode_SubscribeNewHeads(t *testing.T) {
+	var blockNumber int64 = 1
+	u := newRPCServer(t, func(method string, _ []json.RawMessage) interface{} {
+		assert.Equal(t, "eth_getBlockByNumber", method)
+		n := atomic.LoadInt64(&blockNumber)
+		return map[string]interface{}{
+			"number":     fmt.Sprintf("0x%x", n),
+			"hash":       common.BigToHash(big.NewInt(n)),
+			"parentHash": common.BigToHash(big.NewInt(n - 1)),
+		}
+	})
+
+	n := NewHTTPOnlyNode(logger.TestLogger(t), *u, "test", 10*time.Millisecond)
+	require.NoError(t, n.Dial(context.Background()))
+	defer n.Close()
+
+	ch := make(chan *Head)
+	sub, err := n.EthSubscribe(context.Background(), (chan<- *Head)(ch), "newHeads")
+	require.NoError(t, err)
+
+	head := <-ch
+	assert.Equal(t, int64(1), head.Number)
+
+	// The same head is not sent twice
+	select {
+	case head = <-ch:
+		t.Fatalf("unexpected head %v", head.Number)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	atomic.StoreInt64(&blockNumber, 2)
+	head = <-ch
+	assert.Equal(t, int64(2), head.Number)
+
+	sub.Unsubscribe()
+	_, open := <-sub.Err()
+	assert.False(t, open)
+
+	_, err = n.EthSubscribe(context.Background(), (chan<- *Head)(ch), "logs")
+	require.Error(t, err)
+}
+
+func TestHTTPOnlyNode_SubscribeFilterLogs(t *testing.T) {
+	var blockNumber int64 = 10
+	address := common.HexToAddress("0xf0d54349aDdcf704F77AE15b96510dEA15cb7952")
+	u := newRPCServer(t, func(method string, params []json.RawMessage) interface{} {
+		switch method {
+		case "eth_blockNumber":
+			return fmt.Sprintf("0x%x", atomic.LoadInt64(&blockNumber))
+		case "eth_getLogs":
+			var arg struct {
+				FromBlock string `json:"fromBlock"`
+				ToBlock   string `json:"toBlock"`
+			}
+			assert.NoError(t, json.Unmarshal(params[0], &arg))
+			// Only the blocks mined since the subscription started are queried
+			assert.Equal(t, "0xb", arg.FromBlock)
+			assert.Equal(t, "0xb", arg.ToBlock)
+			return []map[string]interface{}{{
+				"address":         address,
+				"topics":          []common.Hash{},
+				"data":            "0x",
+				"blockNumber":     arg.ToBlock,
+				"transactionHash": common.Hash{},
+			}}
+		}
+		t.Errorf("unexpected method %s", method)
+		return nil
+	})
+
+	n := NewHTTPOnlyNode(logger.TestLogger(t), *u, "test", 10*time.Millisecond)
+	require.NoError(t, n.Dial(context.Background()))
+	defer n.Close()
+
+	ch := make(chan types.Log)
+	sub, err := n.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{Addresses: []common.Address{address}}, ch)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	atomic.StoreInt64(&blockNumber, 11)
+	log := <-ch
+	assert.Equal(t, address, log.Address)
+	assert.Equal(t, uint64(11), log.BlockNumber)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/url"
+	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -50,13 +51,17 @@ type rawclient struct {
 }
 
 // Node represents one ethereum node.
-// It must have a ws url and may have a http url
+// It must have a ws url and may have a http url, unless it is HTTP-only, in
+// which case subscriptions are emulated by polling
 type node struct {
-	ws     rawclient
+	ws     *rawclient
 	http   *rawclient
 	log    logger.Logger
 	name   string
 	dialed bool
+	// pollInterval is how often an HTTP-only node polls in place of
+	// subscriptions
+	pollInterval time.Duration
 }
 
 func NewNode(lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string) Node {
@@ -66,38 +71,57 @@ func NewNode(lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string) N
 		"nodeName", name,
 		"nodeTier", "primary",
 	)
-	n.ws.uri = wsuri
+	n.ws = &rawclient{uri: wsuri}
 	if httpuri != nil {
 		n.http = &rawclient{uri: *httpuri}
 	}
 	return n
 }
 
+// NewHTTPOnlyNode returns a node for an RPC endpoint that has no websocket
+// URL. Head and log subscriptions are emulated by polling every pollInterval.
+func NewHTTPOnlyNode(lggr logger.Logger, httpuri url.URL, name string, pollInterval time.Duration) Node {
+	n := new(node)
+	n.name = name
+	n.log = lggr.With(
+		"nodeName", name,
+		"nodeTier", "primary",
+	)
+	n.http = &rawclient{uri: httpuri}
+	n.pollInterval = pollInterval
+	return n
+}
+
+// Dial connects the websocket and HTTP clients of the node. It can be called
+// again after it failed, e.g. by the pool's health checks, and only dials the
+// clients that are not connected yet. It is a no-op once the node is dialed.
 func (n *node) Dial(ctx context.Context) error {
 	if n.dialed {
-		panic("eth.Client.Dial(...) should only be called once during the node's lifetime.")
+		return nil
 	}
 
 	{
-		var httpuri string
+		var wsuri, httpuri string
+		if n.ws != nil {
+			wsuri = n.ws.uri.String()
+		}
 		if n.http != nil {
 			httpuri = n.http.uri.String()
 		}
-		n.log.Debugw("eth.Client#Dial(...)", "wsuri", n.ws.uri.String(), "httpuri", httpuri)
+		n.log.Debugw("eth.Client#Dial(...)", "wsuri", wsuri, "httpuri", httpuri)
 	}
 
-	{
+	if n.ws != nil && n.ws.rpc == nil {
 		uri := n.ws.uri.String()
 		rpc, err := rpc.DialWebsocket(ctx, uri, "")
 		if err != nil {
 			return errors.Wrapf(err, "Error while dialing websocket: %v", uri)
 		}
-		n.dialed = true
 		n.ws.rpc = rpc
 		n.ws.geth = ethclient.NewClient(rpc)
 	}
 
-	if n.http != nil {
+	if n.http != nil && n.http.rpc == nil {
 		uri := n.http.uri.String()
 		rpc, err := rpc.DialHTTP(uri)
 		if err != nil {
@@ -106,6 +130,7 @@ func (n *node) Dial(ctx context.Context) error {
 		n.http.rpc = rpc
 		n.http.geth = ethclient.NewClient(rpc)
 	}
+	n.dialed = true
 
 	return nil
 }
@@ -136,12 +161,20 @@ func (n node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 }
 
 func (n node) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
+	if n.ws == nil {
+		n.log.Debugw("eth.Client#EthSubscribe", "mode", "polling")
+		return n.pollSubscribe(ctx, channel, args...)
+	}
 	n.log.Debugw("eth.Client#EthSubscribe", "mode", "websocket")
 	return n.ws.rpc.EthSubscribe(ctx, channel, args...)
 }
 
 func (n node) Close() {
-	n.ws.rpc.Close()
+	if n.ws != nil {
+		n.ws.rpc.Close()
+	} else {
+		n.http.rpc.Close()
+	}
 }
 
 // GethClient wrappers
@@ -267,9 +300,14 @@ func (n node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint6
 }
 
 func (n node) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
-	n.log.Debugw("eth.Client#SuggestGasPrice()", "mode", "websocket")
-	price, err = n.ws.geth.SuggestGasPrice(ctx)
-	err = n.wrapWS(err)
+	n.log.Debugw("eth.Client#SuggestGasPrice()", "mode", switching(n))
+	if n.ws == nil {
+		price, err = n.http.geth.SuggestGasPrice(ctx)
+		err = n.wrapHTTP(err)
+	} else {
+		price, err = n.ws.geth.SuggestGasPrice(ctx)
+		err = n.wrapWS(err)
+	}
 	return
 }
 
@@ -335,6 +373,12 @@ func (n node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []types
 }
 
 func (n node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
+	if n.ws == nil {
+		n.log.Debugw("eth.Client#SubscribeFilterLogs(...)", "q", q, "mode", "polling")
+		sub, err = n.pollFilterLogs(ctx, q, ch)
+		err = n.wrapHTTP(err)
+		return
+	}
 	n.log.Debugw("eth.Client#SubscribeFilterLogs(...)", "q", q, "mode", "websocket")
 	sub, err = n.ws.geth.SubscribeFilterLogs(ctx, q, ch)
 	err = n.wrapWS(err)
@@ -381,7 +425,10 @@ func switching(n node) string {
 }
 
 func (n node) String() string {
-	s := fmt.Sprintf("(primary)%s:%s", n.name, n.ws.uri.String())
+	s := fmt.Sprintf("(primary)%s", n.name)
+	if n.ws != nil {
+		s = s + fmt.Sprintf(":%s", n.ws.uri.String())
+	}
 	if n.http != nil {
 		s = s + fmt.Sprintf(":%s", n.http.uri.String())
 	}
@@ -391,15 +438,17 @@ func (n node) String() string {
 // Verify checks that all connections to eth nodes match the given chain ID
 func (n node) Verify(ctx context.Context, expectedChainID *big.Int) (err error) {
 	var chainID *big.Int
-	if chainID, err = n.ws.geth.ChainID(ctx); err != nil {
-		return errors.Wrapf(err, "failed to verify chain ID for node %s", n.name)
-	} else if chainID.Cmp(expectedChainID) != 0 {
-		return errors.Errorf(
-			"websocket rpc ChainID doesn't match local chain ID: RPC ID=%s, local ID=%s, node name=%s",
-			chainID.String(),
-			expectedChainID.String(),
-			n.name,
-		)
+	if n.ws != nil {
+		if chainID, err = n.ws.geth.ChainID(ctx); err != nil {
+			return errors.Wrapf(err, "failed to verify chain ID for node %s", n.name)
+		} else if chainID.Cmp(expectedChainID) != 0 {
+			return errors.Errorf(
+				"websocket rpc ChainID doesn't match local chain ID: RPC ID=%s, local ID=%s, node name=%s",
+				chainID.String(),
+				expectedChainID.String(),
+				n.name,
+			)
+		}
 	}
 	if n.http != nil {
 		if chainID, err = n.http.geth.ChainID(ctx); err != nil {
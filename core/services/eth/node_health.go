@@ -0,0 +1,199 @@
+package eth
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// nodeHealthCheckTimeout is the maximum amount of time a single health
+	// check probe may take before the node is considered to have failed it
+	nodeHealthCheckTimeout = 5 * time.Second
+	// nodeOutOfSyncThreshold is the number of blocks a node may lag behind the
+	// highest block seen across the pool before it is marked out-of-sync
+	nodeOutOfSyncThreshold = 5
+	// nodeUnreachableThreshold is the number of consecutive failed health
+	// checks after which a node is marked unreachable
+	nodeUnreachableThreshold = 3
+	// nodeHealthEWMAWeight is the weight given to the newest sample when
+	// updating the moving averages for latency and error rate
+	nodeHealthEWMAWeight = 0.2
+)
+
+// nodeHealthCheckInterval is how often the pool probes each of its primary
+// nodes. It is a var so that tests can shorten it.
+var nodeHealthCheckInterval = 10 * time.Second
+
+// NodeState represents the health of a primary node as seen by the Pool
+type NodeState int
+
+const (
+	// NodeStateUndialed is the state of a node before it has been dialed
+	NodeStateUndialed NodeState = iota
+	// NodeStateAlive means the node is reachable and in sync with the rest
+	// of the pool
+	NodeStateAlive
+	// NodeStateOutOfSync means the node is reachable but its latest head lags
+	// too far behind the highest head seen across the pool
+	NodeStateOutOfSync
+	// NodeStateUnreachable means the node could not be dialed or has failed
+	// too many consecutive health checks
+	NodeStateUnreachable
+	// NodeStateInvalidChainID means the node was redialed but reported a
+	// chain ID that does not match the pool's. It will never be used again.
+	NodeStateInvalidChainID
+)
+
+func (s NodeState) String() string {
+	switch s {
+	case NodeStateUndialed:
+		return "Undialed"
+	case NodeStateAlive:
+		return "Alive"
+	case NodeStateOutOfSync:
+		return "OutOfSync"
+	case NodeStateUnreachable:
+		return "Unreachable"
+	case NodeStateInvalidChainID:
+		return "InvalidChainID"
+	default:
+		return fmt.Sprintf("NodeState(%d)", s)
+	}
+}
+
+// NodeHealth is a point-in-time snapshot of a primary node's health
+type NodeHealth struct {
+	Name              string
+	State             NodeState
+	Dialed            bool
+	LatestBlockNumber int64
+	// Latency is an exponentially weighted moving average of health check latency
+	Latency time.Duration
+	// ErrorRate is an exponentially weighted moving average of health check
+	// failures, between 0 and 1
+	ErrorRate           float64
+	ConsecutiveFailures int
+	LastError           error
+}
+
+// nodeHealth tracks the health of a single primary node. It is safe for
+// concurrent use.
+type nodeHealth struct {
+	mu                  sync.RWMutex
+	state               NodeState
+	dialed              bool
+	latestBlockNumber   int64
+	latency             time.Duration
+	errorRate           float64
+	consecutiveFailures int
+	lastErr             error
+}
+
+func (h *nodeHealth) snapshot(name string) NodeHealth {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return NodeHealth{
+		Name:                name,
+		State:               h.state,
+		Dialed:              h.dialed,
+		LatestBlockNumber:   h.latestBlockNumber,
+		Latency:             h.latency,
+		ErrorRate:           h.errorRate,
+		ConsecutiveFailures: h.consecutiveFailures,
+		LastError:           h.lastErr,
+	}
+}
+
+func (h *nodeHealth) setDialed(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err != nil {
+		h.state = NodeStateUnreachable
+		h.lastErr = err
+		return
+	}
+	h.dialed = true
+	h.state = NodeStateAlive
+	h.consecutiveFailures = 0
+	h.lastErr = nil
+}
+
+func (h *nodeHealth) setInvalidChainID(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.dialed = true
+	h.state = NodeStateInvalidChainID
+	h.lastErr = err
+}
+
+func (h *nodeHealth) isDialed() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.dialed
+}
+
+// recordSuccess updates the moving averages after a successful probe. The
+// state is left alone here, since whether the node is in sync can only be
+// determined relative to the rest of the pool.
+func (h *nodeHealth) recordSuccess(blockNumber int64, latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.latestBlockNumber = blockNumber
+	if h.latency == 0 {
+		h.latency = latency
+	} else {
+		h.latency = time.Duration(nodeHealthEWMAWeight*float64(latency) + (1-nodeHealthEWMAWeight)*float64(h.latency))
+	}
+	h.errorRate = (1 - nodeHealthEWMAWeight) * h.errorRate
+	h.consecutiveFailures = 0
+	h.lastErr = nil
+	if h.state == NodeStateUnreachable {
+		h.state = NodeStateAlive
+	}
+}
+
+// recordFailure updates the moving averages after a failed probe, and
+// returns true if the node has just transitioned to unreachable
+func (h *nodeHealth) recordFailure(err error) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.errorRate = nodeHealthEWMAWeight + (1-nodeHealthEWMAWeight)*h.errorRate
+	h.consecutiveFailures++
+	h.lastErr = err
+	if h.consecutiveFailures >= nodeUnreachableThreshold && h.state != NodeStateUnreachable {
+		h.state = NodeStateUnreachable
+		return true
+	}
+	return false
+}
+
+// updateSyncState compares the node's latest block against the highest block
+// seen across the pool and returns the previous and the new state
+func (h *nodeHealth) updateSyncState(highestBlockNumber int64) (prev, next NodeState) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	prev = h.state
+	if h.state != NodeStateAlive && h.state != NodeStateOutOfSync {
+		return prev, prev
+	}
+	if highestBlockNumber-h.latestBlockNumber > nodeOutOfSyncThreshold {
+		h.state = NodeStateOutOfSync
+	} else {
+		h.state = NodeStateAlive
+	}
+	return prev, h.state
+}
+
+// isBetterThan returns true if a should be preferred over b. Nodes with a
+// higher block number are preferred; ties are broken by lower latency and then
+// by lower error rate.
+func (a NodeHealth) isBetterThan(b NodeHealth) bool {
+	if a.LatestBlockNumber != b.LatestBlockNumber {
+		return a.LatestBlockNumber > b.LatestBlockNumber
+	}
+	if a.Latency != b.Latency {
+		return a.Latency < b.Latency
+	}
+	return a.ErrorRate < b.ErrorRate
+}
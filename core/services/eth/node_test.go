@@ -2,9 +2,14 @@ package eth
 
 import (
 	"context"
+	"net/url"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
 func Test_NodeWrapError(t *testing.T) {
@@ -24,3 +29,29 @@ func Test_NodeWrapError(t *testing.T) {
 		assert.EqualError(t, err, "foo call failed: remote eth node timed out: context deadline exceeded")
 	})
 }
+
+func Test_NodeDial(t *testing.T) {
+	t.Run("can be called again after the HTTP client failed to dial", func(t *testing.T) {
+		wsuri, err := url.Parse("ws://localhost:8546")
+		require.NoError(t, err)
+		n := NewNode(logger.TestLogger(t), *wsuri, &url.URL{Scheme: "http", Host: "invalid host"}, "test").(*node)
+		// simulate a websocket client which dialed successfully
+		wsrpc := rpc.DialInProc(rpc.NewServer())
+		n.ws.rpc = wsrpc
+
+		err = n.Dial(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Error while dialing HTTP")
+		assert.False(t, n.dialed)
+
+		n.http.uri = url.URL{Scheme: "http", Host: "localhost:8545"}
+		require.NoError(t, n.Dial(context.Background()))
+		assert.True(t, n.dialed)
+		assert.Same(t, wsrpc, n.ws.rpc)
+		assert.NotNil(t, n.http.rpc)
+
+		// dialing a dialed node is a no-op
+		require.NoError(t, n.Dial(context.Background()))
+		assert.Same(t, wsrpc, n.ws.rpc)
+	})
+}
@@ -5,34 +5,80 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/atomic"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promPoolRPCNodeStates = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "eth_pool_rpc_node_states",
+		Help: "The number of primary nodes in each state",
+	}, []string{"evmChainID", "state"})
 )
 
 // Pool represents an abstraction over one or more primary nodes
 // It is responsible for liveness checking and balancing queries across live nodes
 type Pool struct {
 	nodes           []Node
+	health          []*nodeHealth
 	sendonlys       []SendOnlyNode
 	chainID         *big.Int
 	roundRobinCount atomic.Uint32
 	logger          logger.Logger
+
+	chStop   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
 }
 
 func NewPool(logger logger.Logger, nodes []Node, sendonlys []SendOnlyNode, chainID *big.Int) *Pool {
-	return &Pool{nodes, sendonlys, chainID, atomic.Uint32{}, logger}
+	health := make([]*nodeHealth, len(nodes))
+	for i := range nodes {
+		health[i] = new(nodeHealth)
+	}
+	return &Pool{
+		nodes:     nodes,
+		health:    health,
+		sendonlys: sendonlys,
+		chainID:   chainID,
+		logger:    logger,
+		chStop:    make(chan struct{}),
+	}
 }
 
-func (p *Pool) Dial(ctx context.Context) (err error) {
-	for _, n := range p.nodes {
-		err = multierr.Combine(err, n.Dial(ctx))
+// Dial dials every node in the pool. A primary node that fails to dial is
+// marked unreachable and redialed in the background; Dial only returns an
+// error if none of the primaries could be dialed, if a send-only node could not
+// be dialed, or if any node is on the wrong chain.
+func (p *Pool) Dial(ctx context.Context) error {
+	var primaryErr error
+	nDialed := 0
+	for i, n := range p.nodes {
+		err := n.Dial(ctx)
+		p.health[i].setDialed(err)
+		if err != nil {
+			primaryErr = multierr.Combine(primaryErr, err)
+			continue
+		}
+		nDialed++
+	}
+	var err error
+	if nDialed == 0 {
+		err = primaryErr
+	} else if primaryErr != nil {
+		p.logger.Warnw("Failed to dial some primary nodes, will retry in the background", "err", primaryErr)
 	}
 	for _, s := range p.sendonlys {
 		err = multierr.Combine(err, s.Dial(ctx))
@@ -40,14 +86,27 @@ func (p *Pool) Dial(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
-	return p.verifyChainIDs(ctx)
+	if err = p.verifyChainIDs(ctx); err != nil {
+		return err
+	}
+	p.reportNodeStates()
+	if len(p.nodes) > 1 {
+		// There is nothing to choose between with a single primary, so
+		// only bother monitoring health when there are several
+		p.wg.Add(1)
+		go p.runHealthCheckLoop()
+	}
+	return nil
 }
 
 func (p *Pool) verifyChainIDs(ctx context.Context) (err error) {
 	if p.chainID == nil {
 		return nil
 	}
-	for _, n := range p.nodes {
+	for i, n := range p.nodes {
+		if !p.health[i].isDialed() {
+			continue
+		}
 		err = multierr.Combine(err, n.Verify(ctx, p.chainID))
 	}
 	for _, s := range p.sendonlys {
@@ -57,7 +116,12 @@ func (p *Pool) verifyChainIDs(ctx context.Context) (err error) {
 }
 
 func (p *Pool) Close() {
-	for _, n := range p.nodes {
+	p.stopOnce.Do(func() { close(p.chStop) })
+	p.wg.Wait()
+	for i, n := range p.nodes {
+		if !p.health[i].isDialed() {
+			continue
+		}
 		n.Close()
 	}
 }
@@ -66,8 +130,47 @@ func (p *Pool) ChainID() *big.Int {
 	return p.chainID
 }
 
+// NodeHealths returns a snapshot of the health of each primary node, in the
+// order the nodes were given to the pool
+func (p *Pool) NodeHealths() []NodeHealth {
+	healths := make([]NodeHealth, len(p.nodes))
+	for i, n := range p.nodes {
+		healths[i] = p.health[i].snapshot(n.String())
+	}
+	return healths
+}
+
+// selectNode returns the healthiest alive primary node, i.e. the one with the
+// highest block number and then the lowest latency. If no node is known to be
+// alive it falls back to round-robin across all dialed nodes, so that calls
+// still have a chance of succeeding while the pool recovers.
+func (p *Pool) selectNode() Node {
+	if len(p.nodes) <= 1 {
+		return p.getRoundRobin()
+	}
+	best := -1
+	var bestHealth NodeHealth
+	for i, h := range p.health {
+		nh := h.snapshot("")
+		if nh.State != NodeStateAlive {
+			continue
+		}
+		if best < 0 || nh.isBetterThan(bestHealth) {
+			best = i
+			bestHealth = nh
+		}
+	}
+	if best < 0 {
+		return p.getRoundRobin()
+	}
+	return p.nodes[best]
+}
+
+// getRoundRobin cycles through the nodes that have been dialed, skipping any
+// that are known to be on the wrong chain
 func (p *Pool) getRoundRobin() Node {
-	nNodes := len(p.nodes)
+	nodes := p.usableNodes()
+	nNodes := len(nodes)
 	if nNodes == 0 {
 		return &erroringNode{errMsg: fmt.Sprintf("no nodes available for chain %s", p.chainID.String())}
 	}
@@ -76,15 +179,144 @@ func (p *Pool) getRoundRobin() Node {
 	count := p.roundRobinCount.Inc() - 1
 	idx := int(count % uint32(nNodes))
 
-	return p.nodes[idx]
+	return nodes[idx]
+}
+
+// usableNodes returns the primary nodes that can safely be called, i.e. the
+// ones that have been dialed and are on the right chain
+func (p *Pool) usableNodes() []Node {
+	nodes := make([]Node, 0, len(p.nodes))
+	for i, n := range p.nodes {
+		nh := p.health[i].snapshot("")
+		if !nh.Dialed || nh.State == NodeStateInvalidChainID {
+			continue
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+func (p *Pool) runHealthCheckLoop() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(utils.WithJitter(nodeHealthCheckInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.chStop:
+			return
+		case <-ticker.C:
+			p.checkNodes()
+		}
+	}
+}
+
+// checkNodes probes every primary node concurrently, then re-evaluates which
+// nodes are out of sync relative to the highest block seen
+func (p *Pool) checkNodes() {
+	ctx, cancel := utils.ContextFromChan(p.chStop)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(len(p.nodes))
+	for i := range p.nodes {
+		go func(i int) {
+			defer wg.Done()
+			p.checkNode(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+
+	var highest int64
+	for _, h := range p.health {
+		nh := h.snapshot("")
+		if nh.State != NodeStateAlive && nh.State != NodeStateOutOfSync {
+			continue
+		}
+		if nh.LatestBlockNumber > highest {
+			highest = nh.LatestBlockNumber
+		}
+	}
+	for i, h := range p.health {
+		prev, next := h.updateSyncState(highest)
+		if prev == next {
+			continue
+		}
+		nh := h.snapshot(p.nodes[i].String())
+		switch next {
+		case NodeStateOutOfSync:
+			p.logger.Warnw("Primary node is out of sync", "node", nh.Name, "blockNumber", nh.LatestBlockNumber, "highestBlockNumber", highest)
+		case NodeStateAlive:
+			p.logger.Infow("Primary node is back in sync", "node", nh.Name, "blockNumber", nh.LatestBlockNumber, "highestBlockNumber", highest)
+		}
+	}
+	p.reportNodeStates()
+}
+
+// checkNode redials the node if it has never been dialed successfully,
+// otherwise it fetches the latest head to measure the node's block height and
+// latency. An already dialed node that fails checks needs no explicit redial,
+// since the underlying rpc client reconnects on the next call.
+func (p *Pool) checkNode(parentCtx context.Context, i int) {
+	n, h := p.nodes[i], p.health[i]
+	lggr := p.logger.With("node", n.String())
+
+	ctx, cancel := context.WithTimeout(parentCtx, nodeHealthCheckTimeout)
+	defer cancel()
+
+	if !h.isDialed() {
+		if err := n.Dial(ctx); err != nil {
+			h.recordFailure(err)
+			lggr.Debugw("Failed to redial primary node", "err", err)
+			return
+		}
+		if p.chainID != nil {
+			if err := n.Verify(ctx, p.chainID); err != nil {
+				h.setInvalidChainID(err)
+				lggr.Errorw("Redialed primary node is on the wrong chain, it will not be used", "err", err)
+				return
+			}
+		}
+		h.setDialed(nil)
+		lggr.Infow("Redialed primary node")
+	}
+
+	start := time.Now()
+	head, err := n.HeaderByNumber(ctx, nil)
+	latency := time.Since(start)
+	if err == nil && head == nil {
+		err = errors.New("got nil head")
+	}
+	if err != nil {
+		if h.recordFailure(err) {
+			lggr.Errorw("Primary node is unreachable", "err", err)
+		}
+		return
+	}
+	prev := h.snapshot("").State
+	h.recordSuccess(head.Number.Int64(), latency)
+	if prev == NodeStateUnreachable {
+		lggr.Infow("Primary node is reachable again")
+	}
+}
+
+func (p *Pool) reportNodeStates() {
+	counts := make(map[NodeState]int)
+	for _, h := range p.health {
+		counts[h.snapshot("").State]++
+	}
+	for _, state := range []NodeState{NodeStateUndialed, NodeStateAlive, NodeStateOutOfSync, NodeStateUnreachable, NodeStateInvalidChainID} {
+		promPoolRPCNodeStates.WithLabelValues(p.chainID.String(), state.String()).Set(float64(counts[state]))
+	}
 }
 
 func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
-	return p.getRoundRobin().CallContext(ctx, result, method, args...)
+	return p.selectNode().CallContext(ctx, result, method, args...)
 }
 
 func (p *Pool) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
-	return p.getRoundRobin().BatchCallContext(ctx, b)
+	return p.selectNode().BatchCallContext(ctx, b)
 }
 
 // Wrapped Geth client methods
@@ -92,9 +324,9 @@ func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error
 	var wg sync.WaitGroup
 	defer wg.Wait()
 
-	main := p.getRoundRobin()
+	main := p.selectNode()
 	var all []SendOnlyNode
-	for _, n := range p.nodes {
+	for _, n := range p.usableNodes() {
 		all = append(all, n)
 	}
 	all = append(all, p.sendonlys...)
@@ -121,62 +353,62 @@ func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error
 }
 
 func (p *Pool) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
-	return p.getRoundRobin().PendingCodeAt(ctx, account)
+	return p.selectNode().PendingCodeAt(ctx, account)
 }
 
 func (p *Pool) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
-	return p.getRoundRobin().PendingNonceAt(ctx, account)
+	return p.selectNode().PendingNonceAt(ctx, account)
 }
 
 func (p *Pool) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
-	return p.getRoundRobin().NonceAt(ctx, account, blockNumber)
+	return p.selectNode().NonceAt(ctx, account, blockNumber)
 }
 
 func (p *Pool) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
-	return p.getRoundRobin().TransactionReceipt(ctx, txHash)
+	return p.selectNode().TransactionReceipt(ctx, txHash)
 }
 
 func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
-	return p.getRoundRobin().BlockByNumber(ctx, number)
+	return p.selectNode().BlockByNumber(ctx, number)
 }
 
 func (p *Pool) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
-	return p.getRoundRobin().BalanceAt(ctx, account, blockNumber)
+	return p.selectNode().BalanceAt(ctx, account, blockNumber)
 }
 
 func (p *Pool) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
-	return p.getRoundRobin().FilterLogs(ctx, q)
+	return p.selectNode().FilterLogs(ctx, q)
 }
 
 func (p *Pool) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
-	return p.getRoundRobin().SubscribeFilterLogs(ctx, q, ch)
+	return p.selectNode().SubscribeFilterLogs(ctx, q, ch)
 }
 
 func (p *Pool) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
-	return p.getRoundRobin().EstimateGas(ctx, call)
+	return p.selectNode().EstimateGas(ctx, call)
 }
 
 func (p *Pool) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
-	return p.getRoundRobin().SuggestGasPrice(ctx)
+	return p.selectNode().SuggestGasPrice(ctx)
 }
 
 func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	return p.getRoundRobin().CallContract(ctx, msg, blockNumber)
+	return p.selectNode().CallContract(ctx, msg, blockNumber)
 }
 
 func (p *Pool) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
-	return p.getRoundRobin().CodeAt(ctx, account, blockNumber)
+	return p.selectNode().CodeAt(ctx, account, blockNumber)
 }
 
 // bind.ContractBackend methods
 func (p *Pool) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
-	return p.getRoundRobin().HeaderByNumber(ctx, n)
+	return p.selectNode().HeaderByNumber(ctx, n)
 }
 
 func (p *Pool) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
-	return p.getRoundRobin().SuggestGasTipCap(ctx)
+	return p.selectNode().SuggestGasTipCap(ctx)
 }
 
 func (p *Pool) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
-	return p.getRoundRobin().EthSubscribe(ctx, channel, args...)
+	return p.selectNode().EthSubscribe(ctx, channel, args...)
 }
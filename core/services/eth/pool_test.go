@@ -0,0 +1,199 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// fakeNode is a primary node whose head and availability can be changed by
+// the test. Any Node method not overridden here will panic if called.
+type fakeNode struct {
+	Node
+	name string
+
+	mu          sync.Mutex
+	blockNumber int64
+	delay       time.Duration
+	dialErr     error
+	headErr     error
+	chainID     *big.Int
+}
+
+func newFakeNode(name string, blockNumber int64) *fakeNode {
+	return &fakeNode{name: name, blockNumber: blockNumber}
+}
+
+func (f *fakeNode) Dial(context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.dialErr
+}
+
+func (f *fakeNode) Close() {}
+
+func (f *fakeNode) Verify(_ context.Context, expectedChainID *big.Int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.chainID != nil && f.chainID.Cmp(expectedChainID) != 0 {
+		return errors.New("wrong chain")
+	}
+	return nil
+}
+
+func (f *fakeNode) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	time.Sleep(f.delay)
+	if f.headErr != nil {
+		return nil, f.headErr
+	}
+	return &types.Header{Number: big.NewInt(f.blockNumber)}, nil
+}
+
+func (f *fakeNode) String() string { return f.name }
+
+func (f *fakeNode) set(fn func(f *fakeNode)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fn(f)
+}
+
+func newTestPool(t *testing.T, nodes ...Node) *Pool {
+	p := NewPool(logger.TestLogger(t), nodes, nil, big.NewInt(42))
+	t.Cleanup(p.Close)
+	return p
+}
+
+func TestPool_SelectNode(t *testing.T) {
+	t.Run("prefers the node with the highest block", func(t *testing.T) {
+		a, b, c := newFakeNode("a", 100), newFakeNode("b", 103), newFakeNode("c", 101)
+		p := newTestPool(t, a, b, c)
+		require.NoError(t, p.Dial(context.Background()))
+
+		p.checkNodes()
+
+		assert.Equal(t, b, p.selectNode())
+	})
+
+	t.Run("breaks ties on block number by latency", func(t *testing.T) {
+		a, b := newFakeNode("a", 100), newFakeNode("b", 100)
+		a.delay = 20 * time.Millisecond
+		p := newTestPool(t, a, b)
+		require.NoError(t, p.Dial(context.Background()))
+
+		p.checkNodes()
+
+		assert.Equal(t, b, p.selectNode())
+	})
+
+	t.Run("marks lagging nodes out of sync and does not select them", func(t *testing.T) {
+		a, b := newFakeNode("a", 100), newFakeNode("b", 100+nodeOutOfSyncThreshold+1)
+		p := newTestPool(t, a, b)
+		require.NoError(t, p.Dial(context.Background()))
+
+		p.checkNodes()
+
+		healths := p.NodeHealths()
+		assert.Equal(t, NodeStateOutOfSync, healths[0].State)
+		assert.Equal(t, NodeStateAlive, healths[1].State)
+
+		// a catches up and is back in sync
+		a.set(func(f *fakeNode) { f.blockNumber = 106 })
+		p.checkNodes()
+		assert.Equal(t, NodeStateAlive, p.NodeHealths()[0].State)
+	})
+
+	t.Run("marks nodes unreachable after consecutive failures and recovers them", func(t *testing.T) {
+		a, b := newFakeNode("a", 100), newFakeNode("b", 105)
+		p := newTestPool(t, a, b)
+		require.NoError(t, p.Dial(context.Background()))
+
+		b.set(func(f *fakeNode) { f.headErr = errors.New("boom") })
+		for i := 0; i < nodeUnreachableThreshold; i++ {
+			p.checkNodes()
+		}
+
+		healths := p.NodeHealths()
+		assert.Equal(t, NodeStateUnreachable, healths[1].State)
+		assert.Greater(t, healths[1].ErrorRate, float64(0))
+		assert.Equal(t, a, p.selectNode())
+
+		b.set(func(f *fakeNode) { f.headErr = nil })
+		p.checkNodes()
+
+		assert.Equal(t, NodeStateAlive, p.NodeHealths()[1].State)
+		assert.Equal(t, b, p.selectNode())
+	})
+
+	t.Run("falls back to round robin when no node is alive", func(t *testing.T) {
+		a, b := newFakeNode("a", 100), newFakeNode("b", 100)
+		p := newTestPool(t, a, b)
+		require.NoError(t, p.Dial(context.Background()))
+
+		a.set(func(f *fakeNode) { f.headErr = errors.New("boom") })
+		b.set(func(f *fakeNode) { f.headErr = errors.New("boom") })
+		for i := 0; i < nodeUnreachableThreshold; i++ {
+			p.checkNodes()
+		}
+
+		assert.ElementsMatch(t, []Node{a, b}, []Node{p.selectNode(), p.selectNode()})
+	})
+}
+
+func TestPool_Dial(t *testing.T) {
+	t.Run("succeeds if at least one primary dials and redials the others in the background", func(t *testing.T) {
+		a, b := newFakeNode("a", 100), newFakeNode("b", 105)
+		b.dialErr = errors.New("connection refused")
+		p := newTestPool(t, a, b)
+
+		require.NoError(t, p.Dial(context.Background()))
+
+		healths := p.NodeHealths()
+		assert.Equal(t, NodeStateAlive, healths[0].State)
+		assert.Equal(t, NodeStateUnreachable, healths[1].State)
+		assert.False(t, healths[1].Dialed)
+		assert.Equal(t, []Node{a}, p.usableNodes())
+
+		b.set(func(f *fakeNode) { f.dialErr = nil })
+		p.checkNodes()
+
+		healths = p.NodeHealths()
+		assert.True(t, healths[1].Dialed)
+		assert.Equal(t, NodeStateAlive, healths[1].State)
+		assert.Equal(t, b, p.selectNode())
+	})
+
+	t.Run("fails if no primary dials", func(t *testing.T) {
+		a, b := newFakeNode("a", 100), newFakeNode("b", 105)
+		a.dialErr = errors.New("connection refused")
+		b.dialErr = errors.New("connection refused")
+		p := newTestPool(t, a, b)
+
+		require.Error(t, p.Dial(context.Background()))
+	})
+
+	t.Run("never uses a redialed node on the wrong chain", func(t *testing.T) {
+		a, b := newFakeNode("a", 100), newFakeNode("b", 105)
+		b.dialErr = errors.New("connection refused")
+		b.chainID = big.NewInt(1)
+		p := newTestPool(t, a, b)
+		require.NoError(t, p.Dial(context.Background()))
+
+		b.set(func(f *fakeNode) { f.dialErr = nil })
+		p.checkNodes()
+
+		assert.Equal(t, NodeStateInvalidChainID, p.NodeHealths()[1].State)
+		assert.Equal(t, a, p.selectNode())
+		assert.Equal(t, []Node{a}, p.usableNodes())
+	})
+}
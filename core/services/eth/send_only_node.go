@@ -46,10 +46,12 @@ func NewSendOnlyNode(lggr logger.Logger, httpuri url.URL, name string) SendOnlyN
 	return s
 }
 
+// Dial connects the HTTP client of the node. It is a no-op once the node is
+// dialed.
 func (s *sendOnlyNode) Dial(_ context.Context) error {
 	s.log.Debugw("eth.Client#Dial(...)")
 	if s.dialed {
-		panic("eth.Client.Dial(...) should only be called once during the node's lifetime.")
+		return nil
 	}
 
 	uri := s.uri.String()
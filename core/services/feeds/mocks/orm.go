@@ -16,13 +16,13 @@ type ORM struct {
 	mock.Mock
 }
 
-// ApproveJobProposal provides a mock function with given fields: ctx, id, externalJobID, status
-func (_m *ORM) ApproveJobProposal(ctx context.Context, id int64, externalJobID uuid.UUID, status feeds.JobProposalStatus) error {
-	ret := _m.Called(ctx, id, externalJobID, status)
+// ApproveSpec provides a mock function with given fields: ctx, id, externalJobID
+func (_m *ORM) ApproveSpec(ctx context.Context, id int64, externalJobID uuid.UUID) error {
+	ret := _m.Called(ctx, id, externalJobID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, uuid.UUID, feeds.JobProposalStatus) error); ok {
-		r0 = rf(ctx, id, externalJobID, status)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uuid.UUID) error); ok {
+		r0 = rf(ctx, id, externalJobID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -30,8 +30,8 @@ func (_m *ORM) ApproveJobProposal(ctx context.Context, id int64, externalJobID u
 	return r0
 }
 
-// CancelJobProposal provides a mock function with given fields: ctx, id
-func (_m *ORM) CancelJobProposal(ctx context.Context, id int64) error {
+// CancelSpec provides a mock function with given fields: ctx, id
+func (_m *ORM) CancelSpec(ctx context.Context, id int64) error {
 	ret := _m.Called(ctx, id)
 
 	var r0 error
@@ -44,6 +44,27 @@ func (_m *ORM) CancelJobProposal(ctx context.Context, id int64) error {
 	return r0
 }
 
+// CountApprovals provides a mock function with given fields: ctx, specID
+func (_m *ORM) CountApprovals(ctx context.Context, specID int64) (int64, error) {
+	ret := _m.Called(ctx, specID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, specID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, specID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CountJobProposals provides a mock function with given fields: ctx
 func (_m *ORM) CountJobProposals(ctx context.Context) (int64, error) {
 	ret := _m.Called(ctx)
@@ -86,6 +107,20 @@ func (_m *ORM) CountManagers(ctx context.Context) (int64, error) {
 	return r0, r1
 }
 
+// CreateApproval provides a mock function with given fields: ctx, specID, approver
+func (_m *ORM) CreateApproval(ctx context.Context, specID int64, approver string) error {
+	ret := _m.Called(ctx, specID, approver)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, specID, approver)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateJobProposal provides a mock function with given fields: ctx, jp
 func (_m *ORM) CreateJobProposal(ctx context.Context, jp *feeds.JobProposal) (int64, error) {
 	ret := _m.Called(ctx, jp)
@@ -128,6 +163,85 @@ func (_m *ORM) CreateManager(ctx context.Context, ms *feeds.FeedsManager) (int64
 	return r0, r1
 }
 
+// CreatePolicyDecision provides a mock function with given fields: ctx, d
+func (_m *ORM) CreatePolicyDecision(ctx context.Context, d feeds.ApprovalPolicyDecision) (int64, error) {
+	ret := _m.Called(ctx, d)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, feeds.ApprovalPolicyDecision) int64); ok {
+		r0 = rf(ctx, d)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, feeds.ApprovalPolicyDecision) error); ok {
+		r1 = rf(ctx, d)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSpec provides a mock function with given fields: ctx, spec
+func (_m *ORM) CreateSpec(ctx context.Context, spec feeds.JobProposalSpec) (int64, error) {
+	ret := _m.Called(ctx, spec)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, feeds.JobProposalSpec) int64); ok {
+		r0 = rf(ctx, spec)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, feeds.JobProposalSpec) error); ok {
+		r1 = rf(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteProposal provides a mock function with given fields: ctx, id
+func (_m *ORM) DeleteProposal(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetApprovalPolicy provides a mock function with given fields: ctx, feedsManagerID
+func (_m *ORM) GetApprovalPolicy(ctx context.Context, feedsManagerID int64) (*feeds.ApprovalPolicy, error) {
+	ret := _m.Called(ctx, feedsManagerID)
+
+	var r0 *feeds.ApprovalPolicy
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *feeds.ApprovalPolicy); ok {
+		r0 = rf(ctx, feedsManagerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.ApprovalPolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, feedsManagerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetJobProposal provides a mock function with given fields: ctx, id
 func (_m *ORM) GetJobProposal(ctx context.Context, id int64) (*feeds.JobProposal, error) {
 	ret := _m.Called(ctx, id)
@@ -174,6 +288,29 @@ func (_m *ORM) GetJobProposalByRemoteUUID(ctx context.Context, _a1 uuid.UUID) (*
 	return r0, r1
 }
 
+// GetLatestSpec provides a mock function with given fields: ctx, jpID
+func (_m *ORM) GetLatestSpec(ctx context.Context, jpID int64) (*feeds.JobProposalSpec, error) {
+	ret := _m.Called(ctx, jpID)
+
+	var r0 *feeds.JobProposalSpec
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *feeds.JobProposalSpec); ok {
+		r0 = rf(ctx, jpID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.JobProposalSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, jpID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetManager provides a mock function with given fields: ctx, id
 func (_m *ORM) GetManager(ctx context.Context, id int64) (*feeds.FeedsManager, error) {
 	ret := _m.Called(ctx, id)
@@ -197,6 +334,29 @@ func (_m *ORM) GetManager(ctx context.Context, id int64) (*feeds.FeedsManager, e
 	return r0, r1
 }
 
+// GetSpecByVersion provides a mock function with given fields: ctx, jpID, version
+func (_m *ORM) GetSpecByVersion(ctx context.Context, jpID int64, version int32) (*feeds.JobProposalSpec, error) {
+	ret := _m.Called(ctx, jpID, version)
+
+	var r0 *feeds.JobProposalSpec
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32) *feeds.JobProposalSpec); ok {
+		r0 = rf(ctx, jpID, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.JobProposalSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int32) error); ok {
+		r1 = rf(ctx, jpID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsJobManaged provides a mock function with given fields: ctx, jobID
 func (_m *ORM) IsJobManaged(ctx context.Context, jobID int64) (bool, error) {
 	ret := _m.Called(ctx, jobID)
@@ -264,27 +424,59 @@ func (_m *ORM) ListManagers(ctx context.Context) ([]feeds.FeedsManager, error) {
 	return r0, r1
 }
 
-// UpdateJobProposalSpec provides a mock function with given fields: ctx, id, spec
-func (_m *ORM) UpdateJobProposalSpec(ctx context.Context, id int64, spec string) error {
-	ret := _m.Called(ctx, id, spec)
+// ListPolicyDecisions provides a mock function with given fields: ctx, jpID
+func (_m *ORM) ListPolicyDecisions(ctx context.Context, jpID int64) ([]feeds.ApprovalPolicyDecision, error) {
+	ret := _m.Called(ctx, jpID)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
-		r0 = rf(ctx, id, spec)
+	var r0 []feeds.ApprovalPolicyDecision
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []feeds.ApprovalPolicyDecision); ok {
+		r0 = rf(ctx, jpID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.ApprovalPolicyDecision)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, jpID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// UpdateJobProposalStatus provides a mock function with given fields: ctx, id, status
-func (_m *ORM) UpdateJobProposalStatus(ctx context.Context, id int64, status feeds.JobProposalStatus) error {
-	ret := _m.Called(ctx, id, status)
+// ListSpecsByJobProposalID provides a mock function with given fields: ctx, jpID
+func (_m *ORM) ListSpecsByJobProposalID(ctx context.Context, jpID int64) ([]feeds.JobProposalSpec, error) {
+	ret := _m.Called(ctx, jpID)
+
+	var r0 []feeds.JobProposalSpec
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []feeds.JobProposalSpec); ok {
+		r0 = rf(ctx, jpID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.JobProposalSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, jpID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RejectSpec provides a mock function with given fields: ctx, id
+func (_m *ORM) RejectSpec(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, feeds.JobProposalStatus) error); ok {
-		r0 = rf(ctx, id, status)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -292,6 +484,27 @@ func (_m *ORM) UpdateJobProposalStatus(ctx context.Context, id int64, status fee
 	return r0
 }
 
+// RevokePendingSpecs provides a mock function with given fields: ctx, jpID
+func (_m *ORM) RevokePendingSpecs(ctx context.Context, jpID int64) (int64, error) {
+	ret := _m.Called(ctx, jpID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, jpID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, jpID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateManager provides a mock function with given fields: ctx, mgr
 func (_m *ORM) UpdateManager(ctx context.Context, mgr feeds.FeedsManager) error {
 	ret := _m.Called(ctx, mgr)
@@ -306,6 +519,41 @@ func (_m *ORM) UpdateManager(ctx context.Context, mgr feeds.FeedsManager) error
 	return r0
 }
 
+// UpdateSpecDefinition provides a mock function with given fields: ctx, id, definition
+func (_m *ORM) UpdateSpecDefinition(ctx context.Context, id int64, definition string) error {
+	ret := _m.Called(ctx, id, definition)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, id, definition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertApprovalPolicy provides a mock function with given fields: ctx, policy
+func (_m *ORM) UpsertApprovalPolicy(ctx context.Context, policy feeds.ApprovalPolicy) (int64, error) {
+	ret := _m.Called(ctx, policy)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, feeds.ApprovalPolicy) int64); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, feeds.ApprovalPolicy) error); ok {
+		r1 = rf(ctx, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpsertJobProposal provides a mock function with given fields: ctx, jp
 func (_m *ORM) UpsertJobProposal(ctx context.Context, jp *feeds.JobProposal) (int64, error) {
 	ret := _m.Called(ctx, jp)
@@ -7,6 +7,8 @@ import (
 
 	feeds "github.com/smartcontractkit/chainlink/core/services/feeds"
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/satori/go.uuid"
 )
 
 // Service is an autogenerated mock type for the Service type
@@ -14,13 +16,13 @@ type Service struct {
 	mock.Mock
 }
 
-// ApproveJobProposal provides a mock function with given fields: ctx, id
-func (_m *Service) ApproveJobProposal(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// ApproveJobProposal provides a mock function with given fields: ctx, id, version, approver
+func (_m *Service) ApproveJobProposal(ctx context.Context, id int64, version int32, approver string) error {
+	ret := _m.Called(ctx, id, version, approver)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, string) error); ok {
+		r0 = rf(ctx, id, version, approver)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -28,13 +30,13 @@ func (_m *Service) ApproveJobProposal(ctx context.Context, id int64) error {
 	return r0
 }
 
-// CancelJobProposal provides a mock function with given fields: ctx, id
-func (_m *Service) CancelJobProposal(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// CancelJobProposal provides a mock function with given fields: ctx, id, version
+func (_m *Service) CancelJobProposal(ctx context.Context, id int64, version int32) error {
+	ret := _m.Called(ctx, id, version)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -98,6 +100,50 @@ func (_m *Service) CreateJobProposal(jp *feeds.JobProposal) (int64, error) {
 	return r0, r1
 }
 
+// DeleteJob provides a mock function with given fields: ctx, feedsManagerID, remoteUUID
+func (_m *Service) DeleteJob(ctx context.Context, feedsManagerID int64, remoteUUID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, feedsManagerID, remoteUUID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uuid.UUID) int64); ok {
+		r0 = rf(ctx, feedsManagerID, remoteUUID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uuid.UUID) error); ok {
+		r1 = rf(ctx, feedsManagerID, remoteUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetApprovalPolicy provides a mock function with given fields: ctx, feedsManagerID
+func (_m *Service) GetApprovalPolicy(ctx context.Context, feedsManagerID int64) (*feeds.ApprovalPolicy, error) {
+	ret := _m.Called(ctx, feedsManagerID)
+
+	var r0 *feeds.ApprovalPolicy
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *feeds.ApprovalPolicy); ok {
+		r0 = rf(ctx, feedsManagerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feeds.ApprovalPolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, feedsManagerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetJobProposal provides a mock function with given fields: id
 func (_m *Service) GetJobProposal(id int64) (*feeds.JobProposal, error) {
 	ret := _m.Called(id)
@@ -165,6 +211,29 @@ func (_m *Service) IsJobManaged(ctx context.Context, jobID int64) (bool, error)
 	return r0, r1
 }
 
+// ListJobProposalSpecs provides a mock function with given fields: ctx, id
+func (_m *Service) ListJobProposalSpecs(ctx context.Context, id int64) ([]feeds.JobProposalSpec, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []feeds.JobProposalSpec
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []feeds.JobProposalSpec); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.JobProposalSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListJobProposals provides a mock function with given fields:
 func (_m *Service) ListJobProposals() ([]feeds.JobProposal, error) {
 	ret := _m.Called()
@@ -211,20 +280,43 @@ func (_m *Service) ListManagers() ([]feeds.FeedsManager, error) {
 	return r0, r1
 }
 
-// ProposeJob provides a mock function with given fields: jp
-func (_m *Service) ProposeJob(jp *feeds.JobProposal) (int64, error) {
-	ret := _m.Called(jp)
+// ListPolicyDecisions provides a mock function with given fields: ctx, id
+func (_m *Service) ListPolicyDecisions(ctx context.Context, id int64) ([]feeds.ApprovalPolicyDecision, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []feeds.ApprovalPolicyDecision
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []feeds.ApprovalPolicyDecision); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.ApprovalPolicyDecision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProposeJob provides a mock function with given fields: jp, version
+func (_m *Service) ProposeJob(jp *feeds.JobProposal, version int32) (int64, error) {
+	ret := _m.Called(jp, version)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func(*feeds.JobProposal) int64); ok {
-		r0 = rf(jp)
+	if rf, ok := ret.Get(0).(func(*feeds.JobProposal, int32) int64); ok {
+		r0 = rf(jp, version)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*feeds.JobProposal) error); ok {
-		r1 = rf(jp)
+	if rf, ok := ret.Get(1).(func(*feeds.JobProposal, int32) error); ok {
+		r1 = rf(jp, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -232,6 +324,20 @@ func (_m *Service) ProposeJob(jp *feeds.JobProposal) (int64, error) {
 	return r0, r1
 }
 
+// ReconnectManagers provides a mock function with given fields:
+func (_m *Service) ReconnectManagers() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RegisterManager provides a mock function with given fields: ms
 func (_m *Service) RegisterManager(ms *feeds.FeedsManager) (int64, error) {
 	ret := _m.Called(ms)
@@ -253,13 +359,13 @@ func (_m *Service) RegisterManager(ms *feeds.FeedsManager) (int64, error) {
 	return r0, r1
 }
 
-// RejectJobProposal provides a mock function with given fields: ctx, id
-func (_m *Service) RejectJobProposal(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// RejectJobProposal provides a mock function with given fields: ctx, id, version
+func (_m *Service) RejectJobProposal(ctx context.Context, id int64, version int32) error {
+	ret := _m.Called(ctx, id, version)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32) error); ok {
+		r0 = rf(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -267,6 +373,27 @@ func (_m *Service) RejectJobProposal(ctx context.Context, id int64) error {
 	return r0
 }
 
+// RevokeJob provides a mock function with given fields: ctx, feedsManagerID, remoteUUID
+func (_m *Service) RevokeJob(ctx context.Context, feedsManagerID int64, remoteUUID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, feedsManagerID, remoteUUID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uuid.UUID) int64); ok {
+		r0 = rf(ctx, feedsManagerID, remoteUUID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uuid.UUID) error); ok {
+		r1 = rf(ctx, feedsManagerID, remoteUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields:
 func (_m *Service) Start() error {
 	ret := _m.Called()
@@ -300,6 +427,20 @@ func (_m *Service) Unsafe_SetConnectionsManager(_a0 feeds.ConnectionsManager) {
 	_m.Called(_a0)
 }
 
+// UpdateApprovalPolicy provides a mock function with given fields: ctx, policy
+func (_m *Service) UpdateApprovalPolicy(ctx context.Context, policy feeds.ApprovalPolicy) error {
+	ret := _m.Called(ctx, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, feeds.ApprovalPolicy) error); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateFeedsManager provides a mock function with given fields: ctx, mgr
 func (_m *Service) UpdateFeedsManager(ctx context.Context, mgr feeds.FeedsManager) error {
 	ret := _m.Called(ctx, mgr)
@@ -314,13 +455,13 @@ func (_m *Service) UpdateFeedsManager(ctx context.Context, mgr feeds.FeedsManage
 	return r0
 }
 
-// UpdateJobProposalSpec provides a mock function with given fields: ctx, id, spec
-func (_m *Service) UpdateJobProposalSpec(ctx context.Context, id int64, spec string) error {
-	ret := _m.Called(ctx, id, spec)
+// UpdateJobProposalSpec provides a mock function with given fields: ctx, id, version, spec
+func (_m *Service) UpdateJobProposalSpec(ctx context.Context, id int64, version int32, spec string) error {
+	ret := _m.Called(ctx, id, version, spec)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
-		r0 = rf(ctx, id, spec)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, string) error); ok {
+		r0 = rf(ctx, id, version, spec)
 	} else {
 		r0 = ret.Error(0)
 	}
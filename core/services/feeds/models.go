@@ -51,6 +51,8 @@ const (
 	JobProposalStatusApproved  JobProposalStatus = "approved"
 	JobProposalStatusRejected  JobProposalStatus = "rejected"
 	JobProposalStatusCancelled JobProposalStatus = "cancelled"
+	JobProposalStatusRevoked   JobProposalStatus = "revoked"
+	JobProposalStatusDeleted   JobProposalStatus = "deleted"
 )
 
 type JobProposal struct {
@@ -63,12 +65,89 @@ type JobProposal struct {
 	ExternalJobID  uuid.NullUUID
 	FeedsManagerID int64
 	Multiaddrs     pq.StringArray `gorm:"type:text[]"`
-	ProposedAt     time.Time
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	// PendingUpdate is true when the feeds manager has proposed a new version
+	// of an approved job or requested its deletion, and the node operator has
+	// yet to act on it.
+	PendingUpdate bool
+	ProposedAt    time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
 
-func (jp *JobProposal) CanEditSpec() bool {
-	return jp.Status == JobProposalStatusPending ||
-		jp.Status == JobProposalStatusCancelled
+// SpecStatus is the status of a version of a job proposal
+type SpecStatus string
+
+const (
+	SpecStatusPending   SpecStatus = "pending"
+	SpecStatusApproved  SpecStatus = "approved"
+	SpecStatusRejected  SpecStatus = "rejected"
+	SpecStatusCancelled SpecStatus = "cancelled"
+	SpecStatusRevoked   SpecStatus = "revoked"
+)
+
+// JobProposalSpec is a version of the spec of a job proposal. Every spec
+// proposed by the feeds manager is kept, and the node operator approves,
+// rejects or cancels a specific version.
+type JobProposalSpec struct {
+	ID              int64
+	Definition      string
+	Version         int32
+	Status          SpecStatus
+	JobProposalID   int64
+	StatusUpdatedAt time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// CanEditDefinition returns true if the node operator may edit the spec
+// before approving it
+func (s *JobProposalSpec) CanEditDefinition() bool {
+	return s.Status == SpecStatusPending ||
+		s.Status == SpecStatusCancelled
+}
+
+// ApprovalPolicy decides which job proposals of a feeds manager are approved
+// without waiting for the node operator, and how many node operators must
+// approve the others.
+type ApprovalPolicy struct {
+	ID             int64
+	FeedsManagerID int64
+	// AutoApproveJobTypes are the job types whose proposals are approved as
+	// soon as they are received.
+	AutoApproveJobTypes pq.StringArray `gorm:"type:text[]"`
+	// AutoApproveUpdateFields are the top level spec fields which may change
+	// in a new version of an approved job for it to be approved as soon as it
+	// is received.
+	AutoApproveUpdateFields pq.StringArray `gorm:"type:text[]"`
+	// RequiredApprovals is the number of distinct users who must approve a
+	// version which is not auto-approved.
+	RequiredApprovals int32
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// ApprovalPolicyDecisionType is the outcome of applying an approval policy to a
+// version of a job proposal
+type ApprovalPolicyDecisionType string
+
+const (
+	ApprovalPolicyDecisionAutoApproved           ApprovalPolicyDecisionType = "auto_approved"
+	ApprovalPolicyDecisionAutoApprovalFailed     ApprovalPolicyDecisionType = "auto_approval_failed"
+	ApprovalPolicyDecisionManualApprovalRequired ApprovalPolicyDecisionType = "manual_approval_required"
+	ApprovalPolicyDecisionApprovalRecorded       ApprovalPolicyDecisionType = "approval_recorded"
+	ApprovalPolicyDecisionApproved               ApprovalPolicyDecisionType = "approved"
+)
+
+// ApprovalPolicyDecision is an entry of the audit trail of the approval
+// policy decisions made on a version of a job proposal.
+type ApprovalPolicyDecision struct {
+	ID                int64
+	JobProposalSpecID int64
+	// Version is the version of the job proposal spec, which is only loaded
+	// when listing decisions.
+	Version   int32
+	Decision  ApprovalPolicyDecisionType
+	Reason    string
+	Approver  null.String
+	CreatedAt time.Time
 }
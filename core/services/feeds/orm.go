@@ -14,21 +14,33 @@ import (
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
 
 type ORM interface {
-	ApproveJobProposal(ctx context.Context, id int64, externalJobID uuid.UUID, status JobProposalStatus) error
-	CancelJobProposal(ctx context.Context, id int64) error
+	ApproveSpec(ctx context.Context, id int64, externalJobID uuid.UUID) error
+	CancelSpec(ctx context.Context, id int64) error
+	CountApprovals(ctx context.Context, specID int64) (int64, error)
 	CountJobProposals(ctx context.Context) (int64, error)
 	CountManagers(ctx context.Context) (int64, error)
+	CreateApproval(ctx context.Context, specID int64, approver string) error
 	CreateJobProposal(ctx context.Context, jp *JobProposal) (int64, error)
 	CreateManager(ctx context.Context, ms *FeedsManager) (int64, error)
+	CreatePolicyDecision(ctx context.Context, d ApprovalPolicyDecision) (int64, error)
+	CreateSpec(ctx context.Context, spec JobProposalSpec) (int64, error)
+	DeleteProposal(ctx context.Context, id int64) error
+	GetApprovalPolicy(ctx context.Context, feedsManagerID int64) (*ApprovalPolicy, error)
 	GetJobProposal(ctx context.Context, id int64) (*JobProposal, error)
 	GetJobProposalByRemoteUUID(ctx context.Context, uuid uuid.UUID) (*JobProposal, error)
+	GetLatestSpec(ctx context.Context, jpID int64) (*JobProposalSpec, error)
 	GetManager(ctx context.Context, id int64) (*FeedsManager, error)
+	GetSpecByVersion(ctx context.Context, jpID int64, version int32) (*JobProposalSpec, error)
 	IsJobManaged(ctx context.Context, jobID int64) (bool, error)
 	ListJobProposals(ctx context.Context) ([]JobProposal, error)
 	ListManagers(ctx context.Context) ([]FeedsManager, error)
-	UpdateJobProposalSpec(ctx context.Context, id int64, spec string) error
-	UpdateJobProposalStatus(ctx context.Context, id int64, status JobProposalStatus) error
+	ListPolicyDecisions(ctx context.Context, jpID int64) ([]ApprovalPolicyDecision, error)
+	ListSpecsByJobProposalID(ctx context.Context, jpID int64) ([]JobProposalSpec, error)
+	RejectSpec(ctx context.Context, id int64) error
+	RevokePendingSpecs(ctx context.Context, jpID int64) (int64, error)
 	UpdateManager(ctx context.Context, mgr FeedsManager) error
+	UpdateSpecDefinition(ctx context.Context, id int64, definition string) error
+	UpsertApprovalPolicy(ctx context.Context, policy ApprovalPolicy) (int64, error)
 	UpsertJobProposal(ctx context.Context, jp *JobProposal) (int64, error)
 }
 
@@ -187,21 +199,23 @@ func (o *orm) UpsertJobProposal(ctx context.Context, jp *JobProposal) (int64, er
 	now := time.Now()
 
 	stmt := `
-INSERT INTO job_proposals (remote_uuid, spec, status, feeds_manager_id, multiaddrs, proposed_at, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO job_proposals (remote_uuid, spec, status, feeds_manager_id, multiaddrs, pending_update, proposed_at, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT (remote_uuid)
 DO
 	UPDATE SET
 		spec = excluded.spec,
 		status = excluded.status,
 		multiaddrs = excluded.multiaddrs,
+		pending_update = excluded.pending_update,
 		proposed_at = excluded.proposed_at,
 		updated_at = excluded.updated_at
 RETURNING id;
 `
 
-	row := o.db.WithContext(ctx).Raw(stmt,
-		jp.RemoteUUID, jp.Spec, jp.Status, jp.FeedsManagerID, jp.Multiaddrs, now, now, now,
+	tx := postgres.TxFromContext(ctx, o.db.WithContext(ctx))
+	row := tx.Raw(stmt,
+		jp.RemoteUUID, jp.Spec, jp.Status, jp.FeedsManagerID, jp.Multiaddrs, jp.PendingUpdate, now, now, now,
 	).Row()
 	if row.Err() != nil {
 		return id, row.Err()
@@ -215,7 +229,7 @@ RETURNING id;
 func (o *orm) ListJobProposals(ctx context.Context) ([]JobProposal, error) {
 	jps := []JobProposal{}
 	stmt := `
-SELECT remote_uuid, id, spec, status, external_job_id, feeds_manager_id, multiaddrs, proposed_at, created_at, updated_at
+SELECT remote_uuid, id, spec, status, external_job_id, feeds_manager_id, multiaddrs, pending_update, proposed_at, created_at, updated_at
 FROM job_proposals;
 `
 
@@ -230,7 +244,7 @@ FROM job_proposals;
 // GetJobProposal gets a job proposal by id
 func (o *orm) GetJobProposal(ctx context.Context, id int64) (*JobProposal, error) {
 	stmt := `
-SELECT id, remote_uuid, spec, status, external_job_id, feeds_manager_id, multiaddrs, proposed_at, created_at, updated_at
+SELECT id, remote_uuid, spec, status, external_job_id, feeds_manager_id, multiaddrs, pending_update, proposed_at, created_at, updated_at
 FROM job_proposals
 WHERE id = ?;
 `
@@ -241,7 +255,7 @@ WHERE id = ?;
 // GetJobProposalByRemoteUUID gets a job proposal by the remote FMS uuid
 func (o *orm) GetJobProposalByRemoteUUID(ctx context.Context, id uuid.UUID) (*JobProposal, error) {
 	stmt := `
-SELECT id, remote_uuid, spec, status, external_job_id, feeds_manager_id, multiaddrs, proposed_at, created_at, updated_at
+SELECT id, remote_uuid, spec, status, external_job_id, feeds_manager_id, multiaddrs, pending_update, proposed_at, created_at, updated_at
 FROM job_proposals
 WHERE remote_uuid = ?;
 `
@@ -263,100 +277,496 @@ func (o *orm) getJobProposal(ctx context.Context, stmt string, values ...interfa
 	return &jp, nil
 }
 
-// UpdateJobProposalStatus updates the status of a job proposal by id.
-func (o *orm) UpdateJobProposalStatus(ctx context.Context, id int64, status JobProposalStatus) error {
-	tx := postgres.TxFromContext(ctx, o.db)
+// pendingUpdateStmt recomputes whether the node operator has yet to act on a
+// change requested by the feeds manager for a job that has been created.
+const pendingUpdateStmt = `
+UPDATE job_proposals
+SET pending_update = (
+	external_job_id IS NOT NULL AND (
+		status = 'deleted' OR
+		EXISTS (
+			SELECT 1
+			FROM job_proposal_specs
+			WHERE job_proposal_id = job_proposals.id AND status = 'pending'
+		)
+	)
+)
+WHERE id = ?;
+`
 
+// CreateSpec creates a new version of the spec of a job proposal.
+func (o *orm) CreateSpec(ctx context.Context, spec JobProposalSpec) (int64, error) {
+	tx := postgres.TxFromContext(ctx, o.db.WithContext(ctx))
+	var id int64
 	now := time.Now()
 
 	stmt := `
-UPDATE job_proposals
-SET status = ?,
-	updated_at = ?
+INSERT INTO job_proposal_specs (definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING id;
+`
+
+	row := tx.Raw(stmt, spec.Definition, spec.Version, spec.Status, spec.JobProposalID, now, now, now).Row()
+	if row.Err() != nil {
+		return id, row.Err()
+	}
+
+	err := row.Scan(&id)
+	return id, err
+}
+
+// GetSpecByVersion gets a version of the spec of a job proposal
+func (o *orm) GetSpecByVersion(ctx context.Context, jpID int64, version int32) (*JobProposalSpec, error) {
+	stmt := `
+SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+FROM job_proposal_specs
+WHERE job_proposal_id = ? AND version = ?;
+`
+
+	return o.getSpec(ctx, stmt, jpID, version)
+}
+
+// GetLatestSpec gets the latest version of the spec of a job proposal
+func (o *orm) GetLatestSpec(ctx context.Context, jpID int64) (*JobProposalSpec, error) {
+	stmt := `
+SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+FROM job_proposal_specs
+WHERE job_proposal_id = ?
+ORDER BY version DESC
+LIMIT 1;
+`
+
+	return o.getSpec(ctx, stmt, jpID)
+}
+
+// getSpecByID gets a job proposal spec by id
+func (o *orm) getSpecByID(ctx context.Context, id int64) (*JobProposalSpec, error) {
+	stmt := `
+SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+FROM job_proposal_specs
 WHERE id = ?;
 `
 
-	result := tx.Exec(stmt, status, now, id)
+	return o.getSpec(ctx, stmt, id)
+}
+
+// getSpec performs the db call to fetch a single job proposal spec record
+func (o *orm) getSpec(ctx context.Context, stmt string, values ...interface{}) (*JobProposalSpec, error) {
+	spec := JobProposalSpec{}
+	result := postgres.TxFromContext(ctx, o.db.WithContext(ctx)).Raw(stmt, values...).Scan(&spec)
 	if result.RowsAffected == 0 {
-		return sql.ErrNoRows
+		return nil, sql.ErrNoRows
 	}
 	if result.Error != nil {
-		return result.Error
+		return nil, result.Error
 	}
 
-	return nil
+	return &spec, nil
 }
 
-// UpdateJobProposalSpec updates the spec of a job proposal by id.
-func (o *orm) UpdateJobProposalSpec(ctx context.Context, id int64, spec string) error {
-	tx := postgres.TxFromContext(ctx, o.db)
+// ListSpecsByJobProposalID lists the versions of the spec of a job proposal,
+// latest first
+func (o *orm) ListSpecsByJobProposalID(ctx context.Context, jpID int64) ([]JobProposalSpec, error) {
+	specs := []JobProposalSpec{}
+	stmt := `
+SELECT id, definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at
+FROM job_proposal_specs
+WHERE job_proposal_id = ?
+ORDER BY version DESC;
+`
+
+	err := o.db.WithContext(ctx).Raw(stmt, jpID).Scan(&specs).Error
+	if err != nil {
+		return specs, err
+	}
+
+	return specs, nil
+}
+
+// UpdateSpecDefinition updates the definition of a job proposal spec by id and
+// clears the approvals given to its previous definition. Until the job proposal
+// has a job, its spec follows the definition of its latest version.
+func (o *orm) UpdateSpecDefinition(ctx context.Context, id int64, definition string) error {
+	tx := postgres.TxFromContext(ctx, o.db.WithContext(ctx))
 	now := time.Now()
 
 	stmt := `
-UPDATE job_proposals
-SET spec = ?,
+UPDATE job_proposal_specs
+SET definition = ?,
 	updated_at = ?
 WHERE id = ?;
 `
 
-	result := tx.Exec(stmt, spec, now, id)
+	result := tx.Exec(stmt, definition, now, id)
+	if result.Error != nil {
+		return result.Error
+	}
 	if result.RowsAffected == 0 {
 		return sql.ErrNoRows
 	}
-	if result.Error != nil {
-		return result.Error
+
+	stmt = `
+DELETE FROM job_proposal_spec_approvals
+WHERE job_proposal_spec_id = ?;
+`
+
+	if err := tx.Exec(stmt, id).Error; err != nil {
+		return err
 	}
 
-	return nil
+	stmt = `
+UPDATE job_proposals
+SET spec = ?,
+	updated_at = ?
+FROM job_proposal_specs s
+WHERE s.id = ? AND job_proposals.id = s.job_proposal_id AND job_proposals.external_job_id IS NULL AND NOT EXISTS (
+	SELECT 1
+	FROM job_proposal_specs
+	WHERE job_proposal_id = s.job_proposal_id AND version > s.version
+);
+`
+
+	return tx.Exec(stmt, definition, now, id).Error
 }
 
-// ApproveJobProposal updates the job proposal as approved.
-func (o *orm) ApproveJobProposal(ctx context.Context, id int64, externalJobID uuid.UUID, status JobProposalStatus) error {
-	tx := postgres.TxFromContext(ctx, o.db)
+// ApproveSpec marks a job proposal spec as approved, along with its job
+// proposal, whose spec becomes the approved definition. The previously
+// approved version, whose job has been replaced, is marked as cancelled.
+func (o *orm) ApproveSpec(ctx context.Context, id int64, externalJobID uuid.UUID) error {
+	tx := postgres.TxFromContext(ctx, o.db.WithContext(ctx))
 	now := time.Now()
 
+	spec, err := o.getSpecByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	stmt := `
+UPDATE job_proposal_specs
+SET status = ?,
+	status_updated_at = ?,
+	updated_at = ?
+WHERE job_proposal_id = ? AND status = ?;
+`
+
+	if err = tx.Exec(stmt, SpecStatusCancelled, now, now, spec.JobProposalID, SpecStatusApproved).Error; err != nil {
+		return err
+	}
+	if err = o.updateSpecStatus(tx, id, SpecStatusApproved, now); err != nil {
+		return err
+	}
+
+	stmt = `
 UPDATE job_proposals
 SET status = ?,
+	spec = ?,
 	external_job_id = ?,
 	updated_at = ?
 WHERE id = ?;
 `
 
-	result := tx.Exec(stmt, status, externalJobID, now, id)
-	if result.RowsAffected == 0 {
-		return sql.ErrNoRows
+	if err = tx.Exec(stmt, JobProposalStatusApproved, spec.Definition, externalJobID, now, spec.JobProposalID).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(pendingUpdateStmt, spec.JobProposalID).Error
+}
+
+// RejectSpec marks a job proposal spec as rejected. A job proposal with no job
+// is rejected along with its last pending version.
+func (o *orm) RejectSpec(ctx context.Context, id int64) error {
+	tx := postgres.TxFromContext(ctx, o.db.WithContext(ctx))
+	now := time.Now()
+
+	spec, err := o.getSpecByID(ctx, id)
+	if err != nil {
+		return err
 	}
+	if err = o.updateSpecStatus(tx, id, SpecStatusRejected, now); err != nil {
+		return err
+	}
+
+	stmt := `
+UPDATE job_proposals
+SET status = ?,
+	updated_at = ?
+WHERE id = ? AND status = ? AND NOT EXISTS (
+	SELECT 1
+	FROM job_proposal_specs
+	WHERE job_proposal_id = job_proposals.id AND status = ?
+);
+`
+
+	if err = tx.Exec(stmt, JobProposalStatusRejected, now, spec.JobProposalID, JobProposalStatusPending, SpecStatusPending).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(pendingUpdateStmt, spec.JobProposalID).Error
+}
+
+// CancelSpec marks an approved job proposal spec as cancelled and unlinks the
+// job from its job proposal. The job proposal goes back to pending if another
+// version awaits approval, and a deleted job proposal stays deleted.
+func (o *orm) CancelSpec(ctx context.Context, id int64) error {
+	tx := postgres.TxFromContext(ctx, o.db.WithContext(ctx))
+	now := time.Now()
+
+	spec, err := o.getSpecByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = o.updateSpecStatus(tx, id, SpecStatusCancelled, now); err != nil {
+		return err
+	}
+
+	stmt := `
+UPDATE job_proposals
+SET status = (
+		CASE
+			WHEN status = 'deleted' THEN 'deleted'::job_proposal_status
+			WHEN EXISTS (
+				SELECT 1
+				FROM job_proposal_specs
+				WHERE job_proposal_id = job_proposals.id AND status = 'pending'
+			) THEN 'pending'::job_proposal_status
+			ELSE 'cancelled'::job_proposal_status
+		END
+	),
+	external_job_id = NULL,
+	pending_update = FALSE,
+	updated_at = ?
+WHERE id = ?;
+`
+
+	result := tx.Exec(stmt, now, spec.JobProposalID)
 	if result.Error != nil {
 		return result.Error
 	}
+	if result.RowsAffected == 0 {
+		return sql.ErrNoRows
+	}
 
 	return nil
 }
 
-// CancelJobProposal cancels a job proposal.
-func (o *orm) CancelJobProposal(ctx context.Context, id int64) error {
-	tx := postgres.TxFromContext(ctx, o.db)
+// RevokePendingSpecs marks the pending specs of a job proposal as revoked,
+// returning the number of specs revoked. A job proposal with no job is revoked
+// along with them.
+func (o *orm) RevokePendingSpecs(ctx context.Context, jpID int64) (int64, error) {
+	tx := postgres.TxFromContext(ctx, o.db.WithContext(ctx))
 	now := time.Now()
+
 	stmt := `
+UPDATE job_proposal_specs
+SET status = ?,
+	status_updated_at = ?,
+	updated_at = ?
+WHERE job_proposal_id = ? AND status = ?;
+`
+
+	result := tx.Exec(stmt, SpecStatusRevoked, now, now, jpID, SpecStatusPending)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return 0, nil
+	}
+
+	stmt = `
+UPDATE job_proposals
+SET status = ?,
+	updated_at = ?
+WHERE id = ? AND status = ?;
+`
+
+	if err := tx.Exec(stmt, JobProposalStatusRevoked, now, jpID, JobProposalStatusPending).Error; err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected, tx.Exec(pendingUpdateStmt, jpID).Error
+}
+
+// DeleteProposal marks a job proposal as deleted, revoking its pending specs.
+// Its job, if any, is kept until the node operator cancels it.
+func (o *orm) DeleteProposal(ctx context.Context, id int64) error {
+	tx := postgres.TxFromContext(ctx, o.db.WithContext(ctx))
+	now := time.Now()
+
+	stmt := `
+UPDATE job_proposal_specs
+SET status = ?,
+	status_updated_at = ?,
+	updated_at = ?
+WHERE job_proposal_id = ? AND status = ?;
+`
+
+	if err := tx.Exec(stmt, SpecStatusRevoked, now, now, id, SpecStatusPending).Error; err != nil {
+		return err
+	}
+
+	stmt = `
 UPDATE job_proposals
 SET status = ?,
-	external_job_id = ?,
 	updated_at = ?
 WHERE id = ?;
 `
 
-	result := tx.Exec(stmt, JobProposalStatusCancelled, nil, now, id)
+	result := tx.Exec(stmt, JobProposalStatusDeleted, now, id)
+	if result.Error != nil {
+		return result.Error
+	}
 	if result.RowsAffected == 0 {
 		return sql.ErrNoRows
 	}
+
+	return tx.Exec(pendingUpdateStmt, id).Error
+}
+
+// updateSpecStatus updates the status of a job proposal spec by id.
+func (o *orm) updateSpecStatus(tx *gorm.DB, id int64, status SpecStatus, now time.Time) error {
+	stmt := `
+UPDATE job_proposal_specs
+SET status = ?,
+	status_updated_at = ?,
+	updated_at = ?
+WHERE id = ?;
+`
+
+	result := tx.Exec(stmt, status, now, now, id)
 	if result.Error != nil {
 		return result.Error
 	}
+	if result.RowsAffected == 0 {
+		return sql.ErrNoRows
+	}
 
 	return nil
 }
 
+// GetApprovalPolicy gets the approval policy of a feeds manager
+func (o *orm) GetApprovalPolicy(ctx context.Context, feedsManagerID int64) (*ApprovalPolicy, error) {
+	stmt := `
+SELECT id, feeds_manager_id, auto_approve_job_types, auto_approve_update_fields, required_approvals, created_at, updated_at
+FROM feeds_manager_approval_policies
+WHERE feeds_manager_id = ?;
+`
+
+	policy := ApprovalPolicy{}
+	result := postgres.TxFromContext(ctx, o.db.WithContext(ctx)).Raw(stmt, feedsManagerID).Scan(&policy)
+	if result.RowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &policy, nil
+}
+
+// UpsertApprovalPolicy creates or replaces the approval policy of a feeds
+// manager.
+func (o *orm) UpsertApprovalPolicy(ctx context.Context, policy ApprovalPolicy) (int64, error) {
+	var id int64
+	now := time.Now()
+
+	stmt := `
+INSERT INTO feeds_manager_approval_policies (feeds_manager_id, auto_approve_job_types, auto_approve_update_fields, required_approvals, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (feeds_manager_id)
+DO
+	UPDATE SET
+		auto_approve_job_types = excluded.auto_approve_job_types,
+		auto_approve_update_fields = excluded.auto_approve_update_fields,
+		required_approvals = excluded.required_approvals,
+		updated_at = excluded.updated_at
+RETURNING id;
+`
+
+	row := postgres.TxFromContext(ctx, o.db.WithContext(ctx)).Raw(stmt,
+		policy.FeedsManagerID, policy.AutoApproveJobTypes, policy.AutoApproveUpdateFields, policy.RequiredApprovals, now, now,
+	).Row()
+	if row.Err() != nil {
+		return id, row.Err()
+	}
+
+	err := row.Scan(&id)
+	return id, err
+}
+
+// CreateApproval records the approval of a job proposal spec by a user. A user
+// approving the same spec twice is only recorded once.
+func (o *orm) CreateApproval(ctx context.Context, specID int64, approver string) error {
+	tx := postgres.TxFromContext(ctx, o.db.WithContext(ctx))
+
+	stmt := `
+INSERT INTO job_proposal_spec_approvals (job_proposal_spec_id, approver, created_at)
+VALUES (?, ?, ?)
+ON CONFLICT (job_proposal_spec_id, approver) DO NOTHING;
+`
+
+	return tx.Exec(stmt, specID, approver, time.Now()).Error
+}
+
+// CountApprovals counts the distinct users who have approved a job proposal
+// spec.
+func (o *orm) CountApprovals(ctx context.Context, specID int64) (int64, error) {
+	var count int64
+	stmt := `
+SELECT COUNT(*)
+FROM job_proposal_spec_approvals
+WHERE job_proposal_spec_id = ?;
+`
+
+	err := postgres.TxFromContext(ctx, o.db.WithContext(ctx)).Raw(stmt, specID).Scan(&count).Error
+	if err != nil {
+		return count, err
+	}
+
+	return count, nil
+}
+
+// CreatePolicyDecision adds a decision to the audit trail of a job proposal
+// spec.
+func (o *orm) CreatePolicyDecision(ctx context.Context, d ApprovalPolicyDecision) (int64, error) {
+	var id int64
+
+	stmt := `
+INSERT INTO approval_policy_decisions (job_proposal_spec_id, decision, reason, approver, created_at)
+VALUES (?, ?, ?, ?, ?)
+RETURNING id;
+`
+
+	row := postgres.TxFromContext(ctx, o.db.WithContext(ctx)).Raw(stmt,
+		d.JobProposalSpecID, d.Decision, d.Reason, d.Approver, time.Now(),
+	).Row()
+	if row.Err() != nil {
+		return id, row.Err()
+	}
+
+	err := row.Scan(&id)
+	return id, err
+}
+
+// ListPolicyDecisions lists the audit trail of the approval policy decisions
+// made on the versions of a job proposal, latest first.
+func (o *orm) ListPolicyDecisions(ctx context.Context, jpID int64) ([]ApprovalPolicyDecision, error) {
+	decisions := []ApprovalPolicyDecision{}
+	stmt := `
+SELECT d.id, d.job_proposal_spec_id, s.version, d.decision, d.reason, d.approver, d.created_at
+FROM approval_policy_decisions d
+INNER JOIN job_proposal_specs s ON s.id = d.job_proposal_spec_id
+WHERE s.job_proposal_id = ?
+ORDER BY d.created_at DESC, d.id DESC;
+`
+
+	err := o.db.WithContext(ctx).Raw(stmt, jpID).Scan(&decisions).Error
+	if err != nil {
+		return decisions, err
+	}
+
+	return decisions, nil
+}
+
 // CountJobProposals counts the number of job proposal records.
 func (o *orm) CountJobProposals(ctx context.Context) (int64, error) {
 	var count int64
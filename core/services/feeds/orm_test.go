@@ -2,6 +2,8 @@ package feeds_test
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"testing"
 
 	"github.com/lib/pq"
@@ -290,42 +292,6 @@ func Test_ORM_ListJobProposals(t *testing.T) {
 	assert.Equal(t, jp.FeedsManagerID, actual.FeedsManagerID)
 }
 
-func Test_ORM_UpdateJobProposalSpec(t *testing.T) {
-	t.Parallel()
-
-	ctx := context.Background()
-	orm := setupORM(t)
-	fmID := createFeedsManager(t, orm)
-
-	jp := &feeds.JobProposal{
-		RemoteUUID:     uuid.NewV4(),
-		Spec:           "",
-		Status:         feeds.JobProposalStatusPending,
-		FeedsManagerID: fmID,
-	}
-
-	id, err := orm.CreateJobProposal(ctx, jp)
-	require.NoError(t, err)
-
-	actualCreated, err := orm.GetJobProposal(context.Background(), id)
-	require.NoError(t, err)
-
-	err = orm.UpdateJobProposalSpec(ctx, id, "updated spec")
-	require.NoError(t, err)
-
-	actual, err := orm.GetJobProposal(context.Background(), id)
-	require.NoError(t, err)
-
-	assert.Equal(t, id, actual.ID)
-	assert.Equal(t, "updated spec", actual.Spec)
-	assert.Equal(t, jp.RemoteUUID, actual.RemoteUUID)
-	assert.Equal(t, jp.Status, actual.Status)
-	assert.Equal(t, jp.FeedsManagerID, actual.FeedsManagerID)
-	require.Equal(t, actualCreated.ProposedAt, actual.ProposedAt)
-	require.Equal(t, actualCreated.CreatedAt, actual.CreatedAt)
-	require.NotEqual(t, actualCreated.UpdatedAt, actual.UpdatedAt)
-}
-
 func Test_ORM_GetJobProposal(t *testing.T) {
 	t.Parallel()
 
@@ -375,40 +341,84 @@ func Test_ORM_GetJobProposal(t *testing.T) {
 	})
 }
 
-func Test_ORM_UpdateJobProposalStatus(t *testing.T) {
+func Test_ORM_CreateSpec(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 	orm := setupORM(t)
 	fmID := createFeedsManager(t, orm)
+	jpID := createJobProposal(t, orm, fmID)
 
-	jp := &feeds.JobProposal{
-		RemoteUUID:     uuid.NewV4(),
-		Spec:           "",
-		Status:         feeds.JobProposalStatusPending,
-		FeedsManagerID: fmID,
-	}
+	v1ID := createSpec(t, orm, jpID, 1)
+	v2ID := createSpec(t, orm, jpID, 2)
 
-	id, err := orm.CreateJobProposal(ctx, jp)
+	actual, err := orm.GetSpecByVersion(ctx, jpID, 1)
 	require.NoError(t, err)
+	assert.Equal(t, v1ID, actual.ID)
+	assert.Equal(t, "spec v1", actual.Definition)
+	assert.Equal(t, int32(1), actual.Version)
+	assert.Equal(t, feeds.SpecStatusPending, actual.Status)
+	assert.Equal(t, jpID, actual.JobProposalID)
 
-	actualCreated, err := orm.GetJobProposal(context.Background(), id)
+	actual, err = orm.GetLatestSpec(ctx, jpID)
 	require.NoError(t, err)
+	assert.Equal(t, v2ID, actual.ID)
+	assert.Equal(t, int32(2), actual.Version)
 
-	err = orm.UpdateJobProposalStatus(ctx, id, feeds.JobProposalStatusRejected)
+	specs, err := orm.ListSpecsByJobProposalID(ctx, jpID)
 	require.NoError(t, err)
+	require.Len(t, specs, 2)
+	assert.Equal(t, v2ID, specs[0].ID)
+	assert.Equal(t, v1ID, specs[1].ID)
 
-	actual, err := orm.GetJobProposal(context.Background(), id)
+	_, err = orm.GetSpecByVersion(ctx, jpID, 3)
+	require.Error(t, err)
+
+	// Versions are unique to a job proposal
+	_, err = orm.CreateSpec(ctx, feeds.JobProposalSpec{
+		Definition:    "duplicate",
+		Version:       2,
+		Status:        feeds.SpecStatusPending,
+		JobProposalID: jpID,
+	})
+	require.Error(t, err)
+}
+
+func Test_ORM_UpdateSpecDefinition(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orm := setupORM(t)
+	fmID := createFeedsManager(t, orm)
+	jpID := createJobProposal(t, orm, fmID)
+	v1ID := createSpec(t, orm, jpID, 1)
+	v2ID := createSpec(t, orm, jpID, 2)
+
+	// Updating an older version leaves the spec of the job proposal alone
+	err := orm.UpdateSpecDefinition(ctx, v1ID, "updated v1")
 	require.NoError(t, err)
 
-	assert.Equal(t, id, actual.ID)
-	assert.Equal(t, feeds.JobProposalStatusRejected, actual.Status)
-	assert.NotEqual(t, actualCreated.UpdatedAt, actual.UpdatedAt)
-	assert.Equal(t, actualCreated.CreatedAt, actual.CreatedAt)
-	assert.Equal(t, actualCreated.ProposedAt, actual.ProposedAt)
+	actual, err := orm.GetSpecByVersion(ctx, jpID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "updated v1", actual.Definition)
+
+	jp, err := orm.GetJobProposal(ctx, jpID)
+	require.NoError(t, err)
+	assert.Equal(t, "", jp.Spec)
+
+	// Updating the latest version updates the spec of the job proposal
+	err = orm.UpdateSpecDefinition(ctx, v2ID, "updated v2")
+	require.NoError(t, err)
+
+	jp, err = orm.GetJobProposal(ctx, jpID)
+	require.NoError(t, err)
+	assert.Equal(t, "updated v2", jp.Spec)
+
+	err = orm.UpdateSpecDefinition(ctx, int64(0), "updated")
+	require.Error(t, err)
 }
 
-func Test_ORM_ApproveJobProposal(t *testing.T) {
+func Test_ORM_ApproveSpec(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
@@ -416,52 +426,88 @@ func Test_ORM_ApproveJobProposal(t *testing.T) {
 	fmID := createFeedsManager(t, orm)
 	externalJobID := uuid.NullUUID{UUID: uuid.NewV4(), Valid: true}
 
-	jp := &feeds.JobProposal{
-		RemoteUUID:     uuid.NewV4(),
-		Spec:           "",
-		Status:         feeds.JobProposalStatusPending,
-		FeedsManagerID: fmID,
-	}
-
 	// Defer the FK requirement of a job proposal.
 	require.NoError(t, orm.db.Exec(
 		`SET CONSTRAINTS job_proposals_job_id_fkey DEFERRED`,
 	).Error)
 
-	id, err := orm.CreateJobProposal(ctx, jp)
-	require.NoError(t, err)
+	jpID := createJobProposal(t, orm, fmID)
+	v1ID := createSpec(t, orm, jpID, 1)
+	v2ID := createSpec(t, orm, jpID, 2)
 
-	actualCreated, err := orm.GetJobProposal(context.Background(), id)
+	actualCreated, err := orm.GetJobProposal(ctx, jpID)
 	require.NoError(t, err)
 
-	err = orm.ApproveJobProposal(ctx, id, externalJobID.UUID, feeds.JobProposalStatusApproved)
+	err = orm.ApproveSpec(ctx, v1ID, externalJobID.UUID)
 	require.NoError(t, err)
 
-	actual, err := orm.GetJobProposal(context.Background(), id)
+	actual, err := orm.GetJobProposal(ctx, jpID)
 	require.NoError(t, err)
 
-	assert.Equal(t, id, actual.ID)
 	assert.Equal(t, externalJobID, actual.ExternalJobID)
 	assert.Equal(t, feeds.JobProposalStatusApproved, actual.Status)
+	assert.True(t, actual.PendingUpdate) // v2 is still pending
+	assert.Equal(t, "spec v1", actual.Spec)
 	assert.NotEqual(t, actualCreated.UpdatedAt, actual.UpdatedAt)
 	assert.Equal(t, actualCreated.CreatedAt, actual.CreatedAt)
 	assert.Equal(t, actualCreated.ProposedAt, actual.ProposedAt)
+
+	// Approving the next version cancels the previous one
+	err = orm.ApproveSpec(ctx, v2ID, externalJobID.UUID)
+	require.NoError(t, err)
+
+	actual, err = orm.GetJobProposal(ctx, jpID)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.JobProposalStatusApproved, actual.Status)
+	assert.False(t, actual.PendingUpdate)
+	assert.Equal(t, "spec v2", actual.Spec)
+
+	v1, err := orm.GetSpecByVersion(ctx, jpID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.SpecStatusCancelled, v1.Status)
+
+	v2, err := orm.GetSpecByVersion(ctx, jpID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.SpecStatusApproved, v2.Status)
 }
 
-func Test_ORM_CancelJobProposal(t *testing.T) {
+func Test_ORM_RejectSpec(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 	orm := setupORM(t)
 	fmID := createFeedsManager(t, orm)
-	externalJobID := uuid.NullUUID{UUID: uuid.NewV4(), Valid: true}
+	jpID := createJobProposal(t, orm, fmID)
+	v1ID := createSpec(t, orm, jpID, 1)
+	v2ID := createSpec(t, orm, jpID, 2)
 
-	jp := &feeds.JobProposal{
-		RemoteUUID:     uuid.NewV4(),
-		Spec:           "",
-		Status:         feeds.JobProposalStatusPending,
-		FeedsManagerID: fmID,
-	}
+	// The job proposal stays pending while another version is pending
+	err := orm.RejectSpec(ctx, v1ID)
+	require.NoError(t, err)
+
+	actual, err := orm.GetJobProposal(ctx, jpID)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.JobProposalStatusPending, actual.Status)
+
+	v1, err := orm.GetSpecByVersion(ctx, jpID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.SpecStatusRejected, v1.Status)
+
+	err = orm.RejectSpec(ctx, v2ID)
+	require.NoError(t, err)
+
+	actual, err = orm.GetJobProposal(ctx, jpID)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.JobProposalStatusRejected, actual.Status)
+}
+
+func Test_ORM_CancelSpec(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orm := setupORM(t)
+	fmID := createFeedsManager(t, orm)
+	externalJobID := uuid.NullUUID{UUID: uuid.NewV4(), Valid: true}
 
 	// Defer the FK requirement of a job proposal so we don't have to setup a
 	// real job.
@@ -469,22 +515,100 @@ func Test_ORM_CancelJobProposal(t *testing.T) {
 		`SET CONSTRAINTS job_proposals_job_id_fkey DEFERRED`,
 	).Error)
 
-	id, err := orm.CreateJobProposal(ctx, jp)
-	require.NoError(t, err)
+	jpID := createJobProposal(t, orm, fmID)
+	specID := createSpec(t, orm, jpID, 1)
 
 	// Approve the job proposal
-	err = orm.ApproveJobProposal(ctx, id, externalJobID.UUID, feeds.JobProposalStatusApproved)
+	err := orm.ApproveSpec(ctx, specID, externalJobID.UUID)
 	require.NoError(t, err)
 
-	err = orm.CancelJobProposal(ctx, id)
+	err = orm.CancelSpec(ctx, specID)
 	require.NoError(t, err)
 
-	actual, err := orm.GetJobProposal(context.Background(), id)
+	actual, err := orm.GetJobProposal(ctx, jpID)
 	require.NoError(t, err)
 
-	assert.Equal(t, id, actual.ID)
+	assert.Equal(t, jpID, actual.ID)
 	assert.Equal(t, uuid.NullUUID{Valid: false}, actual.ExternalJobID)
 	assert.Equal(t, feeds.JobProposalStatusCancelled, actual.Status)
+
+	spec, err := orm.GetSpecByVersion(ctx, jpID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.SpecStatusCancelled, spec.Status)
+}
+
+func Test_ORM_RevokePendingSpecs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orm := setupORM(t)
+	fmID := createFeedsManager(t, orm)
+	jpID := createJobProposal(t, orm, fmID)
+	createSpec(t, orm, jpID, 1)
+	createSpec(t, orm, jpID, 2)
+
+	count, err := orm.RevokePendingSpecs(ctx, jpID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	actual, err := orm.GetJobProposal(ctx, jpID)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.JobProposalStatusRevoked, actual.Status)
+
+	specs, err := orm.ListSpecsByJobProposalID(ctx, jpID)
+	require.NoError(t, err)
+	for _, spec := range specs {
+		assert.Equal(t, feeds.SpecStatusRevoked, spec.Status)
+	}
+
+	count, err = orm.RevokePendingSpecs(ctx, jpID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func Test_ORM_DeleteProposal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orm := setupORM(t)
+	fmID := createFeedsManager(t, orm)
+	externalJobID := uuid.NullUUID{UUID: uuid.NewV4(), Valid: true}
+
+	// Defer the FK requirement of a job proposal so we don't have to setup a
+	// real job.
+	require.NoError(t, orm.db.Exec(
+		`SET CONSTRAINTS job_proposals_job_id_fkey DEFERRED`,
+	).Error)
+
+	jpID := createJobProposal(t, orm, fmID)
+	v1ID := createSpec(t, orm, jpID, 1)
+	createSpec(t, orm, jpID, 2)
+
+	err := orm.ApproveSpec(ctx, v1ID, externalJobID.UUID)
+	require.NoError(t, err)
+
+	err = orm.DeleteProposal(ctx, jpID)
+	require.NoError(t, err)
+
+	// The job is kept until the node operator cancels it
+	actual, err := orm.GetJobProposal(ctx, jpID)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.JobProposalStatusDeleted, actual.Status)
+	assert.Equal(t, externalJobID, actual.ExternalJobID)
+	assert.True(t, actual.PendingUpdate)
+
+	v2, err := orm.GetSpecByVersion(ctx, jpID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.SpecStatusRevoked, v2.Status)
+
+	err = orm.CancelSpec(ctx, v1ID)
+	require.NoError(t, err)
+
+	actual, err = orm.GetJobProposal(ctx, jpID)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.JobProposalStatusDeleted, actual.Status)
+	assert.False(t, actual.ExternalJobID.Valid)
+	assert.False(t, actual.PendingUpdate)
 }
 
 func Test_ORM_IsJobManaged(t *testing.T) {
@@ -510,7 +634,8 @@ func Test_ORM_IsJobManaged(t *testing.T) {
 	jpID, err := orm.CreateJobProposal(ctx, jp)
 	require.NoError(t, err)
 
-	err = orm.ApproveJobProposal(ctx, jpID, externalJobID.UUID, feeds.JobProposalStatusApproved)
+	specID := createSpec(t, orm, jpID, 1)
+	err = orm.ApproveSpec(ctx, specID, externalJobID.UUID)
 	require.NoError(t, err)
 
 	isManaged, err = orm.IsJobManaged(context.Background(), int64(j.ID))
@@ -518,6 +643,121 @@ func Test_ORM_IsJobManaged(t *testing.T) {
 	assert.True(t, isManaged)
 }
 
+func Test_ORM_UpsertApprovalPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orm := setupORM(t)
+	fmID := createFeedsManager(t, orm)
+
+	_, err := orm.GetApprovalPolicy(ctx, fmID)
+	require.Equal(t, sql.ErrNoRows, err)
+
+	id, err := orm.UpsertApprovalPolicy(ctx, feeds.ApprovalPolicy{
+		FeedsManagerID:          fmID,
+		AutoApproveJobTypes:     pq.StringArray{feeds.JobTypeFluxMonitor},
+		AutoApproveUpdateFields: pq.StringArray{},
+		RequiredApprovals:       1,
+	})
+	require.NoError(t, err)
+
+	updatedID, err := orm.UpsertApprovalPolicy(ctx, feeds.ApprovalPolicy{
+		FeedsManagerID:          fmID,
+		AutoApproveJobTypes:     pq.StringArray{},
+		AutoApproveUpdateFields: pq.StringArray{"threshold"},
+		RequiredApprovals:       2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, id, updatedID)
+
+	actual, err := orm.GetApprovalPolicy(ctx, fmID)
+	require.NoError(t, err)
+	assert.Equal(t, id, actual.ID)
+	assert.Equal(t, fmID, actual.FeedsManagerID)
+	assert.Empty(t, actual.AutoApproveJobTypes)
+	assert.Equal(t, pq.StringArray{"threshold"}, actual.AutoApproveUpdateFields)
+	assert.Equal(t, int32(2), actual.RequiredApprovals)
+}
+
+func Test_ORM_CreateApproval(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orm := setupORM(t)
+	fmID := createFeedsManager(t, orm)
+	jpID := createJobProposal(t, orm, fmID)
+	specID := createSpec(t, orm, jpID, 1)
+
+	require.NoError(t, orm.CreateApproval(ctx, specID, "alice@example.com"))
+	require.NoError(t, orm.CreateApproval(ctx, specID, "alice@example.com"))
+	require.NoError(t, orm.CreateApproval(ctx, specID, "bob@example.com"))
+
+	count, err := orm.CountApprovals(ctx, specID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func Test_ORM_UpdateSpecDefinition_ClearsApprovals(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orm := setupORM(t)
+	fmID := createFeedsManager(t, orm)
+	jpID := createJobProposal(t, orm, fmID)
+	specID := createSpec(t, orm, jpID, 1)
+
+	// Alice approves the spec, then Bob edits and approves it. Alice has not
+	// approved the edited definition, so only Bob's approval counts.
+	require.NoError(t, orm.CreateApproval(ctx, specID, "alice@example.com"))
+	require.NoError(t, orm.UpdateSpecDefinition(ctx, specID, "edited by bob"))
+	require.NoError(t, orm.CreateApproval(ctx, specID, "bob@example.com"))
+
+	count, err := orm.CountApprovals(ctx, specID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func Test_ORM_ListPolicyDecisions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orm := setupORM(t)
+	fmID := createFeedsManager(t, orm)
+	jpID := createJobProposal(t, orm, fmID)
+	v1ID := createSpec(t, orm, jpID, 1)
+	v2ID := createSpec(t, orm, jpID, 2)
+
+	_, err := orm.CreatePolicyDecision(ctx, feeds.ApprovalPolicyDecision{
+		JobProposalSpecID: v1ID,
+		Decision:          feeds.ApprovalPolicyDecisionManualApprovalRequired,
+		Reason:            "fluxmonitor jobs are not auto-approved",
+	})
+	require.NoError(t, err)
+
+	id, err := orm.CreatePolicyDecision(ctx, feeds.ApprovalPolicyDecision{
+		JobProposalSpecID: v2ID,
+		Decision:          feeds.ApprovalPolicyDecisionApprovalRecorded,
+		Reason:            "1 of 2 required approvals",
+		Approver:          null.StringFrom("alice@example.com"),
+	})
+	require.NoError(t, err)
+
+	actual, err := orm.ListPolicyDecisions(ctx, jpID)
+	require.NoError(t, err)
+	require.Len(t, actual, 2)
+
+	assert.Equal(t, id, actual[0].ID)
+	assert.Equal(t, v2ID, actual[0].JobProposalSpecID)
+	assert.Equal(t, int32(2), actual[0].Version)
+	assert.Equal(t, feeds.ApprovalPolicyDecisionApprovalRecorded, actual[0].Decision)
+	assert.Equal(t, "1 of 2 required approvals", actual[0].Reason)
+	assert.Equal(t, null.StringFrom("alice@example.com"), actual[0].Approver)
+
+	assert.Equal(t, int32(1), actual[1].Version)
+	assert.Equal(t, feeds.ApprovalPolicyDecisionManualApprovalRequired, actual[1].Decision)
+	assert.False(t, actual[1].Approver.Valid)
+}
+
 // createFeedsManager is a test helper to create a feeds manager
 func createFeedsManager(t *testing.T, orm feeds.ORM) int64 {
 	mgr := &feeds.FeedsManager{
@@ -534,6 +774,35 @@ func createFeedsManager(t *testing.T, orm feeds.ORM) int64 {
 	return id
 }
 
+// createJobProposal is a test helper to create a pending job proposal
+func createJobProposal(t *testing.T, orm feeds.ORM, fmID int64) int64 {
+	jp := &feeds.JobProposal{
+		RemoteUUID:     uuid.NewV4(),
+		Spec:           "",
+		Status:         feeds.JobProposalStatusPending,
+		FeedsManagerID: fmID,
+	}
+
+	id, err := orm.CreateJobProposal(context.Background(), jp)
+	require.NoError(t, err)
+
+	return id
+}
+
+// createSpec is a test helper to create a pending version of the spec of a job
+// proposal
+func createSpec(t *testing.T, orm feeds.ORM, jpID int64, version int32) int64 {
+	id, err := orm.CreateSpec(context.Background(), feeds.JobProposalSpec{
+		Definition:    fmt.Sprintf("spec v%d", version),
+		Version:       version,
+		Status:        feeds.SpecStatusPending,
+		JobProposalID: jpID,
+	})
+	require.NoError(t, err)
+
+	return id
+}
+
 func createJob(t *testing.T, db *gorm.DB, externalJobID uuid.UUID) *job.Job {
 	config := cltest.NewTestGeneralConfig(t)
 	keyStore := cltest.NewKeyStore(t, db)
@@ -0,0 +1,136 @@
+package feeds
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+// defaultApprovalPolicy is the policy of a feeds manager which has none, under
+// which every version must be approved by a single user.
+func defaultApprovalPolicy(feedsManagerID int64) *ApprovalPolicy {
+	return &ApprovalPolicy{
+		FeedsManagerID:          feedsManagerID,
+		AutoApproveJobTypes:     []string{},
+		AutoApproveUpdateFields: []string{},
+		RequiredApprovals:       1,
+	}
+}
+
+// validateApprovalPolicy ensures that the policy can be applied
+func validateApprovalPolicy(policy ApprovalPolicy) error {
+	for _, jt := range policy.AutoApproveJobTypes {
+		if jt != JobTypeFluxMonitor && jt != JobTypeOffchainReporting {
+			return errors.Errorf("unsupported job type: %s", jt)
+		}
+	}
+
+	if policy.RequiredApprovals < 1 {
+		return errors.New("at least one approval must be required")
+	}
+
+	return nil
+}
+
+// evaluateApprovalPolicy returns whether the policy auto-approves a version of
+// a job proposal, along with the reason for the decision. approvedDefinition
+// is the definition of the approved version of the job proposal, or empty if
+// it has no job.
+func evaluateApprovalPolicy(policy ApprovalPolicy, definition string, approvedDefinition string) (bool, string, error) {
+	jobType, err := feedsJobType(definition)
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, jt := range policy.AutoApproveJobTypes {
+		if jt == jobType {
+			return true, fmt.Sprintf("%s jobs are auto-approved", jobType), nil
+		}
+	}
+
+	if approvedDefinition == "" {
+		return false, fmt.Sprintf("%s jobs are not auto-approved", jobType), nil
+	}
+
+	if len(policy.AutoApproveUpdateFields) == 0 {
+		return false, fmt.Sprintf("%s jobs are not auto-approved and no fields are allowed to change in updates", jobType), nil
+	}
+
+	changed, err := changedSpecFields(approvedDefinition, definition)
+	if err != nil {
+		return false, "", err
+	}
+
+	allowed := make(map[string]struct{}, len(policy.AutoApproveUpdateFields))
+	for _, field := range policy.AutoApproveUpdateFields {
+		allowed[field] = struct{}{}
+	}
+
+	var disallowed []string
+	for _, field := range changed {
+		if _, ok := allowed[field]; !ok {
+			disallowed = append(disallowed, field)
+		}
+	}
+	if len(disallowed) > 0 {
+		return false, fmt.Sprintf("fields which are not allowed to change in updates have changed: %s", strings.Join(disallowed, ", ")), nil
+	}
+
+	if len(changed) == 0 {
+		return true, "the spec has not changed", nil
+	}
+
+	return true, fmt.Sprintf("only fields which are allowed to change in updates have changed: %s", strings.Join(changed, ", ")), nil
+}
+
+// feedsJobType returns the feeds manager job type of a spec
+func feedsJobType(definition string) (string, error) {
+	jobType, err := job.ValidateSpec(definition)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse job spec TOML")
+	}
+
+	switch jobType {
+	case job.FluxMonitor:
+		return JobTypeFluxMonitor, nil
+	case job.OffchainReporting:
+		return JobTypeOffchainReporting, nil
+	default:
+		return "", errors.Errorf("unknown job type: %s", jobType)
+	}
+}
+
+// changedSpecFields returns the sorted top level fields whose values differ
+// between two specs, including fields which are only set in one of them.
+func changedSpecFields(a, b string) ([]string, error) {
+	treeA, err := toml.Load(a)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse job spec TOML")
+	}
+	treeB, err := toml.Load(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse job spec TOML")
+	}
+	mapA, mapB := treeA.ToMap(), treeB.ToMap()
+
+	var changed []string
+	for field, valueA := range mapA {
+		if valueB, ok := mapB[field]; !ok || !reflect.DeepEqual(valueA, valueB) {
+			changed = append(changed, field)
+		}
+	}
+	for field := range mapB {
+		if _, ok := mapA[field]; !ok {
+			changed = append(changed, field)
+		}
+	}
+	sort.Strings(changed)
+
+	return changed, nil
+}
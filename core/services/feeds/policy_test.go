@@ -0,0 +1,121 @@
+package feeds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const policyTestSpec = `
+type              = "fluxmonitor"
+schemaVersion     = 1
+name              = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold         = 0.5
+idleTimerPeriod   = "1s"
+idleTimerDisabled = false
+pollTimerPeriod   = "1m"
+pollTimerDisabled = false
+observationSource = """
+ds1  [type=http method=GET url="https://api.coindesk.com/v1/bpi/currentprice.json"];
+jp1  [type=jsonparse path="bpi,USD,rate_float"];
+ds1 -> jp1 -> answer1;
+answer1 [type=median index=0];
+"""
+`
+
+const policyTestSpecUpdatedThreshold = `
+type              = "fluxmonitor"
+schemaVersion     = 1
+name              = "example flux monitor spec"
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold         = 0.1
+idleTimerPeriod   = "1s"
+idleTimerDisabled = false
+pollTimerPeriod   = "30s"
+pollTimerDisabled = false
+observationSource = """
+ds1  [type=http method=GET url="https://api.coindesk.com/v1/bpi/currentprice.json"];
+jp1  [type=jsonparse path="bpi,USD,rate_float"];
+ds1 -> jp1 -> answer1;
+answer1 [type=median index=0];
+"""
+`
+
+func Test_evaluateApprovalPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		policy             ApprovalPolicy
+		approvedDefinition string
+		definition         string
+		wantApproved       bool
+		wantReason         string
+	}{
+		{
+			name:         "job type is auto-approved",
+			policy:       ApprovalPolicy{AutoApproveJobTypes: []string{JobTypeFluxMonitor}},
+			definition:   policyTestSpec,
+			wantApproved: true,
+			wantReason:   "fluxmonitor jobs are auto-approved",
+		},
+		{
+			name:       "job type is not auto-approved",
+			policy:     ApprovalPolicy{AutoApproveJobTypes: []string{JobTypeOffchainReporting}},
+			definition: policyTestSpec,
+			wantReason: "fluxmonitor jobs are not auto-approved",
+		},
+		{
+			name:               "update without allowed fields",
+			policy:             ApprovalPolicy{},
+			approvedDefinition: policyTestSpec,
+			definition:         policyTestSpecUpdatedThreshold,
+			wantReason:         "fluxmonitor jobs are not auto-approved and no fields are allowed to change in updates",
+		},
+		{
+			name:               "update of allowed fields only",
+			policy:             ApprovalPolicy{AutoApproveUpdateFields: []string{"pollTimerPeriod", "threshold"}},
+			approvedDefinition: policyTestSpec,
+			definition:         policyTestSpecUpdatedThreshold,
+			wantApproved:       true,
+			wantReason:         "only fields which are allowed to change in updates have changed: pollTimerPeriod, threshold",
+		},
+		{
+			name:               "update of fields which are not allowed",
+			policy:             ApprovalPolicy{AutoApproveUpdateFields: []string{"threshold"}},
+			approvedDefinition: policyTestSpec,
+			definition:         policyTestSpecUpdatedThreshold,
+			wantReason:         "fields which are not allowed to change in updates have changed: pollTimerPeriod",
+		},
+		{
+			name:               "update without changes",
+			policy:             ApprovalPolicy{AutoApproveUpdateFields: []string{"threshold"}},
+			approvedDefinition: policyTestSpec,
+			definition:         policyTestSpec,
+			wantApproved:       true,
+			wantReason:         "the spec has not changed",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			approved, reason, err := evaluateApprovalPolicy(tc.policy, tc.definition, tc.approvedDefinition)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantApproved, approved)
+			assert.Equal(t, tc.wantReason, reason)
+		})
+	}
+
+	t.Run("invalid spec", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := evaluateApprovalPolicy(ApprovalPolicy{}, "", "")
+		require.Error(t, err)
+	})
+}
@@ -2,7 +2,7 @@
 // versions:
 // 	protoc-gen-go v1.27.1
 // 	protoc        v3.17.3
-// source: pkg/noderpc/proto/feeds_manager.proto
+// source: core/services/feeds/proto/feeds_manager.proto
 
 package proto
 
@@ -54,11 +54,11 @@ func (x JobType) String() string {
 }
 
 func (JobType) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_noderpc_proto_feeds_manager_proto_enumTypes[0].Descriptor()
+	return file_core_services_feeds_proto_feeds_manager_proto_enumTypes[0].Descriptor()
 }
 
 func (JobType) Type() protoreflect.EnumType {
-	return &file_pkg_noderpc_proto_feeds_manager_proto_enumTypes[0]
+	return &file_core_services_feeds_proto_feeds_manager_proto_enumTypes[0]
 }
 
 func (x JobType) Number() protoreflect.EnumNumber {
@@ -67,7 +67,7 @@ func (x JobType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JobType.Descriptor instead.
 func (JobType) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{0}
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{0}
 }
 
 type UpdateNodeRequest struct {
@@ -82,12 +82,20 @@ type UpdateNodeRequest struct {
 	BootstrapMultiaddr string    `protobuf:"bytes,5,opt,name=bootstrap_multiaddr,json=bootstrapMultiaddr,proto3" json:"bootstrap_multiaddr,omitempty"`
 	Version            string    `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
 	ChainIds           []int64   `protobuf:"varint,7,rep,packed,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+	// ocr_key_bundles lists the OCR key bundles of the node. Bundles retired
+	// by a key rotation are left out
+	OcrKeyBundles []*OCRKeyBundle `protobuf:"bytes,8,rep,name=ocr_key_bundles,json=ocrKeyBundles,proto3" json:"ocr_key_bundles,omitempty"`
+	// p2p_peer_ids lists the peer IDs of the P2P keys of the node. Keys
+	// retired by a key rotation are left out
+	P2PPeerIds []string `protobuf:"bytes,9,rep,name=p2p_peer_ids,json=p2pPeerIds,proto3" json:"p2p_peer_ids,omitempty"`
+	// csa_public_key is the hex encoded public key of the CSA key of the node
+	CsaPublicKey string `protobuf:"bytes,10,opt,name=csa_public_key,json=csaPublicKey,proto3" json:"csa_public_key,omitempty"`
 }
 
 func (x *UpdateNodeRequest) Reset() {
 	*x = UpdateNodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[0]
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -100,7 +108,7 @@ func (x *UpdateNodeRequest) String() string {
 func (*UpdateNodeRequest) ProtoMessage() {}
 
 func (x *UpdateNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[0]
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -113,7 +121,7 @@ func (x *UpdateNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNodeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateNodeRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{0}
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *UpdateNodeRequest) GetJobTypes() []JobType {
@@ -165,6 +173,99 @@ func (x *UpdateNodeRequest) GetChainIds() []int64 {
 	return nil
 }
 
+func (x *UpdateNodeRequest) GetOcrKeyBundles() []*OCRKeyBundle {
+	if x != nil {
+		return x.OcrKeyBundles
+	}
+	return nil
+}
+
+func (x *UpdateNodeRequest) GetP2PPeerIds() []string {
+	if x != nil {
+		return x.P2PPeerIds
+	}
+	return nil
+}
+
+func (x *UpdateNodeRequest) GetCsaPublicKey() string {
+	if x != nil {
+		return x.CsaPublicKey
+	}
+	return ""
+}
+
+// OCRKeyBundle holds the public keys of an OCR key bundle
+type OCRKeyBundle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BundleId              string `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	OnchainSigningAddress string `protobuf:"bytes,2,opt,name=onchain_signing_address,json=onchainSigningAddress,proto3" json:"onchain_signing_address,omitempty"`
+	OffchainPublicKey     string `protobuf:"bytes,3,opt,name=offchain_public_key,json=offchainPublicKey,proto3" json:"offchain_public_key,omitempty"`
+	ConfigPublicKey       string `protobuf:"bytes,4,opt,name=config_public_key,json=configPublicKey,proto3" json:"config_public_key,omitempty"`
+}
+
+func (x *OCRKeyBundle) Reset() {
+	*x = OCRKeyBundle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OCRKeyBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OCRKeyBundle) ProtoMessage() {}
+
+func (x *OCRKeyBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OCRKeyBundle.ProtoReflect.Descriptor instead.
+func (*OCRKeyBundle) Descriptor() ([]byte, []int) {
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OCRKeyBundle) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *OCRKeyBundle) GetOnchainSigningAddress() string {
+	if x != nil {
+		return x.OnchainSigningAddress
+	}
+	return ""
+}
+
+func (x *OCRKeyBundle) GetOffchainPublicKey() string {
+	if x != nil {
+		return x.OffchainPublicKey
+	}
+	return ""
+}
+
+func (x *OCRKeyBundle) GetConfigPublicKey() string {
+	if x != nil {
+		return x.ConfigPublicKey
+	}
+	return ""
+}
+
 type UpdateNodeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -174,7 +275,7 @@ type UpdateNodeResponse struct {
 func (x *UpdateNodeResponse) Reset() {
 	*x = UpdateNodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[1]
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -187,7 +288,7 @@ func (x *UpdateNodeResponse) String() string {
 func (*UpdateNodeResponse) ProtoMessage() {}
 
 func (x *UpdateNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[1]
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +301,7 @@ func (x *UpdateNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNodeResponse.ProtoReflect.Descriptor instead.
 func (*UpdateNodeResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{1}
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{2}
 }
 
 type ApprovedJobRequest struct {
@@ -209,12 +310,14 @@ type ApprovedJobRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// version is the version of the job proposal spec which was approved
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *ApprovedJobRequest) Reset() {
 	*x = ApprovedJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[2]
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -227,7 +330,7 @@ func (x *ApprovedJobRequest) String() string {
 func (*ApprovedJobRequest) ProtoMessage() {}
 
 func (x *ApprovedJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[2]
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,7 +343,7 @@ func (x *ApprovedJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovedJobRequest.ProtoReflect.Descriptor instead.
 func (*ApprovedJobRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{2}
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ApprovedJobRequest) GetUuid() string {
@@ -250,6 +353,13 @@ func (x *ApprovedJobRequest) GetUuid() string {
 	return ""
 }
 
+func (x *ApprovedJobRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ApprovedJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -259,7 +369,7 @@ type ApprovedJobResponse struct {
 func (x *ApprovedJobResponse) Reset() {
 	*x = ApprovedJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[3]
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -272,7 +382,7 @@ func (x *ApprovedJobResponse) String() string {
 func (*ApprovedJobResponse) ProtoMessage() {}
 
 func (x *ApprovedJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[3]
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +395,7 @@ func (x *ApprovedJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApprovedJobResponse.ProtoReflect.Descriptor instead.
 func (*ApprovedJobResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{3}
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{4}
 }
 
 type RejectedJobRequest struct {
@@ -294,12 +404,14 @@ type RejectedJobRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// version is the version of the job proposal spec which was rejected
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *RejectedJobRequest) Reset() {
 	*x = RejectedJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[4]
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -312,7 +424,7 @@ func (x *RejectedJobRequest) String() string {
 func (*RejectedJobRequest) ProtoMessage() {}
 
 func (x *RejectedJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[4]
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +437,7 @@ func (x *RejectedJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectedJobRequest.ProtoReflect.Descriptor instead.
 func (*RejectedJobRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{4}
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RejectedJobRequest) GetUuid() string {
@@ -335,6 +447,13 @@ func (x *RejectedJobRequest) GetUuid() string {
 	return ""
 }
 
+func (x *RejectedJobRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type RejectedJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -344,7 +463,7 @@ type RejectedJobResponse struct {
 func (x *RejectedJobResponse) Reset() {
 	*x = RejectedJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[5]
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -357,7 +476,7 @@ func (x *RejectedJobResponse) String() string {
 func (*RejectedJobResponse) ProtoMessage() {}
 
 func (x *RejectedJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[5]
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +489,7 @@ func (x *RejectedJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectedJobResponse.ProtoReflect.Descriptor instead.
 func (*RejectedJobResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{5}
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{6}
 }
 
 type CancelledJobRequest struct {
@@ -379,12 +498,14 @@ type CancelledJobRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// version is the version of the job proposal spec which was cancelled
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *CancelledJobRequest) Reset() {
 	*x = CancelledJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[6]
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -397,7 +518,7 @@ func (x *CancelledJobRequest) String() string {
 func (*CancelledJobRequest) ProtoMessage() {}
 
 func (x *CancelledJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[6]
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +531,7 @@ func (x *CancelledJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelledJobRequest.ProtoReflect.Descriptor instead.
 func (*CancelledJobRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{6}
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CancelledJobRequest) GetUuid() string {
@@ -420,6 +541,13 @@ func (x *CancelledJobRequest) GetUuid() string {
 	return ""
 }
 
+func (x *CancelledJobRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CancelledJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -429,7 +557,7 @@ type CancelledJobResponse struct {
 func (x *CancelledJobResponse) Reset() {
 	*x = CancelledJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[7]
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -442,7 +570,7 @@ func (x *CancelledJobResponse) String() string {
 func (*CancelledJobResponse) ProtoMessage() {}
 
 func (x *CancelledJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[7]
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +583,7 @@ func (x *CancelledJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelledJobResponse.ProtoReflect.Descriptor instead.
 func (*CancelledJobResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{7}
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{8}
 }
 
 type ProposeJobRequest struct {
@@ -466,12 +594,15 @@ type ProposeJobRequest struct {
 	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Spec       string   `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
 	Multiaddrs []string `protobuf:"bytes,3,rep,name=multiaddrs,proto3" json:"multiaddrs,omitempty"`
+	// version is the version of the spec. Proposing a job again with a new
+	// version adds it to the versions of the job proposal
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *ProposeJobRequest) Reset() {
 	*x = ProposeJobRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[8]
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -484,7 +615,7 @@ func (x *ProposeJobRequest) String() string {
 func (*ProposeJobRequest) ProtoMessage() {}
 
 func (x *ProposeJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[8]
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,7 +628,7 @@ func (x *ProposeJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposeJobRequest.ProtoReflect.Descriptor instead.
 func (*ProposeJobRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{8}
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ProposeJobRequest) GetId() string {
@@ -521,6 +652,13 @@ func (x *ProposeJobRequest) GetMultiaddrs() []string {
 	return nil
 }
 
+func (x *ProposeJobRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ProposeJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -532,7 +670,7 @@ type ProposeJobResponse struct {
 func (x *ProposeJobResponse) Reset() {
 	*x = ProposeJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[9]
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -545,7 +683,7 @@ func (x *ProposeJobResponse) String() string {
 func (*ProposeJobResponse) ProtoMessage() {}
 
 func (x *ProposeJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[9]
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -558,7 +696,7 @@ func (x *ProposeJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposeJobResponse.ProtoReflect.Descriptor instead.
 func (*ProposeJobResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP(), []int{9}
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ProposeJobResponse) GetId() string {
@@ -568,78 +706,293 @@ func (x *ProposeJobResponse) GetId() string {
 	return ""
 }
 
-var File_pkg_noderpc_proto_feeds_manager_proto protoreflect.FileDescriptor
-
-var file_pkg_noderpc_proto_feeds_manager_proto_rawDesc = []byte{
-	0x0a, 0x25, 0x70, 0x6b, 0x67, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x63, 0x66, 0x6d, 0x22, 0x9a, 0x02, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x4a, 0x6f, 0x62, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x08, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74,
-	0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65,
-	0x72, 0x12, 0x2f, 0x0a, 0x13, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
-	0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x64,
-	0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52,
-	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x28, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x28, 0x0a, 0x12, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65,
-	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x29, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65,
-	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x24, 0x0a,
-	0x12, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x2a, 0x50, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
-	0x0a, 0x14, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4a, 0x4f, 0x42, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x58, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f,
-	0x52, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x4f, 0x43, 0x52, 0x10, 0x02, 0x32, 0x96, 0x02, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x4d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
-	0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x52, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x4c,
-	0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a,
-	0x0a, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x16, 0x2e, 0x63, 0x66,
-	0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+// DeleteJobRequest marks the job proposal with the remote id as deleted
+type DeleteJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteJobRequest) Reset() {
+	*x = DeleteJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobRequest) ProtoMessage() {}
+
+func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteJobResponse) Reset() {
+	*x = DeleteJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobResponse) ProtoMessage() {}
+
+func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{12}
+}
+
+// RevokeJobRequest withdraws the pending versions of the job proposal with
+// the remote id
+type RevokeJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RevokeJobRequest) Reset() {
+	*x = RevokeJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeJobRequest) ProtoMessage() {}
+
+func (x *RevokeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeJobRequest.ProtoReflect.Descriptor instead.
+func (*RevokeJobRequest) Descriptor() ([]byte, []int) {
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RevokeJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeJobResponse) Reset() {
+	*x = RevokeJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeJobResponse) ProtoMessage() {}
+
+func (x *RevokeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_services_feeds_proto_feeds_manager_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeJobResponse.ProtoReflect.Descriptor instead.
+func (*RevokeJobResponse) Descriptor() ([]byte, []int) {
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP(), []int{14}
+}
+
+var File_core_services_feeds_proto_feeds_manager_proto protoreflect.FileDescriptor
+
+var file_core_services_feeds_proto_feeds_manager_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
+	0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x64,
+	0x73, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x03, 0x63, 0x66, 0x6d, 0x22, 0x9d, 0x03, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x6a, 0x6f,
+	0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
+	0x63, 0x66, 0x6d, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6a, 0x6f, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x69, 0x73, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x70, 0x65,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x42, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x50, 0x65, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x62, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
+	0x70, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x73, 0x12, 0x39, 0x0a, 0x0f, 0x6f, 0x63, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x66, 0x6d,
+	0x2e, 0x4f, 0x43, 0x52, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0d, 0x6f,
+	0x63, 0x72, 0x4b, 0x65, 0x79, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0c,
+	0x70, 0x32, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x32, 0x70, 0x50, 0x65, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x63, 0x73, 0x61, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x73, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x22, 0xbf, 0x01, 0x0a, 0x0c, 0x4f, 0x43, 0x52, 0x4b, 0x65, 0x79, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x66,
+	0x66, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x66, 0x66, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x12,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x15, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x12, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x43, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x71, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x61, 0x64, 0x64, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x22, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x50, 0x0a, 0x07, 0x4a, 0x6f,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x4a, 0x4f, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x58,
+	0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4a, 0x4f,
+	0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x43, 0x52, 0x10, 0x02, 0x32, 0x96, 0x02, 0x0a,
+	0x0c, 0x46, 0x65, 0x65, 0x64, 0x73, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x40, 0x0a,
+	0x0b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x63,
+	0x66, 0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e,
+	0x63, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x0b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e,
+	0x63, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62,
+	0x12, 0x18, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x66, 0x6d,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc4, 0x01, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x4a, 0x6f, 0x62, 0x12, 0x16, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x66,
+	0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x12, 0x15, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x15, 0x2e,
+	0x63, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
 	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3d, 0x5a, 0x3b,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74,
 	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6b, 0x69, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x64,
@@ -649,58 +1002,68 @@ var file_pkg_noderpc_proto_feeds_manager_proto_rawDesc = []byte{
 }
 
 var (
-	file_pkg_noderpc_proto_feeds_manager_proto_rawDescOnce sync.Once
-	file_pkg_noderpc_proto_feeds_manager_proto_rawDescData = file_pkg_noderpc_proto_feeds_manager_proto_rawDesc
+	file_core_services_feeds_proto_feeds_manager_proto_rawDescOnce sync.Once
+	file_core_services_feeds_proto_feeds_manager_proto_rawDescData = file_core_services_feeds_proto_feeds_manager_proto_rawDesc
 )
 
-func file_pkg_noderpc_proto_feeds_manager_proto_rawDescGZIP() []byte {
-	file_pkg_noderpc_proto_feeds_manager_proto_rawDescOnce.Do(func() {
-		file_pkg_noderpc_proto_feeds_manager_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_noderpc_proto_feeds_manager_proto_rawDescData)
+func file_core_services_feeds_proto_feeds_manager_proto_rawDescGZIP() []byte {
+	file_core_services_feeds_proto_feeds_manager_proto_rawDescOnce.Do(func() {
+		file_core_services_feeds_proto_feeds_manager_proto_rawDescData = protoimpl.X.CompressGZIP(file_core_services_feeds_proto_feeds_manager_proto_rawDescData)
 	})
-	return file_pkg_noderpc_proto_feeds_manager_proto_rawDescData
+	return file_core_services_feeds_proto_feeds_manager_proto_rawDescData
 }
 
-var file_pkg_noderpc_proto_feeds_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_noderpc_proto_feeds_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_pkg_noderpc_proto_feeds_manager_proto_goTypes = []interface{}{
+var file_core_services_feeds_proto_feeds_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_core_services_feeds_proto_feeds_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_core_services_feeds_proto_feeds_manager_proto_goTypes = []interface{}{
 	(JobType)(0),                 // 0: cfm.JobType
 	(*UpdateNodeRequest)(nil),    // 1: cfm.UpdateNodeRequest
-	(*UpdateNodeResponse)(nil),   // 2: cfm.UpdateNodeResponse
-	(*ApprovedJobRequest)(nil),   // 3: cfm.ApprovedJobRequest
-	(*ApprovedJobResponse)(nil),  // 4: cfm.ApprovedJobResponse
-	(*RejectedJobRequest)(nil),   // 5: cfm.RejectedJobRequest
-	(*RejectedJobResponse)(nil),  // 6: cfm.RejectedJobResponse
-	(*CancelledJobRequest)(nil),  // 7: cfm.CancelledJobRequest
-	(*CancelledJobResponse)(nil), // 8: cfm.CancelledJobResponse
-	(*ProposeJobRequest)(nil),    // 9: cfm.ProposeJobRequest
-	(*ProposeJobResponse)(nil),   // 10: cfm.ProposeJobResponse
-}
-var file_pkg_noderpc_proto_feeds_manager_proto_depIdxs = []int32{
+	(*OCRKeyBundle)(nil),         // 2: cfm.OCRKeyBundle
+	(*UpdateNodeResponse)(nil),   // 3: cfm.UpdateNodeResponse
+	(*ApprovedJobRequest)(nil),   // 4: cfm.ApprovedJobRequest
+	(*ApprovedJobResponse)(nil),  // 5: cfm.ApprovedJobResponse
+	(*RejectedJobRequest)(nil),   // 6: cfm.RejectedJobRequest
+	(*RejectedJobResponse)(nil),  // 7: cfm.RejectedJobResponse
+	(*CancelledJobRequest)(nil),  // 8: cfm.CancelledJobRequest
+	(*CancelledJobResponse)(nil), // 9: cfm.CancelledJobResponse
+	(*ProposeJobRequest)(nil),    // 10: cfm.ProposeJobRequest
+	(*ProposeJobResponse)(nil),   // 11: cfm.ProposeJobResponse
+	(*DeleteJobRequest)(nil),     // 12: cfm.DeleteJobRequest
+	(*DeleteJobResponse)(nil),    // 13: cfm.DeleteJobResponse
+	(*RevokeJobRequest)(nil),     // 14: cfm.RevokeJobRequest
+	(*RevokeJobResponse)(nil),    // 15: cfm.RevokeJobResponse
+}
+var file_core_services_feeds_proto_feeds_manager_proto_depIdxs = []int32{
 	0,  // 0: cfm.UpdateNodeRequest.job_types:type_name -> cfm.JobType
-	3,  // 1: cfm.FeedsManager.ApprovedJob:input_type -> cfm.ApprovedJobRequest
-	1,  // 2: cfm.FeedsManager.UpdateNode:input_type -> cfm.UpdateNodeRequest
-	5,  // 3: cfm.FeedsManager.RejectedJob:input_type -> cfm.RejectedJobRequest
-	7,  // 4: cfm.FeedsManager.CancelledJob:input_type -> cfm.CancelledJobRequest
-	9,  // 5: cfm.NodeService.ProposeJob:input_type -> cfm.ProposeJobRequest
-	4,  // 6: cfm.FeedsManager.ApprovedJob:output_type -> cfm.ApprovedJobResponse
-	2,  // 7: cfm.FeedsManager.UpdateNode:output_type -> cfm.UpdateNodeResponse
-	6,  // 8: cfm.FeedsManager.RejectedJob:output_type -> cfm.RejectedJobResponse
-	8,  // 9: cfm.FeedsManager.CancelledJob:output_type -> cfm.CancelledJobResponse
-	10, // 10: cfm.NodeService.ProposeJob:output_type -> cfm.ProposeJobResponse
-	6,  // [6:11] is the sub-list for method output_type
-	1,  // [1:6] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
-}
-
-func init() { file_pkg_noderpc_proto_feeds_manager_proto_init() }
-func file_pkg_noderpc_proto_feeds_manager_proto_init() {
-	if File_pkg_noderpc_proto_feeds_manager_proto != nil {
+	2,  // 1: cfm.UpdateNodeRequest.ocr_key_bundles:type_name -> cfm.OCRKeyBundle
+	4,  // 2: cfm.FeedsManager.ApprovedJob:input_type -> cfm.ApprovedJobRequest
+	1,  // 3: cfm.FeedsManager.UpdateNode:input_type -> cfm.UpdateNodeRequest
+	6,  // 4: cfm.FeedsManager.RejectedJob:input_type -> cfm.RejectedJobRequest
+	8,  // 5: cfm.FeedsManager.CancelledJob:input_type -> cfm.CancelledJobRequest
+	10, // 6: cfm.NodeService.ProposeJob:input_type -> cfm.ProposeJobRequest
+	12, // 7: cfm.NodeService.DeleteJob:input_type -> cfm.DeleteJobRequest
+	14, // 8: cfm.NodeService.RevokeJob:input_type -> cfm.RevokeJobRequest
+	5,  // 9: cfm.FeedsManager.ApprovedJob:output_type -> cfm.ApprovedJobResponse
+	3,  // 10: cfm.FeedsManager.UpdateNode:output_type -> cfm.UpdateNodeResponse
+	7,  // 11: cfm.FeedsManager.RejectedJob:output_type -> cfm.RejectedJobResponse
+	9,  // 12: cfm.FeedsManager.CancelledJob:output_type -> cfm.CancelledJobResponse
+	11, // 13: cfm.NodeService.ProposeJob:output_type -> cfm.ProposeJobResponse
+	13, // 14: cfm.NodeService.DeleteJob:output_type -> cfm.DeleteJobResponse
+	15, // 15: cfm.NodeService.RevokeJob:output_type -> cfm.RevokeJobResponse
+	9,  // [9:16] is the sub-list for method output_type
+	2,  // [2:9] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_core_services_feeds_proto_feeds_manager_proto_init() }
+func file_core_services_feeds_proto_feeds_manager_proto_init() {
+	if File_core_services_feeds_proto_feeds_manager_proto != nil {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateNodeRequest); i {
 			case 0:
 				return &v.state
@@ -712,7 +1075,19 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OCRKeyBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateNodeResponse); i {
 			case 0:
 				return &v.state
@@ -724,7 +1099,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApprovedJobRequest); i {
 			case 0:
 				return &v.state
@@ -736,7 +1111,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApprovedJobResponse); i {
 			case 0:
 				return &v.state
@@ -748,7 +1123,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RejectedJobRequest); i {
 			case 0:
 				return &v.state
@@ -760,7 +1135,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RejectedJobResponse); i {
 			case 0:
 				return &v.state
@@ -772,7 +1147,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelledJobRequest); i {
 			case 0:
 				return &v.state
@@ -784,7 +1159,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelledJobResponse); i {
 			case 0:
 				return &v.state
@@ -796,7 +1171,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProposeJobRequest); i {
 			case 0:
 				return &v.state
@@ -808,7 +1183,7 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_noderpc_proto_feeds_manager_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProposeJobResponse); i {
 			case 0:
 				return &v.state
@@ -820,24 +1195,72 @@ func file_pkg_noderpc_proto_feeds_manager_proto_init() {
 				return nil
 			}
 		}
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_services_feeds_proto_feeds_manager_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeJobResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_pkg_noderpc_proto_feeds_manager_proto_rawDesc,
+			RawDescriptor: file_core_services_feeds_proto_feeds_manager_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
-		GoTypes:           file_pkg_noderpc_proto_feeds_manager_proto_goTypes,
-		DependencyIndexes: file_pkg_noderpc_proto_feeds_manager_proto_depIdxs,
-		EnumInfos:         file_pkg_noderpc_proto_feeds_manager_proto_enumTypes,
-		MessageInfos:      file_pkg_noderpc_proto_feeds_manager_proto_msgTypes,
+		GoTypes:           file_core_services_feeds_proto_feeds_manager_proto_goTypes,
+		DependencyIndexes: file_core_services_feeds_proto_feeds_manager_proto_depIdxs,
+		EnumInfos:         file_core_services_feeds_proto_feeds_manager_proto_enumTypes,
+		MessageInfos:      file_core_services_feeds_proto_feeds_manager_proto_msgTypes,
 	}.Build()
-	File_pkg_noderpc_proto_feeds_manager_proto = out.File
-	file_pkg_noderpc_proto_feeds_manager_proto_rawDesc = nil
-	file_pkg_noderpc_proto_feeds_manager_proto_goTypes = nil
-	file_pkg_noderpc_proto_feeds_manager_proto_depIdxs = nil
+	File_core_services_feeds_proto_feeds_manager_proto = out.File
+	file_core_services_feeds_proto_feeds_manager_proto_rawDesc = nil
+	file_core_services_feeds_proto_feeds_manager_proto_goTypes = nil
+	file_core_services_feeds_proto_feeds_manager_proto_depIdxs = nil
 }
@@ -138,6 +138,8 @@ var FeedsManager_ServiceDesc = wsrpc.ServiceDesc{
 //
 type NodeServiceClient interface {
 	ProposeJob(ctx context.Context, in *ProposeJobRequest) (*ProposeJobResponse, error)
+	DeleteJob(ctx context.Context, in *DeleteJobRequest) (*DeleteJobResponse, error)
+	RevokeJob(ctx context.Context, in *RevokeJobRequest) (*RevokeJobResponse, error)
 }
 
 type nodeServiceClient struct {
@@ -157,9 +159,29 @@ func (c *nodeServiceClient) ProposeJob(ctx context.Context, in *ProposeJobReques
 	return out, nil
 }
 
+func (c *nodeServiceClient) DeleteJob(ctx context.Context, in *DeleteJobRequest) (*DeleteJobResponse, error) {
+	out := new(DeleteJobResponse)
+	err := c.cc.Invoke(ctx, "DeleteJob", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeServiceClient) RevokeJob(ctx context.Context, in *RevokeJobRequest) (*RevokeJobResponse, error) {
+	out := new(RevokeJobResponse)
+	err := c.cc.Invoke(ctx, "RevokeJob", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServiceServer is the server API for NodeService service.
 type NodeServiceServer interface {
 	ProposeJob(context.Context, *ProposeJobRequest) (*ProposeJobResponse, error)
+	DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
+	RevokeJob(context.Context, *RevokeJobRequest) (*RevokeJobResponse, error)
 }
 
 func RegisterNodeServiceServer(s wsrpc.ServiceRegistrar, srv NodeServiceServer) {
@@ -174,6 +196,22 @@ func _NodeService_ProposeJob_Handler(srv interface{}, ctx context.Context, dec f
 	return srv.(NodeServiceServer).ProposeJob(ctx, in)
 }
 
+func _NodeService_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(DeleteJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(NodeServiceServer).DeleteJob(ctx, in)
+}
+
+func _NodeService_RevokeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(RevokeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(NodeServiceServer).RevokeJob(ctx, in)
+}
+
 // NodeService_ServiceDesc is the wsrpc.ServiceDesc for NodeService service.
 // It's only intended for direct use with wsrpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,5 +223,13 @@ var NodeService_ServiceDesc = wsrpc.ServiceDesc{
 			MethodName: "ProposeJob",
 			Handler:    _NodeService_ProposeJob_Handler,
 		},
+		{
+			MethodName: "DeleteJob",
+			Handler:    _NodeService_DeleteJob_Handler,
+		},
+		{
+			MethodName: "RevokeJob",
+			Handler:    _NodeService_RevokeJob_Handler,
+		},
 	},
 }
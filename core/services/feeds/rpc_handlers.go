@@ -2,7 +2,9 @@ package feeds
 
 import (
 	"context"
+	"math"
 
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	pb "github.com/smartcontractkit/chainlink/core/services/feeds/proto"
 )
@@ -20,13 +22,19 @@ func NewRPCHandlers(svc Service, feedsManagerID int64) *RPCHandlers {
 	}
 }
 
-// ProposeJob creates a new job proposal record for the feeds manager
+// ProposeJob creates a new job proposal record for the feeds manager, or adds
+// a new version to an existing one
 func (h *RPCHandlers) ProposeJob(ctx context.Context, req *pb.ProposeJobRequest) (*pb.ProposeJobResponse, error) {
 	remoteUUID, err := uuid.FromString(req.Id)
 	if err != nil {
 		return nil, err
 	}
 
+	version := req.GetVersion()
+	if version < 0 || version > math.MaxInt32 {
+		return nil, errors.Errorf("invalid job proposal version %d", version)
+	}
+
 	jp := &JobProposal{
 		Spec:           req.GetSpec(),
 		FeedsManagerID: h.feedsManagerID,
@@ -34,10 +42,42 @@ func (h *RPCHandlers) ProposeJob(ctx context.Context, req *pb.ProposeJobRequest)
 		Multiaddrs:     req.GetMultiaddrs(),
 	}
 
-	_, err = h.svc.ProposeJob(jp)
+	_, err = h.svc.ProposeJob(jp, int32(version))
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.ProposeJobResponse{}, nil
 }
+
+// DeleteJob marks the job proposal as deleted at the request of the feeds
+// manager
+func (h *RPCHandlers) DeleteJob(ctx context.Context, req *pb.DeleteJobRequest) (*pb.DeleteJobResponse, error) {
+	remoteUUID, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = h.svc.DeleteJob(ctx, h.feedsManagerID, remoteUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteJobResponse{}, nil
+}
+
+// RevokeJob revokes the pending versions of the job proposal at the request
+// of the feeds manager
+func (h *RPCHandlers) RevokeJob(ctx context.Context, req *pb.RevokeJobRequest) (*pb.RevokeJobResponse, error) {
+	remoteUUID, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = h.svc.RevokeJob(ctx, h.feedsManagerID, remoteUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeJobResponse{}, nil
+}
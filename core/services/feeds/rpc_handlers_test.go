@@ -2,6 +2,7 @@ package feeds_test
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	uuid "github.com/satori/go.uuid"
@@ -50,12 +51,65 @@ func Test_RPCHandlers_ProposeJob(t *testing.T) {
 			Spec:           spec,
 			FeedsManagerID: h.feedsManagerID,
 			RemoteUUID:     jobID,
-		}).
+		}, int32(2)).
 		Return(int64(1), nil)
 
 	_, err := h.ProposeJob(context.Background(), &pb.ProposeJobRequest{
-		Id:   jobID.String(),
-		Spec: spec,
+		Id:      jobID.String(),
+		Spec:    spec,
+		Version: 2,
+	})
+	require.NoError(t, err)
+}
+
+func Test_RPCHandlers_ProposeJob_InvalidVersion(t *testing.T) {
+	h := setupTestHandlers(t)
+
+	_, err := h.ProposeJob(context.Background(), &pb.ProposeJobRequest{
+		Id:      uuid.NewV4().String(),
+		Spec:    TestSpec,
+		Version: math.MaxInt32 + 1,
+	})
+	require.EqualError(t, err, "invalid job proposal version 2147483648")
+
+	_, err = h.ProposeJob(context.Background(), &pb.ProposeJobRequest{
+		Id:      uuid.NewV4().String(),
+		Spec:    TestSpec,
+		Version: -1,
+	})
+	require.EqualError(t, err, "invalid job proposal version -1")
+}
+
+func Test_RPCHandlers_DeleteJob(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		jobID = uuid.NewV4()
+	)
+	h := setupTestHandlers(t)
+
+	h.svc.
+		On("DeleteJob", ctx, h.feedsManagerID, jobID).
+		Return(int64(1), nil)
+
+	_, err := h.DeleteJob(ctx, &pb.DeleteJobRequest{
+		Id: jobID.String(),
+	})
+	require.NoError(t, err)
+}
+
+func Test_RPCHandlers_RevokeJob(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		jobID = uuid.NewV4()
+	)
+	h := setupTestHandlers(t)
+
+	h.svc.
+		On("RevokeJob", ctx, h.feedsManagerID, jobID).
+		Return(int64(1), nil)
+
+	_, err := h.RevokeJob(ctx, &pb.RevokeJobRequest{
+		Id: jobID.String(),
 	})
 	require.NoError(t, err)
 }
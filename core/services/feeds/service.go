@@ -3,8 +3,12 @@ package feeds
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"sort"
 
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -12,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -28,19 +33,26 @@ type Service interface {
 	Start() error
 	Close() error
 
-	ApproveJobProposal(ctx context.Context, id int64) error
+	ApproveJobProposal(ctx context.Context, id int64, version int32, approver string) error
 	CountManagers() (int64, error)
-	CancelJobProposal(ctx context.Context, id int64) error
+	CancelJobProposal(ctx context.Context, id int64, version int32) error
 	CreateJobProposal(jp *JobProposal) (int64, error)
+	DeleteJob(ctx context.Context, feedsManagerID int64, remoteUUID uuid.UUID) (int64, error)
+	GetApprovalPolicy(ctx context.Context, feedsManagerID int64) (*ApprovalPolicy, error)
 	GetJobProposal(id int64) (*JobProposal, error)
 	GetManager(id int64) (*FeedsManager, error)
 	ListManagers() ([]FeedsManager, error)
 	ListJobProposals() ([]JobProposal, error)
-	ProposeJob(jp *JobProposal) (int64, error)
+	ListJobProposalSpecs(ctx context.Context, id int64) ([]JobProposalSpec, error)
+	ListPolicyDecisions(ctx context.Context, id int64) ([]ApprovalPolicyDecision, error)
+	ProposeJob(jp *JobProposal, version int32) (int64, error)
 	RegisterManager(ms *FeedsManager) (int64, error)
-	RejectJobProposal(ctx context.Context, id int64) error
+	RejectJobProposal(ctx context.Context, id int64, version int32) error
+	RevokeJob(ctx context.Context, feedsManagerID int64, remoteUUID uuid.UUID) (int64, error)
+	ReconnectManagers() error
 	SyncNodeInfo(id int64) error
-	UpdateJobProposalSpec(ctx context.Context, id int64, spec string) error
+	UpdateApprovalPolicy(ctx context.Context, policy ApprovalPolicy) error
+	UpdateJobProposalSpec(ctx context.Context, id int64, version int32, spec string) error
 	UpdateFeedsManager(ctx context.Context, mgr FeedsManager) error
 	IsJobManaged(ctx context.Context, jobID int64) (bool, error)
 
@@ -54,6 +66,8 @@ type service struct {
 	jobORM      job.ORM
 	csaKeyStore keystore.CSA
 	ethKeyStore keystore.Eth
+	ocrKeyStore keystore.OCR
+	p2pKeyStore keystore.P2P
 	jobSpawner  job.Spawner
 	cfg         Config
 	txm         postgres.TransactionManager
@@ -71,6 +85,8 @@ func NewService(
 	jobSpawner job.Spawner,
 	csaKeyStore keystore.CSA,
 	ethKeyStore keystore.Eth,
+	ocrKeyStore keystore.OCR,
+	p2pKeyStore keystore.P2P,
 	cfg Config,
 	chainSet evm.ChainSet,
 	lggr logger.Logger,
@@ -84,6 +100,8 @@ func NewService(
 		jobSpawner:  jobSpawner,
 		csaKeyStore: csaKeyStore,
 		ethKeyStore: ethKeyStore,
+		ocrKeyStore: ocrKeyStore,
+		p2pKeyStore: p2pKeyStore,
 		cfg:         cfg,
 		connMgr:     newConnectionsManager(lggr),
 		chainSet:    chainSet,
@@ -153,6 +171,42 @@ func (s *service) SyncNodeInfo(id int64) error {
 		addresses = append(addresses, k.Address.String())
 	}
 
+	// Keys retired by a rotation are not reported, so that the feeds manager
+	// switches to their replacement
+	ocrKeys, err := s.ocrKeyStore.GetAllActive()
+	if err != nil {
+		return err
+	}
+	sort.Slice(ocrKeys, func(i, j int) bool { return ocrKeys[i].ID() < ocrKeys[j].ID() })
+	ocrKeyBundles := []*pb.OCRKeyBundle{}
+	for _, k := range ocrKeys {
+		ocrKeyBundles = append(ocrKeyBundles, &pb.OCRKeyBundle{
+			BundleId:              k.ID(),
+			OnchainSigningAddress: k.OnChainSigning.Address().String(),
+			OffchainPublicKey:     k.OffChainSigning.PublicKey().String(),
+			ConfigPublicKey:       ocrkey.ConfigPublicKey(k.PublicKeyConfig()).String(),
+		})
+	}
+
+	p2pKeys, err := s.p2pKeyStore.GetAllActive()
+	if err != nil {
+		return err
+	}
+	peerIDs := []string{}
+	for _, k := range p2pKeys {
+		peerIDs = append(peerIDs, k.PeerID().String())
+	}
+	sort.Strings(peerIDs)
+
+	csaKeys, err := s.csaKeyStore.GetAllActive()
+	if err != nil {
+		return err
+	}
+	var csaPublicKey string
+	if len(csaKeys) > 0 {
+		csaPublicKey = csaKeys[0].PublicKeyString()
+	}
+
 	// Make the remote call to FMS
 	fmsClient, err := s.connMgr.GetClient(id)
 	if err != nil {
@@ -174,6 +228,9 @@ func (s *service) SyncNodeInfo(id int64) error {
 		IsBootstrapPeer:    mgr.IsOCRBootstrapPeer,
 		BootstrapMultiaddr: mgr.OCRBootstrapPeerMultiaddr.ValueOrZero(),
 		Version:            s.version,
+		OcrKeyBundles:      ocrKeyBundles,
+		P2PPeerIds:         peerIDs,
+		CsaPublicKey:       csaPublicKey,
 	})
 	if err != nil {
 		return err
@@ -217,6 +274,34 @@ func (s *service) UpdateFeedsManager(ctx context.Context, mgr FeedsManager) erro
 	return nil
 }
 
+// ReconnectManagers restarts the connections to the feeds managers with the
+// current CSA key, e.g. after it was rotated.
+func (s *service) ReconnectManagers() error {
+	mgrs, err := s.ListManagers()
+	if err != nil {
+		return err
+	}
+
+	privkey, err := s.getCSAPrivateKey()
+	if err != nil {
+		return err
+	}
+
+	for _, mgr := range mgrs {
+		// Only restart the existing connections
+		if !mgr.IsConnectionActive {
+			continue
+		}
+		s.lggr.Infow("Restarting connection", "feedsManagerID", mgr.ID)
+		if err = s.connMgr.Disconnect(mgr.ID); err != nil {
+			s.lggr.Infow("Feeds Manager not connected, attempting to connect", "feedsManagerID", mgr.ID)
+		}
+		s.connectFeedManager(mgr, privkey)
+	}
+
+	return nil
+}
+
 // ListManagerServices lists all the manager services.
 func (s *service) ListManagers() ([]FeedsManager, error) {
 	managers, err := s.orm.ListManagers(context.Background())
@@ -264,15 +349,21 @@ func (s *service) CreateJobProposal(jp *JobProposal) (int64, error) {
 	return s.orm.CreateJobProposal(context.Background(), jp)
 }
 
-// ProposeJob creates a job proposal if it does not exist. If it already exists
-// and is pending or rejected, update the existing job proposal and set it's
-// status to pending.
+// ProposeJob creates a job proposal if it does not exist, along with the first
+// version of its spec. If it already exists, the spec is added to it as a new
+// version, which must be greater than its latest version. A version of 0 is
+// taken as the next version, for feeds managers which do not version their
+// proposals.
+//
+// A job proposal whose job has been created stays approved, and is flagged as
+// having a pending update until the new version is approved or rejected.
+// Otherwise the job proposal goes back to pending.
 //
 // The feeds manager id check exists for support of multiple feeds managers in
 // the future so that in the (very slim) off chance that the same uuid is
 // generated by another feeds manager or they maliciously send an existing uuid
 // belonging to another feeds manager, we do not update it.
-func (s *service) ProposeJob(jp *JobProposal) (int64, error) {
+func (s *service) ProposeJob(jp *JobProposal, version int32) (int64, error) {
 	ctx := context.Background()
 
 	// Validate the job spec
@@ -288,6 +379,13 @@ func (s *service) ProposeJob(jp *JobProposal) (int64, error) {
 		}
 	}
 
+	// Reset the job proposal
+	jp.Status = JobProposalStatusPending
+	spec := JobProposalSpec{
+		Definition: jp.Spec,
+		Status:     SpecStatusPending,
+	}
+
 	// Validation checks if a job proposal exists
 	if existing != nil {
 		// Ensure that if the job proposal exists, that it belongs to the feeds manager.
@@ -295,15 +393,197 @@ func (s *service) ProposeJob(jp *JobProposal) (int64, error) {
 			return 0, errors.New("cannot update a job proposal belonging to another feeds manager")
 		}
 
-		if existing.Status == JobProposalStatusApproved {
-			return 0, errors.New("cannot repropose a job that has already been approved")
+		latest, err := s.orm.GetLatestSpec(ctx, existing.ID)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to get the latest version of job proposal")
 		}
+		if version == 0 {
+			version = latest.Version + 1
+		} else if version <= latest.Version {
+			return 0, errors.Errorf("version %d must be greater than the latest version %d", version, latest.Version)
+		}
+
+		if existing.ExternalJobID.Valid {
+			if existing.Status == JobProposalStatusDeleted {
+				return 0, errors.New("cannot repropose a deleted job until its job has been cancelled")
+			}
+
+			// The job proposal keeps the spec of its job until the new version
+			// is approved
+			jp.Status = existing.Status
+			jp.ExternalJobID = existing.ExternalJobID
+			jp.PendingUpdate = true
+			jp.Spec = existing.Spec
+		}
+	} else if version == 0 {
+		version = 1
 	}
+	spec.Version = version
 
-	// Reset the job proposal
-	jp.Status = JobProposalStatusPending
+	ctx, cancel := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
+	defer cancel()
 
-	return s.orm.UpsertJobProposal(ctx, jp)
+	err = s.txm.TransactWithContext(ctx, func(ctx context.Context) error {
+		jp.ID, err = s.orm.UpsertJobProposal(ctx, jp)
+		if err != nil {
+			return err
+		}
+
+		spec.JobProposalID = jp.ID
+		spec.ID, err = s.orm.CreateSpec(ctx, spec)
+
+		return err
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not propose job")
+	}
+
+	s.applyApprovalPolicy(ctx, jp, &spec)
+
+	return jp.ID, nil
+}
+
+// applyApprovalPolicy approves a newly proposed version of a job proposal if
+// the approval policy of its feeds manager allows it, and records the
+// decision. Auto-approval does not need the approvals of any user. Failures
+// are logged and recorded, leaving the version pending for the node operator.
+func (s *service) applyApprovalPolicy(ctx context.Context, jp *JobProposal, spec *JobProposalSpec) {
+	lggr := s.lggr.With("jobProposalID", jp.ID, "version", spec.Version)
+
+	decision := ApprovalPolicyDecision{
+		JobProposalSpecID: spec.ID,
+		Decision:          ApprovalPolicyDecisionManualApprovalRequired,
+	}
+
+	approved, reason, err := s.evaluateApprovalPolicy(ctx, jp, spec)
+	decision.Reason = reason
+	if err == nil && approved {
+		decision.Decision = ApprovalPolicyDecisionAutoApproved
+
+		var fmsClient pb.FeedsManagerClient
+		if fmsClient, err = s.connMgr.GetClient(jp.FeedsManagerID); err == nil {
+			err = s.approveSpec(ctx, fmsClient, jp, spec, decision)
+		}
+		if err == nil {
+			lggr.Infow("Auto-approved job proposal", "reason", reason)
+			return
+		}
+	}
+	if err != nil {
+		lggr.Errorw("Failed to auto-approve job proposal", "err", err)
+		decision.Decision = ApprovalPolicyDecisionAutoApprovalFailed
+		decision.Reason = err.Error()
+	}
+
+	if _, err = s.orm.CreatePolicyDecision(ctx, decision); err != nil {
+		lggr.Errorw("Failed to record approval policy decision", "err", err)
+	}
+}
+
+// evaluateApprovalPolicy returns whether the approval policy of the feeds
+// manager auto-approves a version of a job proposal, and why.
+func (s *service) evaluateApprovalPolicy(ctx context.Context, jp *JobProposal, spec *JobProposalSpec) (bool, string, error) {
+	policy, err := s.GetApprovalPolicy(ctx, jp.FeedsManagerID)
+	if err != nil {
+		return false, "", errors.Wrap(err, "approval policy error")
+	}
+
+	var approvedDefinition string
+	if jp.ExternalJobID.Valid {
+		approvedSpec, err := s.getApprovedSpec(ctx, jp.ID, 0)
+		if err != nil {
+			return false, "", err
+		}
+		approvedDefinition = approvedSpec.Definition
+	}
+
+	return evaluateApprovalPolicy(*policy, spec.Definition, approvedDefinition)
+}
+
+// GetApprovalPolicy gets the approval policy of a feeds manager, which
+// requires a single approval for every version if none has been set.
+func (s *service) GetApprovalPolicy(ctx context.Context, feedsManagerID int64) (*ApprovalPolicy, error) {
+	policy, err := s.orm.GetApprovalPolicy(ctx, feedsManagerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return defaultApprovalPolicy(feedsManagerID), nil
+	}
+
+	return policy, err
+}
+
+// UpdateApprovalPolicy replaces the approval policy of a feeds manager. It
+// applies to the versions proposed from then on.
+func (s *service) UpdateApprovalPolicy(ctx context.Context, policy ApprovalPolicy) error {
+	if err := validateApprovalPolicy(policy); err != nil {
+		return err
+	}
+
+	if _, err := s.orm.GetManager(ctx, policy.FeedsManagerID); err != nil {
+		return errors.Wrap(err, "feeds manager error")
+	}
+
+	if _, err := s.orm.UpsertApprovalPolicy(ctx, policy); err != nil {
+		return errors.Wrap(err, "could not update approval policy")
+	}
+
+	return nil
+}
+
+// ListPolicyDecisions lists the audit trail of the approval policy decisions
+// made on the versions of a job proposal, latest first.
+func (s *service) ListPolicyDecisions(ctx context.Context, id int64) ([]ApprovalPolicyDecision, error) {
+	return s.orm.ListPolicyDecisions(ctx, id)
+}
+
+// RevokeJob revokes the pending versions of a job proposal at the request of
+// the feeds manager. A job proposal which has no job is revoked along with
+// them.
+func (s *service) RevokeJob(ctx context.Context, feedsManagerID int64, remoteUUID uuid.UUID) (int64, error) {
+	jp, err := s.getManagedJobProposal(ctx, feedsManagerID, remoteUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
+	defer cancel()
+	err = s.txm.TransactWithContext(ctx, func(ctx context.Context) error {
+		count, err := s.orm.RevokePendingSpecs(ctx, jp.ID)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return errors.New("job proposal has no pending version")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not revoke job proposal")
+	}
+
+	return jp.ID, nil
+}
+
+// DeleteJob marks a job proposal as deleted at the request of the feeds
+// manager, revoking its pending versions. Its job, if any, keeps running and
+// the job proposal is flagged as having a pending update until the node
+// operator cancels it.
+func (s *service) DeleteJob(ctx context.Context, feedsManagerID int64, remoteUUID uuid.UUID) (int64, error) {
+	jp, err := s.getManagedJobProposal(ctx, feedsManagerID, remoteUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
+	defer cancel()
+	err = s.txm.TransactWithContext(ctx, func(ctx context.Context) error {
+		return s.orm.DeleteProposal(ctx, jp.ID)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "could not delete job proposal")
+	}
+
+	return jp.ID, nil
 }
 
 // GetJobProposal gets a job proposal by id.
@@ -311,29 +591,51 @@ func (s *service) GetJobProposal(id int64) (*JobProposal, error) {
 	return s.orm.GetJobProposal(context.Background(), id)
 }
 
-func (s *service) UpdateJobProposalSpec(ctx context.Context, id int64, spec string) error {
-	jp, err := s.orm.GetJobProposal(ctx, id)
+// ListJobProposalSpecs lists the versions of the spec of a job proposal,
+// latest first.
+func (s *service) ListJobProposalSpecs(ctx context.Context, id int64) ([]JobProposalSpec, error) {
+	return s.orm.ListSpecsByJobProposalID(ctx, id)
+}
+
+// UpdateJobProposalSpec updates the definition of a version of a job proposal
+// spec, or of its latest version if version is 0. Approvals given to the
+// previous definition no longer count towards approving it.
+func (s *service) UpdateJobProposalSpec(ctx context.Context, id int64, version int32, definition string) error {
+	spec, err := s.getSpec(ctx, id, version)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return errors.Wrap(err, "job proposal does not exist")
+			return errors.Wrap(err, "job proposal spec does not exist")
 		}
 
 		return errors.Wrap(err, "database error")
 	}
 
-	if !jp.CanEditSpec() {
-		return errors.New("must be a pending or cancelled job proposal")
+	if !spec.CanEditDefinition() {
+		return errors.New("must be a pending or cancelled job proposal spec")
 	}
 
-	// Update the spec
-	if err = s.orm.UpdateJobProposalSpec(ctx, id, spec); err != nil {
-		return errors.Wrap(err, "could not update job proposal")
+	ctx, cancel := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
+	defer cancel()
+
+	// Update the spec, discarding the approvals given to its previous
+	// definition
+	err = s.txm.TransactWithContext(ctx, func(ctx context.Context) error {
+		return s.orm.UpdateSpecDefinition(ctx, spec.ID, definition)
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not update job proposal spec")
 	}
 
 	return nil
 }
 
-func (s *service) ApproveJobProposal(ctx context.Context, id int64) error {
+// ApproveJobProposal approves a version of a job proposal spec, or its latest
+// version if version is 0, on behalf of the approver. When the approval policy
+// of the feeds manager requires more than one approval, the approval is
+// recorded and the version stays pending until enough distinct users have
+// approved it. The job is created, or updated if an earlier version has
+// already been approved.
+func (s *service) ApproveJobProposal(ctx context.Context, id int64, version int32, approver string) error {
 	jp, err := s.orm.GetJobProposal(ctx, id)
 	if err != nil {
 		return errors.Wrap(err, "job proposal error")
@@ -344,47 +646,129 @@ func (s *service) ApproveJobProposal(ctx context.Context, id int64) error {
 		return errors.Wrap(err, "fms rpc client is not connected")
 	}
 
-	if jp.Status != JobProposalStatusPending && jp.Status != JobProposalStatusCancelled {
-		return errors.New("must be a pending or cancelled job proposal")
+	if jp.Status == JobProposalStatusDeleted {
+		return errors.New("cannot approve a deleted job proposal")
+	}
+
+	spec, err := s.getSpec(ctx, id, version)
+	if err != nil {
+		return errors.Wrap(err, "job proposal spec error")
+	}
+
+	if spec.Status != SpecStatusPending && spec.Status != SpecStatusCancelled {
+		return errors.New("must be a pending or cancelled job proposal spec")
+	}
+
+	policy, err := s.GetApprovalPolicy(ctx, jp.FeedsManagerID)
+	if err != nil {
+		return errors.Wrap(err, "approval policy error")
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
 	defer cancel()
 
-	j, err := s.generateJob(jp.Spec)
+	if policy.RequiredApprovals > 1 {
+		if approver == "" {
+			return errors.New("approver is required")
+		}
+
+		var approved bool
+		err = s.txm.TransactWithContext(ctx, func(ctx context.Context) error {
+			if err = s.orm.CreateApproval(ctx, spec.ID, approver); err != nil {
+				return err
+			}
+
+			var count int64
+			count, err = s.orm.CountApprovals(ctx, spec.ID)
+			if err != nil {
+				return err
+			}
+			if approved = count >= int64(policy.RequiredApprovals); approved {
+				return nil
+			}
+
+			_, err = s.orm.CreatePolicyDecision(ctx, ApprovalPolicyDecision{
+				JobProposalSpecID: spec.ID,
+				Decision:          ApprovalPolicyDecisionApprovalRecorded,
+				Reason:            fmt.Sprintf("%d of %d required approvals", count, policy.RequiredApprovals),
+				Approver:          null.StringFrom(approver),
+			})
+
+			return err
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not record approval")
+		}
+		if !approved {
+			return nil
+		}
+	}
+
+	err = s.approveSpec(ctx, fmsClient, jp, spec, ApprovalPolicyDecision{
+		Decision: ApprovalPolicyDecisionApproved,
+		Reason:   fmt.Sprintf("approved by %d of %d required users", policy.RequiredApprovals, policy.RequiredApprovals),
+		Approver: null.NewString(approver, approver != ""),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not approve job proposal")
+	}
+
+	return nil
+}
+
+// approveSpec creates or updates the job of a version of a job proposal,
+// marks the version as approved and records the decision to approve it.
+func (s *service) approveSpec(ctx context.Context, fmsClient pb.FeedsManagerClient, jp *JobProposal, spec *JobProposalSpec, decision ApprovalPolicyDecision) error {
+	j, err := s.generateJob(spec.Definition)
 	if err != nil {
 		return errors.Wrap(err, "could not generate job from spec")
 	}
 
-	err = s.txm.TransactWithContext(ctx, func(ctx context.Context) error {
-		// Create the job
-		_, err = s.jobSpawner.CreateJob(ctx, *j, j.Name)
-		if err != nil {
-			return err
+	return s.txm.TransactWithContext(ctx, func(ctx context.Context) error {
+		if jp.ExternalJobID.Valid {
+			// Replace the spec of the job created from an earlier version
+			var existing job.Job
+			existing, err = s.jobORM.FindJobByExternalJobID(ctx, jp.ExternalJobID.UUID)
+			if err != nil {
+				return errors.Wrap(err, "job does not exist")
+			}
+
+			j.ExternalJobID = existing.ExternalJobID
+			if _, err = s.jobSpawner.UpdateJob(ctx, existing.ID, *j); err != nil {
+				return err
+			}
+		} else {
+			// Create the job
+			if _, err = s.jobSpawner.CreateJob(ctx, *j, j.Name); err != nil {
+				return err
+			}
 		}
 
 		// Approve the job
-		if err = s.orm.ApproveJobProposal(ctx, id, j.ExternalJobID, JobProposalStatusApproved); err != nil {
+		if err = s.orm.ApproveSpec(ctx, spec.ID, j.ExternalJobID); err != nil {
+			return err
+		}
+
+		decision.JobProposalSpecID = spec.ID
+		if _, err = s.orm.CreatePolicyDecision(ctx, decision); err != nil {
 			return err
 		}
 
 		// Send to FMS Client
 		if _, err = fmsClient.ApprovedJob(ctx, &pb.ApprovedJobRequest{
-			Uuid: jp.RemoteUUID.String(),
+			Uuid:    jp.RemoteUUID.String(),
+			Version: int64(spec.Version),
 		}); err != nil {
 			return err
 		}
 
 		return nil
 	})
-	if err != nil {
-		return errors.Wrap(err, "could not approve job proposal")
-	}
-
-	return nil
 }
 
-func (s *service) RejectJobProposal(ctx context.Context, id int64) error {
+// RejectJobProposal rejects a pending version of a job proposal spec, or its
+// latest version if version is 0.
+func (s *service) RejectJobProposal(ctx context.Context, id int64, version int32) error {
 	jp, err := s.orm.GetJobProposal(ctx, id)
 	if err != nil {
 		return errors.Wrap(err, "job proposal does not exist")
@@ -395,19 +779,25 @@ func (s *service) RejectJobProposal(ctx context.Context, id int64) error {
 		return errors.Wrap(err, "fms rpc client is not connected")
 	}
 
-	if jp.Status != JobProposalStatusPending {
-		return errors.New("must be a pending job proposal")
+	spec, err := s.getSpec(ctx, id, version)
+	if err != nil {
+		return errors.Wrap(err, "job proposal spec does not exist")
+	}
+
+	if spec.Status != SpecStatusPending {
+		return errors.New("must be a pending job proposal spec")
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
 	defer cancel()
 	err = s.txm.TransactWithContext(ctx, func(ctx context.Context) error {
-		if err = s.orm.UpdateJobProposalStatus(ctx, id, JobProposalStatusRejected); err != nil {
+		if err = s.orm.RejectSpec(ctx, spec.ID); err != nil {
 			return err
 		}
 
 		if _, err = fmsClient.RejectedJob(ctx, &pb.RejectedJobRequest{
-			Uuid: jp.RemoteUUID.String(),
+			Uuid:    jp.RemoteUUID.String(),
+			Version: int64(spec.Version),
 		}); err != nil {
 			return err
 		}
@@ -425,14 +815,17 @@ func (s *service) IsJobManaged(ctx context.Context, jobID int64) (bool, error) {
 	return s.orm.IsJobManaged(ctx, jobID)
 }
 
-func (s *service) CancelJobProposal(ctx context.Context, id int64) error {
+// CancelJobProposal cancels the approved version of a job proposal spec and
+// deletes its job. A version of 0 cancels whichever version is approved.
+func (s *service) CancelJobProposal(ctx context.Context, id int64, version int32) error {
 	jp, err := s.orm.GetJobProposal(ctx, id)
 	if err != nil {
 		return errors.Wrap(err, "job proposal does not exist")
 	}
 
-	if jp.Status != JobProposalStatusApproved {
-		return errors.New("must be a approved job proposal")
+	spec, err := s.getApprovedSpec(ctx, id, version)
+	if err != nil {
+		return err
 	}
 
 	fmsClient, err := s.connMgr.GetClient(jp.FeedsManagerID)
@@ -443,7 +836,7 @@ func (s *service) CancelJobProposal(ctx context.Context, id int64) error {
 	ctx, cancel := context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
 	defer cancel()
 	err = s.txm.TransactWithContext(ctx, func(ctx context.Context) error {
-		if err = s.orm.CancelJobProposal(ctx, id); err != nil {
+		if err = s.orm.CancelSpec(ctx, spec.ID); err != nil {
 			return err
 		}
 
@@ -460,7 +853,8 @@ func (s *service) CancelJobProposal(ctx context.Context, id int64) error {
 
 		// Send to FMS Client
 		if _, err = fmsClient.CancelledJob(ctx, &pb.CancelledJobRequest{
-			Uuid: jp.RemoteUUID.String(),
+			Uuid:    jp.RemoteUUID.String(),
+			Version: int64(spec.Version),
 		}); err != nil {
 			return err
 		}
@@ -529,8 +923,9 @@ func (s *service) connectFeedManager(mgr FeedsManager, privkey []byte) {
 
 // getCSAPrivateKey gets the server's CSA private key
 func (s *service) getCSAPrivateKey() (privkey []byte, err error) {
-	// Fetch the server's public key
-	keys, err := s.csaKeyStore.GetAll()
+	// Fetch the server's public key. A CSA key retired by a rotation is not
+	// used anymore.
+	keys, err := s.csaKeyStore.GetAllActive()
 	if err != nil {
 		return privkey, err
 	}
@@ -590,3 +985,49 @@ func (s *service) validateJobProposal(jp *JobProposal) error {
 
 	return nil
 }
+
+// getSpec gets a version of the spec of a job proposal, or its latest version
+// if version is 0.
+func (s *service) getSpec(ctx context.Context, jpID int64, version int32) (*JobProposalSpec, error) {
+	if version == 0 {
+		return s.orm.GetLatestSpec(ctx, jpID)
+	}
+
+	return s.orm.GetSpecByVersion(ctx, jpID, version)
+}
+
+// getApprovedSpec gets the approved version of the spec of a job proposal. If
+// version is not 0, it must be the approved version.
+func (s *service) getApprovedSpec(ctx context.Context, jpID int64, version int32) (*JobProposalSpec, error) {
+	specs, err := s.orm.ListSpecsByJobProposalID(ctx, jpID)
+	if err != nil {
+		return nil, errors.Wrap(err, "database error")
+	}
+
+	for i := range specs {
+		if specs[i].Status == SpecStatusApproved && (version == 0 || specs[i].Version == version) {
+			return &specs[i], nil
+		}
+	}
+
+	return nil, errors.New("must be an approved job proposal spec")
+}
+
+// getManagedJobProposal gets a job proposal by the remote FMS uuid, ensuring
+// that it belongs to the feeds manager.
+func (s *service) getManagedJobProposal(ctx context.Context, feedsManagerID int64, remoteUUID uuid.UUID) (*JobProposal, error) {
+	jp, err := s.orm.GetJobProposalByRemoteUUID(ctx, remoteUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(err, "job proposal does not exist")
+		}
+
+		return nil, errors.Wrap(err, "database error")
+	}
+
+	if jp.FeedsManagerID != feedsManagerID {
+		return nil, errors.New("cannot update a job proposal belonging to another feeds manager")
+	}
+
+	return jp, nil
+}
@@ -22,6 +22,8 @@ import (
 	jobmocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	pgmocks "github.com/smartcontractkit/chainlink/core/services/postgres/mocks"
@@ -70,6 +72,8 @@ type TestService struct {
 	fmsClient   *mocks.FeedsManagerClient
 	csaKeystore *ksmocks.CSA
 	ethKeystore *ksmocks.Eth
+	ocrKeystore *ksmocks.OCR
+	p2pKeystore *ksmocks.P2P
 	cfg         *mocks.Config
 	cc          evm.ChainSet
 }
@@ -84,6 +88,8 @@ func setupTestService(t *testing.T) *TestService {
 		fmsClient   = &mocks.FeedsManagerClient{}
 		csaKeystore = &ksmocks.CSA{}
 		ethKeystore = &ksmocks.Eth{}
+		ocrKeystore = &ksmocks.OCR{}
+		p2pKeystore = &ksmocks.P2P{}
 		cfg         = &mocks.Config{}
 	)
 
@@ -97,6 +103,8 @@ func setupTestService(t *testing.T) *TestService {
 			fmsClient,
 			csaKeystore,
 			ethKeystore,
+			ocrKeystore,
+			p2pKeystore,
 			cfg,
 		)
 	})
@@ -104,7 +112,7 @@ func setupTestService(t *testing.T) *TestService {
 	gcfg := configtest.NewTestGeneralConfig(t)
 	gcfg.Overrides.EthereumDisabled = null.BoolFrom(true)
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{GeneralConfig: gcfg})
-	svc := feeds.NewService(orm, jobORM, txm, spawner, csaKeystore, ethKeystore, cfg, cc, logger.TestLogger(t), "1.0.0")
+	svc := feeds.NewService(orm, jobORM, txm, spawner, csaKeystore, ethKeystore, ocrKeystore, p2pKeystore, cfg, cc, logger.TestLogger(t), "1.0.0")
 	svc.SetConnectionsManager(connMgr)
 
 	return &TestService{
@@ -117,6 +125,8 @@ func setupTestService(t *testing.T) *TestService {
 		fmsClient:   fmsClient,
 		csaKeystore: csaKeystore,
 		ethKeystore: ethKeystore,
+		ocrKeystore: ocrKeystore,
+		p2pKeystore: p2pKeystore,
 		cfg:         cfg,
 		cc:          cc,
 	}
@@ -142,7 +152,7 @@ func Test_Service_RegisterManager(t *testing.T) {
 	svc.orm.On("CountManagers", context.Background()).Return(int64(0), nil)
 	svc.orm.On("CreateManager", context.Background(), &ms).
 		Return(id, nil)
-	svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{key}, nil)
+	svc.csaKeystore.On("GetAllActive").Return([]csakey.KeyV2{key}, nil)
 	// ListManagers runs in a goroutine so it might be called.
 	svc.orm.On("ListManagers", context.Background()).Return([]feeds.FeedsManager{ms}, nil).Maybe()
 	svc.connMgr.On("Connect", mock.IsType(feeds.ConnectOpts{}))
@@ -230,12 +240,23 @@ func Test_Service_ProposeJob(t *testing.T) {
 			Status:         "pending",
 			Spec:           TestSpec,
 		}
-		httpTimeout = models.MustMakeDuration(1 * time.Second)
+		externalJobID = uuid.NullUUID{UUID: uuid.NewV4(), Valid: true}
+		httpTimeout   = models.MustMakeDuration(1 * time.Second)
+		latestSpec    = &feeds.JobProposalSpec{ID: 1, Version: 1, JobProposalID: id}
+		spec          = func(version int32) feeds.JobProposalSpec {
+			return feeds.JobProposalSpec{
+				Definition:    TestSpec,
+				Version:       version,
+				Status:        feeds.SpecStatusPending,
+				JobProposalID: id,
+			}
+		}
 	)
 
 	testCases := []struct {
 		name     string
 		proposal feeds.JobProposal
+		version  int32
 		before   func(svc *TestService)
 		wantID   int64
 		wantErr  string
@@ -245,7 +266,13 @@ func Test_Service_ProposeJob(t *testing.T) {
 			before: func(svc *TestService) {
 				svc.cfg.On("DefaultHTTPTimeout").Return(httpTimeout)
 				svc.orm.On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).Return(nil, sql.ErrNoRows)
+				mockTransactWithContext(ctx, svc.txm)
 				svc.orm.On("UpsertJobProposal", ctx, &jp).Return(id, nil)
+				svc.orm.On("CreateSpec", ctx, spec(1)).Return(int64(1), nil)
+				svc.orm.On("GetApprovalPolicy", mock.Anything, jp.FeedsManagerID).Return(nil, sql.ErrNoRows)
+				svc.orm.On("CreatePolicyDecision", mock.Anything, mock.MatchedBy(func(d feeds.ApprovalPolicyDecision) bool {
+					return d.Decision == feeds.ApprovalPolicyDecisionManualApprovalRequired
+				})).Return(int64(1), nil)
 			},
 			wantID:   id,
 			proposal: jp,
@@ -257,14 +284,23 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.
 					On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).
 					Return(&feeds.JobProposal{
+						ID:             id,
 						FeedsManagerID: jp.FeedsManagerID,
 						RemoteUUID:     jp.RemoteUUID,
 						Status:         feeds.JobProposalStatusPending,
 					}, nil)
+				svc.orm.On("GetLatestSpec", ctx, id).Return(latestSpec, nil)
+				mockTransactWithContext(ctx, svc.txm)
 				svc.orm.On("UpsertJobProposal", ctx, &jp).Return(id, nil)
+				svc.orm.On("CreateSpec", ctx, spec(3)).Return(int64(2), nil)
+				svc.orm.On("GetApprovalPolicy", mock.Anything, jp.FeedsManagerID).Return(nil, sql.ErrNoRows)
+				svc.orm.On("CreatePolicyDecision", mock.Anything, mock.MatchedBy(func(d feeds.ApprovalPolicyDecision) bool {
+					return d.Decision == feeds.ApprovalPolicyDecisionManualApprovalRequired
+				})).Return(int64(1), nil)
 			},
 			wantID:   id,
 			proposal: jp,
+			version:  3,
 		},
 		{
 			name: "Updates the status of a rejected job proposal",
@@ -273,11 +309,82 @@ func Test_Service_ProposeJob(t *testing.T) {
 				svc.orm.
 					On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).
 					Return(&feeds.JobProposal{
+						ID:             id,
 						FeedsManagerID: jp.FeedsManagerID,
 						RemoteUUID:     jp.RemoteUUID,
 						Status:         feeds.JobProposalStatusRejected,
 					}, nil)
+				svc.orm.On("GetLatestSpec", ctx, id).Return(latestSpec, nil)
+				mockTransactWithContext(ctx, svc.txm)
+				svc.orm.On("UpsertJobProposal", ctx, &jp).Return(id, nil)
+				svc.orm.On("CreateSpec", ctx, spec(2)).Return(int64(2), nil)
+				svc.orm.On("GetApprovalPolicy", mock.Anything, jp.FeedsManagerID).Return(nil, sql.ErrNoRows)
+				svc.orm.On("CreatePolicyDecision", mock.Anything, mock.MatchedBy(func(d feeds.ApprovalPolicyDecision) bool {
+					return d.Decision == feeds.ApprovalPolicyDecisionManualApprovalRequired
+				})).Return(int64(1), nil)
+			},
+			wantID:   id,
+			proposal: jp,
+		},
+		{
+			name: "Adds a pending update to an approved job proposal",
+			before: func(svc *TestService) {
+				svc.cfg.On("DefaultHTTPTimeout").Return(httpTimeout)
+				svc.orm.
+					On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).
+					Return(&feeds.JobProposal{
+						ID:             id,
+						FeedsManagerID: jp.FeedsManagerID,
+						RemoteUUID:     jp.RemoteUUID,
+						Spec:           "approved spec",
+						Status:         feeds.JobProposalStatusApproved,
+						ExternalJobID:  externalJobID,
+					}, nil)
+				svc.orm.On("GetLatestSpec", ctx, id).Return(latestSpec, nil)
+				mockTransactWithContext(ctx, svc.txm)
+				// The job proposal keeps the approved spec until the update is approved
+				svc.orm.On("UpsertJobProposal", ctx, mock.MatchedBy(func(actual *feeds.JobProposal) bool {
+					return actual.Status == feeds.JobProposalStatusApproved && actual.PendingUpdate && actual.Spec == "approved spec"
+				})).Return(id, nil)
+				svc.orm.On("CreateSpec", ctx, spec(2)).Return(int64(2), nil)
+				svc.orm.On("GetApprovalPolicy", mock.Anything, jp.FeedsManagerID).Return(nil, sql.ErrNoRows)
+				svc.orm.On("ListSpecsByJobProposalID", mock.Anything, id).Return([]feeds.JobProposalSpec{
+					{ID: 1, Definition: TestSpec, Version: 1, Status: feeds.SpecStatusApproved, JobProposalID: id},
+				}, nil)
+				svc.orm.On("CreatePolicyDecision", mock.Anything, mock.MatchedBy(func(d feeds.ApprovalPolicyDecision) bool {
+					return d.Decision == feeds.ApprovalPolicyDecisionManualApprovalRequired &&
+						d.Reason == "fluxmonitor jobs are not auto-approved and no fields are allowed to change in updates"
+				})).Return(int64(1), nil)
+			},
+			wantID:   id,
+			proposal: jp,
+		},
+		{
+			name: "Auto-approves a job type allowed by the approval policy",
+			before: func(svc *TestService) {
+				svc.cfg.On("DefaultHTTPTimeout").Return(httpTimeout)
+				svc.orm.On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).Return(nil, sql.ErrNoRows)
+				mockTransactWithContext(ctx, svc.txm)
 				svc.orm.On("UpsertJobProposal", ctx, &jp).Return(id, nil)
+				svc.orm.On("CreateSpec", ctx, spec(1)).Return(int64(1), nil)
+				svc.orm.On("GetApprovalPolicy", mock.Anything, jp.FeedsManagerID).Return(&feeds.ApprovalPolicy{
+					FeedsManagerID:          jp.FeedsManagerID,
+					AutoApproveJobTypes:     pq.StringArray{feeds.JobTypeFluxMonitor},
+					AutoApproveUpdateFields: pq.StringArray{},
+					RequiredApprovals:       1,
+				}, nil)
+				svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.spawner.On("CreateJob", ctx, mock.Anything, null.StringFrom("example flux monitor spec")).Return(job.Job{}, nil)
+				svc.orm.On("ApproveSpec", ctx, int64(1), mock.Anything).Return(nil)
+				svc.orm.On("CreatePolicyDecision", ctx, mock.MatchedBy(func(d feeds.ApprovalPolicyDecision) bool {
+					return d.Decision == feeds.ApprovalPolicyDecisionAutoApproved &&
+						d.JobProposalSpecID == 1 &&
+						d.Reason == "fluxmonitor jobs are auto-approved"
+				})).Return(int64(1), nil)
+				svc.fmsClient.On("ApprovedJob", ctx, &proto.ApprovedJobRequest{
+					Uuid:    jp.RemoteUUID.String(),
+					Version: 1,
+				}).Return(&proto.ApprovedJobResponse{}, nil)
 			},
 			wantID:   id,
 			proposal: jp,
@@ -316,19 +423,40 @@ func Test_Service_ProposeJob(t *testing.T) {
 			wantErr: "cannot update a job proposal belonging to another feeds manager",
 		},
 		{
-			name:     "ensure an upsert does not occur on an approved job proposal",
+			name:     "version must be greater than the latest version",
 			proposal: jp,
+			version:  1,
 			before: func(svc *TestService) {
 				svc.cfg.On("DefaultHTTPTimeout").Return(httpTimeout)
 				svc.orm.
 					On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).
 					Return(&feeds.JobProposal{
+						ID:             id,
 						FeedsManagerID: jp.FeedsManagerID,
 						RemoteUUID:     jp.RemoteUUID,
-						Status:         feeds.JobProposalStatusApproved,
+						Status:         feeds.JobProposalStatusPending,
 					}, nil)
+				svc.orm.On("GetLatestSpec", ctx, id).Return(latestSpec, nil)
 			},
-			wantErr: "cannot repropose a job that has already been approved",
+			wantErr: "version 1 must be greater than the latest version 1",
+		},
+		{
+			name:     "ensure an upsert does not occur on a deleted job proposal which still has a job",
+			proposal: jp,
+			before: func(svc *TestService) {
+				svc.cfg.On("DefaultHTTPTimeout").Return(httpTimeout)
+				svc.orm.
+					On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).
+					Return(&feeds.JobProposal{
+						ID:             id,
+						FeedsManagerID: jp.FeedsManagerID,
+						RemoteUUID:     jp.RemoteUUID,
+						Status:         feeds.JobProposalStatusDeleted,
+						ExternalJobID:  externalJobID,
+					}, nil)
+				svc.orm.On("GetLatestSpec", ctx, id).Return(latestSpec, nil)
+			},
+			wantErr: "cannot repropose a deleted job until its job has been cancelled",
 		},
 	}
 
@@ -343,7 +471,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 				tc.before(svc)
 			}
 
-			actual, err := svc.ProposeJob(&tc.proposal)
+			actual, err := svc.ProposeJob(&tc.proposal, tc.version)
 
 			if tc.wantErr != "" {
 				require.Error(t, err)
@@ -376,6 +504,9 @@ func Test_Service_SyncNodeInfo(t *testing.T) {
 		nodeVersion = &versioning.NodeVersion{
 			Version: "1.0.0",
 		}
+		ocrKey = cltest.DefaultOCRKey
+		p2pKey = cltest.DefaultP2PKey
+		csaKey = cltest.DefaultCSAKey
 	)
 
 	svc := setupTestService(t)
@@ -383,6 +514,9 @@ func Test_Service_SyncNodeInfo(t *testing.T) {
 	// Mock fetching the information to send
 	svc.orm.On("GetManager", ctx, feedsMgr.ID).Return(feedsMgr, nil)
 	svc.ethKeystore.On("SendingKeys").Return([]ethkey.KeyV2{sendingKey}, nil)
+	svc.ocrKeystore.On("GetAllActive").Return([]ocrkey.KeyV2{ocrKey}, nil)
+	svc.p2pKeystore.On("GetAllActive").Return([]p2pkey.KeyV2{p2pKey}, nil)
+	svc.csaKeystore.On("GetAllActive").Return([]csakey.KeyV2{csaKey}, nil)
 	svc.cfg.On("ChainID").Return(chainID)
 	svc.connMgr.On("GetClient", feedsMgr.ID).Return(svc.fmsClient, nil)
 	svc.connMgr.On("IsConnected", feedsMgr.ID).Return(false, nil)
@@ -396,6 +530,14 @@ func Test_Service_SyncNodeInfo(t *testing.T) {
 		IsBootstrapPeer:    true,
 		BootstrapMultiaddr: multiaddr,
 		Version:            nodeVersion.Version,
+		OcrKeyBundles: []*proto.OCRKeyBundle{{
+			BundleId:              ocrKey.ID(),
+			OnchainSigningAddress: ocrKey.OnChainSigning.Address().String(),
+			OffchainPublicKey:     ocrKey.OffChainSigning.PublicKey().String(),
+			ConfigPublicKey:       ocrkey.ConfigPublicKey(ocrKey.PublicKeyConfig()).String(),
+		}},
+		P2PPeerIds:   []string{p2pKey.PeerID().String()},
+		CsaPublicKey: csaKey.PublicKeyString(),
 	}).Return(&proto.UpdateNodeResponse{}, nil)
 
 	err = svc.SyncNodeInfo(feedsMgr.ID)
@@ -416,7 +558,7 @@ func Test_Service_UpdateFeedsManager(t *testing.T) {
 
 	ctx = mockTransactWithContext(ctx, svc.txm)
 	svc.orm.On("UpdateManager", ctx, mgr).Return(nil)
-	svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{key}, nil)
+	svc.csaKeystore.On("GetAllActive").Return([]csakey.KeyV2{key}, nil)
 	svc.connMgr.On("Disconnect", mgr.ID).Return(nil)
 	svc.connMgr.On("Connect", mock.IsType(feeds.ConnectOpts{})).Return(nil)
 
@@ -424,6 +566,139 @@ func Test_Service_UpdateFeedsManager(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_Service_ReconnectManagers(t *testing.T) {
+	key := cltest.DefaultCSAKey
+
+	var (
+		connected    = feeds.FeedsManager{ID: 1}
+		disconnected = feeds.FeedsManager{ID: 2}
+	)
+
+	svc := setupTestService(t)
+
+	svc.orm.On("ListManagers", context.Background()).Return([]feeds.FeedsManager{connected, disconnected}, nil)
+	svc.csaKeystore.On("GetAllActive").Return([]csakey.KeyV2{key}, nil)
+	svc.connMgr.On("IsConnected", connected.ID).Return(true)
+	svc.connMgr.On("IsConnected", disconnected.ID).Return(false)
+	svc.connMgr.On("Disconnect", connected.ID).Return(nil)
+	svc.connMgr.On("Connect", mock.MatchedBy(func(opts feeds.ConnectOpts) bool {
+		return opts.FeedsManagerID == connected.ID
+	})).Once()
+
+	err := svc.ReconnectManagers()
+	require.NoError(t, err)
+}
+
+func Test_Service_GetApprovalPolicy(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		policy = feeds.ApprovalPolicy{
+			ID:                      1,
+			FeedsManagerID:          1,
+			AutoApproveJobTypes:     pq.StringArray{feeds.JobTypeFluxMonitor},
+			AutoApproveUpdateFields: pq.StringArray{},
+			RequiredApprovals:       1,
+		}
+	)
+
+	t.Run("returns the policy of the feeds manager", func(t *testing.T) {
+		svc := setupTestService(t)
+		svc.orm.On("GetApprovalPolicy", ctx, int64(1)).Return(&policy, nil)
+
+		actual, err := svc.GetApprovalPolicy(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, &policy, actual)
+	})
+
+	t.Run("returns the default policy if none has been set", func(t *testing.T) {
+		svc := setupTestService(t)
+		svc.orm.On("GetApprovalPolicy", ctx, int64(2)).Return(nil, sql.ErrNoRows)
+
+		actual, err := svc.GetApprovalPolicy(ctx, 2)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), actual.FeedsManagerID)
+		assert.Empty(t, actual.AutoApproveJobTypes)
+		assert.Empty(t, actual.AutoApproveUpdateFields)
+		assert.Equal(t, int32(1), actual.RequiredApprovals)
+	})
+}
+
+func Test_Service_UpdateApprovalPolicy(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		policy = feeds.ApprovalPolicy{
+			FeedsManagerID:          1,
+			AutoApproveJobTypes:     pq.StringArray{feeds.JobTypeOffchainReporting},
+			AutoApproveUpdateFields: pq.StringArray{"threshold"},
+			RequiredApprovals:       2,
+		}
+	)
+
+	testCases := []struct {
+		name    string
+		policy  func() feeds.ApprovalPolicy
+		before  func(svc *TestService)
+		wantErr string
+	}{
+		{
+			name:   "success",
+			policy: func() feeds.ApprovalPolicy { return policy },
+			before: func(svc *TestService) {
+				svc.orm.On("GetManager", ctx, policy.FeedsManagerID).Return(&feeds.FeedsManager{ID: 1}, nil)
+				svc.orm.On("UpsertApprovalPolicy", ctx, policy).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "unsupported job type",
+			policy: func() feeds.ApprovalPolicy {
+				p := policy
+				p.AutoApproveJobTypes = pq.StringArray{"webhook"}
+				return p
+			},
+			wantErr: "unsupported job type: webhook",
+		},
+		{
+			name: "no required approvals",
+			policy: func() feeds.ApprovalPolicy {
+				p := policy
+				p.RequiredApprovals = 0
+				return p
+			},
+			wantErr: "at least one approval must be required",
+		},
+		{
+			name:   "feeds manager does not exist",
+			policy: func() feeds.ApprovalPolicy { return policy },
+			before: func(svc *TestService) {
+				svc.orm.On("GetManager", ctx, policy.FeedsManagerID).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: "feeds manager error: sql: no rows in result set",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			svc := setupTestService(t)
+			if tc.before != nil {
+				tc.before(svc)
+			}
+
+			err := svc.UpdateApprovalPolicy(ctx, tc.policy())
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_Service_ListJobProposals(t *testing.T) {
 	t.Parallel()
 
@@ -483,6 +758,7 @@ ds1 -> ds1_parse -> ds1_multiply -> answer1;
 answer1 [type=median index=0];
 """
 `
+		externalJobID   = uuid.Must(uuid.FromString("00000000-0000-0000-0000-000000000001"))
 		pendingProposal = &feeds.JobProposal{
 			ID:             1,
 			RemoteUUID:     uuid.NewV4(),
@@ -490,23 +766,48 @@ answer1 [type=median index=0];
 			FeedsManagerID: 2,
 			Spec:           spec,
 		}
-		cancelledProposal = &feeds.JobProposal{
+		approvedProposal = &feeds.JobProposal{
 			ID:             1,
 			RemoteUUID:     uuid.NewV4(),
-			Status:         feeds.JobProposalStatusCancelled,
+			Status:         feeds.JobProposalStatusApproved,
+			ExternalJobID:  uuid.NullUUID{UUID: externalJobID, Valid: true},
 			FeedsManagerID: 2,
 			Spec:           spec,
+			PendingUpdate:  true,
+		}
+		pendingSpec = &feeds.JobProposalSpec{
+			ID:            20,
+			Definition:    spec,
+			Version:       2,
+			Status:        feeds.SpecStatusPending,
+			JobProposalID: 1,
+		}
+		cancelledSpec = &feeds.JobProposalSpec{
+			ID:            20,
+			Definition:    spec,
+			Version:       2,
+			Status:        feeds.SpecStatusCancelled,
+			JobProposalID: 1,
 		}
 		jb = job.Job{
-			ID: int32(1),
+			ID:            int32(1),
+			ExternalJobID: externalJobID,
+		}
+		requireTwoApprovals = &feeds.ApprovalPolicy{
+			FeedsManagerID:          2,
+			AutoApproveJobTypes:     pq.StringArray{},
+			AutoApproveUpdateFields: pq.StringArray{},
+			RequiredApprovals:       2,
 		}
 	)
 
 	testCases := []struct {
-		name    string
-		before  func(svc *TestService)
-		id      int64
-		wantErr string
+		name     string
+		before   func(svc *TestService)
+		id       int64
+		version  int32
+		approver string
+		wantErr  string
 	}{
 		{
 			name: "pending job success",
@@ -514,6 +815,8 @@ answer1 [type=median index=0];
 			before: func(svc *TestService) {
 				svc.orm.On("GetJobProposal", ctx, pendingProposal.ID).Return(pendingProposal, nil)
 				svc.connMgr.On("GetClient", pendingProposal.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("GetLatestSpec", ctx, pendingProposal.ID).Return(pendingSpec, nil)
+				svc.orm.On("GetApprovalPolicy", ctx, int64(2)).Return(nil, sql.ErrNoRows)
 				ctx = mockTransactWithContext(ctx, svc.txm)
 
 				svc.cfg.On("DefaultHTTPTimeout").Return(models.MakeDuration(1 * time.Minute))
@@ -526,26 +829,32 @@ answer1 [type=median index=0];
 						null.StringFrom("LINK / ETH | version 3 | contract 0x0000000000000000000000000000000000000000"),
 					).
 					Return(jb, nil)
-				svc.orm.On("ApproveJobProposal",
+				svc.orm.On("ApproveSpec",
 					mock.MatchedBy(func(ctx context.Context) bool { return true }),
-					pendingProposal.ID,
-					uuid.Must(uuid.FromString("00000000-0000-0000-0000-000000000001")),
-					feeds.JobProposalStatusApproved,
+					pendingSpec.ID,
+					externalJobID,
 				).Return(nil)
+				svc.orm.On("CreatePolicyDecision", ctx, mock.MatchedBy(func(d feeds.ApprovalPolicyDecision) bool {
+					return d.Decision == feeds.ApprovalPolicyDecisionApproved && d.JobProposalSpecID == 20
+				})).Return(int64(1), nil)
 				svc.fmsClient.On("ApprovedJob",
 					mock.MatchedBy(func(ctx context.Context) bool { return true }),
 					&proto.ApprovedJobRequest{
-						Uuid: pendingProposal.RemoteUUID.String(),
+						Uuid:    pendingProposal.RemoteUUID.String(),
+						Version: int64(pendingSpec.Version),
 					},
 				).Return(&proto.ApprovedJobResponse{}, nil)
 			},
 		},
 		{
-			name: "cancelled job success",
-			id:   cancelledProposal.ID,
+			name:    "cancelled version success",
+			id:      pendingProposal.ID,
+			version: cancelledSpec.Version,
 			before: func(svc *TestService) {
-				svc.orm.On("GetJobProposal", ctx, cancelledProposal.ID).Return(cancelledProposal, nil)
-				svc.connMgr.On("GetClient", cancelledProposal.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("GetJobProposal", ctx, pendingProposal.ID).Return(pendingProposal, nil)
+				svc.connMgr.On("GetClient", pendingProposal.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("GetSpecByVersion", ctx, pendingProposal.ID, cancelledSpec.Version).Return(cancelledSpec, nil)
+				svc.orm.On("GetApprovalPolicy", ctx, int64(2)).Return(nil, sql.ErrNoRows)
 				ctx = mockTransactWithContext(ctx, svc.txm)
 
 				svc.cfg.On("DefaultHTTPTimeout").Return(models.MakeDuration(1 * time.Minute))
@@ -558,20 +867,121 @@ answer1 [type=median index=0];
 						null.StringFrom("LINK / ETH | version 3 | contract 0x0000000000000000000000000000000000000000"),
 					).
 					Return(jb, nil)
-				svc.orm.On("ApproveJobProposal",
+				svc.orm.On("ApproveSpec",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					cancelledSpec.ID,
+					externalJobID,
+				).Return(nil)
+				svc.orm.On("CreatePolicyDecision", ctx, mock.MatchedBy(func(d feeds.ApprovalPolicyDecision) bool {
+					return d.Decision == feeds.ApprovalPolicyDecisionApproved && d.JobProposalSpecID == 20
+				})).Return(int64(1), nil)
+				svc.fmsClient.On("ApprovedJob",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					&proto.ApprovedJobRequest{
+						Uuid:    pendingProposal.RemoteUUID.String(),
+						Version: int64(cancelledSpec.Version),
+					},
+				).Return(&proto.ApprovedJobResponse{}, nil)
+			},
+		},
+		{
+			name: "update of an approved job success",
+			id:   approvedProposal.ID,
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposal", ctx, approvedProposal.ID).Return(approvedProposal, nil)
+				svc.connMgr.On("GetClient", approvedProposal.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("GetLatestSpec", ctx, approvedProposal.ID).Return(pendingSpec, nil)
+				svc.orm.On("GetApprovalPolicy", ctx, int64(2)).Return(nil, sql.ErrNoRows)
+				ctx = mockTransactWithContext(ctx, svc.txm)
+
+				svc.cfg.On("DefaultHTTPTimeout").Return(models.MakeDuration(1 * time.Minute))
+				svc.jobORM.On("FindJobByExternalJobID", ctx, externalJobID).Return(jb, nil)
+				svc.spawner.
+					On("UpdateJob",
+						ctx,
+						jb.ID,
+						mock.MatchedBy(func(j job.Job) bool {
+							return j.ExternalJobID == externalJobID
+						}),
+					).
+					Return(jb, nil)
+				svc.orm.On("ApproveSpec",
 					mock.MatchedBy(func(ctx context.Context) bool { return true }),
-					cancelledProposal.ID,
-					uuid.Must(uuid.FromString("00000000-0000-0000-0000-000000000001")),
-					feeds.JobProposalStatusApproved,
+					pendingSpec.ID,
+					externalJobID,
 				).Return(nil)
+				svc.orm.On("CreatePolicyDecision", ctx, mock.MatchedBy(func(d feeds.ApprovalPolicyDecision) bool {
+					return d.Decision == feeds.ApprovalPolicyDecisionApproved && d.JobProposalSpecID == 20
+				})).Return(int64(1), nil)
 				svc.fmsClient.On("ApprovedJob",
 					mock.MatchedBy(func(ctx context.Context) bool { return true }),
 					&proto.ApprovedJobRequest{
-						Uuid: cancelledProposal.RemoteUUID.String(),
+						Uuid:    approvedProposal.RemoteUUID.String(),
+						Version: int64(pendingSpec.Version),
 					},
 				).Return(&proto.ApprovedJobResponse{}, nil)
 			},
 		},
+		{
+			name:     "records an approval when more approvals are required",
+			id:       pendingProposal.ID,
+			approver: "alice@example.com",
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposal", ctx, pendingProposal.ID).Return(pendingProposal, nil)
+				svc.connMgr.On("GetClient", pendingProposal.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("GetLatestSpec", ctx, pendingProposal.ID).Return(pendingSpec, nil)
+				svc.orm.On("GetApprovalPolicy", ctx, int64(2)).Return(requireTwoApprovals, nil)
+				ctx = mockTransactWithContext(ctx, svc.txm)
+
+				svc.orm.On("CreateApproval", ctx, pendingSpec.ID, "alice@example.com").Return(nil)
+				svc.orm.On("CountApprovals", ctx, pendingSpec.ID).Return(int64(1), nil)
+				svc.orm.On("CreatePolicyDecision", ctx, feeds.ApprovalPolicyDecision{
+					JobProposalSpecID: pendingSpec.ID,
+					Decision:          feeds.ApprovalPolicyDecisionApprovalRecorded,
+					Reason:            "1 of 2 required approvals",
+					Approver:          null.StringFrom("alice@example.com"),
+				}).Return(int64(1), nil)
+			},
+		},
+		{
+			name:     "approves once the required approvals are met",
+			id:       pendingProposal.ID,
+			approver: "bob@example.com",
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposal", ctx, pendingProposal.ID).Return(pendingProposal, nil)
+				svc.connMgr.On("GetClient", pendingProposal.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("GetLatestSpec", ctx, pendingProposal.ID).Return(pendingSpec, nil)
+				svc.orm.On("GetApprovalPolicy", ctx, int64(2)).Return(requireTwoApprovals, nil)
+				ctx = mockTransactWithContext(ctx, svc.txm)
+
+				svc.orm.On("CreateApproval", ctx, pendingSpec.ID, "bob@example.com").Return(nil)
+				svc.orm.On("CountApprovals", ctx, pendingSpec.ID).Return(int64(2), nil)
+				svc.cfg.On("DefaultHTTPTimeout").Return(models.MakeDuration(1 * time.Minute))
+				svc.spawner.On("CreateJob", ctx, mock.Anything, mock.Anything).Return(jb, nil)
+				svc.orm.On("ApproveSpec", ctx, pendingSpec.ID, externalJobID).Return(nil)
+				svc.orm.On("CreatePolicyDecision", ctx, feeds.ApprovalPolicyDecision{
+					JobProposalSpecID: pendingSpec.ID,
+					Decision:          feeds.ApprovalPolicyDecisionApproved,
+					Reason:            "approved by 2 of 2 required users",
+					Approver:          null.StringFrom("bob@example.com"),
+				}).Return(int64(1), nil)
+				svc.fmsClient.On("ApprovedJob", ctx, &proto.ApprovedJobRequest{
+					Uuid:    pendingProposal.RemoteUUID.String(),
+					Version: int64(pendingSpec.Version),
+				}).Return(&proto.ApprovedJobResponse{}, nil)
+			},
+		},
+		{
+			name: "approver is required when more than one approval is required",
+			id:   pendingProposal.ID,
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposal", ctx, pendingProposal.ID).Return(pendingProposal, nil)
+				svc.connMgr.On("GetClient", pendingProposal.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("GetLatestSpec", ctx, pendingProposal.ID).Return(pendingSpec, nil)
+				svc.orm.On("GetApprovalPolicy", ctx, int64(2)).Return(requireTwoApprovals, nil)
+			},
+			wantErr: "approver is required",
+		},
 		{
 			name: "job proposal does not exist",
 			id:   int64(1),
@@ -580,30 +990,57 @@ answer1 [type=median index=0];
 			},
 			wantErr: "job proposal error: Not Found",
 		},
+		{
+			name:    "job proposal spec does not exist",
+			id:      int64(1),
+			version: 3,
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposal", ctx, pendingProposal.ID).Return(pendingProposal, nil)
+				svc.connMgr.On("GetClient", pendingProposal.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("GetSpecByVersion", ctx, pendingProposal.ID, int32(3)).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: "job proposal spec error: sql: no rows in result set",
+		},
 		{
 			name: "FMS client not connected",
 			id:   int64(1),
 			before: func(svc *TestService) {
-				svc.orm.On("GetJobProposal", ctx, cancelledProposal.ID).Return(pendingProposal, nil)
+				svc.orm.On("GetJobProposal", ctx, pendingProposal.ID).Return(pendingProposal, nil)
 				svc.connMgr.On("GetClient", pendingProposal.FeedsManagerID).Return(nil, errors.New("Not Connected"))
 			},
 			wantErr: "fms rpc client is not connected: Not Connected",
 		},
 		{
-			name: "job proposal already approved",
+			name: "job proposal spec already approved",
+			id:   int64(1),
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposal", ctx, approvedProposal.ID).Return(approvedProposal, nil)
+				svc.connMgr.On("GetClient", approvedProposal.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("GetLatestSpec", ctx, approvedProposal.ID).Return(&feeds.JobProposalSpec{
+					ID:            20,
+					Definition:    spec,
+					Version:       1,
+					Status:        feeds.SpecStatusApproved,
+					JobProposalID: 1,
+				}, nil)
+			},
+			wantErr: "must be a pending or cancelled job proposal spec",
+		},
+		{
+			name: "job proposal deleted",
 			id:   int64(1),
 			before: func(svc *TestService) {
 				jp := &feeds.JobProposal{
 					ID:             1,
 					RemoteUUID:     uuid.NewV4(),
-					Status:         feeds.JobProposalStatusApproved,
+					Status:         feeds.JobProposalStatusDeleted,
 					FeedsManagerID: 2,
 					Spec:           spec,
 				}
 				svc.orm.On("GetJobProposal", ctx, jp.ID).Return(jp, nil)
 				svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
 			},
-			wantErr: "must be a pending or cancelled job proposal",
+			wantErr: "cannot approve a deleted job proposal",
 		},
 		{
 			name: "orm error",
@@ -611,6 +1048,8 @@ answer1 [type=median index=0];
 			before: func(svc *TestService) {
 				svc.orm.On("GetJobProposal", ctx, pendingProposal.ID).Return(pendingProposal, nil)
 				svc.connMgr.On("GetClient", pendingProposal.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.orm.On("GetLatestSpec", ctx, pendingProposal.ID).Return(pendingSpec, nil)
+				svc.orm.On("GetApprovalPolicy", ctx, int64(2)).Return(nil, sql.ErrNoRows)
 				ctx = mockTransactWithContext(ctx, svc.txm)
 
 				svc.cfg.On("DefaultHTTPTimeout").Return(models.MakeDuration(1 * time.Minute))
@@ -637,7 +1076,7 @@ answer1 [type=median index=0];
 				tc.before(svc)
 			}
 
-			err := svc.ApproveJobProposal(ctx, tc.id)
+			err := svc.ApproveJobProposal(ctx, tc.id, tc.version, tc.approver)
 
 			if tc.wantErr != "" {
 				require.Error(t, err)
@@ -658,26 +1097,33 @@ func Test_Service_RejectJobProposal(t *testing.T) {
 			Status:         feeds.JobProposalStatusPending,
 			FeedsManagerID: 2,
 		}
+		spec = &feeds.JobProposalSpec{
+			ID:            10,
+			Version:       2,
+			Status:        feeds.SpecStatusPending,
+			JobProposalID: jp.ID,
+		}
 	)
 
 	svc := setupTestService(t)
 
 	svc.orm.On("GetJobProposal", ctx, jp.ID).Return(jp, nil)
+	svc.orm.On("GetSpecByVersion", ctx, jp.ID, spec.Version).Return(spec, nil)
 	ctx = mockTransactWithContext(ctx, svc.txm)
-	svc.orm.On("UpdateJobProposalStatus",
+	svc.orm.On("RejectSpec",
 		mock.MatchedBy(func(ctx context.Context) bool { return true }),
-		jp.ID,
-		feeds.JobProposalStatusRejected,
+		spec.ID,
 	).Return(nil)
 	svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
 	svc.fmsClient.On("RejectedJob",
 		mock.MatchedBy(func(ctx context.Context) bool { return true }),
 		&proto.RejectedJobRequest{
-			Uuid: jp.RemoteUUID.String(),
+			Uuid:    jp.RemoteUUID.String(),
+			Version: int64(spec.Version),
 		},
 	).Return(&proto.RejectedJobResponse{}, nil)
 
-	err := svc.RejectJobProposal(ctx, jp.ID)
+	err := svc.RejectJobProposal(ctx, jp.ID, spec.Version)
 	require.NoError(t, err)
 }
 
@@ -691,6 +1137,10 @@ func Test_Service_CancelJobProposal(t *testing.T) {
 			Status:         feeds.JobProposalStatusApproved,
 			FeedsManagerID: 2,
 		}
+		specs = []feeds.JobProposalSpec{
+			{ID: 11, Version: 2, Status: feeds.SpecStatusPending, JobProposalID: jp.ID},
+			{ID: 10, Version: 1, Status: feeds.SpecStatusApproved, JobProposalID: jp.ID},
+		}
 		j = job.Job{
 			ID:            1,
 			ExternalJobID: externalJobID,
@@ -699,6 +1149,7 @@ func Test_Service_CancelJobProposal(t *testing.T) {
 
 	testCases := []struct {
 		name     string
+		version  int32
 		beforeFn func(svc *TestService)
 		wantErr  string
 	}{
@@ -708,10 +1159,11 @@ func Test_Service_CancelJobProposal(t *testing.T) {
 				ctx := mockTransactWithContext(context.Background(), svc.txm)
 
 				svc.orm.On("GetJobProposal", ctx, jp.ID).Return(jp, nil)
+				svc.orm.On("ListSpecsByJobProposalID", ctx, jp.ID).Return(specs, nil)
 				svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
-				svc.orm.On("CancelJobProposal",
+				svc.orm.On("CancelSpec",
 					mock.MatchedBy(func(ctx context.Context) bool { return true }),
-					jp.ID,
+					specs[1].ID,
 				).Return(nil)
 				svc.jobORM.On("FindJobByExternalJobID", ctx, externalJobID).Return(j, nil)
 				svc.spawner.On("DeleteJob", ctx, j.ID).Return(nil)
@@ -719,28 +1171,26 @@ func Test_Service_CancelJobProposal(t *testing.T) {
 				svc.fmsClient.On("CancelledJob",
 					mock.MatchedBy(func(ctx context.Context) bool { return true }),
 					&proto.CancelledJobRequest{
-						Uuid: jp.RemoteUUID.String(),
+						Uuid:    jp.RemoteUUID.String(),
+						Version: int64(specs[1].Version),
 					},
 				).Return(&proto.CancelledJobResponse{}, nil)
 			},
 		},
 		{
-			name: "must be an approved job proposal",
+			name:    "must be an approved job proposal spec",
+			version: 2,
 			beforeFn: func(svc *TestService) {
-				svc.orm.On("GetJobProposal", context.Background(), jp.ID).Return(&feeds.JobProposal{
-					ID:             1,
-					ExternalJobID:  uuid.NullUUID{UUID: externalJobID, Valid: true},
-					RemoteUUID:     externalJobID,
-					Status:         feeds.JobProposalStatusPending,
-					FeedsManagerID: 2,
-				}, nil)
+				svc.orm.On("GetJobProposal", context.Background(), jp.ID).Return(jp, nil)
+				svc.orm.On("ListSpecsByJobProposalID", context.Background(), jp.ID).Return(specs, nil)
 			},
-			wantErr: "must be a approved job proposal",
+			wantErr: "must be an approved job proposal spec",
 		},
 		{
 			name: "rpc client not connected",
 			beforeFn: func(svc *TestService) {
 				svc.orm.On("GetJobProposal", context.Background(), jp.ID).Return(jp, nil)
+				svc.orm.On("ListSpecsByJobProposalID", context.Background(), jp.ID).Return(specs, nil)
 				svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(nil, errors.New("not connected"))
 			},
 			wantErr: "fms rpc client: not connected",
@@ -756,7 +1206,7 @@ func Test_Service_CancelJobProposal(t *testing.T) {
 
 			tc.beforeFn(svc)
 
-			err := svc.CancelJobProposal(context.Background(), jp.ID)
+			err := svc.CancelJobProposal(context.Background(), jp.ID, tc.version)
 
 			if tc.wantErr != "" {
 				require.Error(t, err)
@@ -770,6 +1220,101 @@ func Test_Service_CancelJobProposal(t *testing.T) {
 	}
 }
 
+func Test_Service_RevokeJob(t *testing.T) {
+	var (
+		ctx = context.Background()
+		jp  = &feeds.JobProposal{
+			ID:             1,
+			RemoteUUID:     uuid.NewV4(),
+			Status:         feeds.JobProposalStatusPending,
+			FeedsManagerID: 2,
+		}
+	)
+
+	testCases := []struct {
+		name           string
+		feedsManagerID int64
+		before         func(svc *TestService)
+		wantErr        string
+	}{
+		{
+			name:           "success",
+			feedsManagerID: jp.FeedsManagerID,
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).Return(jp, nil)
+				mockTransactWithContext(ctx, svc.txm)
+				svc.orm.On("RevokePendingSpecs", ctx, jp.ID).Return(int64(1), nil)
+			},
+		},
+		{
+			name:           "nothing to revoke",
+			feedsManagerID: jp.FeedsManagerID,
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).Return(jp, nil)
+				mockTransactWithContext(ctx, svc.txm)
+				svc.orm.On("RevokePendingSpecs", ctx, jp.ID).Return(int64(0), nil)
+			},
+			wantErr: "could not revoke job proposal: job proposal has no pending version",
+		},
+		{
+			name:           "belongs to another feeds manager",
+			feedsManagerID: 3,
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).Return(jp, nil)
+			},
+			wantErr: "cannot update a job proposal belonging to another feeds manager",
+		},
+		{
+			name:           "does not exist",
+			feedsManagerID: jp.FeedsManagerID,
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: "job proposal does not exist: sql: no rows in result set",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := setupTestService(t)
+			tc.before(svc)
+
+			id, err := svc.RevokeJob(ctx, tc.feedsManagerID, jp.RemoteUUID)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, jp.ID, id)
+			}
+		})
+	}
+}
+
+func Test_Service_DeleteJob(t *testing.T) {
+	var (
+		ctx = context.Background()
+		jp  = &feeds.JobProposal{
+			ID:             1,
+			RemoteUUID:     uuid.NewV4(),
+			Status:         feeds.JobProposalStatusApproved,
+			FeedsManagerID: 2,
+		}
+	)
+
+	svc := setupTestService(t)
+
+	svc.orm.On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).Return(jp, nil)
+	mockTransactWithContext(ctx, svc.txm)
+	svc.orm.On("DeleteProposal", ctx, jp.ID).Return(nil)
+
+	id, err := svc.DeleteJob(ctx, jp.FeedsManagerID, jp.RemoteUUID)
+	require.NoError(t, err)
+	assert.Equal(t, jp.ID, id)
+}
+
 func Test_Service_IsJobManaged(t *testing.T) {
 	t.Parallel()
 
@@ -788,50 +1333,75 @@ func Test_Service_UpdateJobProposalSpec(t *testing.T) {
 	var (
 		ctx         = context.Background()
 		proposalID  = int64(1)
+		specID      = int64(10)
 		updatedSpec = "updated spec"
 	)
 
 	testCases := []struct {
-		name       string
-		before     func(svc *TestService)
-		proposalID int64
-		wantErr    string
+		name    string
+		before  func(svc *TestService)
+		version int32
+		wantErr string
 	}{
 		{
 			name: "success",
 			before: func(svc *TestService) {
-				jp := &feeds.JobProposal{
-					ID:         proposalID,
-					RemoteUUID: uuid.NewV4(),
-					Status:     feeds.JobProposalStatusPending,
-					Spec:       "spec",
+				spec := &feeds.JobProposalSpec{
+					ID:            specID,
+					Definition:    "spec",
+					Version:       1,
+					Status:        feeds.SpecStatusPending,
+					JobProposalID: proposalID,
+				}
+
+				svc.orm.
+					On("GetLatestSpec", ctx, proposalID).
+					Return(spec, nil)
+				mockTransactWithContext(ctx, svc.txm)
+				svc.orm.On("UpdateSpecDefinition",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					specID,
+					updatedSpec,
+				).Return(nil)
+			},
+		},
+		{
+			name:    "success with version",
+			version: 2,
+			before: func(svc *TestService) {
+				spec := &feeds.JobProposalSpec{
+					ID:            specID,
+					Definition:    "spec",
+					Version:       2,
+					Status:        feeds.SpecStatusCancelled,
+					JobProposalID: proposalID,
 				}
 
 				svc.orm.
-					On("GetJobProposal", ctx, proposalID).
-					Return(jp, nil)
-				svc.orm.On("UpdateJobProposalSpec",
+					On("GetSpecByVersion", ctx, proposalID, int32(2)).
+					Return(spec, nil)
+				mockTransactWithContext(ctx, svc.txm)
+				svc.orm.On("UpdateSpecDefinition",
 					mock.MatchedBy(func(ctx context.Context) bool { return true }),
-					proposalID,
+					specID,
 					updatedSpec,
 				).Return(nil)
 			},
-			proposalID: proposalID,
 		},
 		{
 			name: "does not exist",
 			before: func(svc *TestService) {
 				svc.orm.
-					On("GetJobProposal", ctx, proposalID).
+					On("GetLatestSpec", ctx, proposalID).
 					Return(nil, sql.ErrNoRows)
 			},
-			wantErr: "job proposal does not exist: sql: no rows in result set",
+			wantErr: "job proposal spec does not exist: sql: no rows in result set",
 		},
 		{
 			name: "other get errors",
 			before: func(svc *TestService) {
 				svc.orm.
-					On("GetJobProposal", ctx, proposalID).
+					On("GetLatestSpec", ctx, proposalID).
 					Return(nil, errors.New("other db error"))
 			},
 			wantErr: "database error: other db error",
@@ -839,18 +1409,19 @@ func Test_Service_UpdateJobProposalSpec(t *testing.T) {
 		{
 			name: "cannot edit",
 			before: func(svc *TestService) {
-				jp := &feeds.JobProposal{
-					ID:         1,
-					RemoteUUID: uuid.NewV4(),
-					Status:     feeds.JobProposalStatusApproved,
-					Spec:       "spec",
+				spec := &feeds.JobProposalSpec{
+					ID:            specID,
+					Definition:    "spec",
+					Version:       1,
+					Status:        feeds.SpecStatusApproved,
+					JobProposalID: proposalID,
 				}
 
 				svc.orm.
-					On("GetJobProposal", ctx, proposalID).
-					Return(jp, nil)
+					On("GetLatestSpec", ctx, proposalID).
+					Return(spec, nil)
 			},
-			wantErr: "must be a pending or cancelled job proposal",
+			wantErr: "must be a pending or cancelled job proposal spec",
 		},
 	}
 
@@ -865,7 +1436,7 @@ func Test_Service_UpdateJobProposalSpec(t *testing.T) {
 				tc.before(svc)
 			}
 
-			err := svc.UpdateJobProposalSpec(ctx, proposalID, updatedSpec)
+			err := svc.UpdateJobProposalSpec(ctx, proposalID, tc.version, updatedSpec)
 			if tc.wantErr != "" {
 				assert.EqualError(t, err, tc.wantErr)
 			} else {
@@ -892,7 +1463,7 @@ func Test_Service_StartStop(t *testing.T) {
 
 	svc := setupTestService(t)
 
-	svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{key}, nil)
+	svc.csaKeystore.On("GetAllActive").Return([]csakey.KeyV2{key}, nil)
 	svc.orm.On("ListManagers", context.Background()).Return([]feeds.FeedsManager{mgr}, nil)
 	svc.connMgr.On("IsConnected", mgr.ID).Return(false)
 	svc.connMgr.On("Connect", mock.IsType(feeds.ConnectOpts{}))
@@ -1,6 +1,7 @@
 package fluxmonitorv2
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -70,7 +71,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.Service, err erro
 		NewORM(d.db, chain.TxManager(), strategy),
 		d.jobORM,
 		d.pipelineORM,
-		NewKeyStore(d.ethKeyStore),
+		NewKeyStore(d.ethKeyStore, common.HexToAddress(chain.Config().FundingTreasuryAddress())),
 		chain.Client(),
 		chain.LogBroadcaster(),
 		d.pipelineRunner,
@@ -2,6 +2,7 @@ package fluxmonitorv2
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 )
@@ -17,9 +18,38 @@ type KeyStoreInterface interface {
 // KeyStore implements KeyStoreInterface
 type KeyStore struct {
 	keystore.Eth
+	treasury common.Address
 }
 
-// NewKeyStore initializes a new keystore
-func NewKeyStore(ks keystore.Eth) *KeyStore {
-	return &KeyStore{ks}
+// NewKeyStore initializes a new keystore. The funding treasury key of the
+// chain, if it has one, is never used to submit answers.
+func NewKeyStore(ks keystore.Eth, treasury common.Address) *KeyStore {
+	return &KeyStore{ks, treasury}
+}
+
+// GetRoundRobinAddress returns the next sending key to use, out of the
+// whitelist if one is given, leaving out the funding treasury key
+func (ks *KeyStore) GetRoundRobinAddress(whitelist ...common.Address) (common.Address, error) {
+	if ks.treasury == (common.Address{}) {
+		return ks.Eth.GetRoundRobinAddress(whitelist...)
+	}
+	if len(whitelist) == 0 {
+		keys, err := ks.Eth.SendingKeys()
+		if err != nil {
+			return common.Address{}, err
+		}
+		for _, k := range keys {
+			whitelist = append(whitelist, k.Address.Address())
+		}
+	}
+	var addresses []common.Address
+	for _, address := range whitelist {
+		if address != ks.treasury {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		return common.Address{}, errors.New("no keys available")
+	}
+	return ks.Eth.GetRoundRobinAddress(addresses...)
 }
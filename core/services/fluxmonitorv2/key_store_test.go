@@ -3,6 +3,7 @@ package fluxmonitorv2_test
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
@@ -15,7 +16,7 @@ func TestKeyStore_SendingKeys(t *testing.T) {
 	db := pgtest.NewGormDB(t)
 	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
 
-	ks := fluxmonitorv2.NewKeyStore(ethKeyStore)
+	ks := fluxmonitorv2.NewKeyStore(ethKeyStore, common.Address{})
 
 	key, err := ethKeyStore.Create(&cltest.FixtureChainID)
 	require.NoError(t, err)
@@ -34,10 +35,31 @@ func TestKeyStore_GetRoundRobinAddress(t *testing.T) {
 
 	_, k0Address := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-	ks := fluxmonitorv2.NewKeyStore(ethKeyStore)
+	ks := fluxmonitorv2.NewKeyStore(ethKeyStore, common.Address{})
 
 	// Gets the only address in the keystore
 	addr, err := ks.GetRoundRobinAddress()
 	require.NoError(t, err)
 	require.Equal(t, k0Address, addr)
 }
+
+func TestKeyStore_GetRoundRobinAddress_SkipsTreasury(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, treasury := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, k1Address := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	ks := fluxmonitorv2.NewKeyStore(ethKeyStore, treasury)
+
+	for i := 0; i < 3; i++ {
+		addr, err := ks.GetRoundRobinAddress()
+		require.NoError(t, err)
+		require.Equal(t, k1Address, addr)
+	}
+
+	_, err := ks.GetRoundRobinAddress(treasury)
+	require.Error(t, err)
+}
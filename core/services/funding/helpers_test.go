@@ -0,0 +1,6 @@
+package funding
+
+// TopUp runs a single top-up check of the Manager
+func TopUp(m Manager) {
+	m.(*manager).topUp()
+}
@@ -0,0 +1,225 @@
+package funding
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// dailyCapPeriod is the rolling window over which FundingDailyCapWei applies
+const dailyCapPeriod = 24 * time.Hour
+
+// balanceFetchTimeout bounds the call checking the balance of a key right
+// before it is topped up
+const balanceFetchTimeout = 15 * time.Second
+
+type (
+	// Manager tops up the sending keys of a chain from its treasury key when
+	// their balance drops below their funding minimum balance
+	Manager interface {
+		httypes.HeadTrackable
+		service.Service
+	}
+
+	// Config is the chain scoped config used by Manager
+	Config interface {
+		EvmGasLimitTransfer() uint64
+		FundingDailyCapWei() *big.Int
+		FundingTreasuryAddress() string
+		KeySpecificFundingMinBalanceWei(addr common.Address) *big.Int
+		KeySpecificFundingTopUpAmountWei(addr common.Address) *big.Int
+	}
+
+	// KeyStore is the subset of keystore.Eth used by Manager
+	KeyStore interface {
+		GetStatesForChain(chainID *big.Int) ([]ethkey.State, error)
+	}
+
+	// BalanceReader returns the last known balance of a key, e.g.
+	// services.BalanceMonitor
+	BalanceReader interface {
+		GetEthBalance(address common.Address) *assets.Eth
+	}
+
+	// TxTrigger wakes up the sending of queued eth_txes, e.g.
+	// bulletprooftxmanager.TxManager
+	TxTrigger interface {
+		Trigger(address common.Address)
+	}
+
+	manager struct {
+		utils.StartStopOnce
+		chainID     big.Int
+		orm         ORM
+		config      Config
+		keyStore    KeyStore
+		balances    BalanceReader
+		ethClient   eth.Client
+		txm         TxTrigger
+		logger      logger.Logger
+		sleeperTask utils.SleeperTask
+	}
+)
+
+var _ Manager = (*manager)(nil)
+
+// NewManager returns a new funding Manager for the chain. It relies on
+// balances to notice the keys that need a top-up, and checks their balance
+// on chain again before sending one. It does nothing while the chain has no
+// FundingTreasuryAddress.
+func NewManager(chainID big.Int, orm ORM, config Config, keyStore KeyStore, balances BalanceReader, ethClient eth.Client, txm TxTrigger, lggr logger.Logger) Manager {
+	m := &manager{
+		chainID:   chainID,
+		orm:       orm,
+		config:    config,
+		keyStore:  keyStore,
+		balances:  balances,
+		ethClient: ethClient,
+		txm:       txm,
+		logger:    lggr.Named("FundingManager"),
+	}
+	m.sleeperTask = utils.NewSleeperTask(utils.SleeperTaskFuncWorker(m.topUp))
+	return m
+}
+
+func (m *manager) Start() error {
+	return m.StartOnce("FundingManager", func() error { return nil })
+}
+
+func (m *manager) Close() error {
+	return m.StopOnce("FundingManager", func() error {
+		return m.sleeperTask.Stop()
+	})
+}
+
+// OnNewLongestChain checks whether any key needs a top-up
+func (m *manager) OnNewLongestChain(_ context.Context, _ eth.Head) {
+	m.IfStarted(m.sleeperTask.WakeUp)
+}
+
+func (m *manager) topUp() {
+	if m.config.FundingTreasuryAddress() == "" {
+		return
+	}
+	treasury := common.HexToAddress(m.config.FundingTreasuryAddress())
+	states, err := m.keyStore.GetStatesForChain(&m.chainID)
+	if err != nil {
+		m.logger.Errorw("Failed to load keys", "err", err)
+		return
+	}
+	if !hasKey(states, treasury) {
+		m.logger.Errorw("Treasury key is not a key of this chain, no top-ups will be sent", "treasuryAddress", treasury)
+		return
+	}
+
+	// remaining is the treasury balance left after the top-ups queued so far,
+	// or nil if it is not known
+	var remaining *big.Int
+	if balance := m.balances.GetEthBalance(treasury); balance != nil {
+		remaining = new(big.Int).Set(balance.ToInt())
+	}
+	var spent *big.Int
+	var queued bool
+	for _, state := range states {
+		address := state.Address.Address()
+		if address == treasury || state.IsFunding {
+			continue
+		}
+		minBalance := m.config.KeySpecificFundingMinBalanceWei(address)
+		amount := m.config.KeySpecificFundingTopUpAmountWei(address)
+		if minBalance == nil || amount == nil || amount.Sign() <= 0 {
+			continue
+		}
+		if known := m.balances.GetEthBalance(address); known == nil || known.ToInt().Cmp(minBalance) >= 0 {
+			continue
+		}
+		lggr := m.logger.With("address", address, "minBalance", minBalance, "amount", amount)
+
+		pending, err := m.orm.HasPendingTransferTo(m.chainID, address)
+		if err != nil {
+			lggr.Errorw("Failed to check for pending top-ups", "err", err)
+			continue
+		} else if pending {
+			lggr.Debug("Key is below its minimum balance, but a top-up is already pending")
+			continue
+		}
+		// The balance monitor may not have seen the last top-up confirm yet
+		balance, err := m.balanceAt(address)
+		if err != nil {
+			lggr.Errorw("Failed to fetch balance", "err", err)
+			continue
+		} else if balance.Cmp(minBalance) >= 0 {
+			continue
+		}
+
+		if dailyCap := m.config.FundingDailyCapWei(); dailyCap != nil {
+			if spent == nil {
+				if spent, err = m.orm.SpentSince(m.chainID, time.Now().Add(-dailyCapPeriod)); err != nil {
+					lggr.Errorw("Failed to load the amount spent on top-ups", "err", err)
+					return
+				}
+			}
+			if new(big.Int).Add(spent, amount).Cmp(dailyCap) > 0 {
+				lggr.Warnw("Key is below its minimum balance, but topping it up would exceed the daily cap", "balance", balance, "spent", spent, "dailyCap", dailyCap)
+				continue
+			}
+		}
+		if remaining != nil && remaining.Cmp(amount) < 0 {
+			lggr.Warnw("Key is below its minimum balance, but the treasury key cannot afford to top it up", "balance", balance, "treasuryAddress", treasury, "treasuryBalance", remaining)
+			continue
+		}
+
+		t := Transfer{
+			EVMChainID:  *utils.NewBig(&m.chainID),
+			FromAddress: treasury,
+			ToAddress:   address,
+			Amount:      toEth(amount),
+			Balance:     toEth(balance),
+			MinBalance:  toEth(minBalance),
+		}
+		if err = m.orm.CreateTransfer(&t, m.config.EvmGasLimitTransfer()); err != nil {
+			lggr.Errorw("Failed to queue top-up", "err", err)
+			continue
+		}
+		lggr.Infow("Queued top-up", "balance", balance, "treasuryAddress", treasury, "ethTxID", t.EthTxID.Int64)
+		queued = true
+		if spent != nil {
+			spent.Add(spent, amount)
+		}
+		if remaining != nil {
+			remaining.Sub(remaining, amount)
+		}
+	}
+	if queued {
+		m.txm.Trigger(treasury)
+	}
+}
+
+func (m *manager) balanceAt(address common.Address) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), balanceFetchTimeout)
+	defer cancel()
+	return m.ethClient.BalanceAt(ctx, address, nil)
+}
+
+func hasKey(states []ethkey.State, address common.Address) bool {
+	for _, state := range states {
+		if state.Address.Address() == address {
+			return true
+		}
+	}
+	return false
+}
+
+func toEth(wei *big.Int) assets.Eth {
+	return assets.Eth(*new(big.Int).Set(wei))
+}
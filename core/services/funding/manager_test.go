@@ -0,0 +1,141 @@
+package funding_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/config/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/funding"
+	"github.com/smartcontractkit/chainlink/core/services/funding/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type balances map[common.Address]*assets.Eth
+
+func (b balances) GetEthBalance(address common.Address) *assets.Eth {
+	return b[address]
+}
+
+func TestManager_TopUp(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(4)
+	treasury := cltest.NewAddress()
+	low := cltest.NewAddress()
+	high := cltest.NewAddress()
+	unconfigured := cltest.NewAddress()
+	minBalance := big.NewInt(1000)
+	amount := big.NewInt(5000)
+
+	states := []ethkey.State{
+		{Address: ethkey.EIP55AddressFromAddress(treasury), EVMChainID: *utils.NewBig(chainID)},
+		{Address: ethkey.EIP55AddressFromAddress(low), EVMChainID: *utils.NewBig(chainID)},
+		{Address: ethkey.EIP55AddressFromAddress(high), EVMChainID: *utils.NewBig(chainID)},
+		{Address: ethkey.EIP55AddressFromAddress(unconfigured), EVMChainID: *utils.NewBig(chainID)},
+	}
+	bals := balances{
+		treasury:     assets.NewEth(100000),
+		low:          assets.NewEth(999),
+		high:         assets.NewEth(1000),
+		unconfigured: assets.NewEth(0),
+	}
+
+	setup := func(t *testing.T, keyStates []ethkey.State, dailyCap *big.Int) (funding.Manager, *mocks.ORM, *bptxmmocks.TxManager, func()) {
+		orm := new(mocks.ORM)
+		orm.Test(t)
+		cfg := new(evmmocks.ChainScopedConfig)
+		cfg.Test(t)
+		cfg.On("FundingTreasuryAddress").Return(treasury.Hex())
+		cfg.On("FundingDailyCapWei").Return(dailyCap).Maybe()
+		cfg.On("EvmGasLimitTransfer").Return(uint64(21000)).Maybe()
+		for _, address := range []common.Address{low, high} {
+			cfg.On("KeySpecificFundingMinBalanceWei", address).Return(minBalance)
+			cfg.On("KeySpecificFundingTopUpAmountWei", address).Return(amount)
+		}
+		cfg.On("KeySpecificFundingMinBalanceWei", unconfigured).Return(nil)
+		cfg.On("KeySpecificFundingTopUpAmountWei", unconfigured).Return(nil)
+		ks := new(ksmocks.Eth)
+		ks.Test(t)
+		ks.On("GetStatesForChain", chainID).Return(keyStates, nil)
+		ethClient := cltest.NewEthClientMock(t)
+		txm := new(bptxmmocks.TxManager)
+		txm.Test(t)
+
+		m := funding.NewManager(*chainID, orm, cfg, ks, bals, ethClient, txm, logger.TestLogger(t))
+		return m, orm, txm, func() {
+			ethClient.On("BalanceAt", mock.Anything, low, (*big.Int)(nil)).Return(big.NewInt(999), nil)
+		}
+	}
+
+	t.Run("tops up keys below their minimum balance from the treasury key", func(t *testing.T) {
+		m, orm, txm, expectBalanceAt := setup(t, states, nil)
+		expectBalanceAt()
+		orm.On("HasPendingTransferTo", *chainID, low).Return(false, nil).Once()
+		orm.On("CreateTransfer", mock.MatchedBy(func(tr *funding.Transfer) bool {
+			return tr.FromAddress == treasury && tr.ToAddress == low && tr.Amount.ToInt().Cmp(amount) == 0 &&
+				tr.Balance.ToInt().Int64() == 999 && tr.MinBalance.ToInt().Cmp(minBalance) == 0
+		}), uint64(21000)).Return(nil).Once()
+		txm.On("Trigger", treasury).Once()
+
+		funding.TopUp(m)
+
+		orm.AssertExpectations(t)
+		txm.AssertExpectations(t)
+	})
+
+	t.Run("does not top up a key with a pending top-up", func(t *testing.T) {
+		m, orm, txm, _ := setup(t, states, nil)
+		orm.On("HasPendingTransferTo", *chainID, low).Return(true, nil).Once()
+
+		funding.TopUp(m)
+
+		orm.AssertExpectations(t)
+		txm.AssertNotCalled(t, "Trigger", mock.Anything)
+	})
+
+	t.Run("does not exceed the daily cap", func(t *testing.T) {
+		m, orm, txm, expectBalanceAt := setup(t, states, big.NewInt(9000))
+		expectBalanceAt()
+		orm.On("HasPendingTransferTo", *chainID, low).Return(false, nil).Once()
+		orm.On("SpentSince", *chainID, mock.Anything).Return(big.NewInt(4001), nil).Once()
+
+		funding.TopUp(m)
+
+		orm.AssertExpectations(t)
+		orm.AssertNotCalled(t, "CreateTransfer", mock.Anything, mock.Anything)
+		txm.AssertNotCalled(t, "Trigger", mock.Anything)
+	})
+
+	t.Run("does nothing without a treasury key", func(t *testing.T) {
+		orm := new(mocks.ORM)
+		orm.Test(t)
+		cfg := new(evmmocks.ChainScopedConfig)
+		cfg.Test(t)
+		cfg.On("FundingTreasuryAddress").Return("")
+		ks := new(ksmocks.Eth)
+		ks.Test(t)
+
+		m := funding.NewManager(*chainID, orm, cfg, ks, bals, cltest.NewEthClientMock(t), new(bptxmmocks.TxManager), logger.TestLogger(t))
+		funding.TopUp(m)
+
+		ks.AssertNotCalled(t, "GetStatesForChain", mock.Anything)
+	})
+
+	t.Run("does nothing if the treasury key is not a key of the chain", func(t *testing.T) {
+		m, orm, txm, _ := setup(t, states[1:], nil)
+
+		funding.TopUp(m)
+
+		orm.AssertNotCalled(t, "HasPendingTransferTo", mock.Anything, mock.Anything)
+		txm.AssertNotCalled(t, "Trigger", mock.Anything)
+	})
+}
@@ -0,0 +1,30 @@
+package funding
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Transfer is the audit log entry of a top-up sent by the treasury key of a
+// chain to one of its sending keys
+type Transfer struct {
+	ID          int64
+	EVMChainID  utils.Big `gorm:"column:evm_chain_id"`
+	EthTxID     null.Int
+	FromAddress common.Address
+	ToAddress   common.Address
+	Amount      assets.Eth
+	// Balance is the last known balance of the topped up key, which was
+	// below MinBalance
+	Balance    assets.Eth
+	MinBalance assets.Eth
+	CreatedAt  time.Time
+	// EthTxState is the state of the eth_tx sending the top-up, or empty if it
+	// has since been reaped
+	EthTxState null.String
+}
@@ -0,0 +1,100 @@
+package funding
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+//go:generate mockery --name ORM --output ./mocks/ --case=underscore
+
+type ORM interface {
+	CreateTransfer(t *Transfer, gasLimit uint64) error
+	HasPendingTransferTo(chainID big.Int, to common.Address) (bool, error)
+	ListTransfers(offset, limit int) ([]Transfer, int, error)
+	SpentSince(chainID big.Int, since time.Time) (*big.Int, error)
+}
+
+type orm struct {
+	db *gorm.DB
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *gorm.DB) ORM {
+	return &orm{db}
+}
+
+// CreateTransfer queues the eth_tx sending the top-up and records it in the
+// audit log, atomically
+func (o *orm) CreateTransfer(t *Transfer, gasLimit uint64) error {
+	return postgres.GormTransactionWithDefaultContext(o.db, func(tx *gorm.DB) error {
+		etx, err := bulletprooftxmanager.SendEther(tx, t.EVMChainID.ToInt(), t.FromAddress, t.ToAddress, t.Amount, gasLimit)
+		if err != nil {
+			return errors.Wrap(err, "CreateTransfer failed to insert eth_tx")
+		}
+		t.EthTxID.SetValid(etx.ID)
+		t.EthTxState.SetValid(string(etx.State))
+		t.CreatedAt = time.Now()
+		err = tx.Raw(`INSERT INTO eth_funding_transfers (evm_chain_id, eth_tx_id, from_address, to_address, amount, balance, min_balance, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?) RETURNING id`, t.EVMChainID, t.EthTxID, t.FromAddress, t.ToAddress, t.Amount, t.Balance, t.MinBalance, t.CreatedAt).Scan(&t.ID).Error
+		return errors.Wrap(err, "CreateTransfer failed to insert eth_funding_transfer")
+	})
+}
+
+// HasPendingTransferTo returns true if a top-up to the key has been queued or
+// sent but not confirmed yet
+func (o *orm) HasPendingTransferTo(chainID big.Int, to common.Address) (exists bool, err error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	err = o.db.WithContext(ctx).Raw(`SELECT EXISTS (
+	SELECT 1 FROM eth_funding_transfers
+	JOIN eth_txes ON eth_txes.id = eth_funding_transfers.eth_tx_id
+	WHERE eth_funding_transfers.evm_chain_id = ? AND eth_funding_transfers.to_address = ?
+	AND eth_txes.state IN ('unstarted', 'in_progress', 'unconfirmed')
+)`, chainID.String(), to).Scan(&exists).Error
+	return exists, errors.Wrap(err, "HasPendingTransferTo failed")
+}
+
+// SpentSince returns the total amount of the top-ups on the chain since the
+// given time, leaving out those that failed to be sent
+func (o *orm) SpentSince(chainID big.Int, since time.Time) (*big.Int, error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	var spent string
+	err := o.db.WithContext(ctx).Raw(`SELECT COALESCE(SUM(amount), 0)::text FROM eth_funding_transfers
+LEFT JOIN eth_txes ON eth_txes.id = eth_funding_transfers.eth_tx_id
+WHERE eth_funding_transfers.evm_chain_id = ? AND eth_funding_transfers.created_at >= ?
+AND (eth_txes.state IS NULL OR eth_txes.state <> 'fatal_error')`, chainID.String(), since).Scan(&spent).Error
+	if err != nil {
+		return nil, errors.Wrap(err, "SpentSince failed")
+	}
+	amount, ok := new(big.Int).SetString(spent, 10)
+	if !ok {
+		return nil, errors.Errorf("SpentSince failed to parse amount %q", spent)
+	}
+	return amount, nil
+}
+
+// ListTransfers returns a page of the audit log of every chain, most recent
+// first, and the total number of transfers
+func (o *orm) ListTransfers(offset, limit int) (transfers []Transfer, count int, err error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	db := o.db.WithContext(ctx)
+	var total int64
+	if err = db.Raw(`SELECT count(*) FROM eth_funding_transfers`).Scan(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "ListTransfers failed to count transfers")
+	}
+	err = db.Raw(`SELECT eth_funding_transfers.*, eth_txes.state AS eth_tx_state FROM eth_funding_transfers
+LEFT JOIN eth_txes ON eth_txes.id = eth_funding_transfers.eth_tx_id
+ORDER BY eth_funding_transfers.created_at DESC, eth_funding_transfers.id DESC
+OFFSET ? LIMIT ?`, offset, limit).Scan(&transfers).Error
+	return transfers, int(total), errors.Wrap(err, "ListTransfers failed to load transfers")
+}
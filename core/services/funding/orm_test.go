@@ -0,0 +1,70 @@
+package funding_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/funding"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestORM_Transfers(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, treasury := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, to := cltest.MustInsertRandomKey(t, ethKeyStore)
+	orm := funding.NewORM(db)
+
+	newTransfer := func(amount int64) *funding.Transfer {
+		return &funding.Transfer{
+			EVMChainID:  *utils.NewBig(&cltest.FixtureChainID),
+			FromAddress: treasury,
+			ToAddress:   to,
+			Amount:      assets.NewEthValue(amount),
+			Balance:     assets.NewEthValue(1),
+			MinBalance:  assets.NewEthValue(10),
+		}
+	}
+
+	tr1 := newTransfer(100)
+	require.NoError(t, orm.CreateTransfer(tr1, 21000))
+	require.True(t, tr1.EthTxID.Valid)
+
+	etx, err := cltest.FindEthTxWithAttempts(db, tr1.EthTxID.Int64)
+	require.NoError(t, err)
+	assert.Equal(t, bulletprooftxmanager.EthTxUnstarted, etx.State)
+	assert.Equal(t, treasury, etx.FromAddress)
+	assert.Equal(t, to, etx.ToAddress)
+	assert.Equal(t, int64(100), etx.Value.ToInt().Int64())
+
+	pending, err := orm.HasPendingTransferTo(cltest.FixtureChainID, to)
+	require.NoError(t, err)
+	assert.True(t, pending)
+	pending, err = orm.HasPendingTransferTo(cltest.FixtureChainID, treasury)
+	require.NoError(t, err)
+	assert.False(t, pending)
+
+	tr2 := newTransfer(50)
+	require.NoError(t, orm.CreateTransfer(tr2, 21000))
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET state = 'fatal_error', error = 'oh no' WHERE id = ?`, tr2.EthTxID).Error)
+
+	spent, err := orm.SpentSince(cltest.FixtureChainID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), spent.Int64(), "failed top-ups do not count towards the daily cap")
+
+	transfers, count, err := orm.ListTransfers(0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, transfers, 2)
+	assert.Equal(t, tr2.ID, transfers[0].ID)
+	assert.Equal(t, "fatal_error", transfers[0].EthTxState.String)
+	assert.Equal(t, tr1.ID, transfers[1].ID)
+	assert.Equal(t, int64(100), transfers[1].Amount.ToInt().Int64())
+}
@@ -174,7 +174,7 @@ func (b *BlockHistoryEstimator) BumpLegacyGas(originalGasPrice *big.Int, gasLimi
 	return BumpLegacyGasPriceOnly(b.config, b.getGasPrice(), originalGasPrice, gasLimit)
 }
 
-func (b *BlockHistoryEstimator) GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+func (b *BlockHistoryEstimator) GetDynamicFee(gasLimit uint64, _ ...Opt) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
 	if !b.config.EvmEIP1559DynamicFees() {
 		return fee, 0, errors.New("Can't get dynamic fee, EIP1559 is disabled")
 	}
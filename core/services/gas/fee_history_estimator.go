@@ -0,0 +1,380 @@
+package gas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promFeeHistoryEstimatorBaseFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_fee_history_estimator_base_fee",
+		Help: "Predicted base fee of the next block (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+
+	promFeeHistoryEstimatorTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_fee_history_estimator_tip_cap",
+		Help: "Fee history estimator tip cap at given priority (in Wei)",
+	},
+		[]string{"priority", "evmChainID"},
+	)
+)
+
+// feeHistoryPriorities lists the priority levels in the order their reward
+// percentiles are requested from eth_feeHistory
+var feeHistoryPriorities = []Priority{PrioritySlow, PriorityStandard, PriorityUrgent}
+
+// feeHistoryPercentiles are the reward percentiles requested for each entry of
+// feeHistoryPriorities
+var feeHistoryPercentiles = []float64{10, 50, 90}
+
+var _ Estimator = &feeHistoryEstimator{}
+
+//go:generate mockery --name feeHistoryRPCClient --output ./mocks/ --case=underscore --structname FeeHistoryRPCClient
+type feeHistoryRPCClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// FeeHistory is the result of an eth_feeHistory call
+type FeeHistory struct {
+	OldestBlock int64
+	// BaseFeePerGas includes the base fee of the block following the newest
+	// returned block, so it usually has one more entry than GasUsedRatio
+	BaseFeePerGas []*big.Int
+	GasUsedRatio  []float64
+	// Reward holds, per block, the effective priority fee paid at each of the
+	// requested percentiles
+	Reward [][]*big.Int
+}
+
+type feeHistoryInternal struct {
+	OldestBlock   *hexutil.Big     `json:"oldestBlock"`
+	BaseFeePerGas []*hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64        `json:"gasUsedRatio"`
+	Reward        [][]*hexutil.Big `json:"reward"`
+}
+
+// UnmarshalJSON unmarshals an eth_feeHistory result
+func (f *FeeHistory) UnmarshalJSON(data []byte) error {
+	fi := feeHistoryInternal{}
+	if err := json.Unmarshal(data, &fi); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal to feeHistoryInternal, got: '%s'", data)
+	}
+	if fi.OldestBlock == nil {
+		return errors.Errorf("expected 'oldestBlock' to not be null, got: '%s'", data)
+	}
+	h := FeeHistory{
+		OldestBlock:   fi.OldestBlock.ToInt().Int64(),
+		BaseFeePerGas: make([]*big.Int, len(fi.BaseFeePerGas)),
+		GasUsedRatio:  fi.GasUsedRatio,
+		Reward:        make([][]*big.Int, len(fi.Reward)),
+	}
+	for i, bf := range fi.BaseFeePerGas {
+		h.BaseFeePerGas[i] = (*big.Int)(bf)
+	}
+	for i, rewards := range fi.Reward {
+		h.Reward[i] = make([]*big.Int, len(rewards))
+		for j, r := range rewards {
+			h.Reward[i][j] = (*big.Int)(r)
+		}
+	}
+	*f = h
+	return nil
+}
+
+// feeHistoryEstimator estimates gas from a single eth_feeHistory call per
+// head. It predicts the base fee of the next block and derives a tip for each
+// priority level from the reward percentiles of recent blocks.
+type feeHistoryEstimator struct {
+	utils.StartStopOnce
+	client    feeHistoryRPCClient
+	chainID   big.Int
+	config    Config
+	mb        *utils.Mailbox
+	wg        *sync.WaitGroup
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	baseFee *big.Int
+	tipCaps map[Priority]*big.Int
+	mu      sync.RWMutex
+
+	logger logger.Logger
+}
+
+// NewFeeHistoryEstimator returns a new estimator that uses eth_feeHistory to
+// recalculate gas prices on every new head
+func NewFeeHistoryEstimator(lggr logger.Logger, config Config, client feeHistoryRPCClient, chainID big.Int) Estimator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &feeHistoryEstimator{
+		utils.StartStopOnce{},
+		client,
+		chainID,
+		config,
+		utils.NewMailbox(1),
+		new(sync.WaitGroup),
+		ctx,
+		cancel,
+		nil,
+		nil,
+		sync.RWMutex{},
+		lggr.Named("fee_history_estimator"),
+	}
+}
+
+// OnNewLongestChain schedules a refresh of the fee history
+func (f *feeHistoryEstimator) OnNewLongestChain(_ context.Context, head eth.Head) {
+	f.mb.Deliver(head)
+}
+
+func (f *feeHistoryEstimator) Start() error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		f.logger.Debugw("FeeHistoryEstimator: starting")
+		ctx, cancel := context.WithTimeout(f.ctx, maxStartTime)
+		defer cancel()
+		f.FetchAndRecalculate(ctx)
+		f.wg.Add(1)
+		go f.runLoop()
+		f.logger.Debugw("FeeHistoryEstimator: started")
+		return nil
+	})
+}
+
+func (f *feeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		f.ctxCancel()
+		f.wg.Wait()
+		return nil
+	})
+}
+
+func (f *feeHistoryEstimator) runLoop() {
+	defer f.wg.Done()
+	for {
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-f.mb.Notify():
+			if _, exists := f.mb.Retrieve(); !exists {
+				continue
+			}
+			f.FetchAndRecalculate(f.ctx)
+		}
+	}
+}
+
+// FetchAndRecalculate fetches the latest fee history and updates the
+// estimates. On failure the previous estimates are kept.
+func (f *feeHistoryEstimator) FetchAndRecalculate(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, maxEthNodeRequestTime)
+	defer cancel()
+
+	blockCount := f.config.FeeHistoryEstimatorBlockCount()
+	var history FeeHistory
+	if err := f.client.CallContext(ctx, &history, "eth_feeHistory", hexutil.Uint(blockCount), "latest", feeHistoryPercentiles); err != nil {
+		f.logger.Warnw("FeeHistoryEstimator: error fetching fee history", "err", err)
+		return
+	}
+	if err := f.Recalculate(history); err != nil {
+		f.logger.Warnw("FeeHistoryEstimator: cannot calculate prices from fee history", "err", err, "oldestBlock", history.OldestBlock)
+	}
+}
+
+// Recalculate sets the predicted base fee and per-priority tip caps from the
+// given fee history
+func (f *feeHistoryEstimator) Recalculate(history FeeHistory) error {
+	baseFee, err := predictNextBaseFee(history)
+	if err != nil {
+		return err
+	}
+
+	tipCaps := make(map[Priority]*big.Int, len(feeHistoryPriorities))
+	minTipCap := f.config.EvmGasTipCapMinimum()
+	for i, priority := range feeHistoryPriorities {
+		tipCap := medianReward(history, i)
+		if tipCap == nil {
+			// Every block in the window was empty, so there is no
+			// competition for inclusion
+			tipCap = f.config.EvmGasTipCapDefault()
+		}
+		if tipCap.Cmp(minTipCap) < 0 {
+			tipCap = minTipCap
+		}
+		tipCaps[priority] = tipCap
+		promFeeHistoryEstimatorTipCap.WithLabelValues(string(priority), f.chainID.String()).Set(float64(tipCap.Int64()))
+	}
+	promFeeHistoryEstimatorBaseFee.WithLabelValues(f.chainID.String()).Set(float64(baseFee.Int64()))
+
+	f.logger.Debugw("FeeHistoryEstimator: setting new prices",
+		"baseFeeWei", baseFee,
+		"slowTipCapWei", tipCaps[PrioritySlow],
+		"standardTipCapWei", tipCaps[PriorityStandard],
+		"urgentTipCapWei", tipCaps[PriorityUrgent],
+		"oldestBlock", history.OldestBlock,
+	)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.baseFee = baseFee
+	f.tipCaps = tipCaps
+	return nil
+}
+
+// predictNextBaseFee returns the base fee of the block following the newest
+// block in the history. Nodes include it as the final entry of baseFeePerGas;
+// if it is missing it is derived from the newest block using the EIP-1559
+// update rule.
+func predictNextBaseFee(history FeeHistory) (*big.Int, error) {
+	nBlocks := len(history.GasUsedRatio)
+	if nBlocks == 0 || len(history.BaseFeePerGas) == 0 {
+		return nil, errors.New("fee history contained no blocks")
+	}
+	if len(history.BaseFeePerGas) > nBlocks {
+		if next := history.BaseFeePerGas[nBlocks]; next != nil {
+			return next, nil
+		}
+	}
+	newest := nBlocks - 1
+	if len(history.BaseFeePerGas) < nBlocks {
+		newest = len(history.BaseFeePerGas) - 1
+	}
+	if history.BaseFeePerGas[newest] == nil {
+		return nil, errors.New("fee history was missing baseFeePerGas")
+	}
+	return nextBaseFee(history.BaseFeePerGas[newest], history.GasUsedRatio[newest]), nil
+}
+
+// nextBaseFee applies the EIP-1559 base fee update rule. The base fee moves by
+// up to 1/8 depending on how far gas used deviates from the 50% target.
+// See: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1559.md
+func nextBaseFee(baseFee *big.Int, gasUsedRatio float64) *big.Int {
+	// Work in parts per million to stay in integer arithmetic
+	const ppm = 1000000
+	deviation := int64((gasUsedRatio - 0.5) * 2 * ppm)
+	delta := new(big.Int).Mul(baseFee, big.NewInt(deviation))
+	delta.Div(delta, big.NewInt(8*ppm))
+	next := new(big.Int).Add(baseFee, delta)
+	if next.Sign() < 0 {
+		return big.NewInt(0)
+	}
+	return next
+}
+
+// medianReward returns the median reward at the given percentile index across
+// all non-empty blocks, or nil if there were none
+func medianReward(history FeeHistory, idx int) *big.Int {
+	var rewards []*big.Int
+	for i, blockRewards := range history.Reward {
+		if i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0 {
+			// Empty blocks report zero rewards which would skew the estimate
+			continue
+		}
+		if idx < len(blockRewards) && blockRewards[idx] != nil {
+			rewards = append(rewards, blockRewards[idx])
+		}
+	}
+	if len(rewards) == 0 {
+		return nil
+	}
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	return rewards[len(rewards)/2]
+}
+
+func (f *feeHistoryEstimator) getPrices(priority Priority) (baseFee, tipCap *big.Int) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if f.tipCaps == nil {
+		return nil, nil
+	}
+	return f.baseFee, f.tipCaps[priority]
+}
+
+func (f *feeHistoryEstimator) GetLegacyGas(_ []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	priority := priorityFromOpts(opts)
+	var baseFee, tipCap *big.Int
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		baseFee, tipCap = f.getPrices(priority)
+	})
+	if !ok {
+		return nil, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if tipCap == nil {
+		return nil, 0, errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	}
+	// On chains without EIP-1559 the base fee is zero and the reward is the
+	// full gas price paid
+	gasPrice = f.capGasPrice(new(big.Int).Add(baseFee, tipCap), priority)
+	return
+}
+
+func (f *feeHistoryEstimator) capGasPrice(gasPrice *big.Int, priority Priority) *big.Int {
+	max := f.config.EvmMaxGasPriceWei()
+	min := f.config.EvmMinGasPriceWei()
+	if gasPrice.Cmp(max) > 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated %s gas price of %s Wei exceeds ETH_MAX_GAS_PRICE_WEI=%s, using the maximum instead", priority, gasPrice.String(), max.String()), "gasPriceWei", gasPrice, "maxGasPriceWei", max)
+		return max
+	} else if gasPrice.Cmp(min) < 0 {
+		return min
+	}
+	return gasPrice
+}
+
+func (f *feeHistoryEstimator) BumpLegacyGas(originalGasPrice *big.Int, gasLimit uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	var current *big.Int
+	if baseFee, tipCap := f.getPrices(PriorityStandard); tipCap != nil {
+		current = f.capGasPrice(new(big.Int).Add(baseFee, tipCap), PriorityStandard)
+	}
+	return BumpLegacyGasPriceOnly(f.config, current, originalGasPrice, gasLimit)
+}
+
+func (f *feeHistoryEstimator) GetDynamicFee(gasLimit uint64, opts ...Opt) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	if !f.config.EvmEIP1559DynamicFees() {
+		return fee, 0, errors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+	priority := priorityFromOpts(opts)
+	var baseFee, tipCap *big.Int
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		baseFee, tipCap = f.getPrices(priority)
+	})
+	if !ok {
+		return fee, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if tipCap == nil {
+		return fee, 0, errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	}
+	// Doubling the base fee keeps the transaction includable through six
+	// consecutive full blocks
+	feeCap := new(big.Int).Mul(baseFee, big.NewInt(2))
+	feeCap.Add(feeCap, tipCap)
+	max := f.config.EvmMaxGasPriceWei()
+	if feeCap.Cmp(max) > 0 {
+		feeCap = max
+	}
+	if tipCap.Cmp(feeCap) > 0 {
+		tipCap = feeCap
+	}
+	fee.FeeCap = feeCap
+	fee.TipCap = tipCap
+	return
+}
+
+func (f *feeHistoryEstimator) BumpDynamicFee(originalFee DynamicFee, originalGasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	_, tipCap := f.getPrices(PriorityStandard)
+	return BumpDynamicFeeOnly(f.config, tipCap, originalFee, originalGasLimit)
+}
@@ -0,0 +1,156 @@
+package gas_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+)
+
+func newFeeHistoryConfig(eip1559 bool) *mocks.Config {
+	config := new(mocks.Config)
+	config.On("FeeHistoryEstimatorBlockCount").Return(uint16(3))
+	config.On("EvmEIP1559DynamicFees").Return(eip1559)
+	config.On("EvmGasLimitMultiplier").Return(float32(1))
+	config.On("EvmGasTipCapDefault").Return(assets.GWei(1))
+	config.On("EvmGasTipCapMinimum").Return(assets.GWei(1))
+	config.On("EvmMaxGasPriceWei").Return(assets.GWei(500))
+	config.On("EvmMinGasPriceWei").Return(assets.GWei(1))
+	return config
+}
+
+func gweiHex(ns ...int64) string {
+	var s []string
+	for _, n := range ns {
+		s = append(s, fmt.Sprintf("%q", hexutil.EncodeBig(assets.GWei(n))))
+	}
+	return "[" + strings.Join(s, ",") + "]"
+}
+
+func expectFeeHistory(t *testing.T, client *mocks.FeeHistoryRPCClient, data string) {
+	client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", hexutil.Uint(3), "latest", []float64{10, 50, 90}).Return(nil).Run(func(args mock.Arguments) {
+		res := args.Get(1).(*gas.FeeHistory)
+		require.NoError(t, json.Unmarshal([]byte(data), res))
+	})
+}
+
+func Test_FeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	// The middle block is empty and must be ignored when picking tips
+	history := fmt.Sprintf(`{"oldestBlock":"0x10","baseFeePerGas":%s,"gasUsedRatio":[0.9,0,0.7],"reward":[%s,%s,%s]}`,
+		gweiHex(100, 110, 120, 130), gweiHex(1, 2, 5), gweiHex(0, 0, 0), gweiHex(3, 4, 9))
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		client := new(mocks.FeeHistoryRPCClient)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), newFeeHistoryConfig(false), client, *big.NewInt(0))
+
+		_, _, err := f.GetLegacyGas(nil, 100000)
+		assert.EqualError(t, err, "FeeHistoryEstimator is not started; cannot estimate gas")
+	})
+
+	t.Run("returns legacy gas price for each priority", func(t *testing.T) {
+		client := new(mocks.FeeHistoryRPCClient)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), newFeeHistoryConfig(false), client, *big.NewInt(0))
+		expectFeeHistory(t, client, history)
+
+		require.NoError(t, f.Start())
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		gasPrice, gasLimit, err := f.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(134), gasPrice)
+		assert.Equal(t, uint64(100000), gasLimit)
+
+		gasPrice, _, err = f.GetLegacyGas(nil, 100000, gas.PrioritySlow.Opts()...)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(133), gasPrice)
+
+		gasPrice, _, err = f.GetLegacyGas(nil, 100000, gas.PriorityUrgent.Opts()...)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(139), gasPrice)
+
+		_, _, err = f.GetDynamicFee(100000)
+		assert.EqualError(t, err, "Can't get dynamic fee, EIP1559 is disabled")
+	})
+
+	t.Run("returns dynamic fee for each priority", func(t *testing.T) {
+		client := new(mocks.FeeHistoryRPCClient)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), newFeeHistoryConfig(true), client, *big.NewInt(0))
+		expectFeeHistory(t, client, history)
+
+		require.NoError(t, f.Start())
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		fee, _, err := f.GetDynamicFee(100000)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(4), fee.TipCap)
+		assert.Equal(t, assets.GWei(264), fee.FeeCap)
+
+		fee, _, err = f.GetDynamicFee(100000, gas.PriorityUrgent.Opts()...)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(9), fee.TipCap)
+		assert.Equal(t, assets.GWei(269), fee.FeeCap)
+
+		fee, _, err = f.GetDynamicFee(100000, gas.PrioritySlow.Opts()...)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(3), fee.TipCap)
+		assert.Equal(t, assets.GWei(263), fee.FeeCap)
+	})
+
+	t.Run("predicts next base fee when node omits it", func(t *testing.T) {
+		client := new(mocks.FeeHistoryRPCClient)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), newFeeHistoryConfig(false), client, *big.NewInt(0))
+		// Newest block was completely full so base fee rises by 12.5%
+		expectFeeHistory(t, client, fmt.Sprintf(`{"oldestBlock":"0x10","baseFeePerGas":%s,"gasUsedRatio":[0.5,0.5,1],"reward":[%s,%s,%s]}`,
+			gweiHex(120, 120, 120), gweiHex(2, 2, 2), gweiHex(2, 2, 2), gweiHex(2, 2, 2)))
+
+		require.NoError(t, f.Start())
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		gasPrice, _, err := f.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, assets.GWei(137), gasPrice)
+	})
+
+	t.Run("calling GetLegacyGas on started estimator if initial call failed returns error", func(t *testing.T) {
+		client := new(mocks.FeeHistoryRPCClient)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), newFeeHistoryConfig(false), client, *big.NewInt(0))
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("kaboom"))
+
+		require.NoError(t, f.Start())
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		_, _, err := f.GetLegacyGas(nil, 100000)
+		assert.EqualError(t, err, "FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	})
+}
+
+func Test_ParsePriority(t *testing.T) {
+	t.Parallel()
+
+	p, err := gas.ParsePriority("")
+	require.NoError(t, err)
+	assert.Equal(t, gas.PriorityStandard, p)
+
+	p, err = gas.ParsePriority("urgent")
+	require.NoError(t, err)
+	assert.Equal(t, gas.PriorityUrgent, p)
+	assert.Equal(t, []gas.Opt{gas.OptPriorityUrgent}, p.Opts())
+	assert.Nil(t, gas.PriorityStandard.Opts())
+
+	_, err = gas.ParsePriority("asap")
+	assert.Error(t, err)
+}
@@ -33,7 +33,7 @@ func (f *fixedPriceEstimator) BumpLegacyGas(originalGasPrice *big.Int, originalG
 	return BumpLegacyGasPriceOnly(f.config, f.config.EvmGasPriceDefault(), originalGasPrice, originalGasLimit)
 }
 
-func (f *fixedPriceEstimator) GetDynamicFee(originalGasLimit uint64) (d DynamicFee, chainSpecificGasLimit uint64, err error) {
+func (f *fixedPriceEstimator) GetDynamicFee(originalGasLimit uint64, _ ...Opt) (d DynamicFee, chainSpecificGasLimit uint64, err error) {
 	gasTipCap := f.config.EvmGasTipCapDefault()
 	if gasTipCap == nil {
 		return d, 0, errors.New("cannot calculate dynamic fee: EthGasTipCapDefault was not set")
@@ -253,6 +253,20 @@ func (_m *Config) EvmMinGasPriceWei() *big.Int {
 	return r0
 }
 
+// FeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBlockCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // GasEstimatorMode provides a mock function with given fields:
 func (_m *Config) GasEstimatorMode() string {
 	ret := _m.Called()
@@ -89,27 +89,34 @@ func (_m *Estimator) Close() error {
 	return r0
 }
 
-// GetDynamicFee provides a mock function with given fields: gasLimit
-func (_m *Estimator) GetDynamicFee(gasLimit uint64) (gas.DynamicFee, uint64, error) {
-	ret := _m.Called(gasLimit)
+// GetDynamicFee provides a mock function with given fields: gasLimit, opts
+func (_m *Estimator) GetDynamicFee(gasLimit uint64, opts ...gas.Opt) (gas.DynamicFee, uint64, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, gasLimit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 gas.DynamicFee
-	if rf, ok := ret.Get(0).(func(uint64) gas.DynamicFee); ok {
-		r0 = rf(gasLimit)
+	if rf, ok := ret.Get(0).(func(uint64, ...gas.Opt) gas.DynamicFee); ok {
+		r0 = rf(gasLimit, opts...)
 	} else {
 		r0 = ret.Get(0).(gas.DynamicFee)
 	}
 
 	var r1 uint64
-	if rf, ok := ret.Get(1).(func(uint64) uint64); ok {
-		r1 = rf(gasLimit)
+	if rf, ok := ret.Get(1).(func(uint64, ...gas.Opt) uint64); ok {
+		r1 = rf(gasLimit, opts...)
 	} else {
 		r1 = ret.Get(1).(uint64)
 	}
 
 	var r2 error
-	if rf, ok := ret.Get(2).(func(uint64) error); ok {
-		r2 = rf(gasLimit)
+	if rf, ok := ret.Get(2).(func(uint64, ...gas.Opt) error); ok {
+		r2 = rf(gasLimit, opts...)
 	} else {
 		r2 = ret.Error(2)
 	}
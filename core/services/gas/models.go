@@ -38,6 +38,8 @@ func NewEstimator(lggr logger.Logger, ethClient eth.Client, config Config) Estim
 		return NewOptimismEstimator(lggr, config, ethClient)
 	case "Optimism2":
 		return NewOptimism2Estimator(lggr, config, ethClient)
+	case "FeeHistory":
+		return NewFeeHistoryEstimator(lggr, config, ethClient, *ethClient.ChainID())
 	default:
 		logger.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
 		return NewFixedPriceEstimator(config)
@@ -58,7 +60,7 @@ type Estimator interface {
 	Close() error
 	GetLegacyGas(calldata []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error)
 	BumpLegacyGas(originalGasPrice *big.Int, gasLimit uint64) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error)
-	GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error)
+	GetDynamicFee(gasLimit uint64, opts ...Opt) (fee DynamicFee, chainSpecificGasLimit uint64, err error)
 	BumpDynamicFee(original DynamicFee, gasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error)
 }
 
@@ -68,8 +70,65 @@ type Opt int
 const (
 	// OptForceRefetch forces the estimator to bust a cache if necessary
 	OptForceRefetch Opt = iota
+	// OptPrioritySlow asks for a price that will get the transaction included
+	// eventually. Estimators that do not support priority levels ignore it.
+	OptPrioritySlow
+	// OptPriorityUrgent asks for a price that will get the transaction
+	// included as soon as possible. Estimators that do not support priority
+	// levels ignore it.
+	OptPriorityUrgent
 )
 
+// Priority is the urgency with which a transaction should be included on
+// chain. It is persisted per transaction so that the estimate can be made at
+// the time the transaction is broadcast.
+type Priority string
+
+const (
+	PrioritySlow     Priority = "slow"
+	PriorityStandard Priority = "standard"
+	PriorityUrgent   Priority = "urgent"
+)
+
+// ParsePriority parses a priority level. An empty string is treated as
+// PriorityStandard.
+func ParsePriority(s string) (Priority, error) {
+	switch p := Priority(s); p {
+	case "":
+		return PriorityStandard, nil
+	case PrioritySlow, PriorityStandard, PriorityUrgent:
+		return p, nil
+	default:
+		return "", errors.Errorf("unknown gas priority %q, must be one of %q, %q or %q", s, PrioritySlow, PriorityStandard, PriorityUrgent)
+	}
+}
+
+// Opts returns the estimator options that select this priority level
+func (p Priority) Opts() []Opt {
+	switch p {
+	case PrioritySlow:
+		return []Opt{OptPrioritySlow}
+	case PriorityUrgent:
+		return []Opt{OptPriorityUrgent}
+	default:
+		return nil
+	}
+}
+
+// priorityFromOpts returns the priority level selected by opts, defaulting to
+// PriorityStandard
+func priorityFromOpts(opts []Opt) Priority {
+	for _, opt := range opts {
+		switch opt {
+		case OptPrioritySlow:
+			return PrioritySlow
+		case OptPriorityUrgent:
+			return PriorityUrgent
+		}
+	}
+	return PriorityStandard
+}
+
 func applyMultiplier(gasLimit uint64, multiplier float32) uint64 {
 	return uint64(decimal.NewFromBigInt(big.NewInt(0).SetUint64(gasLimit), 0).Mul(decimal.NewFromFloat32(multiplier)).IntPart())
 }
@@ -93,6 +152,7 @@ type Config interface {
 	EvmGasTipCapMinimum() *big.Int
 	EvmMaxGasPriceWei() *big.Int
 	EvmMinGasPriceWei() *big.Int
+	FeeHistoryEstimatorBlockCount() uint16
 	GasEstimatorMode() string
 }
 
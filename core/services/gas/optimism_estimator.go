@@ -165,7 +165,7 @@ func (o *optimismEstimator) BumpLegacyGas(originalGasPrice *big.Int, originalGas
 
 func (o *optimismEstimator) OnNewLongestChain(_ context.Context, _ eth.Head) {}
 
-func (*optimismEstimator) GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+func (*optimismEstimator) GetDynamicFee(gasLimit uint64, _ ...Opt) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
 	err = errors.New("dynamic fees are not implemented for Optimism")
 	return
 }
@@ -298,7 +298,7 @@ func (o *optimism2Estimator) refreshPrice() (t *time.Timer) {
 
 func (o *optimism2Estimator) OnNewLongestChain(_ context.Context, _ eth.Head) {}
 
-func (*optimism2Estimator) GetDynamicFee(_ uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+func (*optimism2Estimator) GetDynamicFee(_ uint64, _ ...Opt) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
 	err = errors.New("dynamic fees are not implemented for Optimism")
 	return
 }
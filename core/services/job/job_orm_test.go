@@ -20,6 +20,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -153,6 +154,85 @@ func TestORM(t *testing.T) {
 
 		cltest.AssertCount(t, db, job.ExternalInitiatorWebhookSpec{}, 2)
 	})
+
+	t.Run("updates a job with a new pipeline spec", func(t *testing.T) {
+		tree, err := toml.LoadFile("../../testdata/tomlspecs/direct-request-spec.toml")
+		require.NoError(t, err)
+		jb, err := directrequest.ValidatedDirectRequestSpec(tree.String())
+		require.NoError(t, err)
+		jb.ExternalJobID = uuid.NewV4()
+		jb.Name = null.StringFrom("update me")
+		created, err := orm.CreateJob(context.Background(), &jb, jb.Pipeline)
+		require.NoError(t, err)
+		run := mustInsertPipelineRun(t, db, created)
+
+		updatedSpec, err := directrequest.ValidatedDirectRequestSpec(tree.String())
+		require.NoError(t, err)
+		updatedSpec.ExternalJobID = created.ExternalJobID
+		updatedSpec.Name = null.StringFrom("renamed")
+		p, err := pipeline.Parse(`ds1 [type=memo value=1]`)
+		require.NoError(t, err)
+		updated, err := orm.UpdateJob(context.Background(), created.ID, &updatedSpec, *p)
+		require.NoError(t, err)
+
+		assert.Equal(t, created.ID, updated.ID)
+		assert.Equal(t, created.ExternalJobID, updated.ExternalJobID)
+		assert.Equal(t, *created.DirectRequestSpecID, *updated.DirectRequestSpecID)
+		assert.Equal(t, "renamed", updated.Name.ValueOrZero())
+		assert.NotEqual(t, created.PipelineSpecID, updated.PipelineSpecID)
+		assert.Equal(t, p.Source, updated.PipelineSpec.DotDagSource)
+
+		// The previous pipeline spec is left as it was for the runs that use it
+		var previous pipeline.Spec
+		require.NoError(t, db.First(&previous, created.PipelineSpecID).Error)
+		assert.Equal(t, created.PipelineSpec.DotDagSource, previous.DotDagSource)
+
+		runs, count, err := orm.PipelineRunsByJobID(created.ID, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		assert.Equal(t, run.ID, runs[0].ID)
+		assert.Equal(t, created.PipelineSpecID, runs[0].PipelineSpecID)
+
+		require.NoError(t, orm.DeleteJob(context.Background(), created.ID))
+		var specs int64
+		require.NoError(t, db.Model(pipeline.Spec{}).Where("id IN (?)", []int32{created.PipelineSpecID, updated.PipelineSpecID}).Count(&specs).Error)
+		assert.Zero(t, specs)
+	})
+
+	t.Run("does not allow a job's type to be changed on update", func(t *testing.T) {
+		tree, err := toml.LoadFile("../../testdata/tomlspecs/direct-request-spec.toml")
+		require.NoError(t, err)
+		jb, err := directrequest.ValidatedDirectRequestSpec(tree.String())
+		require.NoError(t, err)
+		jb.ExternalJobID = uuid.NewV4()
+		jb.Name = null.StringFrom("type mismatch")
+		created, err := orm.CreateJob(context.Background(), &jb, jb.Pipeline)
+		require.NoError(t, err)
+
+		ocrSpec := makeOCRJobSpec(t, address)
+		ocrSpec.ExternalJobID = created.ExternalJobID
+		_, err = orm.UpdateJob(context.Background(), created.ID, ocrSpec, ocrSpec.Pipeline)
+		require.Error(t, err)
+		assert.Equal(t, job.ErrJobTypeMismatch, errors.Cause(err))
+	})
+
+	t.Run("pauses and unpauses a job", func(t *testing.T) {
+		ocrSpec := makeOCRJobSpec(t, address)
+		created, err := orm.CreateJob(context.Background(), ocrSpec, ocrSpec.Pipeline)
+		require.NoError(t, err)
+
+		require.NoError(t, orm.SetJobPaused(context.Background(), created.ID, true))
+		jb, err := orm.FindJob(context.Background(), created.ID)
+		require.NoError(t, err)
+		assert.True(t, jb.PausedAt.Valid)
+
+		require.NoError(t, orm.SetJobPaused(context.Background(), created.ID, false))
+		jb, err = orm.FindJob(context.Background(), created.ID)
+		require.NoError(t, err)
+		assert.False(t, jb.PausedAt.Valid)
+
+		require.Equal(t, gorm.ErrRecordNotFound, orm.SetJobPaused(context.Background(), 0, true))
+	})
 }
 
 func TestORM_DeleteJob_DeletesAssociatedRecords(t *testing.T) {
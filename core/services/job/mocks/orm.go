@@ -8,6 +8,10 @@ import (
 	job "github.com/smartcontractkit/chainlink/core/services/job"
 	mock "github.com/stretchr/testify/mock"
 
+	models "github.com/smartcontractkit/chainlink/core/store/models"
+
+	p2pkey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
+
 	pipeline "github.com/smartcontractkit/chainlink/core/services/pipeline"
 
 	uuid "github.com/satori/go.uuid"
@@ -261,3 +265,52 @@ func (_m *ORM) PipelineRunsByJobID(jobID int32, offset int, size int) ([]pipelin
 func (_m *ORM) RecordError(ctx context.Context, jobID int32, description string) {
 	_m.Called(ctx, jobID, description)
 }
+
+// SetJobPaused provides a mock function with given fields: ctx, id, paused
+func (_m *ORM) SetJobPaused(ctx context.Context, id int32, paused bool) error {
+	ret := _m.Called(ctx, id, paused)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, bool) error); ok {
+		r0 = rf(ctx, id, paused)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateJob provides a mock function with given fields: ctx, id, jobSpec, _a3
+func (_m *ORM) UpdateJob(ctx context.Context, id int32, jobSpec *job.Job, _a3 pipeline.Pipeline) (job.Job, error) {
+	ret := _m.Called(ctx, id, jobSpec, _a3)
+
+	var r0 job.Job
+	if rf, ok := ret.Get(0).(func(context.Context, int32, *job.Job, pipeline.Pipeline) job.Job); ok {
+		r0 = rf(ctx, id, jobSpec, _a3)
+	} else {
+		r0 = ret.Get(0).(job.Job)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32, *job.Job, pipeline.Pipeline) error); ok {
+		r1 = rf(ctx, id, jobSpec, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOCRKeys provides a mock function with given fields: ctx, id, keyBundleID, peerID
+func (_m *ORM) UpdateOCRKeys(ctx context.Context, id int32, keyBundleID *models.Sha256Hash, peerID *p2pkey.PeerID) error {
+	ret := _m.Called(ctx, id, keyBundleID, peerID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, *models.Sha256Hash, *p2pkey.PeerID) error); ok {
+		r0 = rf(ctx, id, keyBundleID, peerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
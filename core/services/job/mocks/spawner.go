@@ -95,6 +95,20 @@ func (_m *Spawner) Healthy() error {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Ready provides a mock function with given fields:
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -109,6 +123,20 @@ func (_m *Spawner) Ready() error {
 	return r0
 }
 
+// RestartJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) RestartJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields:
 func (_m *Spawner) Start() error {
 	ret := _m.Called()
@@ -136,3 +164,43 @@ func (_m *Spawner) StartService(spec job.Job) error {
 
 	return r0
 }
+
+// StopJob provides a mock function with given fields: jobID
+func (_m *Spawner) StopJob(jobID int32) {
+	_m.Called(jobID)
+}
+
+// UnpauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) UnpauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateJob provides a mock function with given fields: ctx, jobID, spec
+func (_m *Spawner) UpdateJob(ctx context.Context, jobID int32, spec job.Job) (job.Job, error) {
+	ret := _m.Called(ctx, jobID, spec)
+
+	var r0 job.Job
+	if rf, ok := ret.Get(0).(func(context.Context, int32, job.Job) job.Job); ok {
+		r0 = rf(ctx, jobID, spec)
+	} else {
+		r0 = ret.Get(0).(job.Job)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32, job.Job) error); ok {
+		r1 = rf(ctx, jobID, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -106,6 +106,7 @@ type Job struct {
 	SchemaVersion                 uint32
 	Name                          null.String
 	MaxTaskDuration               models.Interval
+	PausedAt                      null.Time         `toml:"-"`
 	Pipeline                      pipeline.Pipeline `toml:"observationSource" gorm:"-"`
 }
 
@@ -261,6 +262,7 @@ type DirectRequestSpec struct {
 	MinIncomingConfirmations clnull.Uint32            `toml:"minIncomingConfirmations"`
 	Requesters               models.AddressCollection `toml:"requesters"`
 	MinContractPayment       *assets.Link             `toml:"minContractPaymentLinkJuels"`
+	FromAddresses            models.AddressCollection `toml:"fromAddresses"`
 	EVMChainID               *utils.Big               `toml:"evmChainID" gorm:"column:evm_chain_id"`
 	CreatedAt                time.Time                `toml:"-"`
 	UpdatedAt                time.Time                `toml:"-"`
@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -33,6 +34,7 @@ var (
 
 var (
 	ErrViolatesForeignKeyConstraint = errors.New("violates foreign key constraint")
+	ErrJobTypeMismatch              = errors.New("job type mismatch")
 )
 
 type ORM interface {
@@ -43,6 +45,9 @@ type ORM interface {
 	FindJobByExternalJobID(ctx context.Context, uuid uuid.UUID) (Job, error)
 	FindJobIDsWithBridge(name string) ([]int32, error)
 	DeleteJob(ctx context.Context, id int32) error
+	UpdateJob(ctx context.Context, id int32, jobSpec *Job, pipeline pipeline.Pipeline) (Job, error)
+	SetJobPaused(ctx context.Context, id int32, paused bool) error
+	UpdateOCRKeys(ctx context.Context, id int32, keyBundleID *models.Sha256Hash, peerID *p2pkey.PeerID) error
 	RecordError(ctx context.Context, jobID int32, description string)
 	DismissError(ctx context.Context, errorID int32) error
 	Close() error
@@ -101,6 +106,36 @@ func (o *orm) Close() error {
 // Returns a fully populated Job.
 func (o *orm) CreateJob(ctx context.Context, jobSpec *Job, p pipeline.Pipeline) (Job, error) {
 	var jb Job
+	if err := o.validateBridges(p); err != nil {
+		return jb, err
+	}
+
+	tx := postgres.TxFromContext(ctx, o.db)
+
+	// Autogenerate a job ID if not specified
+	if jobSpec.ExternalJobID == (uuid.UUID{}) {
+		jobSpec.ExternalJobID = uuid.NewV4()
+	}
+
+	if err := o.createTypeSpec(tx, jobSpec); err != nil {
+		return jb, err
+	}
+
+	pipelineSpecID, err := o.pipelineORM.CreateSpec(ctx, tx, p, jobSpec.MaxTaskDuration)
+	if err != nil {
+		return jb, errors.Wrap(err, "failed to create pipeline spec")
+	}
+	jobSpec.PipelineSpecID = pipelineSpecID
+	err = tx.Create(jobSpec).Error
+	if err != nil {
+		return jb, errors.Wrap(err, "failed to create job")
+	}
+
+	return o.FindJob(ctx, jobSpec.ID)
+}
+
+// validateBridges checks that every bridge referenced by the pipeline exists
+func (o *orm) validateBridges(p pipeline.Pipeline) error {
 	for _, task := range p.Tasks {
 		if task.Type() == pipeline.TaskTypeBridge {
 			// Bridge must exist
@@ -108,68 +143,52 @@ func (o *orm) CreateJob(ctx context.Context, jobSpec *Job, p pipeline.Pipeline)
 			bt := bridges.BridgeType{}
 			if err := o.db.First(&bt, "name = ?", name).Error; err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
-					return jb, errors.Wrap(pipeline.ErrNoSuchBridge, name)
+					return errors.Wrap(pipeline.ErrNoSuchBridge, name)
 				}
-				return jb, err
+				return err
 			}
 		}
 	}
 
-	tx := postgres.TxFromContext(ctx, o.db)
-
-	// Autogenerate a job ID if not specified
-	if jobSpec.ExternalJobID == (uuid.UUID{}) {
-		jobSpec.ExternalJobID = uuid.NewV4()
-	}
+	return nil
+}
 
+// createTypeSpec validates and inserts the type-specific spec record (e.g.
+// the OCR oracle spec) and sets the corresponding foreign key on jobSpec
+func (o *orm) createTypeSpec(tx *gorm.DB, jobSpec *Job) error {
 	switch jobSpec.Type {
 	case DirectRequest:
 		err := tx.Create(&jobSpec.DirectRequestSpec).Error
 		if err != nil {
-			return jb, errors.Wrap(err, "failed to create DirectRequestSpec for jobSpec")
+			return errors.Wrap(err, "failed to create DirectRequestSpec for jobSpec")
 		}
 		jobSpec.DirectRequestSpecID = &jobSpec.DirectRequestSpec.ID
 	case FluxMonitor:
 		err := tx.Create(&jobSpec.FluxMonitorSpec).Error
 		if err != nil {
-			return jb, errors.Wrap(err, "failed to create FluxMonitorSpec for jobSpec")
+			return errors.Wrap(err, "failed to create FluxMonitorSpec for jobSpec")
 		}
 		jobSpec.FluxMonitorSpecID = &jobSpec.FluxMonitorSpec.ID
 	case OffchainReporting:
-		if jobSpec.OffchainreportingOracleSpec.EncryptedOCRKeyBundleID != nil {
-			_, err := o.keyStore.OCR().Get(jobSpec.OffchainreportingOracleSpec.EncryptedOCRKeyBundleID.String())
-			if err != nil {
-				return jb, errors.Wrapf(ErrNoSuchKeyBundle, "%v", jobSpec.OffchainreportingOracleSpec.EncryptedOCRKeyBundleID)
-			}
-		}
-		if jobSpec.OffchainreportingOracleSpec.P2PPeerID != nil {
-			_, err := o.keyStore.P2P().Get(jobSpec.OffchainreportingOracleSpec.P2PPeerID.Raw())
-			if err != nil {
-				return jb, errors.Wrapf(ErrNoSuchPeerID, "%v", jobSpec.OffchainreportingOracleSpec.P2PPeerID)
-			}
-		}
-		if jobSpec.OffchainreportingOracleSpec.TransmitterAddress != nil {
-			_, err := o.keyStore.Eth().Get(jobSpec.OffchainreportingOracleSpec.TransmitterAddress.Hex())
-			if err != nil {
-				return jb, errors.Wrapf(ErrNoSuchTransmitterAddress, "%v", jobSpec.OffchainreportingOracleSpec.TransmitterAddress)
-			}
+		if err := o.validateOCRKeys(jobSpec.OffchainreportingOracleSpec); err != nil {
+			return err
 		}
 
 		err := tx.Create(&jobSpec.OffchainreportingOracleSpec).Error
 		if err != nil {
-			return jb, errors.Wrap(err, "failed to create OffchainreportingOracleSpec for jobSpec")
+			return errors.Wrap(err, "failed to create OffchainreportingOracleSpec for jobSpec")
 		}
 		jobSpec.OffchainreportingOracleSpecID = &jobSpec.OffchainreportingOracleSpec.ID
 	case Keeper:
 		err := tx.Create(&jobSpec.KeeperSpec).Error
 		if err != nil {
-			return jb, errors.Wrap(err, "failed to create KeeperSpec for jobSpec")
+			return errors.Wrap(err, "failed to create KeeperSpec for jobSpec")
 		}
 		jobSpec.KeeperSpecID = &jobSpec.KeeperSpec.ID
 	case Cron:
 		err := tx.Create(&jobSpec.CronSpec).Error
 		if err != nil {
-			return jb, errors.Wrap(err, "failed to create CronSpec for jobSpec")
+			return errors.Wrap(err, "failed to create CronSpec for jobSpec")
 		}
 		jobSpec.CronSpecID = &jobSpec.CronSpec.ID
 	case VRF:
@@ -177,41 +196,204 @@ func (o *orm) CreateJob(ctx context.Context, jobSpec *Job, p pipeline.Pipeline)
 		pqErr, ok := err.(*pgconn.PgError)
 		if err != nil && ok && pqErr.Code == "23503" {
 			if pqErr.ConstraintName == "vrf_specs_public_key_fkey" {
-				return jb, errors.Wrapf(ErrNoSuchPublicKey, "%s", jobSpec.VRFSpec.PublicKey.String())
+				return errors.Wrapf(ErrNoSuchPublicKey, "%s", jobSpec.VRFSpec.PublicKey.String())
 			}
 		}
 		if err != nil {
-			return jb, errors.Wrap(err, "failed to create VRFSpec for jobSpec")
+			return errors.Wrap(err, "failed to create VRFSpec for jobSpec")
 		}
 		jobSpec.VRFSpecID = &jobSpec.VRFSpec.ID
 	case Webhook:
 		err := tx.Create(&jobSpec.WebhookSpec).Error
 		if err != nil {
-			return jb, errors.Wrap(err, "failed to create WebhookSpec for jobSpec")
+			return errors.Wrap(err, "failed to create WebhookSpec for jobSpec")
 		}
 		jobSpec.WebhookSpecID = &jobSpec.WebhookSpec.ID
 		for i, eiWS := range jobSpec.WebhookSpec.ExternalInitiatorWebhookSpecs {
 			jobSpec.WebhookSpec.ExternalInitiatorWebhookSpecs[i].WebhookSpecID = jobSpec.WebhookSpec.ID
 			err := tx.Create(&jobSpec.WebhookSpec.ExternalInitiatorWebhookSpecs[i]).Error
 			if err != nil {
-				return jb, errors.Wrapf(err, "failed to create ExternalInitiatorWebhookSpec for WebhookSpec: %#v", eiWS)
+				return errors.Wrapf(err, "failed to create ExternalInitiatorWebhookSpec for WebhookSpec: %#v", eiWS)
 			}
 		}
 	default:
 		logger.Fatalf("Unsupported jobSpec.Type: %v", jobSpec.Type)
 	}
 
+	return nil
+}
+
+// validateOCRKeys checks that the keys referenced by an OCR spec exist in
+// the keystore
+func (o *orm) validateOCRKeys(spec *OffchainReportingOracleSpec) error {
+	if spec.EncryptedOCRKeyBundleID != nil {
+		_, err := o.keyStore.OCR().Get(spec.EncryptedOCRKeyBundleID.String())
+		if err != nil {
+			return errors.Wrapf(ErrNoSuchKeyBundle, "%v", spec.EncryptedOCRKeyBundleID)
+		}
+	}
+	if spec.P2PPeerID != nil {
+		_, err := o.keyStore.P2P().Get(spec.P2PPeerID.Raw())
+		if err != nil {
+			return errors.Wrapf(ErrNoSuchPeerID, "%v", spec.P2PPeerID)
+		}
+	}
+	if spec.TransmitterAddress != nil {
+		_, err := o.keyStore.Eth().Get(spec.TransmitterAddress.Hex())
+		if err != nil {
+			return errors.Wrapf(ErrNoSuchTransmitterAddress, "%v", spec.TransmitterAddress)
+		}
+	}
+	return nil
+}
+
+// UpdateJob replaces the spec of an existing job. The job keeps its ID,
+// external job ID and type spec ID, so any state keyed on the type spec (e.g.
+// OCR persistent state) is preserved. The pipeline is stored as a new pipeline
+// spec; the previous one is left untouched, so finished and suspended runs
+// keep the graph they started with. The job type cannot be changed.
+//
+// NOTE: This is not wrapped in a db transaction so if you call this, you should
+// use postgres.TransactionManager to create the transaction in the context.
+func (o *orm) UpdateJob(ctx context.Context, id int32, jobSpec *Job, p pipeline.Pipeline) (Job, error) {
+	var jb Job
+	if err := o.validateBridges(p); err != nil {
+		return jb, err
+	}
+
+	tx := postgres.TxFromContext(ctx, o.db)
+
+	var existing Job
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existing, "jobs.id = ?", id).Error; err != nil {
+		return jb, err
+	}
+	if existing.Type != jobSpec.Type {
+		return jb, errors.Wrapf(ErrJobTypeMismatch, "cannot change job type from %s to %s", existing.Type, jobSpec.Type)
+	}
+	if jobSpec.ExternalJobID == (uuid.UUID{}) {
+		jobSpec.ExternalJobID = existing.ExternalJobID
+	} else if jobSpec.ExternalJobID != existing.ExternalJobID {
+		return jb, errors.Errorf("cannot change external job ID from %s to %s", existing.ExternalJobID, jobSpec.ExternalJobID)
+	}
+
+	if err := o.updateTypeSpec(tx, existing, jobSpec); err != nil {
+		return jb, err
+	}
+
 	pipelineSpecID, err := o.pipelineORM.CreateSpec(ctx, tx, p, jobSpec.MaxTaskDuration)
 	if err != nil {
 		return jb, errors.Wrap(err, "failed to create pipeline spec")
 	}
 	jobSpec.PipelineSpecID = pipelineSpecID
-	err = tx.Create(jobSpec).Error
+
+	err = tx.Exec(`UPDATE jobs SET name = ?, schema_version = ?, max_task_duration = ?, pipeline_spec_id = ? WHERE id = ?`,
+		jobSpec.Name, jobSpec.SchemaVersion, jobSpec.MaxTaskDuration, pipelineSpecID, id,
+	).Error
 	if err != nil {
-		return jb, errors.Wrap(err, "failed to create job")
+		return jb, errors.Wrap(err, "failed to update job")
 	}
 
-	return o.FindJob(ctx, jobSpec.ID)
+	return o.FindJob(ctx, id)
+}
+
+// updateTypeSpec overwrites the type-specific spec record of existing with
+// the one from jobSpec
+func (o *orm) updateTypeSpec(tx *gorm.DB, existing Job, jobSpec *Job) error {
+	var spec interface{}
+	switch jobSpec.Type {
+	case DirectRequest:
+		jobSpec.DirectRequestSpecID = existing.DirectRequestSpecID
+		jobSpec.DirectRequestSpec.ID = *existing.DirectRequestSpecID
+		spec = jobSpec.DirectRequestSpec
+	case FluxMonitor:
+		jobSpec.FluxMonitorSpecID = existing.FluxMonitorSpecID
+		jobSpec.FluxMonitorSpec.ID = *existing.FluxMonitorSpecID
+		spec = jobSpec.FluxMonitorSpec
+	case OffchainReporting:
+		if err := o.validateOCRKeys(jobSpec.OffchainreportingOracleSpec); err != nil {
+			return err
+		}
+		jobSpec.OffchainreportingOracleSpecID = existing.OffchainreportingOracleSpecID
+		jobSpec.OffchainreportingOracleSpec.ID = *existing.OffchainreportingOracleSpecID
+		spec = jobSpec.OffchainreportingOracleSpec
+	case Keeper:
+		jobSpec.KeeperSpecID = existing.KeeperSpecID
+		jobSpec.KeeperSpec.ID = *existing.KeeperSpecID
+		spec = jobSpec.KeeperSpec
+	case Cron:
+		jobSpec.CronSpecID = existing.CronSpecID
+		jobSpec.CronSpec.ID = *existing.CronSpecID
+		spec = jobSpec.CronSpec
+	case VRF:
+		jobSpec.VRFSpecID = existing.VRFSpecID
+		jobSpec.VRFSpec.ID = *existing.VRFSpecID
+		spec = jobSpec.VRFSpec
+	case Webhook:
+		jobSpec.WebhookSpecID = existing.WebhookSpecID
+		jobSpec.WebhookSpec.ID = *existing.WebhookSpecID
+		spec = jobSpec.WebhookSpec
+	default:
+		return errors.Errorf("unsupported job type: %v", jobSpec.Type)
+	}
+
+	err := tx.Omit(clause.Associations, "created_at").Save(spec).Error
+	if pqErr, ok := err.(*pgconn.PgError); ok && pqErr.Code == "23503" && pqErr.ConstraintName == "vrf_specs_public_key_fkey" {
+		return errors.Wrapf(ErrNoSuchPublicKey, "%s", jobSpec.VRFSpec.PublicKey.String())
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to update %T for jobSpec", spec)
+	}
+
+	if jobSpec.Type == Webhook {
+		err = tx.Exec(`DELETE FROM external_initiator_webhook_specs WHERE webhook_spec_id = ?`, jobSpec.WebhookSpec.ID).Error
+		if err != nil {
+			return errors.Wrap(err, "failed to delete ExternalInitiatorWebhookSpecs for WebhookSpec")
+		}
+		for i, eiWS := range jobSpec.WebhookSpec.ExternalInitiatorWebhookSpecs {
+			jobSpec.WebhookSpec.ExternalInitiatorWebhookSpecs[i].WebhookSpecID = jobSpec.WebhookSpec.ID
+			err := tx.Create(&jobSpec.WebhookSpec.ExternalInitiatorWebhookSpecs[i]).Error
+			if err != nil {
+				return errors.Wrapf(err, "failed to create ExternalInitiatorWebhookSpec for WebhookSpec: %#v", eiWS)
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetJobPaused marks the job as paused or unpaused. Paused jobs are not
+// started by the spawner.
+func (o *orm) SetJobPaused(ctx context.Context, id int32, paused bool) error {
+	tx := postgres.TxFromContext(ctx, o.db)
+	var pausedAt interface{}
+	if paused {
+		pausedAt = time.Now()
+	}
+	result := tx.Exec(`UPDATE jobs SET paused_at = ? WHERE id = ?`, pausedAt, id)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "SetJobPaused failed")
+	} else if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdateOCRKeys sets the key bundle and peer ID of an OCR job, leaving the
+// rest of its spec untouched. A nil key falls back to the node's configured
+// key.
+func (o *orm) UpdateOCRKeys(ctx context.Context, id int32, keyBundleID *models.Sha256Hash, peerID *p2pkey.PeerID) error {
+	tx := postgres.TxFromContext(ctx, o.db)
+	result := tx.Exec(`
+		UPDATE offchainreporting_oracle_specs SET encrypted_ocr_key_bundle_id = ?, p2p_peer_id = ?, updated_at = NOW()
+		FROM jobs WHERE jobs.offchainreporting_oracle_spec_id = offchainreporting_oracle_specs.id AND jobs.id = ?`,
+		keyBundleID, peerID, id,
+	)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "UpdateOCRKeys failed")
+	} else if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
 }
 
 // DeleteJob removes a job
@@ -221,6 +403,7 @@ func (o *orm) DeleteJob(ctx context.Context, id int32) error {
 	err := tx.Exec(`
 		WITH deleted_jobs AS (
 			DELETE FROM jobs WHERE id = ? RETURNING
+				id,
 				pipeline_spec_id,
 				offchainreporting_oracle_spec_id,
 				keeper_spec_id,
@@ -251,7 +434,7 @@ func (o *orm) DeleteJob(ctx context.Context, id int32) error {
 		deleted_dr_specs AS (
 			DELETE FROM direct_request_specs WHERE id IN (SELECT direct_request_spec_id FROM deleted_jobs)
 		)
-		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)
+		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs) OR job_id IN (SELECT id FROM deleted_jobs)
 	`, id).Error
 	if err != nil {
 		return errors.Wrap(err, "DeleteJob failed to delete job")
@@ -444,8 +627,9 @@ func (o *orm) preloadJobIDs(runs []pipeline.Run) error {
 		ids = append(ids, run.PipelineSpecID)
 	}
 
-	// construct a WHERE IN query
-	sql := `SELECT id, pipeline_spec_id FROM jobs WHERE pipeline_spec_id IN (?);`
+	// construct a WHERE IN query. A job's earlier pipeline specs still point at
+	// it, so runs started before the job was updated are attributed to it too
+	sql := `SELECT job_id AS id, id AS pipeline_spec_id FROM pipeline_specs WHERE id IN (?) AND job_id IS NOT NULL;`
 	query, args, err := sqlx.In(sql, ids)
 	if err != nil {
 		return err
@@ -511,8 +695,8 @@ func (o *orm) PipelineRunsByJobID(jobID int32, offset, size int) ([]pipeline.Run
 	var count int64
 	err := o.db.
 		Model(pipeline.Run{}).
-		Joins("INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id").
-		Where("jobs.id = ?", jobID).
+		Joins("INNER JOIN pipeline_specs ON pipeline_runs.pipeline_spec_id = pipeline_specs.id").
+		Where("pipeline_specs.job_id = ?", jobID).
 		Count(&count).
 		Error
 
@@ -526,11 +710,11 @@ func (o *orm) PipelineRunsByJobID(jobID int32, offset, size int) ([]pipeline.Run
 			return db.
 				Order("created_at ASC, id ASC")
 		}).
-		Joins("INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id").
-		Where("jobs.id = ?", jobID).
+		Joins("INNER JOIN pipeline_specs ON pipeline_runs.pipeline_spec_id = pipeline_specs.id").
+		Where("pipeline_specs.job_id = ?", jobID).
 		Limit(size).
 		Offset(offset).
-		Order("created_at DESC, id DESC").
+		Order("pipeline_runs.created_at DESC, pipeline_runs.id DESC").
 		Find(&pipelineRuns).
 		Error
 
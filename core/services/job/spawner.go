@@ -26,6 +26,11 @@ type (
 		service.Service
 		CreateJob(ctx context.Context, spec Job, name null.String) (Job, error)
 		DeleteJob(ctx context.Context, jobID int32) error
+		UpdateJob(ctx context.Context, jobID int32, spec Job) (Job, error)
+		PauseJob(ctx context.Context, jobID int32) error
+		UnpauseJob(ctx context.Context, jobID int32) error
+		RestartJob(ctx context.Context, jobID int32) error
+		StopJob(jobID int32)
 		ActiveJobs() map[int32]Job
 
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -104,6 +109,10 @@ func (js *spawner) startAllServices() {
 	}
 
 	for _, spec := range specs {
+		if spec.PausedAt.Valid {
+			logger.Infow("Not starting paused job", "jobID", spec.ID)
+			continue
+		}
 		if err = js.StartService(spec); err != nil {
 			logger.Errorf("Couldn't start service %v: %v", spec.Name, err)
 		}
@@ -229,13 +238,18 @@ func (js *spawner) DeleteJob(ctx context.Context, jobID int32) error {
 		defer js.activeJobsMu.RUnlock()
 		aj, exists = js.activeJobs[jobID]
 	}()
-	if !exists {
-		return errors.Errorf("job not found (id: %v)", jobID)
+	if exists {
+		// Stop the service if we own the job.
+		js.stopService(jobID)
+	} else {
+		// A paused job has no running services, but may still be deleted
+		var err error
+		aj, err = js.pausedJob(ctx, jobID)
+		if err != nil {
+			return err
+		}
 	}
 
-	// Stop the service if we own the job.
-	js.stopService(jobID)
-
 	aj.delegate.BeforeJobDeleted(aj.spec)
 
 	combctx, cancel := utils.CombinedContext(js.chStop, ctx)
@@ -259,6 +273,161 @@ func (js *spawner) DeleteJob(ctx context.Context, jobID int32) error {
 	return nil
 }
 
+// pausedJob returns an activeJob with no services for a job that exists but
+// is paused
+func (js *spawner) pausedJob(ctx context.Context, jobID int32) (aj activeJob, err error) {
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil || !jb.PausedAt.Valid {
+		return aj, errors.Errorf("job not found (id: %v)", jobID)
+	}
+	delegate, exists := js.jobTypeDelegates[jb.Type]
+	if !exists {
+		return aj, errors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
+	}
+	return activeJob{delegate: delegate, spec: jb}, nil
+}
+
+// UpdateJob atomically replaces the spec of an existing job, keeping its ID
+// and run history. The job's services are stopped and restarted with the new
+// spec, unless the job is paused.
+//
+// Should not get called before Start()
+func (js *spawner) UpdateJob(ctx context.Context, jobID int32, spec Job) (Job, error) {
+	var jb Job
+	delegate, exists := js.jobTypeDelegates[spec.Type]
+	if !exists {
+		return jb, errors.Errorf("job type '%s' has not been registered with the job.Spawner", spec.Type)
+	}
+
+	var oldJob activeJob
+	var wasActive bool
+	func() {
+		js.activeJobsMu.RLock()
+		defer js.activeJobsMu.RUnlock()
+		oldJob, wasActive = js.activeJobs[jobID]
+	}()
+	if wasActive {
+		js.stopService(jobID)
+	}
+
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+
+	ctx, cancel = context.WithTimeout(ctx, postgres.DefaultQueryTimeout)
+	defer cancel()
+	err := js.txm.TransactWithContext(ctx, func(ctx context.Context) error {
+		var err error
+		jb, err = js.orm.UpdateJob(ctx, jobID, &spec, spec.Pipeline)
+		return err
+	})
+	if err != nil {
+		logger.Errorw("Error updating job", "jobID", jobID, "error", err)
+		if wasActive {
+			// Put the old job back the way it was
+			if serr := js.StartService(oldJob.spec); serr != nil {
+				logger.Errorw("Error restarting job after failed update", "jobID", jobID, "error", serr)
+			}
+		}
+		return jb, err
+	}
+
+	if wasActive {
+		oldJob.delegate.BeforeJobDeleted(oldJob.spec)
+	}
+	delegate.AfterJobCreated(jb)
+
+	if !jb.PausedAt.Valid {
+		if err = js.StartService(jb); err != nil {
+			return jb, err
+		}
+	}
+
+	logger.Infow("Updated job", "type", jb.Type, "jobID", jb.ID)
+	return jb, nil
+}
+
+// PauseJob stops the services of a job without deleting it. A paused job is
+// not started again until it is resumed, even across node restarts.
+//
+// Should not get called before Start()
+func (js *spawner) PauseJob(ctx context.Context, jobID int32) error {
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+
+	if err := js.orm.SetJobPaused(ctx, jobID, true); err != nil {
+		return err
+	}
+	js.stopService(jobID)
+
+	logger.Infow("Paused job", "jobID", jobID)
+	return nil
+}
+
+// UnpauseJob restarts the services of a paused job. It is a no-op if the job
+// is already running.
+//
+// Should not get called before Start()
+func (js *spawner) UnpauseJob(ctx context.Context, jobID int32) error {
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	if !jb.PausedAt.Valid {
+		return nil
+	}
+	if err = js.orm.SetJobPaused(ctx, jobID, false); err != nil {
+		return err
+	}
+	jb.PausedAt = null.Time{}
+
+	if err = js.StartService(jb); err != nil {
+		return err
+	}
+
+	logger.Infow("Resumed job", "jobID", jobID)
+	return nil
+}
+
+// RestartJob stops the services of a job and starts them again from the
+// spec in the database, e.g. after the keys it uses were changed. Paused jobs
+// are left alone.
+//
+// Should not get called before Start()
+func (js *spawner) RestartJob(ctx context.Context, jobID int32) error {
+	ctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	if jb.PausedAt.Valid {
+		return nil
+	}
+	js.stopService(jobID)
+
+	if err = js.StartService(jb); err != nil {
+		return err
+	}
+
+	logger.Infow("Restarted job", "jobID", jobID)
+	return nil
+}
+
+// StopJob stops the services of a job without deleting or pausing it, e.g.
+// while a resource they use is replaced. The job is started again by
+// RestartJob, or when the node restarts.
+func (js *spawner) StopJob(jobID int32) {
+	js.stopService(jobID)
+	logger.Infow("Stopped job", "jobID", jobID)
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -224,4 +224,110 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 
 		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
 	})
+	clearDB(t, db)
+
+	t.Run("pauses and resumes job services without deleting the job", func(t *testing.T) {
+		jobSpecA := makeOCRJobSpec(t, address)
+
+		serviceA1 := new(mocks.Service)
+		serviceA2 := new(mocks.Service)
+		serviceA1.On("Start").Return(nil).Once()
+		serviceA2.On("Start").Return(nil).Once()
+
+		orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db), keyStore)
+		d := offchainreporting.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t))
+		delegateA := &delegate{jobSpecA.Type, []job.Service{serviceA1, serviceA2}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobSpecA.Type: delegateA,
+		}, txm)
+		spawner.Start()
+
+		jobA, err := spawner.CreateJob(context.Background(), *jobSpecA, null.String{})
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.PauseJob(context.Background(), jobA.ID))
+		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
+		require.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+
+		jb, err := orm.FindJob(context.Background(), jobA.ID)
+		require.NoError(t, err)
+		require.True(t, jb.PausedAt.Valid)
+
+		// A paused job is not started when the spawner restarts
+		require.NoError(t, spawner.Close())
+		spawner = job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobSpecA.Type: delegateA,
+		}, txm)
+		spawner.Start()
+		require.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+
+		serviceA1.On("Start").Return(nil).Once()
+		serviceA2.On("Start").Return(nil).Once()
+		require.NoError(t, spawner.UnpauseJob(context.Background(), jobA.ID))
+		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		jb, err = orm.FindJob(context.Background(), jobA.ID)
+		require.NoError(t, err)
+		require.False(t, jb.PausedAt.Valid)
+
+		// Paused jobs can still be deleted
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.PauseJob(context.Background(), jobA.ID))
+		require.NoError(t, spawner.DeleteJob(context.Background(), jobA.ID))
+		_, err = orm.FindJob(context.Background(), jobA.ID)
+		require.Error(t, err)
+
+		require.NoError(t, spawner.Close())
+		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
+	})
+
+	clearDB(t, db)
+
+	t.Run("restarts job services with the new spec on 'UpdateJob()'", func(t *testing.T) {
+		jobSpecA := makeOCRJobSpec(t, address)
+
+		serviceA1 := new(mocks.Service)
+		serviceA2 := new(mocks.Service)
+		serviceA1.On("Start").Return(nil).Once()
+		serviceA2.On("Start").Return(nil).Once()
+
+		orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db), keyStore)
+		d := offchainreporting.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t))
+		delegateA := &delegate{jobSpecA.Type, []job.Service{serviceA1, serviceA2}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobSpecA.Type: delegateA,
+		}, txm)
+		spawner.Start()
+		defer spawner.Close()
+
+		jobA, err := spawner.CreateJob(context.Background(), *jobSpecA, null.StringFrom("original"))
+		require.NoError(t, err)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		serviceA1.On("Start").Return(nil).Once()
+		serviceA2.On("Start").Return(nil).Once()
+
+		updatedSpec := makeOCRJobSpec(t, address)
+		updatedSpec.ExternalJobID = jobA.ExternalJobID
+		updatedSpec.Name = null.StringFrom("updated")
+		updated, err := spawner.UpdateJob(context.Background(), jobA.ID, *updatedSpec)
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
+
+		require.Equal(t, jobA.ID, updated.ID)
+		require.Equal(t, jobA.ExternalJobID, updated.ExternalJobID)
+		require.Equal(t, jobA.PipelineSpecID, updated.PipelineSpecID)
+		require.Equal(t, *jobA.OffchainreportingOracleSpecID, *updated.OffchainreportingOracleSpecID)
+		require.Equal(t, "updated", updated.Name.ValueOrZero())
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+	})
 }
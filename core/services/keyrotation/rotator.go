@@ -0,0 +1,332 @@
+package keyrotation
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
+	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// reapInterval is how often the retired keys whose grace period has elapsed
+// are deleted
+const reapInterval = time.Minute
+
+type (
+	// Rotator replaces CSA, OCR and P2P keys by new keys, and re-points the
+	// jobs using the old keys to the new ones. Old keys are retired and kept
+	// until KeyRotationGracePeriod has elapsed, so that in-flight OCR rounds
+	// and feeds manager connections can complete.
+	Rotator interface {
+		service.Service
+
+		RotateCSAKey(ctx context.Context, id string) (csakey.KeyV2, error)
+		RotateOCRKey(ctx context.Context, id string) (ocrkey.KeyV2, error)
+		RotateP2PKey(ctx context.Context, id string) (p2pkey.KeyV2, error)
+	}
+
+	// Config is the config used by Rotator
+	Config interface {
+		KeyRotationGracePeriod() time.Duration
+	}
+
+	// KeyStore is the subset of keystore.Master used by Rotator
+	KeyStore interface {
+		CSA() keystore.CSA
+		OCR() keystore.OCR
+		P2P() keystore.P2P
+		DeleteExpiredRetiredKeys(now time.Time) ([]keystore.RetiredKey, error)
+	}
+
+	rotator struct {
+		utils.StartStopOnce
+		config       Config
+		keyStore     KeyStore
+		jobORM       job.ORM
+		jobSpawner   job.Spawner
+		chainSet     evm.ChainSet
+		peerWrapper  *offchainreporting.SingletonPeerWrapper
+		feedsService feeds.Service
+		logger       logger.Logger
+
+		// mu serializes rotations, so that jobs are re-pointed in the order
+		// their keys were rotated
+		mu sync.Mutex
+
+		stop chan struct{}
+		done chan struct{}
+	}
+)
+
+var _ Rotator = (*rotator)(nil)
+
+// NewRotator returns a new Rotator. peerWrapper is nil when OCR is disabled,
+// and feedsService is nil when the node has no feeds manager support; the
+// P2P peer is then not restarted, and the new keys are not reported.
+func NewRotator(
+	config Config,
+	keyStore KeyStore,
+	jobORM job.ORM,
+	jobSpawner job.Spawner,
+	chainSet evm.ChainSet,
+	peerWrapper *offchainreporting.SingletonPeerWrapper,
+	feedsService feeds.Service,
+	lggr logger.Logger,
+) Rotator {
+	return &rotator{
+		config:       config,
+		keyStore:     keyStore,
+		jobORM:       jobORM,
+		jobSpawner:   jobSpawner,
+		chainSet:     chainSet,
+		peerWrapper:  peerWrapper,
+		feedsService: feedsService,
+		logger:       lggr.Named("KeyRotator"),
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+}
+
+func (r *rotator) Start() error {
+	return r.StartOnce("KeyRotator", func() error {
+		go r.run()
+
+		return nil
+	})
+}
+
+func (r *rotator) Close() error {
+	return r.StopOnce("KeyRotator", func() error {
+		close(r.stop)
+		<-r.done
+		return nil
+	})
+}
+
+func (r *rotator) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(reapInterval)
+	defer ticker.Stop()
+
+	r.deleteExpiredKeys()
+	for {
+		select {
+		case <-ticker.C:
+			r.deleteExpiredKeys()
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// deleteExpiredKeys deletes the retired keys whose grace period has elapsed
+func (r *rotator) deleteExpiredKeys() {
+	deleted, err := r.keyStore.DeleteExpiredRetiredKeys(time.Now())
+	if err != nil {
+		r.logger.Errorw("Failed to delete expired retired keys", "err", err)
+		return
+	}
+	for _, rk := range deleted {
+		r.logger.Infow("Deleted retired key", "keyType", rk.KeyType, "keyID", rk.KeyID, "replacedBy", rk.ReplacedBy)
+	}
+}
+
+// RotateCSAKey replaces the CSA key with id by a new key, and reconnects to
+// the feeds managers with it. The feeds managers are told about the new key
+// over the old connection first, so that they accept the new one.
+func (r *rotator) RotateCSAKey(ctx context.Context, id string) (csakey.KeyV2, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, err := r.keyStore.CSA().Rotate(id, r.config.KeyRotationGracePeriod())
+	if err != nil {
+		return key, errors.Wrap(err, "failed to rotate CSA key")
+	}
+	r.logger.Infow("Rotated CSA key", "oldKeyID", id, "newKeyID", key.ID())
+
+	r.syncFeedsManagers()
+	if r.feedsService != nil {
+		if err = r.feedsService.ReconnectManagers(); err != nil {
+			return key, errors.Wrapf(err, "CSA key was rotated to %s, but failed to reconnect to the feeds managers", key.ID())
+		}
+	}
+	return key, nil
+}
+
+// RotateOCRKey replaces the OCR key bundle with id by a new bundle, and
+// restarts the OCR jobs using it with the new bundle. Jobs without a key
+// bundle of their own use the new bundle if OCR_KEY_BUNDLE_ID is the old
+// bundle.
+func (r *rotator) RotateOCRKey(ctx context.Context, id string) (ocrkey.KeyV2, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, err := r.keyStore.OCR().Rotate(id, r.config.KeyRotationGracePeriod())
+	if err != nil {
+		return key, errors.Wrap(err, "failed to rotate OCR key")
+	}
+	r.logger.Infow("Rotated OCR key", "oldKeyID", id, "newKeyID", key.ID())
+
+	jobs, err := r.ocrJobs()
+	if err != nil {
+		return key, errors.Wrapf(err, "OCR key was rotated to %s, but failed to load the jobs to update", key.ID())
+	}
+	newBundleID, err := models.Sha256HashFromHex(key.ID())
+	if err != nil {
+		return key, err
+	}
+	for _, jb := range jobs {
+		spec := jb.OffchainreportingOracleSpec
+		usesKey, err2 := r.usesOCRKey(*spec, id)
+		if err2 != nil {
+			err = multierr.Append(err, errors.Wrapf(err2, "job %d", jb.ID))
+			continue
+		} else if !usesKey {
+			continue
+		}
+		if err2 = r.rebindJob(ctx, jb, &newBundleID, spec.P2PPeerID); err2 != nil {
+			err = multierr.Append(err, err2)
+		}
+	}
+	r.syncFeedsManagers()
+	if err != nil {
+		return key, errors.Wrapf(err, "OCR key was rotated to %s, but failed to update some jobs", key.ID())
+	}
+	return key, nil
+}
+
+// RotateP2PKey replaces the P2P key with id by a new key, and restarts the
+// OCR jobs using it with the new key. If the P2P peer of the node uses the
+// old key, the peer and all OCR jobs are restarted.
+func (r *rotator) RotateP2PKey(ctx context.Context, id string) (p2pkey.KeyV2, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	oldKey, err := r.keyStore.P2P().Get(id)
+	if err != nil {
+		return p2pkey.KeyV2{}, errors.Wrap(err, "failed to rotate P2P key")
+	}
+	key, err := r.keyStore.P2P().Rotate(id, r.config.KeyRotationGracePeriod())
+	if err != nil {
+		return key, errors.Wrap(err, "failed to rotate P2P key")
+	}
+	r.logger.Infow("Rotated P2P key", "oldKeyID", id, "newKeyID", key.ID())
+
+	jobs, err := r.ocrJobs()
+	if err != nil {
+		return key, errors.Wrapf(err, "P2P key was rotated to %s, but failed to load the jobs to update", key.ID())
+	}
+	oldPeerID, newPeerID := oldKey.PeerID(), key.PeerID()
+
+	// The peer is shared by all OCR jobs, so they are all stopped before it is
+	// restarted, and started again afterwards. They are left stopped if the
+	// peer fails to restart.
+	restartAll := false
+	if r.peerWrapper != nil && r.peerWrapper.IsStarted() && r.peerWrapper.PeerID == oldPeerID {
+		for _, jb := range jobs {
+			r.jobSpawner.StopJob(jb.ID)
+		}
+		if err = r.peerWrapper.Restart(); err != nil {
+			return key, errors.Wrapf(err, "P2P key was rotated to %s, but failed to restart the P2P peer. OCR jobs are stopped until the node is restarted", key.ID())
+		}
+		restartAll = true
+	}
+
+	for _, jb := range jobs {
+		spec := jb.OffchainreportingOracleSpec
+		var err2 error
+		if spec.P2PPeerID != nil && *spec.P2PPeerID == oldPeerID {
+			err2 = r.rebindJob(ctx, jb, spec.EncryptedOCRKeyBundleID, &newPeerID)
+		} else if restartAll {
+			err2 = errors.Wrapf(r.jobSpawner.RestartJob(ctx, jb.ID), "failed to restart job %d", jb.ID)
+		}
+		if err2 != nil {
+			err = multierr.Append(err, err2)
+		}
+	}
+	r.syncFeedsManagers()
+	if err != nil {
+		return key, errors.Wrapf(err, "P2P key was rotated to %s, but failed to update some jobs", key.ID())
+	}
+	return key, nil
+}
+
+// ocrJobs returns all the OCR jobs
+func (r *rotator) ocrJobs() (ocrJobs []job.Job, err error) {
+	jobs, _, err := r.jobORM.JobsV2(0, math.MaxInt32)
+	if err != nil {
+		return nil, err
+	}
+	for _, jb := range jobs {
+		if jb.Type == job.OffchainReporting && jb.OffchainreportingOracleSpec != nil {
+			ocrJobs = append(ocrJobs, jb)
+		}
+	}
+	return ocrJobs, nil
+}
+
+// usesOCRKey returns whether the OCR job with spec uses the key bundle with
+// id, either explicitly or through OCR_KEY_BUNDLE_ID
+func (r *rotator) usesOCRKey(spec job.OffchainReportingOracleSpec, id string) (bool, error) {
+	if spec.IsBootstrapPeer {
+		return false, nil
+	}
+	if spec.EncryptedOCRKeyBundleID != nil {
+		return spec.EncryptedOCRKeyBundleID.String() == id, nil
+	}
+	chain, err := r.chainSet.Get(spec.EVMChainID.ToInt())
+	if err != nil {
+		return false, err
+	}
+	kb, err := chain.Config().OCRKeyBundleID()
+	if err != nil {
+		return false, err
+	}
+	return kb == id, nil
+}
+
+// rebindJob points the OCR job jb to the given keys, and restarts it
+func (r *rotator) rebindJob(ctx context.Context, jb job.Job, keyBundleID *models.Sha256Hash, peerID *p2pkey.PeerID) error {
+	if err := r.jobORM.UpdateOCRKeys(ctx, jb.ID, keyBundleID, peerID); err != nil {
+		return errors.Wrapf(err, "failed to update the keys of job %d", jb.ID)
+	}
+	if err := r.jobSpawner.RestartJob(ctx, jb.ID); err != nil {
+		return errors.Wrapf(err, "failed to restart job %d", jb.ID)
+	}
+	r.logger.Infow("Updated the keys of job", "jobID", jb.ID, "keyBundleID", keyBundleID, "peerID", peerID)
+	return nil
+}
+
+// syncFeedsManagers reports the current keys of the node to the feeds
+// managers
+func (r *rotator) syncFeedsManagers() {
+	if r.feedsService == nil {
+		return
+	}
+	mgrs, err := r.feedsService.ListManagers()
+	if err != nil {
+		r.logger.Errorw("Failed to list feeds managers", "err", err)
+		return
+	}
+	for _, mgr := range mgrs {
+		if err = r.feedsService.SyncNodeInfo(mgr.ID); err != nil {
+			r.logger.Errorw("Failed to sync node info with feeds manager", "feedsManagerID", mgr.ID, "err", err)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package keyrotation_test
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	evmconfigmocks "github.com/smartcontractkit/chainlink/core/chains/evm/config/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	feedsmocks "github.com/smartcontractkit/chainlink/core/services/feeds/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/keyrotation"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
+	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const gracePeriod = time.Hour
+
+type config struct{}
+
+func (config) KeyRotationGracePeriod() time.Duration { return gracePeriod }
+
+type keyStore struct {
+	csa     *ksmocks.CSA
+	ocr     *ksmocks.OCR
+	p2p     *ksmocks.P2P
+	deleted chan time.Time
+}
+
+func (ks *keyStore) CSA() keystore.CSA { return ks.csa }
+func (ks *keyStore) OCR() keystore.OCR { return ks.ocr }
+func (ks *keyStore) P2P() keystore.P2P { return ks.p2p }
+
+func (ks *keyStore) DeleteExpiredRetiredKeys(now time.Time) ([]keystore.RetiredKey, error) {
+	ks.deleted <- now
+	return nil, nil
+}
+
+type testRotator struct {
+	keyrotation.Rotator
+	keyStore     *keyStore
+	jobORM       *jobmocks.ORM
+	spawner      *jobmocks.Spawner
+	chainSet     *evmmocks.ChainSet
+	feedsService *feedsmocks.Service
+}
+
+func setupTestRotator(t *testing.T) *testRotator {
+	ks := &keyStore{
+		csa:     new(ksmocks.CSA),
+		ocr:     new(ksmocks.OCR),
+		p2p:     new(ksmocks.P2P),
+		deleted: make(chan time.Time, 1),
+	}
+	var (
+		jobORM       = new(jobmocks.ORM)
+		spawner      = new(jobmocks.Spawner)
+		chainSet     = new(evmmocks.ChainSet)
+		feedsService = new(feedsmocks.Service)
+	)
+	t.Cleanup(func() {
+		mock.AssertExpectationsForObjects(t, ks.csa, ks.ocr, ks.p2p, jobORM, spawner, chainSet, feedsService)
+	})
+
+	r := keyrotation.NewRotator(config{}, ks, jobORM, spawner, chainSet, nil, feedsService, logger.TestLogger(t))
+	return &testRotator{r, ks, jobORM, spawner, chainSet, feedsService}
+}
+
+// expectSync expects the keys to be reported to a single feeds manager
+func (r *testRotator) expectSync() {
+	r.feedsService.On("ListManagers").Return([]feeds.FeedsManager{{ID: 1}}, nil)
+	r.feedsService.On("SyncNodeInfo", int64(1)).Return(nil)
+}
+
+func ocrJob(id int32, spec job.OffchainReportingOracleSpec) job.Job {
+	return job.Job{ID: id, Type: job.OffchainReporting, OffchainreportingOracleSpec: &spec}
+}
+
+func mustSha256Hash(t *testing.T, hex string) models.Sha256Hash {
+	h, err := models.Sha256HashFromHex(hex)
+	require.NoError(t, err)
+	return h
+}
+
+func TestRotator_DeletesExpiredKeys(t *testing.T) {
+	r := setupTestRotator(t)
+
+	require.NoError(t, r.Start())
+	select {
+	case <-r.keyStore.deleted:
+	case <-time.After(cltest.DefaultWaitTimeout):
+		t.Fatal("expired keys were not deleted")
+	}
+	require.NoError(t, r.Close())
+}
+
+func TestRotator_RotateCSAKey(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		oldKey = cltest.DefaultCSAKey
+		newKey = csakey.MustNewV2XXXTestingOnly(big.NewInt(2))
+	)
+
+	t.Run("reports the new key and reconnects to the feeds managers", func(t *testing.T) {
+		r := setupTestRotator(t)
+		r.keyStore.csa.On("Rotate", oldKey.ID(), gracePeriod).Return(newKey, nil)
+		r.expectSync()
+		r.feedsService.On("ReconnectManagers").Return(nil)
+
+		key, err := r.RotateCSAKey(ctx, oldKey.ID())
+		require.NoError(t, err)
+		require.Equal(t, newKey, key)
+	})
+
+	t.Run("fails if the key cannot be rotated", func(t *testing.T) {
+		r := setupTestRotator(t)
+		r.keyStore.csa.On("Rotate", oldKey.ID(), gracePeriod).Return(csakey.KeyV2{}, keystore.ErrKeyRetired)
+
+		_, err := r.RotateCSAKey(ctx, oldKey.ID())
+		require.ErrorIs(t, err, keystore.ErrKeyRetired)
+	})
+}
+
+func TestRotator_RotateOCRKey(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		oldKey      = cltest.DefaultOCRKey
+		newKey      = ocrkey.MustNewV2XXXTestingOnly(big.NewInt(2))
+		otherKey    = ocrkey.MustNewV2XXXTestingOnly(big.NewInt(3))
+		oldBundleID = mustSha256Hash(t, oldKey.ID())
+		newBundleID = mustSha256Hash(t, newKey.ID())
+		otherID     = mustSha256Hash(t, otherKey.ID())
+		peerID      = cltest.DefaultP2PPeerID
+		chainID     = utils.NewBigI(1337)
+	)
+
+	jobs := []job.Job{
+		// uses the old key explicitly
+		ocrJob(1, job.OffchainReportingOracleSpec{EncryptedOCRKeyBundleID: &oldBundleID, P2PPeerID: &peerID}),
+		// uses the old key through OCR_KEY_BUNDLE_ID
+		ocrJob(2, job.OffchainReportingOracleSpec{EVMChainID: chainID}),
+		// uses another key
+		ocrJob(3, job.OffchainReportingOracleSpec{EncryptedOCRKeyBundleID: &otherID}),
+		// uses no key
+		ocrJob(4, job.OffchainReportingOracleSpec{IsBootstrapPeer: true}),
+		{ID: 5, Type: job.FluxMonitor},
+	}
+
+	setup := func(t *testing.T) *testRotator {
+		r := setupTestRotator(t)
+		r.keyStore.ocr.On("Rotate", oldKey.ID(), gracePeriod).Return(newKey, nil)
+		r.jobORM.On("JobsV2", 0, math.MaxInt32).Return(jobs, len(jobs), nil)
+
+		chain := new(evmmocks.Chain)
+		cfg := new(evmconfigmocks.ChainScopedConfig)
+		r.chainSet.On("Get", chainID.ToInt()).Return(chain, nil)
+		chain.On("Config").Return(cfg)
+		cfg.On("OCRKeyBundleID").Return(oldKey.ID(), nil)
+		return r
+	}
+
+	t.Run("re-points the jobs using the old key to the new key", func(t *testing.T) {
+		r := setup(t)
+		r.jobORM.On("UpdateOCRKeys", ctx, int32(1), &newBundleID, &peerID).Return(nil)
+		r.spawner.On("RestartJob", ctx, int32(1)).Return(nil)
+		r.jobORM.On("UpdateOCRKeys", ctx, int32(2), &newBundleID, (*p2pkey.PeerID)(nil)).Return(nil)
+		r.spawner.On("RestartJob", ctx, int32(2)).Return(nil)
+		r.expectSync()
+
+		key, err := r.RotateOCRKey(ctx, oldKey.ID())
+		require.NoError(t, err)
+		require.Equal(t, newKey, key)
+	})
+
+	t.Run("keeps updating the other jobs when one fails", func(t *testing.T) {
+		r := setup(t)
+		r.jobORM.On("UpdateOCRKeys", ctx, int32(1), &newBundleID, &peerID).Return(errors.New("boom"))
+		r.jobORM.On("UpdateOCRKeys", ctx, int32(2), &newBundleID, (*p2pkey.PeerID)(nil)).Return(nil)
+		r.spawner.On("RestartJob", ctx, int32(2)).Return(nil)
+		r.expectSync()
+
+		key, err := r.RotateOCRKey(ctx, oldKey.ID())
+		require.EqualError(t, err, "OCR key was rotated to "+newKey.ID()+", but failed to update some jobs: failed to update the keys of job 1: boom")
+		require.Equal(t, newKey, key)
+	})
+}
+
+func TestRotator_RotateP2PKey(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		oldKey    = cltest.DefaultP2PKey
+		newKey    = p2pkey.MustNewV2XXXTestingOnly(big.NewInt(2))
+		oldPeerID = oldKey.PeerID()
+		newPeerID = newKey.PeerID()
+		otherID   = p2pkey.MustNewV2XXXTestingOnly(big.NewInt(3)).PeerID()
+		bundleID  = mustSha256Hash(t, cltest.DefaultOCRKey.ID())
+	)
+
+	jobs := []job.Job{
+		ocrJob(1, job.OffchainReportingOracleSpec{EncryptedOCRKeyBundleID: &bundleID, P2PPeerID: &oldPeerID}),
+		ocrJob(2, job.OffchainReportingOracleSpec{P2PPeerID: &otherID}),
+	}
+
+	r := setupTestRotator(t)
+	r.keyStore.p2p.On("Get", oldKey.ID()).Return(oldKey, nil)
+	r.keyStore.p2p.On("Rotate", oldKey.ID(), gracePeriod).Return(newKey, nil)
+	r.jobORM.On("JobsV2", 0, math.MaxInt32).Return(jobs, len(jobs), nil)
+	r.jobORM.On("UpdateOCRKeys", ctx, int32(1), &bundleID, &newPeerID).Return(nil)
+	r.spawner.On("RestartJob", ctx, int32(1)).Return(nil)
+	r.expectSync()
+
+	key, err := r.RotateP2PKey(ctx, oldKey.ID())
+	require.NoError(t, err)
+	require.Equal(t, newKey, key)
+}
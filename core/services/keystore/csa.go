@@ -2,6 +2,7 @@ package keystore
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
@@ -20,6 +21,8 @@ type CSA interface {
 	Delete(id string) (csakey.KeyV2, error)
 	Import(keyJSON []byte, password string) (csakey.KeyV2, error)
 	Export(id string, password string) ([]byte, error)
+	Rotate(id string, gracePeriod time.Duration) (csakey.KeyV2, error)
+	GetAllActive() ([]csakey.KeyV2, error)
 
 	GetV1KeysAsV2() ([]csakey.KeyV2, error)
 }
@@ -118,6 +121,43 @@ func (ks *csa) Import(keyJSON []byte, password string) (csakey.KeyV2, error) {
 	return key, ks.keyManager.safeAddKey(key)
 }
 
+// Rotate replaces the CSA key with id by a new key. The old key is retired,
+// and kept until gracePeriod has elapsed.
+func (ks *csa) Rotate(id string, gracePeriod time.Duration) (csakey.KeyV2, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return csakey.KeyV2{}, ErrLocked
+	}
+	oldKey, err := ks.getByID(id)
+	if err != nil {
+		return csakey.KeyV2{}, err
+	}
+	newKey, err := csakey.NewV2()
+	if err != nil {
+		return csakey.KeyV2{}, err
+	}
+	if err = ks.rotateKey(oldKey, newKey, gracePeriod); err != nil {
+		return csakey.KeyV2{}, err
+	}
+	return newKey, nil
+}
+
+// GetAllActive returns the CSA keys which were not retired by a rotation
+func (ks *csa) GetAllActive() (keys []csakey.KeyV2, _ error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	for id, key := range ks.keyRing.CSA {
+		if !ks.isRetired(id) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
 func (ks *csa) Export(id string, password string) ([]byte, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -2,6 +2,7 @@ package keystore_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
@@ -17,6 +18,7 @@ func Test_CSAKeyStore_E2E(t *testing.T) {
 	ks := keyStore.CSA()
 	reset := func() {
 		require.NoError(t, db.Exec("DELETE FROM encrypted_key_rings").Error)
+		require.NoError(t, db.Exec("DELETE FROM retired_keys").Error)
 		keyStore.ResetXXXTestOnly()
 		keyStore.Unlock(cltest.Password)
 	}
@@ -78,4 +80,21 @@ func Test_CSAKeyStore_E2E(t *testing.T) {
 		_, err = ks.Get(newKey.ID())
 		require.Error(t, err)
 	})
+
+	t.Run("rotates a key", func(t *testing.T) {
+		defer reset()
+		oldKey, err := ks.Create()
+		require.NoError(t, err)
+		newKey, err := ks.Rotate(oldKey.ID(), time.Hour)
+		require.NoError(t, err)
+		require.NotEqual(t, oldKey.ID(), newKey.ID())
+		keys, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Equal(t, 2, len(keys))
+		keys, err = ks.GetAllActive()
+		require.NoError(t, err)
+		require.Equal(t, []csakey.KeyV2{newKey}, keys)
+		_, err = ks.Rotate(oldKey.ID(), time.Hour)
+		require.ErrorIs(t, err, keystore.ErrKeyRetired)
+	})
 }
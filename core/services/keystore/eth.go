@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sort"
@@ -23,6 +24,7 @@ type Eth interface {
 	GetAll() ([]ethkey.KeyV2, error)
 	Create(chainID *big.Int) (ethkey.KeyV2, error)
 	Add(key ethkey.KeyV2, chainID *big.Int) error
+	AddExternal(address common.Address, chainID *big.Int) (ethkey.KeyV2, error)
 	Delete(id string) (ethkey.KeyV2, error)
 	Import(keyJSON []byte, password string, chainID *big.Int) (ethkey.KeyV2, error)
 	Export(id string, password string) ([]byte, error)
@@ -31,10 +33,12 @@ type Eth interface {
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 
 	SignTx(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SetExternalSigner(signer ExternalSigner)
 
 	SendingKeys() (keys []ethkey.KeyV2, err error)
 	FundingKeys() (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(addresses ...common.Address) (address common.Address, err error)
+	GetLoadBalancedAddress(load KeyLoad, addresses ...common.Address) (address common.Address, err error)
 
 	GetState(id string) (ethkey.State, error)
 	SetState(ethkey.State) error
@@ -44,10 +48,15 @@ type Eth interface {
 	GetV1KeysAsV2(chainID *big.Int) ([]ethkey.KeyV2, []ethkey.State, error)
 }
 
+// KeyLoad returns how loaded a sending key is, e.g. by the number of
+// transactions queued for it, and false if the key must not be used
+type KeyLoad func(address common.Address) (load int64, usable bool)
+
 type eth struct {
 	*keyManager
-	subscribers   [](chan struct{})
-	subscribersMu *sync.RWMutex
+	externalSigner ExternalSigner
+	subscribers    [](chan struct{})
+	subscribersMu  *sync.RWMutex
 }
 
 var _ Eth = &eth{}
@@ -116,6 +125,30 @@ func (ks *eth) Add(key ethkey.KeyV2, chainID *big.Int) error {
 	return nil
 }
 
+// AddExternal adds a key whose private key is held by the external signer.
+// Only its address is stored, and transactions from it are signed by the
+// external signer.
+func (ks *eth) AddExternal(address common.Address, chainID *big.Int) (ethkey.KeyV2, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	if ks.externalSigner == nil {
+		return ethkey.KeyV2{}, errors.New("no external signer is configured")
+	}
+	key := ethkey.FromExternalAddress(ethkey.EIP55AddressFromAddress(address))
+	if _, found := ks.keyRing.Eth[key.ID()]; found {
+		return ethkey.KeyV2{}, fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	err := ks.addEthKeyWithState(key, ethkey.State{EVMChainID: *utils.NewBig(chainID), IsExternal: true})
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "unable to add eth key")
+	}
+	ks.notify()
+	return key, nil
+}
+
 func (ks *eth) EnsureKeys(chainID *big.Int) (
 	sendingKey ethkey.KeyV2,
 	sendDidExist bool,
@@ -236,20 +269,43 @@ func (ks *eth) SubscribeToKeyChanges() (ch chan struct{}, unsub func()) {
 	}
 }
 
+// SignTx signs tx with the key of address, or with the external signer if the
+// key is external. Errors of the external signer are classified as
+// ErrExternalSignerUnavailable or ErrExternalSignerRejected.
 func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
-	ks.lock.RLock()
-	defer ks.lock.RUnlock()
-	if ks.isLocked() {
-		return nil, ErrLocked
-	}
-	key, err := ks.getByID(address.Hex())
+	key, externalSigner, err := ks.getSigningKey(address)
 	if err != nil {
 		return nil, err
 	}
+	if key.IsExternal() {
+		// The keystore is not locked while waiting on the external signer
+		if externalSigner == nil {
+			return nil, newExternalSignerError(ErrExternalSignerUnavailable, errors.Errorf("no external signer is configured to sign for %s", address.Hex()))
+		}
+		return externalSigner.SignTx(context.Background(), address, tx, chainID)
+	}
 	signer := types.LatestSignerForChainID(chainID)
 	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
 }
 
+// SetExternalSigner sets the signer of the keys whose private keys are held
+// outside of the node
+func (ks *eth) SetExternalSigner(signer ExternalSigner) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	ks.externalSigner = signer
+}
+
+func (ks *eth) getSigningKey(address common.Address) (ethkey.KeyV2, ExternalSigner, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, nil, ErrLocked
+	}
+	key, err := ks.getByID(address.Hex())
+	return key, ks.externalSigner, err
+}
+
 func (ks *eth) SendingKeys() (sendingKeys []ethkey.KeyV2, err error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -275,19 +331,7 @@ func (ks *eth) GetRoundRobinAddress(whitelist ...common.Address) (common.Address
 		return common.Address{}, ErrLocked
 	}
 
-	var keys []ethkey.KeyV2
-	if len(whitelist) == 0 {
-		keys = ks.sendingKeys()
-	} else if len(whitelist) > 0 {
-		for _, k := range ks.sendingKeys() {
-			for _, addr := range whitelist {
-				if addr == k.Address.Address() {
-					keys = append(keys, k)
-				}
-			}
-		}
-	}
-
+	keys := ks.whitelistedSendingKeys(whitelist)
 	if len(keys) == 0 {
 		return common.Address{}, errors.New("no keys available")
 	}
@@ -301,6 +345,61 @@ func (ks *eth) GetRoundRobinAddress(whitelist ...common.Address) (common.Address
 	return leastRecentlyUsed.Address.Address(), nil
 }
 
+// GetLoadBalancedAddress returns the usable sending key with the lowest load,
+// out of the whitelist if one is given. Keys with the same load are used
+// round robin.
+func (ks *eth) GetLoadBalancedAddress(load KeyLoad, whitelist ...common.Address) (common.Address, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return common.Address{}, ErrLocked
+	}
+
+	keys := ks.whitelistedSendingKeys(whitelist)
+	if len(keys) == 0 {
+		return common.Address{}, errors.New("no keys available")
+	}
+
+	loads := make(map[common.Address]int64, len(keys))
+	usableKeys := keys[:0]
+	for _, k := range keys {
+		if n, usable := load(k.Address.Address()); usable {
+			loads[k.Address.Address()] = n
+			usableKeys = append(usableKeys, k)
+		}
+	}
+	if len(usableKeys) == 0 {
+		return common.Address{}, errors.Errorf("none of the %d available keys can be used", len(keys))
+	}
+
+	sort.SliceStable(usableKeys, func(i, j int) bool {
+		li, lj := loads[usableKeys[i].Address.Address()], loads[usableKeys[j].Address.Address()]
+		if li != lj {
+			return li < lj
+		}
+		return ks.keyStates.Eth[usableKeys[i].ID()].LastUsed().Before(ks.keyStates.Eth[usableKeys[j].ID()].LastUsed())
+	})
+
+	leastLoaded := usableKeys[0]
+	ks.keyStates.Eth[leastLoaded.ID()].WasUsed()
+	return leastLoaded.Address.Address(), nil
+}
+
+// caller must hold lock!
+func (ks *eth) whitelistedSendingKeys(whitelist []common.Address) (keys []ethkey.KeyV2) {
+	if len(whitelist) == 0 {
+		return ks.sendingKeys()
+	}
+	for _, k := range ks.sendingKeys() {
+		for _, addr := range whitelist {
+			if addr == k.Address.Address() {
+				keys = append(keys, k)
+			}
+		}
+	}
+	return keys
+}
+
 func (ks *eth) GetState(id string) (ethkey.State, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -13,6 +13,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
 )
@@ -170,6 +173,58 @@ func Test_EthKeyStore_GetRoundRobinAddress(t *testing.T) {
 	})
 }
 
+func Test_EthKeyStore_GetLoadBalancedAddress(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db)
+	ethKeyStore := keyStore.Eth()
+
+	_, k1 := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, k2 := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, k3 := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	loads := map[common.Address]int64{k1: 3, k2: 1, k3: 0}
+	load := func(address common.Address) (int64, bool) {
+		// k3 has the lowest load but not enough balance
+		return loads[address], address != k3
+	}
+
+	t.Run("picks the usable key with the fewest pending transactions", func(t *testing.T) {
+		address, err := ethKeyStore.GetLoadBalancedAddress(load)
+		require.NoError(t, err)
+		require.Equal(t, k2, address)
+	})
+
+	t.Run("with address filter, picks among the given addresses", func(t *testing.T) {
+		address, err := ethKeyStore.GetLoadBalancedAddress(load, k1, k3, cltest.NewAddress())
+		require.NoError(t, err)
+		require.Equal(t, k1, address)
+	})
+
+	t.Run("rotates between keys with the same load", func(t *testing.T) {
+		even := func(common.Address) (int64, bool) { return 0, true }
+		address1, err := ethKeyStore.GetLoadBalancedAddress(even, k1, k2)
+		require.NoError(t, err)
+		address2, err := ethKeyStore.GetLoadBalancedAddress(even, k1, k2)
+		require.NoError(t, err)
+		require.NotEqual(t, address1, address2)
+	})
+
+	t.Run("errors when no key is usable", func(t *testing.T) {
+		_, err := ethKeyStore.GetLoadBalancedAddress(load, k3)
+		require.Error(t, err)
+		require.Equal(t, "none of the 1 available keys can be used", err.Error())
+	})
+
+	t.Run("errors when no address matches", func(t *testing.T) {
+		_, err := ethKeyStore.GetLoadBalancedAddress(load, cltest.NewAddress())
+		require.Error(t, err)
+		require.Equal(t, "no keys available", err.Error())
+	})
+}
+
 func Test_EthKeyStore_SignTx(t *testing.T) {
 	db := pgtest.NewGormDB(t)
 	keyStore := cltest.NewKeyStore(t, db)
@@ -190,6 +245,50 @@ func Test_EthKeyStore_SignTx(t *testing.T) {
 	require.NotEqual(t, tx, signed)
 }
 
+func Test_EthKeyStore_AddExternal(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	ethKeyStore := keyStore.Eth()
+
+	chainID := big.NewInt(eth.NullClientChainID)
+	address := cltest.NewAddress()
+	tx := types.NewTransaction(0, cltest.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+
+	_, err := ethKeyStore.AddExternal(address, chainID)
+	require.EqualError(t, err, "no external signer is configured")
+
+	signer := new(mocks.ExternalSigner)
+	ethKeyStore.SetExternalSigner(signer)
+
+	key, err := ethKeyStore.AddExternal(address, chainID)
+	require.NoError(t, err)
+	assert.True(t, key.IsExternal())
+	assert.Equal(t, address, key.Address.Address())
+
+	_, err = ethKeyStore.AddExternal(address, chainID)
+	require.EqualError(t, err, fmt.Sprintf("key with ID %s already exists", address.Hex()))
+
+	states, err := ethKeyStore.GetStatesForKeys([]ethkey.KeyV2{key})
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	assert.True(t, states[0].IsExternal)
+
+	_, err = ethKeyStore.Export(key.ID(), cltest.Password)
+	require.EqualError(t, err, "cannot export a key held by an external signer")
+
+	signedTx := types.NewTransaction(0, cltest.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), nil)
+	signer.On("SignTx", mock.Anything, address, tx, chainID).Return(signedTx, nil).Once()
+	signed, err := ethKeyStore.SignTx(address, tx, chainID)
+	require.NoError(t, err)
+	assert.Equal(t, signedTx, signed)
+
+	signer.On("SignTx", mock.Anything, address, tx, chainID).Return(nil, keystore.ErrExternalSignerRejected).Once()
+	_, err = ethKeyStore.SignTx(address, tx, chainID)
+	require.ErrorIs(t, err, keystore.ErrExternalSignerRejected)
+
+	signer.AssertExpectations(t)
+}
+
 func Test_EthKeyStore_E2E(t *testing.T) {
 	db := pgtest.NewGormDB(t)
 	keyStore := keystore.ExposedNewMaster(t, db)
@@ -0,0 +1,182 @@
+package keystore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrExternalSignerUnavailable is returned when the external signer could
+	// not be reached or did not answer in time. Signing may succeed if retried.
+	ErrExternalSignerUnavailable = errors.New("external signer unavailable")
+	// ErrExternalSignerRejected is returned when the external signer refused
+	// to sign a transaction, or returned something other than the transaction
+	// signed by the requested key. Signing will not succeed if retried.
+	ErrExternalSignerRejected = errors.New("external signer rejected transaction")
+)
+
+//go:generate mockery --name ExternalSigner --output mocks/ --case=underscore
+
+// ExternalSigner signs transactions with ETH keys whose private keys are held
+// outside of the node. Errors must be classified as
+// ErrExternalSignerUnavailable or ErrExternalSignerRejected.
+type ExternalSigner interface {
+	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// externalSignerError classifies the error of an external signer while
+// keeping its message
+type externalSignerError struct {
+	kind error
+	err  error
+}
+
+func newExternalSignerError(kind error, err error) error {
+	return &externalSignerError{kind, err}
+}
+
+func (e *externalSignerError) Error() string {
+	return fmt.Sprintf("%s: %s", e.kind, e.err)
+}
+
+func (e *externalSignerError) Is(target error) bool {
+	return target == e.kind
+}
+
+func (e *externalSignerError) Unwrap() error {
+	return e.err
+}
+
+type jsonRPCSigner struct {
+	client  *rpc.Client
+	timeout time.Duration
+}
+
+var _ ExternalSigner = &jsonRPCSigner{}
+
+// NewJSONRPCSigner returns an ExternalSigner which signs with the
+// eth_signTransaction method of the JSON-RPC API at the HTTP url, as
+// implemented by Clef, Web3Signer and geth. Every request is given up on after
+// timeout.
+func NewJSONRPCSigner(u url.URL, timeout time.Duration) (ExternalSigner, error) {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.Errorf("external signer URL must be http or https, got: %s", u.Scheme)
+	}
+	client, err := rpc.DialHTTP(u.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create external signer client")
+	}
+	return &jsonRPCSigner{client, timeout}, nil
+}
+
+// signTransactionArgs are the params of eth_signTransaction
+type signTransactionArgs struct {
+	From                 common.Address   `json:"from"`
+	To                   *common.Address  `json:"to,omitempty"`
+	Gas                  hexutil.Uint64   `json:"gas"`
+	GasPrice             *hexutil.Big     `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big     `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big     `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big     `json:"value"`
+	Nonce                hexutil.Uint64   `json:"nonce"`
+	Data                 hexutil.Bytes    `json:"data"`
+	ChainID              *hexutil.Big     `json:"chainId"`
+	AccessList           types.AccessList `json:"accessList,omitempty"`
+}
+
+func newSignTransactionArgs(fromAddress common.Address, tx *types.Transaction, chainID *big.Int) signTransactionArgs {
+	args := signTransactionArgs{
+		From:    fromAddress,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		args.AccessList = tx.AccessList()
+	} else {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+	return args
+}
+
+// SignTx asks the external signer to sign tx and checks that what it returns
+// is tx, signed by fromAddress
+func (s *jsonRPCSigner) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var result json.RawMessage
+	err := s.client.CallContext(ctx, &result, "eth_signTransaction", newSignTransactionArgs(fromAddress, tx, chainID))
+	if err != nil {
+		var rpcErr rpc.Error
+		if errors.As(err, &rpcErr) {
+			// The signer answered, and refused
+			return nil, newExternalSignerError(ErrExternalSignerRejected, err)
+		}
+		// Timeouts, connection and HTTP errors are most likely transient or
+		// due to misconfiguration, and must not fail the transaction
+		return nil, newExternalSignerError(ErrExternalSignerUnavailable, err)
+	}
+
+	signedTx, err := decodeSignTransactionResult(result)
+	if err != nil {
+		return nil, newExternalSignerError(ErrExternalSignerRejected, err)
+	}
+	if err = verifySignedTx(fromAddress, tx, signedTx, chainID); err != nil {
+		return nil, newExternalSignerError(ErrExternalSignerRejected, err)
+	}
+	return signedTx, nil
+}
+
+// decodeSignTransactionResult decodes the signed transaction returned by
+// eth_signTransaction, which is either its raw encoding, or an object holding
+// it in the raw field like geth and Clef return
+func decodeSignTransactionResult(result json.RawMessage) (*types.Transaction, error) {
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		var object struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err = json.Unmarshal(result, &object); err != nil || len(object.Raw) == 0 {
+			return nil, errors.Errorf("unexpected eth_signTransaction result: %s", result)
+		}
+		raw = object.Raw
+	}
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "failed to decode signed transaction")
+	}
+	return signedTx, nil
+}
+
+// verifySignedTx ensures that signedTx is tx, signed by fromAddress, so that
+// a misbehaving signer cannot get the node to send anything else
+func verifySignedTx(fromAddress common.Address, tx *types.Transaction, signedTx *types.Transaction, chainID *big.Int) error {
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signedTx) != signer.Hash(tx) || signedTx.Type() != tx.Type() {
+		return errors.New("signed transaction does not match the transaction to sign")
+	}
+	sender, err := types.Sender(signer, signedTx)
+	if err != nil {
+		return errors.Wrap(err, "invalid signature")
+	}
+	if sender != fromAddress {
+		return errors.Errorf("transaction was signed by %s instead of %s", sender.Hex(), fromAddress.Hex())
+	}
+	return nil
+}
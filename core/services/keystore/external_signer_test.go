@@ -0,0 +1,139 @@
+package keystore_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newExternalSignerServer(t *testing.T, handler func(w http.ResponseWriter, id json.RawMessage)) keystore.ExternalSigner {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.Unmarshal(body, &req))
+		assert.Equal(t, "eth_signTransaction", req.Method)
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, req.ID)
+	}))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	signer, err := keystore.NewJSONRPCSigner(*u, 100*time.Millisecond)
+	require.NoError(t, err)
+	return signer
+}
+
+func respondResult(w http.ResponseWriter, id json.RawMessage, result interface{}) {
+	b, _ := json.Marshal(result)
+	fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, id, b)
+}
+
+func Test_JSONRPCSigner_SignTx(t *testing.T) {
+	t.Parallel()
+
+	chainID := big.NewInt(1337)
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	otherKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	from := key.Address.Address()
+	tx := types.NewTransaction(0, cltest.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+
+	signedRaw := func(t *testing.T, k ethkey.KeyV2, tx *types.Transaction) hexutil.Bytes {
+		signed, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), k.ToEcdsaPrivKey())
+		require.NoError(t, err)
+		raw, err := signed.MarshalBinary()
+		require.NoError(t, err)
+		return raw
+	}
+
+	t.Run("accepts the raw signed transaction", func(t *testing.T) {
+		signer := newExternalSignerServer(t, func(w http.ResponseWriter, id json.RawMessage) {
+			respondResult(w, id, signedRaw(t, key, tx))
+		})
+		signed, err := signer.SignTx(context.Background(), from, tx, chainID)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, from, sender)
+	})
+
+	t.Run("accepts an object holding the raw signed transaction", func(t *testing.T) {
+		signer := newExternalSignerServer(t, func(w http.ResponseWriter, id json.RawMessage) {
+			respondResult(w, id, map[string]interface{}{"raw": signedRaw(t, key, tx)})
+		})
+		_, err := signer.SignTx(context.Background(), from, tx, chainID)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejected when the signer returns an error", func(t *testing.T) {
+		signer := newExternalSignerServer(t, func(w http.ResponseWriter, id json.RawMessage) {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32000,"message":"request denied"}}`, id)
+		})
+		_, err := signer.SignTx(context.Background(), from, tx, chainID)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, keystore.ErrExternalSignerRejected)
+		assert.Contains(t, err.Error(), "request denied")
+	})
+
+	t.Run("rejected when signed by another key", func(t *testing.T) {
+		signer := newExternalSignerServer(t, func(w http.ResponseWriter, id json.RawMessage) {
+			respondResult(w, id, signedRaw(t, otherKey, tx))
+		})
+		_, err := signer.SignTx(context.Background(), from, tx, chainID)
+		assert.ErrorIs(t, err, keystore.ErrExternalSignerRejected)
+	})
+
+	t.Run("rejected when another transaction is signed", func(t *testing.T) {
+		otherTx := types.NewTransaction(1, cltest.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), nil)
+		signer := newExternalSignerServer(t, func(w http.ResponseWriter, id json.RawMessage) {
+			respondResult(w, id, signedRaw(t, key, otherTx))
+		})
+		_, err := signer.SignTx(context.Background(), from, tx, chainID)
+		assert.ErrorIs(t, err, keystore.ErrExternalSignerRejected)
+	})
+
+	t.Run("unavailable on HTTP errors", func(t *testing.T) {
+		signer := newExternalSignerServer(t, func(w http.ResponseWriter, id json.RawMessage) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		_, err := signer.SignTx(context.Background(), from, tx, chainID)
+		assert.ErrorIs(t, err, keystore.ErrExternalSignerUnavailable)
+	})
+
+	t.Run("unavailable on timeout", func(t *testing.T) {
+		signer := newExternalSignerServer(t, func(w http.ResponseWriter, id json.RawMessage) {
+			time.Sleep(300 * time.Millisecond)
+			respondResult(w, id, signedRaw(t, key, tx))
+		})
+		_, err := signer.SignTx(context.Background(), from, tx, chainID)
+		assert.ErrorIs(t, err, keystore.ErrExternalSignerUnavailable)
+	})
+}
+
+func Test_NewJSONRPCSigner(t *testing.T) {
+	t.Parallel()
+
+	_, err := keystore.NewJSONRPCSigner(url.URL{Scheme: "ws", Host: "localhost:8550"}, time.Second)
+	require.EqualError(t, err, "external signer URL must be http or https, got: ws")
+}
@@ -29,6 +29,9 @@ type EncryptedEthKeyExport struct {
 }
 
 func (key KeyV2) ToEncryptedJSON(password string, scryptParams utils.ScryptParams) (export []byte, err error) {
+	if key.IsExternal() {
+		return nil, errors.New("cannot export a key held by an external signer")
+	}
 	// DEV: uuid is derived directly from the address, since it is not stored internally
 	id, err := uuid.FromBytes(key.Address.Bytes()[:16])
 	if err != nil {
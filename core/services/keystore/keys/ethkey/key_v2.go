@@ -56,6 +56,12 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// FromExternalAddress returns a key which holds only the address of a private
+// key that is kept in an external signer
+func FromExternalAddress(address EIP55Address) KeyV2 {
+	return KeyV2{Address: address}
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
@@ -68,6 +74,12 @@ func (key KeyV2) ToEcdsaPrivKey() *ecdsa.PrivateKey {
 	return key.privateKey
 }
 
+// IsExternal returns true if the private key of the key is kept in an external
+// signer
+func (key KeyV2) IsExternal() bool {
+	return key.privateKey == nil
+}
+
 func (key KeyV2) String() string {
 	return fmt.Sprintf("EthKeyV2{PrivateKey: <redacted>, Address: %s}", key.Address)
 }
@@ -11,6 +11,7 @@ type State struct {
 	Address    EIP55Address
 	NextNonce  int64
 	IsFunding  bool
+	IsExternal bool
 	EVMChainID utils.Big `gorm:"column:evm_chain_id"`
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
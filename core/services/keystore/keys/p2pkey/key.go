@@ -15,6 +15,8 @@ import (
 	cryptop2p "github.com/libp2p/go-libp2p-core/crypto"
 	peer "github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // Key represents a libp2p private key
@@ -143,3 +145,33 @@ func (ep2pk EncryptedP2PKey) Decrypt(auth string) (k Key, err error) {
 		privK,
 	}, nil
 }
+
+// Encrypt returns the PrivateKey in k, encrypted via auth
+func (k Key) Encrypt(auth string, scryptParams utils.ScryptParams) (ep2pk EncryptedP2PKey, err error) {
+	marshalledPrivK, err := cryptop2p.MarshalPrivateKey(k.PrivKey)
+	if err != nil {
+		return ep2pk, errors.Wrap(err, "could not marshal private key")
+	}
+	cryptoJSON, err := keystore.EncryptDataV3(
+		marshalledPrivK,
+		[]byte(adulteratedPassword(auth)),
+		scryptParams.N,
+		scryptParams.P,
+	)
+	if err != nil {
+		return ep2pk, errors.Wrap(err, "could not encrypt key")
+	}
+	encryptedPrivKey, err := json.Marshal(&cryptoJSON)
+	if err != nil {
+		return ep2pk, errors.Wrap(err, "could not encode cryptoJSON")
+	}
+	pubKey, err := k.GetPublic().Raw()
+	if err != nil {
+		return ep2pk, errors.Wrap(err, "could not get raw public key")
+	}
+	return EncryptedP2PKey{
+		PeerID:           k.PeerID(),
+		PubKey:           pubKey,
+		EncryptedPrivKey: encryptedPrivKey,
+	}, nil
+}
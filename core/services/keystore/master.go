@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/pkg/errors"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -18,9 +22,21 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 )
 
-var ErrLocked = errors.New("Keystore is locked")
+var (
+	ErrLocked = errors.New("Keystore is locked")
+	// ErrWrongPassword is returned when rotating the password of the keystore
+	// with an old password which is not the one it was unlocked with
+	ErrWrongPassword = errors.New("old password does not match")
+	// ErrWeakPassword is returned when rotating the password of the keystore
+	// to a password which does not meet the password policy
+	ErrWeakPassword = errors.New("password does not meet the requirements")
+	// ErrKeyRetired is returned when rotating a key which was already
+	// replaced by a rotation
+	ErrKeyRetired = errors.New("key was already rotated")
+)
 
 type Master interface {
 	CSA() CSA
@@ -29,6 +45,9 @@ type Master interface {
 	P2P() P2P
 	VRF() VRF
 	Unlock(password string) error
+	RotatePassword(oldPassword, newPassword string) error
+	RetiredKeys() ([]RetiredKey, error)
+	DeleteExpiredRetiredKeys(now time.Time) ([]RetiredKey, error)
 	Migrate(vrfPassword string, chainID *big.Int) error
 	IsEmpty() (bool, error)
 }
@@ -84,6 +103,77 @@ func (ks *master) VRF() VRF {
 	return ks.vrf
 }
 
+// RetiredKeys returns the keys which were replaced by a rotation, and are
+// kept until they expire
+func (ks *master) RetiredKeys() (retired []RetiredKey, _ error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	for _, rk := range ks.keyStates.Retired {
+		retired = append(retired, *rk)
+	}
+	sort.Slice(retired, func(i, j int) bool {
+		return retired[i].RetiredAt.Before(retired[j].RetiredAt)
+	})
+	return retired, nil
+}
+
+// DeleteExpiredRetiredKeys deletes the retired keys which expired before now
+// from the keystore, and returns them
+func (ks *master) DeleteExpiredRetiredKeys(now time.Time) (deleted []RetiredKey, err error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	var ids []string
+	for id, rk := range ks.keyStates.Retired {
+		if rk.ExpiresAt.Before(now) {
+			ids = append(ids, id)
+			deleted = append(deleted, *rk)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	// remove keys from keyring, keeping them to add them back if save fails
+	keyRing := reflect.Indirect(reflect.ValueOf(ks.keyRing))
+	removed := make(map[string]reflect.Value)
+	for _, rk := range deleted {
+		keyMap := keyRing.FieldByName(rk.KeyType)
+		id := reflect.ValueOf(rk.KeyID)
+		if key := keyMap.MapIndex(id); key.IsValid() {
+			removed[rk.KeyID] = key
+			keyMap.SetMapIndex(id, reflect.Value{})
+		}
+	}
+	// legacy V1 copies of the keys are deleted too, or they would be migrated
+	// back to the keyring on the next start
+	err = ks.save(func(tx *gorm.DB) error {
+		orm := NewORM(tx)
+		for _, key := range removed {
+			if err := orm.deleteV1Key(key.Interface()); err != nil {
+				return err
+			}
+		}
+		return orm.deleteRetiredKeys(ids)
+	})
+	if err != nil {
+		for _, rk := range deleted {
+			if key, ok := removed[rk.KeyID]; ok {
+				keyRing.FieldByName(rk.KeyType).SetMapIndex(reflect.ValueOf(rk.KeyID), key)
+			}
+		}
+		return nil, err
+	}
+	for _, id := range ids {
+		delete(ks.keyStates.Retired, id)
+	}
+	return deleted, nil
+}
+
 func (ks *master) IsEmpty() (bool, error) {
 	var count int64
 	err := ks.orm.db.Model(encryptedKeyRing{}).Count(&count).Error
@@ -205,14 +295,135 @@ func (km *keyManager) Unlock(password string) error {
 		return err
 	}
 	km.keyStates = ks
+	// External keys are not in the key ring, as their private keys are held by
+	// the external signer
+	for id, state := range ks.Eth {
+		if state.IsExternal {
+			km.keyRing.Eth[id] = ethkey.FromExternalAddress(state.Address)
+		}
+	}
 
 	km.password = password
 	return nil
 }
 
+// RotatePassword re-encrypts every key of the keystore with newPassword, in
+// a single DB transaction. The keystore must be unlocked with oldPassword, and
+// newPassword must meet the password policy.
+//
+// Legacy V1 keys encrypted with the keystore password are re-encrypted too, as
+// they are migrated to the key ring again every time the node starts. VRF V1
+// keys are left untouched, as they are encrypted with their own password.
+func (km *keyManager) RotatePassword(oldPassword, newPassword string) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if oldPassword != km.password {
+		return ErrWrongPassword
+	}
+	if err := utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return fmt.Errorf("%w: %v", ErrWeakPassword, err)
+	}
+	err := km.saveWithPassword(newPassword, func(tx *gorm.DB) error {
+		return km.reencryptV1Keys(NewORM(tx), newPassword)
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to rotate keystore password")
+	}
+	km.password = newPassword
+	km.logger.Info("Rotated keystore password")
+	return nil
+}
+
+// caller must hold lock!
+func (km *keyManager) reencryptV1Keys(orm ksORM, newPassword string) error {
+	csaKeys, err := orm.GetEncryptedV1CSAKeys()
+	if err != nil {
+		return errors.Wrap(err, "unable to load V1 CSA keys")
+	}
+	for i := range csaKeys {
+		if err = csaKeys[i].Unlock(km.password); err != nil {
+			return errors.Wrapf(err, "unable to decrypt V1 CSA key %d", csaKeys[i].ID)
+		}
+		privkey, err := csaKeys[i].Unsafe_GetPrivateKey()
+		if err != nil {
+			return err
+		}
+		encrypted, err := crypto.NewEncryptedPrivateKey(privkey, newPassword, km.scryptParams)
+		if err != nil {
+			return errors.Wrapf(err, "unable to encrypt V1 CSA key %d", csaKeys[i].ID)
+		}
+		if err = orm.updateEncryptedV1Key(&csaKeys[i], "encrypted_private_key", *encrypted); err != nil {
+			return err
+		}
+	}
+
+	ethKeys, err := orm.GetEncryptedV1EthKeys()
+	if err != nil {
+		return errors.Wrap(err, "unable to load V1 ETH keys")
+	}
+	for i := range ethKeys {
+		dKey, err := keystore.DecryptKey(ethKeys[i].JSON, km.password)
+		if err != nil {
+			return errors.Wrapf(err, "unable to decrypt V1 ETH key %s", ethKeys[i].Address)
+		}
+		encrypted, err := keystore.EncryptKey(dKey, newPassword, km.scryptParams.N, km.scryptParams.P)
+		if err != nil {
+			return errors.Wrapf(err, "unable to encrypt V1 ETH key %s", ethKeys[i].Address)
+		}
+		if err = orm.updateEncryptedV1Key(&ethKeys[i], "json", datatypes.JSON(encrypted)); err != nil {
+			return err
+		}
+	}
+
+	ocrKeys, err := orm.GetEncryptedV1OCRKeys()
+	if err != nil {
+		return errors.Wrap(err, "unable to load V1 OCR keys")
+	}
+	for i := range ocrKeys {
+		pk, err := ocrKeys[i].Decrypt(km.password)
+		if err != nil {
+			return errors.Wrapf(err, "unable to decrypt V1 OCR key %s", ocrKeys[i].ID)
+		}
+		encrypted, err := pk.Encrypt(newPassword, km.scryptParams)
+		if err != nil {
+			return errors.Wrapf(err, "unable to encrypt V1 OCR key %s", ocrKeys[i].ID)
+		}
+		if err = orm.updateEncryptedV1Key(&ocrKeys[i], "encrypted_private_keys", encrypted.EncryptedPrivateKeys); err != nil {
+			return err
+		}
+	}
+
+	p2pKeys, err := orm.GetEncryptedV1P2PKeys()
+	if err != nil {
+		return errors.Wrap(err, "unable to load V1 P2P keys")
+	}
+	for i := range p2pKeys {
+		pk, err := p2pKeys[i].Decrypt(km.password)
+		if err != nil {
+			return errors.Wrapf(err, "unable to decrypt V1 P2P key %s", p2pKeys[i].PeerID)
+		}
+		encrypted, err := pk.Encrypt(newPassword, km.scryptParams)
+		if err != nil {
+			return errors.Wrapf(err, "unable to encrypt V1 P2P key %s", p2pKeys[i].PeerID)
+		}
+		if err = orm.updateEncryptedV1Key(&p2pKeys[i], "encrypted_priv_key", encrypted.EncryptedPrivKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // caller must hold lock!
 func (km *keyManager) save(callbacks ...func(*gorm.DB) error) error {
-	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
+	return km.saveWithPassword(km.password, callbacks...)
+}
+
+// caller must hold lock!
+func (km *keyManager) saveWithPassword(password string, callbacks ...func(*gorm.DB) error) error {
+	ekb, err := km.keyRing.Encrypt(password, km.scryptParams)
 	if err != nil {
 		return errors.Wrap(err, "unable to encrypt keyRing")
 	}
@@ -280,6 +491,57 @@ func (km *keyManager) isLocked() bool {
 	return len(km.password) == 0
 }
 
+// caller must hold lock!
+func (km *keyManager) isRetired(id string) bool {
+	_, retired := km.keyStates.Retired[id]
+	return retired
+}
+
+// replacementID returns the ID of the key which replaced the key with id
+// through one or more rotations, or id if it was not rotated
+//
+// caller must hold lock!
+func (km *keyManager) replacementID(id string) string {
+	for i := 0; i < len(km.keyStates.Retired); i++ {
+		rk, retired := km.keyStates.Retired[id]
+		if !retired {
+			break
+		}
+		id = rk.ReplacedBy
+	}
+	return id
+}
+
+// rotateKey adds newKey to the keyring and retires oldKey, which is kept until
+// gracePeriod has elapsed
+//
+// caller must hold lock!
+func (km *keyManager) rotateKey(oldKey Key, newKey Key, gracePeriod time.Duration) error {
+	if km.isRetired(oldKey.ID()) {
+		return errors.Wrapf(ErrKeyRetired, "key %s", oldKey.ID())
+	}
+	keyType, err := getFieldNameForKey(oldKey)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	rk := RetiredKey{
+		KeyID:      oldKey.ID(),
+		KeyType:    keyType,
+		ReplacedBy: newKey.ID(),
+		RetiredAt:  now,
+		ExpiresAt:  now.Add(gracePeriod),
+	}
+	err = km.safeAddKey(newKey, func(tx *gorm.DB) error {
+		return NewORM(tx).createRetiredKey(&rk)
+	})
+	if err != nil {
+		return err
+	}
+	km.keyStates.Retired[rk.KeyID] = &rk
+	return nil
+}
+
 func getFieldNameForKey(unknownKey Key) (string, error) {
 	switch unknownKey.(type) {
 	case csakey.KeyV2:
@@ -1,11 +1,16 @@
 package keystore_test
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -56,3 +61,175 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(cltest.Password))
 	})
 }
+
+func TestMasterKeystore_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+
+	newPassword := "R0tated-P4ssw0rd!#abc"
+	require.ErrorIs(t, keyStore.RotatePassword(cltest.Password, newPassword), keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	ethKey, _ := cltest.MustAddRandomKeyToKeystore(t, keyStore.Eth())
+	ocrKey, err := keyStore.OCR().Create()
+	require.NoError(t, err)
+	p2pKey, err := keyStore.P2P().Create()
+	require.NoError(t, err)
+	csaKey, err := keyStore.CSA().Create()
+	require.NoError(t, err)
+	vrfKey, err := keyStore.VRF().Create()
+	require.NoError(t, err)
+
+	v1CSAKey, err := csakey.New(cltest.Password, utils.FastScryptParams)
+	require.NoError(t, err)
+	require.NoError(t, db.Create(v1CSAKey).Error)
+	v1OCRKeyBundle, err := ocrkey.NewKeyBundle()
+	require.NoError(t, err)
+	v1OCRKey, err := v1OCRKeyBundle.Encrypt(cltest.Password, utils.FastScryptParams)
+	require.NoError(t, err)
+	require.NoError(t, db.Create(v1OCRKey).Error)
+
+	t.Run("refuses a wrong old password", func(t *testing.T) {
+		require.ErrorIs(t, keyStore.RotatePassword("wrong password", newPassword), keystore.ErrWrongPassword)
+	})
+
+	t.Run("refuses a weak new password", func(t *testing.T) {
+		require.ErrorIs(t, keyStore.RotatePassword(cltest.Password, "password"), keystore.ErrWeakPassword)
+	})
+
+	t.Run("re-encrypts every key with the new password", func(t *testing.T) {
+		require.NoError(t, keyStore.RotatePassword(cltest.Password, newPassword))
+
+		keyStore.ResetXXXTestOnly()
+		require.Error(t, keyStore.Unlock(cltest.Password))
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(newPassword))
+
+		_, err := keyStore.Eth().Get(ethKey.ID())
+		require.NoError(t, err)
+		_, err = keyStore.OCR().Get(ocrKey.ID())
+		require.NoError(t, err)
+		_, err = keyStore.P2P().Get(p2pKey.ID())
+		require.NoError(t, err)
+		_, err = keyStore.CSA().Get(csaKey.ID())
+		require.NoError(t, err)
+		_, err = keyStore.VRF().Get(vrfKey.ID())
+		require.NoError(t, err)
+
+		v1Keys, err := keystore.NewORM(db).GetEncryptedV1CSAKeys()
+		require.NoError(t, err)
+		require.Len(t, v1Keys, 1)
+		require.NoError(t, v1Keys[0].Unlock(newPassword))
+		ocrKeys, err := keystore.NewORM(db).GetEncryptedV1OCRKeys()
+		require.NoError(t, err)
+		require.Len(t, ocrKeys, 1)
+		_, err = ocrKeys[0].Decrypt(newPassword)
+		require.NoError(t, err)
+	})
+}
+
+func TestMasterKeystore_DeleteExpiredRetiredKeys(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+
+	ocrKey, err := keyStore.OCR().Create()
+	require.NoError(t, err)
+	newOCRKey, err := keyStore.OCR().Rotate(ocrKey.ID(), time.Hour)
+	require.NoError(t, err)
+	p2pKey, err := keyStore.P2P().Create()
+	require.NoError(t, err)
+	newP2PKey, err := keyStore.P2P().Rotate(p2pKey.ID(), 2*time.Hour)
+	require.NoError(t, err)
+
+	retired, err := keyStore.RetiredKeys()
+	require.NoError(t, err)
+	require.Len(t, retired, 2)
+	require.Equal(t, ocrKey.ID(), retired[0].KeyID)
+	require.Equal(t, "OCR", retired[0].KeyType)
+	require.Equal(t, newOCRKey.ID(), retired[0].ReplacedBy)
+	require.Equal(t, p2pKey.ID(), retired[1].KeyID)
+
+	t.Run("keeps the retired keys across restarts", func(t *testing.T) {
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(cltest.Password))
+		retired, err = keyStore.RetiredKeys()
+		require.NoError(t, err)
+		require.Len(t, retired, 2)
+		keys, err := keyStore.OCR().GetAllActive()
+		require.NoError(t, err)
+		require.Equal(t, []ocrkey.KeyV2{newOCRKey}, keys)
+	})
+
+	t.Run("deletes the keys whose grace period has elapsed", func(t *testing.T) {
+		deleted, err := keyStore.DeleteExpiredRetiredKeys(time.Now().Add(90 * time.Minute))
+		require.NoError(t, err)
+		require.Len(t, deleted, 1)
+		require.Equal(t, ocrKey.ID(), deleted[0].KeyID)
+
+		_, err = keyStore.OCR().Get(ocrKey.ID())
+		require.Error(t, err)
+		_, err = keyStore.P2P().Get(p2pKey.ID())
+		require.NoError(t, err)
+
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(cltest.Password))
+		retired, err = keyStore.RetiredKeys()
+		require.NoError(t, err)
+		require.Len(t, retired, 1)
+		require.Equal(t, p2pKey.ID(), retired[0].KeyID)
+		_, err = keyStore.OCR().Get(ocrKey.ID())
+		require.Error(t, err)
+		_, err = keyStore.P2P().Get(newP2PKey.ID())
+		require.NoError(t, err)
+	})
+}
+
+func TestMasterKeystore_DeleteExpiredRetiredKeys_V1Keys(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+
+	v1CSAKey, err := csakey.New(cltest.Password, utils.FastScryptParams)
+	require.NoError(t, err)
+	require.NoError(t, db.Create(v1CSAKey).Error)
+	v1OCRKeyBundle, err := ocrkey.NewKeyBundle()
+	require.NoError(t, err)
+	v1OCRKey, err := v1OCRKeyBundle.Encrypt(cltest.Password, utils.FastScryptParams)
+	require.NoError(t, err)
+	require.NoError(t, db.Create(v1OCRKey).Error)
+	require.NoError(t, keyStore.Migrate(cltest.Password, big.NewInt(0)))
+
+	csaKey := v1CSAKey.ToV2()
+	ocrKey := v1OCRKeyBundle.ToV2()
+	_, err = keyStore.CSA().Rotate(csaKey.ID(), time.Hour)
+	require.NoError(t, err)
+	_, err = keyStore.OCR().Rotate(ocrKey.ID(), time.Hour)
+	require.NoError(t, err)
+
+	deleted, err := keyStore.DeleteExpiredRetiredKeys(time.Now().Add(2 * time.Hour))
+	require.NoError(t, err)
+	require.Len(t, deleted, 2)
+
+	// The V1 keys are migrated to the key ring every time the node starts
+	keyStore.ResetXXXTestOnly()
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	require.NoError(t, keyStore.Migrate(cltest.Password, big.NewInt(0)))
+
+	_, err = keyStore.CSA().Get(csaKey.ID())
+	require.Error(t, err)
+	_, err = keyStore.OCR().Get(ocrKey.ID())
+	require.Error(t, err)
+	csaKeys, err := keystore.NewORM(db).GetEncryptedV1CSAKeys()
+	require.NoError(t, err)
+	require.Empty(t, csaKeys)
+	ocrKeys, err := keystore.NewORM(db).GetEncryptedV1OCRKeys()
+	require.NoError(t, err)
+	require.Empty(t, ocrKeys)
+}
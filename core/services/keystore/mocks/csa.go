@@ -6,6 +6,8 @@ import (
 	csakey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // CSA is an autogenerated mock type for the CSA type
@@ -136,6 +138,29 @@ func (_m *CSA) GetAll() ([]csakey.KeyV2, error) {
 	return r0, r1
 }
 
+// GetAllActive provides a mock function with given fields:
+func (_m *CSA) GetAllActive() ([]csakey.KeyV2, error) {
+	ret := _m.Called()
+
+	var r0 []csakey.KeyV2
+	if rf, ok := ret.Get(0).(func() []csakey.KeyV2); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]csakey.KeyV2)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetV1KeysAsV2 provides a mock function with given fields:
 func (_m *CSA) GetV1KeysAsV2() ([]csakey.KeyV2, error) {
 	ret := _m.Called()
@@ -179,3 +204,24 @@ func (_m *CSA) Import(keyJSON []byte, password string) (csakey.KeyV2, error) {
 
 	return r0, r1
 }
+
+// Rotate provides a mock function with given fields: id, gracePeriod
+func (_m *CSA) Rotate(id string, gracePeriod time.Duration) (csakey.KeyV2, error) {
+	ret := _m.Called(id, gracePeriod)
+
+	var r0 csakey.KeyV2
+	if rf, ok := ret.Get(0).(func(string, time.Duration) csakey.KeyV2); ok {
+		r0 = rf(id, gracePeriod)
+	} else {
+		r0 = ret.Get(0).(csakey.KeyV2)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Duration) error); ok {
+		r1 = rf(id, gracePeriod)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -6,6 +6,7 @@ import (
 	big "math/big"
 
 	common "github.com/ethereum/go-ethereum/common"
+	keystore "github.com/smartcontractkit/chainlink/core/services/keystore"
 	ethkey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 
 	mock "github.com/stretchr/testify/mock"
@@ -32,6 +33,27 @@ func (_m *Eth) Add(key ethkey.KeyV2, chainID *big.Int) error {
 	return r0
 }
 
+// AddExternal provides a mock function with given fields: address, chainID
+func (_m *Eth) AddExternal(address common.Address, chainID *big.Int) (ethkey.KeyV2, error) {
+	ret := _m.Called(address, chainID)
+
+	var r0 ethkey.KeyV2
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int) ethkey.KeyV2); ok {
+		r0 = rf(address, chainID)
+	} else {
+		r0 = ret.Get(0).(ethkey.KeyV2)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *big.Int) error); ok {
+		r1 = rf(address, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: chainID
 func (_m *Eth) Create(chainID *big.Int) (ethkey.KeyV2, error) {
 	ret := _m.Called(chainID)
@@ -206,6 +228,36 @@ func (_m *Eth) GetAll() ([]ethkey.KeyV2, error) {
 	return r0, r1
 }
 
+// GetLoadBalancedAddress provides a mock function with given fields: load, addresses
+func (_m *Eth) GetLoadBalancedAddress(load keystore.KeyLoad, addresses ...common.Address) (common.Address, error) {
+	_va := make([]interface{}, len(addresses))
+	for _i := range addresses {
+		_va[_i] = addresses[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, load)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func(keystore.KeyLoad, ...common.Address) common.Address); ok {
+		r0 = rf(load, addresses...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(keystore.KeyLoad, ...common.Address) error); ok {
+		r1 = rf(load, addresses...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRoundRobinAddress provides a mock function with given fields: addresses
 func (_m *Eth) GetRoundRobinAddress(addresses ...common.Address) (common.Address, error) {
 	_va := make([]interface{}, len(addresses))
@@ -378,6 +430,11 @@ func (_m *Eth) SendingKeys() ([]ethkey.KeyV2, error) {
 	return r0, r1
 }
 
+// SetExternalSigner provides a mock function with given fields: signer
+func (_m *Eth) SetExternalSigner(signer keystore.ExternalSigner) {
+	_m.Called(signer)
+}
+
 // SetState provides a mock function with given fields: _a0
 func (_m *Eth) SetState(_a0 ethkey.State) error {
 	ret := _m.Called(_a0)
@@ -48,15 +48,32 @@ func (ekr encryptedKeyRing) Decrypt(password string) (keyRing, error) {
 }
 
 type keyStates struct {
-	Eth map[string]*ethkey.State
+	Eth     map[string]*ethkey.State
+	Retired map[string]*RetiredKey
 }
 
 func newKeyStates() keyStates {
 	return keyStates{
-		Eth: make(map[string]*ethkey.State),
+		Eth:     make(map[string]*ethkey.State),
+		Retired: make(map[string]*RetiredKey),
 	}
 }
 
+// RetiredKey is a CSA, OCR or P2P key which was replaced by a new key when it
+// was rotated. It is kept until ExpiresAt, so that the services still using it
+// can switch to its replacement.
+type RetiredKey struct {
+	KeyID      string `gorm:"primary_key"`
+	KeyType    string
+	ReplacedBy string
+	RetiredAt  time.Time
+	ExpiresAt  time.Time
+}
+
+func (RetiredKey) TableName() string {
+	return "retired_keys"
+}
+
 func (ks keyStates) validate(kr keyRing) (err error) {
 	for id := range kr.Eth {
 		_, exists := ks.Eth[id]
@@ -114,6 +131,10 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 		rawKeys.CSA = append(rawKeys.CSA, csaKey.Raw())
 	}
 	for _, ethKey := range kr.Eth {
+		// External keys are only stored as eth_key_states
+		if ethKey.IsExternal() {
+			continue
+		}
 		rawKeys.Eth = append(rawKeys.Eth, ethKey.Raw())
 	}
 	for _, ocrKey := range kr.OCR {
@@ -2,11 +2,14 @@ package keystore
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ocrkey"
 )
 
+//go:generate mockery --name OCR --output mocks/ --case=underscore
+
 type OCR interface {
 	Get(id string) (ocrkey.KeyV2, error)
 	GetAll() ([]ocrkey.KeyV2, error)
@@ -15,6 +18,8 @@ type OCR interface {
 	Delete(id string) (ocrkey.KeyV2, error)
 	Import(keyJSON []byte, password string) (ocrkey.KeyV2, error)
 	Export(id string, password string) ([]byte, error)
+	Rotate(id string, gracePeriod time.Duration) (ocrkey.KeyV2, error)
+	GetAllActive() ([]ocrkey.KeyV2, error)
 	EnsureKey() (ocrkey.KeyV2, bool, error)
 
 	GetV1KeysAsV2() ([]ocrkey.KeyV2, error)
@@ -108,6 +113,43 @@ func (ks *ocr) Import(keyJSON []byte, password string) (ocrkey.KeyV2, error) {
 	return key, ks.keyManager.safeAddKey(key)
 }
 
+// Rotate replaces the OCR key with id by a new key. The old key is retired,
+// and kept until gracePeriod has elapsed.
+func (ks *ocr) Rotate(id string, gracePeriod time.Duration) (ocrkey.KeyV2, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ocrkey.KeyV2{}, ErrLocked
+	}
+	oldKey, err := ks.getByID(id)
+	if err != nil {
+		return ocrkey.KeyV2{}, err
+	}
+	newKey, err := ocrkey.NewV2()
+	if err != nil {
+		return ocrkey.KeyV2{}, err
+	}
+	if err = ks.rotateKey(oldKey, newKey, gracePeriod); err != nil {
+		return ocrkey.KeyV2{}, err
+	}
+	return newKey, nil
+}
+
+// GetAllActive returns the OCR keys which were not retired by a rotation
+func (ks *ocr) GetAllActive() (keys []ocrkey.KeyV2, _ error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	for id, key := range ks.keyRing.OCR {
+		if !ks.isRetired(id) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
 func (ks *ocr) Export(id string, password string) ([]byte, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -55,11 +55,48 @@ func (orm ksORM) loadKeyStates() (keyStates, error) {
 	for i := 0; i < len(ethkeystates); i++ {
 		ks.Eth[ethkeystates[i].KeyID()] = &ethkeystates[i]
 	}
+	var retiredKeys []RetiredKey
+	if err := orm.db.Find(&retiredKeys).Error; err != nil {
+		return ks, errors.Wrap(err, "error loading retired_keys from DB")
+	}
+	for i := 0; i < len(retiredKeys); i++ {
+		ks.Retired[retiredKeys[i].KeyID] = &retiredKeys[i]
+	}
 	return ks, nil
 }
 
+func (orm ksORM) createRetiredKey(rk *RetiredKey) error {
+	return errors.Wrap(orm.db.Create(rk).Error, "error creating retired key")
+}
+
+func (orm ksORM) deleteRetiredKeys(ids []string) error {
+	err := orm.db.Exec(`DELETE FROM retired_keys WHERE key_id IN (?)`, ids).Error
+	return errors.Wrap(err, "error deleting retired keys")
+}
+
+// deleteV1Key deletes the legacy V1 copy of a CSA, OCR or P2P key, if any, so
+// that it is not migrated to the key ring again when the node starts
+func (orm ksORM) deleteV1Key(key interface{}) error {
+	var err error
+	switch k := key.(type) {
+	case csakey.KeyV2:
+		err = orm.db.Where("public_key = ?", []byte(k.PublicKey)).Delete(&csakey.Key{}).Error
+	case ocrkey.KeyV2:
+		err = orm.db.Where("on_chain_signing_address = ?", ocrkey.OnChainSigningAddress(k.PublicKeyAddressOnChain())).Delete(&ocrkey.EncryptedKeyBundle{}).Error
+	case p2pkey.KeyV2:
+		err = orm.db.Where("peer_id = ?", k.PeerID()).Delete(&p2pkey.EncryptedP2PKey{}).Error
+	}
+	return errors.Wrap(err, "error deleting V1 key")
+}
+
 // ~~~~~~~~~~~~~~~~~~~~ LEGACY FUNCTIONS FOR V1 MIGRATION ~~~~~~~~~~~~~~~~~~~~
 
+// updateEncryptedV1Key saves the re-encrypted private key of a legacy V1 key
+func (orm ksORM) updateEncryptedV1Key(key interface{}, column string, value interface{}) error {
+	err := orm.db.Model(key).Update(column, value).Error
+	return errors.Wrapf(err, "error updating %s of V1 key", column)
+}
+
 func (orm ksORM) GetEncryptedV1CSAKeys() (retrieved []csakey.Key, err error) {
 	return retrieved, orm.db.Find(&retrieved).Error
 }
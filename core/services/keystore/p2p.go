@@ -2,6 +2,7 @@ package keystore
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -9,6 +10,8 @@ import (
 	"gorm.io/gorm"
 )
 
+//go:generate mockery --name P2P --output mocks/ --case=underscore
+
 type P2P interface {
 	Get(id string) (p2pkey.KeyV2, error)
 	GetAll() ([]p2pkey.KeyV2, error)
@@ -17,6 +20,8 @@ type P2P interface {
 	Delete(id string) (p2pkey.KeyV2, error)
 	Import(keyJSON []byte, password string) (p2pkey.KeyV2, error)
 	Export(id string, password string) ([]byte, error)
+	Rotate(id string, gracePeriod time.Duration) (p2pkey.KeyV2, error)
+	GetAllActive() ([]p2pkey.KeyV2, error)
 	EnsureKey() (p2pkey.KeyV2, bool, error)
 
 	GetV1KeysAsV2() ([]p2pkey.KeyV2, error)
@@ -114,6 +119,43 @@ func (ks *p2p) Import(keyJSON []byte, password string) (p2pkey.KeyV2, error) {
 	return key, ks.keyManager.safeAddKey(key)
 }
 
+// Rotate replaces the P2P key with id by a new key. The old key is retired,
+// and kept until gracePeriod has elapsed.
+func (ks *p2p) Rotate(id string, gracePeriod time.Duration) (p2pkey.KeyV2, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return p2pkey.KeyV2{}, ErrLocked
+	}
+	oldKey, err := ks.getByID(id)
+	if err != nil {
+		return p2pkey.KeyV2{}, err
+	}
+	newKey, err := p2pkey.NewV2()
+	if err != nil {
+		return p2pkey.KeyV2{}, err
+	}
+	if err = ks.rotateKey(oldKey, newKey, gracePeriod); err != nil {
+		return p2pkey.KeyV2{}, err
+	}
+	return newKey, nil
+}
+
+// GetAllActive returns the P2P keys which were not retired by a rotation
+func (ks *p2p) GetAllActive() (keys []p2pkey.KeyV2, _ error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	for id, key := range ks.keyRing.P2P {
+		if !ks.isRetired(id) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
 func (ks *p2p) Export(id string, password string) ([]byte, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -165,13 +207,23 @@ func (ks *p2p) GetOrFirst(id string) (p2pkey.KeyV2, error) {
 		return p2pkey.KeyV2{}, ErrLocked
 	}
 	if id != "" {
+		if replacementID := ks.replacementID(id); replacementID != id {
+			logger.Warnf("P2P key %s was rotated, using its replacement %s", id, replacementID)
+			id = replacementID
+		}
 		return ks.getByID(id)
-	} else if len(ks.keyRing.P2P) == 1 {
-		logger.Warn("No P2P_PEER_ID set, defaulting to first key in database")
-		for _, key := range ks.keyRing.P2P {
-			return key, nil
+	}
+	// Retired keys are never picked by default
+	var active []p2pkey.KeyV2
+	for id, key := range ks.keyRing.P2P {
+		if !ks.isRetired(id) {
+			active = append(active, key)
 		}
-	} else if len(ks.keyRing.P2P) == 0 {
+	}
+	if len(active) == 1 {
+		logger.Warn("No P2P_PEER_ID set, defaulting to first key in database")
+		return active[0], nil
+	} else if len(active) == 0 {
 		return p2pkey.KeyV2{}, errors.New("no p2p keys exist")
 	}
 	return p2pkey.KeyV2{}, errors.New(
@@ -3,6 +3,7 @@ package keystore_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
@@ -19,6 +20,7 @@ func Test_P2PKeyStore_E2E(t *testing.T) {
 	ks := keyStore.P2P()
 	reset := func() {
 		require.NoError(t, db.Exec("DELETE FROM encrypted_key_rings").Error)
+		require.NoError(t, db.Exec("DELETE FROM retired_keys").Error)
 		keyStore.ResetXXXTestOnly()
 		keyStore.Unlock(cltest.Password)
 	}
@@ -118,6 +120,30 @@ func Test_P2PKeyStore_E2E(t *testing.T) {
 		require.Equal(t, k1, k4)
 	})
 
+	t.Run("rotates a key", func(t *testing.T) {
+		defer reset()
+		oldKey, err := ks.Create()
+		require.NoError(t, err)
+		newKey, err := ks.Rotate(oldKey.ID(), time.Hour)
+		require.NoError(t, err)
+		require.NotEqual(t, oldKey.ID(), newKey.ID())
+		keys, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Equal(t, 2, len(keys))
+		keys, err = ks.GetAllActive()
+		require.NoError(t, err)
+		require.Equal(t, []p2pkey.KeyV2{newKey}, keys)
+		// the old key is replaced by the new one, whether configured or not
+		k, err := ks.GetOrFirst(oldKey.ID())
+		require.NoError(t, err)
+		require.Equal(t, newKey, k)
+		k, err = ks.GetOrFirst("")
+		require.NoError(t, err)
+		require.Equal(t, newKey, k)
+		_, err = ks.Rotate(oldKey.ID(), time.Hour)
+		require.ErrorIs(t, err, keystore.ErrKeyRetired)
+	})
+
 	t.Run("clears p2p_peers on delete", func(t *testing.T) {
 		key, err := ks.Create()
 		require.NoError(t, err)
@@ -46,6 +46,10 @@ type (
 		service.Service
 		httypes.HeadTrackable
 		ReplayFromBlock(number int64)
+		// StartReplay replays historical logs to the listeners of a single job
+		// or contract only, see ReplayRequest
+		StartReplay(req ReplayRequest) (Replay, error)
+		GetReplay(id string) (Replay, error)
 
 		IsConnected() bool
 		Register(listener Listener, opts ListenerOpts) (unsubscribe func())
@@ -71,6 +75,8 @@ type (
 		backfillBlockNumber null.Int64
 
 		ethSubscriber *ethSubscriber
+		// logPoller is used instead of ethSubscriber if EvmLogPollerEnabled
+		logPoller     *logPoller
 		registrations *registrations
 		logPool       *logPool
 
@@ -85,6 +91,10 @@ type (
 		wgDone                sync.WaitGroup
 		trackedAddressesCount atomic.Uint32
 		replayChannel         chan int64
+		replayRequests        chan startReplayRequest
+		replaysMu             sync.RWMutex
+		replays               map[string]*Replay
+		replayIDs             []string
 		highestSavedHead      *eth.Head
 		lastSeenHeadNumber    atomic.Int64
 		logger                logger.Logger
@@ -98,6 +108,8 @@ type (
 		BlockBackfillSkip() bool
 		EvmFinalityDepth() uint32
 		EvmLogBackfillBatchSize() uint32
+		EvmLogPollInterval() time.Duration
+		EvmLogPollerEnabled() bool
 	}
 
 	ListenerOpts struct {
@@ -137,6 +149,7 @@ func NewBroadcaster(orm ORM, ethClient eth.Client, config Config, logger logger.
 		connected:        abool.New(),
 		evmChainID:       *ethClient.ChainID(),
 		ethSubscriber:    newEthSubscriber(ethClient, config, logger, chStop),
+		logPoller:        newLogPoller(ethClient, orm, config, logger, chStop),
 		registrations:    newRegistrations(logger, *ethClient.ChainID()),
 		logPool:          newLogPool(),
 		addSubscriber:    utils.NewMailbox(0),
@@ -146,6 +159,8 @@ func NewBroadcaster(orm ORM, ethClient eth.Client, config Config, logger logger.
 		chStop:           chStop,
 		highestSavedHead: highestSavedHead,
 		replayChannel:    make(chan int64, 1),
+		replayRequests:   make(chan startReplayRequest),
+		replays:          make(map[string]*Replay),
 	}
 }
 
@@ -249,11 +264,6 @@ func (b *broadcaster) startResubscribeLoop() {
 		b.logger.Debug("LogBroadcaster: Resubscribing and backfilling logs...")
 		addresses, topics := b.registrations.addressesAndTopics()
 
-		newSubscription, abort := b.ethSubscriber.createSubscription(addresses, topics)
-		if abort {
-			return
-		}
-
 		if b.config.BlockBackfillSkip() && b.highestSavedHead != nil {
 			b.logger.Warn("LogBroadcaster: BlockBackfillSkip is set to true, preventing a deep backfill - some earlier chain events might be missed.")
 			b.highestSavedHead = nil
@@ -284,7 +294,18 @@ func (b *broadcaster) startResubscribeLoop() {
 			)
 		}
 
-		chBackfilledLogs, abort := b.ethSubscriber.backfillLogs(b.backfillBlockNumber, addresses, topics)
+		var newSubscription managedSubscription
+		var chBackfilledLogs chan types.Log
+		var abort bool
+		if b.config.EvmLogPollerEnabled() {
+			// The log poller fetches the logs from the backfill block itself
+			newSubscription, abort = b.logPoller.createSubscription(b.backfillBlockNumber, addresses, topics)
+		} else {
+			newSubscription, abort = b.ethSubscriber.createSubscription(addresses, topics)
+			if !abort {
+				chBackfilledLogs, abort = b.ethSubscriber.backfillLogs(b.backfillBlockNumber, addresses, topics)
+			}
+		}
 		if abort {
 			return
 		}
@@ -352,6 +373,9 @@ func (b *broadcaster) eventLoop(chRawLogs <-chan types.Log, chErr <-chan error)
 			b.logger.Debugw("LogBroadcaster: Returning from the event loop to replay logs from specific block number", "blockNumber", blockNumber)
 			return true, nil
 
+		case sr := <-b.replayRequests:
+			sr.chErr <- b.onStartReplay(sr.replay)
+
 		case <-debounceResubscribe.C:
 			if needsResubscribe {
 				b.logger.Debug("LogBroadcaster: Returning from the event loop to resubscribe")
@@ -555,6 +579,14 @@ func (n *NullBroadcaster) Register(listener Listener, opts ListenerOpts) (unsubs
 func (n *NullBroadcaster) ReplayFromBlock(number int64) {
 }
 
+func (n *NullBroadcaster) StartReplay(req ReplayRequest) (Replay, error) {
+	return Replay{}, errors.New(n.ErrMsg)
+}
+
+func (n *NullBroadcaster) GetReplay(id string) (Replay, error) {
+	return Replay{}, errors.New(n.ErrMsg)
+}
+
 func (n *NullBroadcaster) BackfillBlockNumber() null.Int64 {
 	return null.NewInt64(0, false)
 }
@@ -35,6 +35,12 @@ func (tc) EvmFinalityDepth() uint32 {
 func (tc) EvmLogBackfillBatchSize() uint32 {
 	return 1
 }
+func (tc) EvmLogPollInterval() time.Duration {
+	return time.Second
+}
+func (tc) EvmLogPollerEnabled() bool {
+	return false
+}
 
 type listener struct {
 	logs chan Broadcast
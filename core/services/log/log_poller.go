@@ -0,0 +1,286 @@
+package log
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type (
+	// logPoller is used instead of the ethSubscriber when ETH_LOG_POLLER_ENABLED
+	// is set. It emulates a log subscription by calling eth_getLogs for each new
+	// range of blocks, so it works with HTTP-only RPC endpoints, and a dropped
+	// connection only delays logs rather than losing them.
+	//
+	// The logs it fetches are saved along with the hash of the newest block
+	// polled. Before each poll, the saved hashes are compared with the chain's
+	// to detect reorgs: the logs of orphaned blocks are sent to the broadcaster
+	// as removed, and their blocks are polled again.
+	logPoller struct {
+		ethClient eth.Client
+		orm       ORM
+		config    Config
+		logger    logger.Logger
+		chStop    chan struct{}
+	}
+)
+
+func newLogPoller(ethClient eth.Client, orm ORM, config Config, logger logger.Logger, chStop chan struct{}) *logPoller {
+	return &logPoller{
+		ethClient: ethClient,
+		orm:       orm,
+		config:    config,
+		logger:    logger,
+		chStop:    chStop,
+	}
+}
+
+// createSubscription starts polling for the logs of the given addresses and
+// topics. Polling starts from fromBlockOverride if it is set, otherwise from
+// BlockBackfillDepth blocks behind the latest head. If the poller stopped at
+// an earlier block (e.g. because the node was down) it resumes from there
+// instead, unless BlockBackfillSkip is set.
+func (lp *logPoller) createSubscription(fromBlockOverride null.Int64, addresses []common.Address, topics []common.Hash) (subscr managedSubscription, abort bool) {
+	if len(addresses) == 0 {
+		return newNoopSubscription(), false
+	}
+
+	ctx, cancel := utils.ContextFromChan(lp.chStop)
+	defer cancel()
+
+	var fromBlock int64
+	utils.RetryWithBackoff(ctx, func() (retry bool) {
+		var err error
+		fromBlock, err = lp.startBlock(ctx, fromBlockOverride)
+		if err != nil {
+			lp.logger.Errorw("LogBroadcaster: Log poller could not determine the block to poll from, will retry", "err", err)
+			return true
+		}
+		return false
+	})
+	select {
+	case <-lp.chStop:
+		return nil, true
+	default:
+	}
+
+	lp.logger.Debugw("LogBroadcaster: Starting log poller", "fromBlock", fromBlock, "addresses", addresses, "topics", topics)
+
+	sub := &pollingSubscription{
+		chRawLogs: make(chan types.Log),
+		chDone:    make(chan struct{}),
+	}
+	sub.wg.Add(1)
+	go lp.run(sub, fromBlock, ethereum.FilterQuery{
+		Addresses: addresses,
+		Topics:    [][]common.Hash{topics},
+	})
+	return sub, false
+}
+
+func (lp *logPoller) startBlock(ctx context.Context, fromBlockOverride null.Int64) (int64, error) {
+	var fromBlock int64
+	if fromBlockOverride.Valid {
+		fromBlock = fromBlockOverride.Int64
+	} else {
+		ctx, cancel := eth.DefaultQueryCtx(ctx)
+		defer cancel()
+		latest, err := lp.ethClient.HeadByNumber(ctx, nil)
+		if err != nil {
+			return 0, errors.Wrap(err, "fetching latest block header")
+		} else if latest == nil {
+			return 0, errors.New("got nil block header")
+		}
+		fromBlock = latest.Number - int64(lp.config.BlockBackfillDepth())
+		if fromBlock < 0 {
+			fromBlock = 0
+		}
+	}
+
+	if lp.config.BlockBackfillSkip() {
+		return fromBlock, nil
+	}
+	polled, err := lp.orm.PolledBlocks()
+	if err != nil {
+		return 0, err
+	}
+	if len(polled) > 0 && polled[0].BlockNumber+1 < fromBlock {
+		lp.logger.Infow("LogBroadcaster: Log poller resuming from the last block it polled",
+			"fromBlock", polled[0].BlockNumber+1, "backfillFromBlock", fromBlock)
+		fromBlock = polled[0].BlockNumber + 1
+	}
+	return fromBlock, nil
+}
+
+func (lp *logPoller) run(sub *pollingSubscription, fromBlock int64, query ethereum.FilterQuery) {
+	defer sub.wg.Done()
+
+	ctx, cancel := utils.ContextFromChan(sub.chDone)
+	defer cancel()
+
+	ticker := time.NewTicker(lp.config.EvmLogPollInterval())
+	defer ticker.Stop()
+
+	for {
+		fromBlock = lp.poll(ctx, sub, fromBlock, query)
+
+		select {
+		case <-ticker.C:
+		case <-sub.chDone:
+			return
+		case <-lp.chStop:
+			return
+		}
+	}
+}
+
+// poll sends the logs from fromBlock up to the latest head, and returns the
+// block to poll from next time
+func (lp *logPoller) poll(ctx context.Context, sub *pollingSubscription, fromBlock int64, query ethereum.FilterQuery) int64 {
+	fromBlock, err := lp.handleReorg(ctx, sub, fromBlock)
+	if err != nil {
+		lp.logger.Warnw("LogBroadcaster: Log poller could not check for a reorg, will retry", "err", err)
+		return fromBlock
+	}
+
+	ctxHead, cancel := eth.DefaultQueryCtx(ctx)
+	latest, err := lp.ethClient.HeadByNumber(ctxHead, nil)
+	cancel()
+	if err != nil {
+		lp.logger.Warnw("LogBroadcaster: Log poller could not fetch latest block header, will retry", "err", err)
+		return fromBlock
+	} else if latest == nil {
+		lp.logger.Warn("LogBroadcaster: Log poller got nil block header, will retry")
+		return fromBlock
+	}
+	if latest.Number < fromBlock {
+		return fromBlock
+	}
+
+	batchSize := int64(lp.config.EvmLogBackfillBatchSize())
+	for from := fromBlock; from <= latest.Number; from += batchSize {
+		to := from + batchSize - 1
+		if to > latest.Number {
+			to = latest.Number
+		}
+		query.FromBlock = big.NewInt(from)
+		query.ToBlock = big.NewInt(to)
+
+		ctxLogs, cancel := eth.DefaultQueryCtx(ctx)
+		logs, err := lp.ethClient.FilterLogs(ctxLogs, query)
+		cancel()
+		if err != nil {
+			lp.logger.Warnw("LogBroadcaster: Log poller could not fetch logs, will retry", "err", err, "fromBlock", from, "toBlock", to)
+			return from
+		}
+		if err = lp.orm.InsertLogs(logs); err != nil {
+			lp.logger.Errorw("LogBroadcaster: Log poller could not save logs, will retry", "err", err, "fromBlock", from, "toBlock", to)
+			return from
+		}
+		for _, log := range logs {
+			if !sub.send(log) {
+				return from
+			}
+		}
+	}
+
+	if err = lp.orm.InsertPolledBlock(PolledBlock{BlockNumber: latest.Number, BlockHash: latest.Hash}); err != nil {
+		lp.logger.Errorw("LogBroadcaster: Log poller could not save polled block", "err", err, "blockNumber", latest.Number)
+	}
+	// Reorgs deeper than the finality depth are not expected, so there is no
+	// need to keep older logs
+	if err = lp.orm.DeleteLogsBefore(latest.Number - int64(lp.config.EvmFinalityDepth())); err != nil {
+		lp.logger.Errorw("LogBroadcaster: Log poller could not delete old logs", "err", err)
+	}
+	return latest.Number + 1
+}
+
+// handleReorg compares the hashes of the polled blocks with the chain's,
+// newest first. If the newest doesn't match, the logs above the newest one
+// that does are deleted and sent as removed, and the next poll starts from
+// the block after it.
+func (lp *logPoller) handleReorg(ctx context.Context, sub *pollingSubscription, fromBlock int64) (int64, error) {
+	polled, err := lp.orm.PolledBlocks()
+	if err != nil {
+		return fromBlock, err
+	}
+	if len(polled) == 0 {
+		return fromBlock, nil
+	}
+
+	ancestor := polled[len(polled)-1].BlockNumber - 1
+	for i, block := range polled {
+		ctxHead, cancel := eth.DefaultQueryCtx(ctx)
+		head, err := lp.ethClient.HeadByNumber(ctxHead, big.NewInt(block.BlockNumber))
+		cancel()
+		if err != nil {
+			return fromBlock, errors.Wrapf(err, "fetching block header %v", block.BlockNumber)
+		}
+		if head != nil && head.Hash == block.BlockHash {
+			if i == 0 {
+				return fromBlock, nil
+			}
+			ancestor = block.BlockNumber
+			break
+		}
+	}
+	if ancestor < 0 {
+		ancestor = 0
+	}
+
+	lp.logger.Warnw("LogBroadcaster: Log poller detected a reorg, removing logs of orphaned blocks",
+		"newestPolledBlock", polled[0].BlockNumber, "commonAncestor", ancestor)
+
+	removed, err := lp.orm.DeleteLogsAfter(ancestor)
+	if err != nil {
+		return fromBlock, err
+	}
+	for _, log := range removed {
+		log.Removed = true
+		if !sub.send(log) {
+			break
+		}
+	}
+	if ancestor+1 < fromBlock {
+		fromBlock = ancestor + 1
+	}
+	return fromBlock, nil
+}
+
+// pollingSubscription is the managedSubscription returned by the log poller.
+// Polling errors are retried rather than returned, so its Err channel never
+// receives.
+type pollingSubscription struct {
+	chRawLogs chan types.Log
+	chDone    chan struct{}
+	wg        sync.WaitGroup
+}
+
+func (sub *pollingSubscription) Err() <-chan error    { return nil }
+func (sub *pollingSubscription) Logs() chan types.Log { return sub.chRawLogs }
+
+func (sub *pollingSubscription) Unsubscribe() {
+	close(sub.chDone)
+	sub.wg.Wait()
+	close(sub.chRawLogs)
+}
+
+func (sub *pollingSubscription) send(log types.Log) bool {
+	select {
+	case sub.chRawLogs <- log:
+		return true
+	case <-sub.chDone:
+		return false
+	}
+}
@@ -0,0 +1,85 @@
+package log
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	ethmocks "github.com/smartcontractkit/chainlink/core/services/eth/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func fromBlock(n int64) interface{} {
+	return mock.MatchedBy(func(q ethereum.FilterQuery) bool {
+		return q.FromBlock.Int64() == n
+	})
+}
+
+func TestLogPoller_PollsLogsAndHandlesReorgs(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	orm := NewORM(db, *big.NewInt(0))
+
+	ethClient := new(ethmocks.Client)
+	ethClient.Test(t)
+	defer ethClient.AssertExpectations(t)
+
+	lp := newLogPoller(ethClient, orm, tc{}, logger.TestLogger(t), make(chan struct{}))
+	sub := &pollingSubscription{chRawLogs: make(chan types.Log, 10), chDone: make(chan struct{})}
+	addr := common.HexToAddress("0xf0d54349aDdcf704F77AE15b96510dEA15cb7952")
+	query := ethereum.FilterQuery{Addresses: []common.Address{addr}}
+
+	hash3 := utils.NewHash()
+	log2 := types.Log{Address: addr, BlockNumber: 2, BlockHash: utils.NewHash(), TxHash: utils.NewHash(), Topics: []common.Hash{utils.NewHash()}, Data: []byte{1}}
+	log3 := types.Log{Address: addr, BlockNumber: 3, BlockHash: hash3, TxHash: utils.NewHash(), Topics: []common.Hash{utils.NewHash()}, Data: []byte{2}}
+
+	// The test config's batch size is 1, so each block is fetched separately
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&eth.Head{Number: 3, Hash: hash3}, nil).Once()
+	ethClient.On("FilterLogs", mock.Anything, fromBlock(2)).Return([]types.Log{log2}, nil).Once()
+	ethClient.On("FilterLogs", mock.Anything, fromBlock(3)).Return([]types.Log{log3}, nil).Once()
+
+	next := lp.poll(context.Background(), sub, 2, query)
+	assert.Equal(t, int64(4), next)
+	require.Len(t, sub.chRawLogs, 2)
+	assert.Equal(t, log2, <-sub.chRawLogs)
+	assert.Equal(t, log3, <-sub.chRawLogs)
+
+	polled, err := orm.PolledBlocks()
+	require.NoError(t, err)
+	assert.Equal(t, []PolledBlock{{BlockNumber: 3, BlockHash: hash3}}, polled)
+
+	// Block 3 is reorged out
+	newHash3 := utils.NewHash()
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(3)).Return(&eth.Head{Number: 3, Hash: newHash3}, nil).Once()
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&eth.Head{Number: 3, Hash: newHash3}, nil).Once()
+	ethClient.On("FilterLogs", mock.Anything, fromBlock(3)).Return(nil, nil).Once()
+
+	next = lp.poll(context.Background(), sub, next, query)
+	assert.Equal(t, int64(4), next)
+	require.Len(t, sub.chRawLogs, 1)
+	removed := <-sub.chRawLogs
+	assert.True(t, removed.Removed)
+	assert.Equal(t, hash3, removed.BlockHash)
+	assert.Equal(t, log3.TxHash, removed.TxHash)
+	assert.Equal(t, log3.Topics, removed.Topics)
+	assert.Equal(t, log3.Data, removed.Data)
+
+	polled, err = orm.PolledBlocks()
+	require.NoError(t, err)
+	assert.Equal(t, []PolledBlock{{BlockNumber: 3, BlockHash: newHash3}}, polled)
+
+	// Only block 2's log is still saved
+	removedLogs, err := orm.DeleteLogsAfter(1)
+	require.NoError(t, err)
+	require.Len(t, removedLogs, 1)
+	assert.Equal(t, log2.BlockHash, removedLogs[0].BlockHash)
+}
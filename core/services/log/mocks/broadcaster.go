@@ -58,6 +58,27 @@ func (_m *Broadcaster) DependentReady() {
 	_m.Called()
 }
 
+// GetReplay provides a mock function with given fields: id
+func (_m *Broadcaster) GetReplay(id string) (log.Replay, error) {
+	ret := _m.Called(id)
+
+	var r0 log.Replay
+	if rf, ok := ret.Get(0).(func(string) log.Replay); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(log.Replay)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Healthy provides a mock function with given fields:
 func (_m *Broadcaster) Healthy() error {
 	ret := _m.Called()
@@ -154,6 +175,27 @@ func (_m *Broadcaster) Start() error {
 	return r0
 }
 
+// StartReplay provides a mock function with given fields: req
+func (_m *Broadcaster) StartReplay(req log.ReplayRequest) (log.Replay, error) {
+	ret := _m.Called(req)
+
+	var r0 log.Replay
+	if rf, ok := ret.Get(0).(func(log.ReplayRequest) log.Replay); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(log.Replay)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(log.ReplayRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WasAlreadyConsumed provides a mock function with given fields: db, lb
 func (_m *Broadcaster) WasAlreadyConsumed(db *gorm.DB, lb log.Broadcast) (bool, error) {
 	ret := _m.Called(db, lb)
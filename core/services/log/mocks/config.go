@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // Config is an autogenerated mock type for the Config type
 type Config struct {
@@ -64,3 +68,31 @@ func (_m *Config) EvmLogBackfillBatchSize() uint32 {
 
 	return r0
 }
+
+// EvmLogPollInterval provides a mock function with given fields:
+func (_m *Config) EvmLogPollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EvmLogPollerEnabled provides a mock function with given fields:
+func (_m *Config) EvmLogPollerEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
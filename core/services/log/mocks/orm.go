@@ -6,6 +6,8 @@ import (
 	common "github.com/ethereum/go-ethereum/common"
 	gorm "gorm.io/gorm"
 
+	types "github.com/ethereum/go-ethereum/core/types"
+
 	log "github.com/smartcontractkit/chainlink/core/services/log"
 
 	mock "github.com/stretchr/testify/mock"
@@ -16,6 +18,43 @@ type ORM struct {
 	mock.Mock
 }
 
+// DeleteLogsAfter provides a mock function with given fields: blockNumber
+func (_m *ORM) DeleteLogsAfter(blockNumber int64) ([]types.Log, error) {
+	ret := _m.Called(blockNumber)
+
+	var r0 []types.Log
+	if rf, ok := ret.Get(0).(func(int64) []types.Log); ok {
+		r0 = rf(blockNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteLogsBefore provides a mock function with given fields: blockNumber
+func (_m *ORM) DeleteLogsBefore(blockNumber int64) error {
+	ret := _m.Called(blockNumber)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(blockNumber)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindConsumedLogs provides a mock function with given fields: fromBlockNum, toBlockNum
 func (_m *ORM) FindConsumedLogs(fromBlockNum int64, toBlockNum int64) ([]log.LogBroadcast, error) {
 	ret := _m.Called(fromBlockNum, toBlockNum)
@@ -39,6 +78,34 @@ func (_m *ORM) FindConsumedLogs(fromBlockNum int64, toBlockNum int64) ([]log.Log
 	return r0, r1
 }
 
+// InsertLogs provides a mock function with given fields: logs
+func (_m *ORM) InsertLogs(logs []types.Log) error {
+	ret := _m.Called(logs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]types.Log) error); ok {
+		r0 = rf(logs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertPolledBlock provides a mock function with given fields: block
+func (_m *ORM) InsertPolledBlock(block log.PolledBlock) error {
+	ret := _m.Called(block)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(log.PolledBlock) error); ok {
+		r0 = rf(block)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // MarkBroadcastConsumed provides a mock function with given fields: tx, blockHash, blockNumber, logIndex, jobID
 func (_m *ORM) MarkBroadcastConsumed(tx *gorm.DB, blockHash common.Hash, blockNumber uint64, logIndex uint, jobID int32) error {
 	ret := _m.Called(tx, blockHash, blockNumber, logIndex, jobID)
@@ -53,6 +120,29 @@ func (_m *ORM) MarkBroadcastConsumed(tx *gorm.DB, blockHash common.Hash, blockNu
 	return r0
 }
 
+// PolledBlocks provides a mock function with given fields:
+func (_m *ORM) PolledBlocks() ([]log.PolledBlock, error) {
+	ret := _m.Called()
+
+	var r0 []log.PolledBlock
+	if rf, ok := ret.Get(0).(func() []log.PolledBlock); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]log.PolledBlock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WasBroadcastConsumed provides a mock function with given fields: tx, blockHash, logIndex, jobID
 func (_m *ORM) WasBroadcastConsumed(tx *gorm.DB, blockHash common.Hash, logIndex uint, jobID int32) (bool, error) {
 	ret := _m.Called(tx, blockHash, logIndex, jobID)
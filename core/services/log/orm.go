@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"gorm.io/gorm"
@@ -17,6 +18,22 @@ type ORM interface {
 	FindConsumedLogs(fromBlockNum int64, toBlockNum int64) ([]LogBroadcast, error)
 	WasBroadcastConsumed(tx *gorm.DB, blockHash common.Hash, logIndex uint, jobID int32) (bool, error)
 	MarkBroadcastConsumed(tx *gorm.DB, blockHash common.Hash, blockNumber uint64, logIndex uint, jobID int32) error
+
+	// InsertLogs saves logs fetched by the log poller, ignoring logs it has
+	// already saved
+	InsertLogs(logs []types.Log) error
+	// InsertPolledBlock records that the log poller has polled logs up to and
+	// including the block
+	InsertPolledBlock(block PolledBlock) error
+	// PolledBlocks returns the blocks the log poller has polled up to, newest
+	// first
+	PolledBlocks() ([]PolledBlock, error)
+	// DeleteLogsAfter deletes the saved logs and polled blocks above the block
+	// number, and returns the deleted logs
+	DeleteLogsAfter(blockNumber int64) ([]types.Log, error)
+	// DeleteLogsBefore deletes the saved logs and polled blocks below the
+	// block number
+	DeleteLogsBefore(blockNumber int64) error
 }
 
 type orm struct {
@@ -80,6 +97,114 @@ func (o *orm) MarkBroadcastConsumed(tx *gorm.DB, blockHash common.Hash, blockNum
 	return nil
 }
 
+func (o *orm) InsertLogs(logs []types.Log) error {
+	return o.db.Transaction(func(tx *gorm.DB) error {
+		for _, log := range logs {
+			topics := make(pq.ByteaArray, len(log.Topics))
+			for i, topic := range log.Topics {
+				topics[i] = topic.Bytes()
+			}
+			data := log.Data
+			if data == nil {
+				data = []byte{}
+			}
+			err := tx.Exec(`
+				INSERT INTO evm_logs (evm_chain_id, block_hash, block_number, log_index, address, topics, data, tx_hash, tx_index, created_at)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, NOW())
+				ON CONFLICT DO NOTHING
+			`, o.evmChainID, log.BlockHash, log.BlockNumber, log.Index, log.Address, topics, data, log.TxHash, log.TxIndex).Error
+			if err != nil {
+				return errors.Wrap(err, "while inserting log")
+			}
+		}
+		return nil
+	})
+}
+
+func (o *orm) InsertPolledBlock(block PolledBlock) error {
+	err := o.db.Exec(`
+		INSERT INTO evm_log_poller_blocks (evm_chain_id, block_number, block_hash, created_at)
+		VALUES (?, ?, ?, NOW())
+		ON CONFLICT (evm_chain_id, block_number) DO UPDATE SET block_hash = EXCLUDED.block_hash, created_at = EXCLUDED.created_at
+	`, o.evmChainID, block.BlockNumber, block.BlockHash).Error
+	return errors.Wrap(err, "while inserting polled block")
+}
+
+func (o *orm) PolledBlocks() (blocks []PolledBlock, err error) {
+	err = o.db.Raw(`
+		SELECT block_number, block_hash FROM evm_log_poller_blocks
+		WHERE evm_chain_id = ?
+		ORDER BY block_number DESC
+	`, o.evmChainID).Scan(&blocks).Error
+	return blocks, errors.Wrap(err, "while loading polled blocks")
+}
+
+func (o *orm) DeleteLogsAfter(blockNumber int64) (logs []types.Log, err error) {
+	err = o.db.Transaction(func(tx *gorm.DB) error {
+		var rows []evmLog
+		err = tx.Raw(`
+			DELETE FROM evm_logs WHERE evm_chain_id = ? AND block_number > ?
+			RETURNING block_hash, block_number, log_index, address, topics, data, tx_hash, tx_index
+		`, o.evmChainID, blockNumber).Scan(&rows).Error
+		if err != nil {
+			return errors.Wrap(err, "while deleting logs")
+		}
+		for _, row := range rows {
+			logs = append(logs, row.toLog())
+		}
+		err = tx.Exec(`DELETE FROM evm_log_poller_blocks WHERE evm_chain_id = ? AND block_number > ?`, o.evmChainID, blockNumber).Error
+		return errors.Wrap(err, "while deleting polled blocks")
+	})
+	return logs, err
+}
+
+func (o *orm) DeleteLogsBefore(blockNumber int64) error {
+	return o.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`DELETE FROM evm_logs WHERE evm_chain_id = ? AND block_number < ?`, o.evmChainID, blockNumber).Error
+		if err != nil {
+			return errors.Wrap(err, "while deleting logs")
+		}
+		err = tx.Exec(`DELETE FROM evm_log_poller_blocks WHERE evm_chain_id = ? AND block_number < ?`, o.evmChainID, blockNumber).Error
+		return errors.Wrap(err, "while deleting polled blocks")
+	})
+}
+
+// PolledBlock is a block that the log poller has polled logs up to. Its hash
+// is compared with the chain's to detect reorgs.
+type PolledBlock struct {
+	BlockNumber int64
+	BlockHash   common.Hash
+}
+
+// evmLog - gorm-compatible receive data from evm_logs table columns
+type evmLog struct {
+	BlockHash   common.Hash
+	BlockNumber int64
+	LogIndex    int64
+	Address     common.Address
+	Topics      pq.ByteaArray
+	Data        []byte
+	TxHash      common.Hash
+	TxIndex     int64
+}
+
+func (l evmLog) toLog() types.Log {
+	topics := make([]common.Hash, len(l.Topics))
+	for i, topic := range l.Topics {
+		topics[i] = common.BytesToHash(topic)
+	}
+	return types.Log{
+		Address:     l.Address,
+		Topics:      topics,
+		Data:        l.Data,
+		BlockNumber: uint64(l.BlockNumber),
+		TxHash:      l.TxHash,
+		TxIndex:     uint(l.TxIndex),
+		BlockHash:   l.BlockHash,
+		Index:       uint(l.LogIndex),
+	}
+}
+
 // LogBroadcast - gorm-compatible receive data from log_broadcasts table columns
 type LogBroadcast struct {
 	BlockHash  common.Hash
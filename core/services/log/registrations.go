@@ -164,6 +164,38 @@ func (r *registrations) sendLogs(logsToSend []logsOnBlock, latestHead eth.Head,
 	}
 }
 
+// listenersFor returns the registrations of the listeners of the job, of the
+// contract, or of the job for the contract, if both are given
+func (r *registrations) listenersFor(jobID *int32, contract *common.Address) []registration {
+	type key struct {
+		listener Listener
+		contract common.Address
+	}
+	seen := make(map[key]struct{})
+	var regs []registration
+	for _, sub := range r.subscribers {
+		for addr, topics := range sub.handlers {
+			if contract != nil && addr != *contract {
+				continue
+			}
+			for _, listeners := range topics {
+				for listener, metadata := range listeners {
+					if jobID != nil && listener.JobID() != *jobID {
+						continue
+					}
+					k := key{listener, addr}
+					if _, exists := seen[k]; exists {
+						continue
+					}
+					seen[k] = struct{}{}
+					regs = append(regs, registration{listener, metadata.opts})
+				}
+			}
+		}
+	}
+	return regs
+}
+
 // Returns true if there is at least one filter value (or no filters at all) that matches an actual received value for every index i, or false otherwise
 func filtersContainValues(topicValues []common.Hash, filters [][]Topic) bool {
 	for i := 0; i < len(topicValues) && i < len(filters); i++ {
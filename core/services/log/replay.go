@@ -0,0 +1,316 @@
+package log
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ReplayState is the state of a scoped replay
+type ReplayState string
+
+const (
+	ReplayStateInProgress ReplayState = "in_progress"
+	ReplayStateCompleted  ReplayState = "completed"
+	ReplayStateErrored    ReplayState = "errored"
+)
+
+// maxReplays is the number of replays kept in memory, so their progress can
+// be queried. The oldest is forgotten when another one starts.
+const maxReplays = 100
+
+// replayRequestTimeout is how long StartReplay waits for the event loop to
+// pick up a request
+const replayRequestTimeout = 10 * time.Second
+
+var (
+	ErrReplayNotFound    = errors.New("replay not found")
+	ErrNoReplayListeners = errors.New("no listeners are registered for the job or contract")
+)
+
+type (
+	// ReplayRequest is a replay of historical logs that only delivers them to
+	// the listeners of a job, of a contract, or the listeners of a job for a
+	// contract. Unlike ReplayFromBlock it doesn't resubscribe, so other
+	// listeners never see the replayed logs.
+	ReplayRequest struct {
+		JobID     *int32          `json:"jobID"`
+		Contract  *common.Address `json:"contract"`
+		FromBlock int64           `json:"fromBlock"`
+		// ToBlock defaults to the latest block
+		ToBlock null.Int64 `json:"toBlock"`
+		// DryRun lists the logs that would be delivered without delivering them
+		DryRun bool `json:"dryRun"`
+	}
+
+	// Replay is the progress of a scoped replay
+	Replay struct {
+		ID         string
+		EVMChainID big.Int
+		Request    ReplayRequest
+		State      ReplayState
+		// ToBlock is the last block that will be replayed, once it is known
+		ToBlock null.Int64
+		// LastBlock is the last block that has been replayed so far
+		LastBlock null.Int64
+		// Delivered is the number of logs delivered to listeners, or that
+		// would be delivered on a dry run
+		Delivered int
+		// Logs are the logs that would be delivered, on a dry run only
+		Logs       []ReplayedLog
+		Error      string
+		CreatedAt  time.Time
+		FinishedAt *time.Time
+	}
+
+	// ReplayedLog is a log that a replay delivers to the listener of a job
+	ReplayedLog struct {
+		JobID int32
+		Log   types.Log
+	}
+
+	startReplayRequest struct {
+		replay *Replay
+		chErr  chan error
+	}
+)
+
+// Validate checks that the request is scoped and its block range is valid
+func (r ReplayRequest) Validate() error {
+	if r.JobID == nil && r.Contract == nil {
+		return errors.New("replay must be scoped to a job ID, a contract address, or both")
+	}
+	if r.FromBlock < 0 {
+		return errors.Errorf("fromBlock cannot be negative: %v", r.FromBlock)
+	}
+	if r.ToBlock.Valid && r.ToBlock.Int64 < r.FromBlock {
+		return errors.Errorf("toBlock %v is before fromBlock %v", r.ToBlock.Int64, r.FromBlock)
+	}
+	return nil
+}
+
+// StartReplay starts replaying the logs of the request's block range to the
+// listeners it is scoped to, and returns its initial progress. It fails if no
+// registered listener matches the request.
+func (b *broadcaster) StartReplay(req ReplayRequest) (Replay, error) {
+	if err := req.Validate(); err != nil {
+		return Replay{}, err
+	}
+	replay := &Replay{
+		ID:         uuid.NewV4().String(),
+		EVMChainID: b.evmChainID,
+		Request:    req,
+		State:      ReplayStateInProgress,
+		CreatedAt:  time.Now(),
+	}
+	sr := startReplayRequest{replay: replay, chErr: make(chan error, 1)}
+	select {
+	case b.replayRequests <- sr:
+	case <-time.After(replayRequestTimeout):
+		return Replay{}, errors.New("LogBroadcaster is not connected, try again later")
+	case <-b.chStop:
+		return Replay{}, errors.New("LogBroadcaster is stopped")
+	}
+	if err := <-sr.chErr; err != nil {
+		return Replay{}, err
+	}
+	b.logger.Infow("LogBroadcaster: Scoped replay started", "replayID", replay.ID, "jobID", req.JobID,
+		"contract", req.Contract, "fromBlock", req.FromBlock, "toBlock", req.ToBlock, "dryRun", req.DryRun)
+	return b.GetReplay(replay.ID)
+}
+
+// GetReplay returns the progress of a replay started by StartReplay
+func (b *broadcaster) GetReplay(id string) (Replay, error) {
+	b.replaysMu.RLock()
+	defer b.replaysMu.RUnlock()
+	replay, exists := b.replays[id]
+	if !exists {
+		return Replay{}, ErrReplayNotFound
+	}
+	return *replay, nil
+}
+
+// onStartReplay is called from the event loop, which owns the registrations
+func (b *broadcaster) onStartReplay(replay *Replay) error {
+	regs := b.registrations.listenersFor(replay.Request.JobID, replay.Request.Contract)
+	if len(regs) == 0 {
+		return ErrNoReplayListeners
+	}
+
+	b.replaysMu.Lock()
+	b.replays[replay.ID] = replay
+	b.replayIDs = append(b.replayIDs, replay.ID)
+	if len(b.replayIDs) > maxReplays {
+		delete(b.replays, b.replayIDs[0])
+		b.replayIDs = b.replayIDs[1:]
+	}
+	b.replaysMu.Unlock()
+
+	b.wgDone.Add(1)
+	go b.runReplay(replay, regs)
+	return nil
+}
+
+func (b *broadcaster) runReplay(replay *Replay, regs []registration) {
+	defer b.wgDone.Done()
+
+	ctx, cancel := utils.ContextFromChan(b.chStop)
+	defer cancel()
+
+	err := b.replay(ctx, replay, regs)
+
+	b.replaysMu.Lock()
+	defer b.replaysMu.Unlock()
+	now := time.Now()
+	replay.FinishedAt = &now
+	if err != nil {
+		replay.State = ReplayStateErrored
+		replay.Error = err.Error()
+		b.logger.Errorw("LogBroadcaster: Scoped replay failed", "replayID", replay.ID, "err", err)
+		return
+	}
+	replay.State = ReplayStateCompleted
+	b.logger.Infow("LogBroadcaster: Scoped replay completed", "replayID", replay.ID, "delivered", replay.Delivered)
+}
+
+// replay fetches the logs of the listeners' contracts and topics in batches
+// of EvmLogBackfillBatchSize blocks, and delivers each to the listeners that
+// match it, in order. Logs that a listener has already consumed, or that
+// don't have its required number of confirmations yet, are skipped.
+func (b *broadcaster) replay(ctx context.Context, replay *Replay, regs []registration) error {
+	ctxHead, cancel := eth.DefaultQueryCtx(ctx)
+	latestHead, err := b.ethSubscriber.ethClient.HeadByNumber(ctxHead, nil)
+	cancel()
+	if err != nil {
+		return errors.Wrap(err, "fetching latest block header")
+	} else if latestHead == nil {
+		return errors.New("got nil block header")
+	}
+
+	req := replay.Request
+	toBlock := latestHead.Number
+	if req.ToBlock.Valid && req.ToBlock.Int64 < toBlock {
+		toBlock = req.ToBlock.Int64
+	}
+	if req.FromBlock > toBlock {
+		return errors.Errorf("fromBlock %v is after the latest block %v", req.FromBlock, latestHead.Number)
+	}
+	b.replaysMu.Lock()
+	replay.ToBlock = null.Int64From(toBlock)
+	b.replaysMu.Unlock()
+
+	addresses := make(map[common.Address]struct{})
+	topics := make(map[common.Hash]struct{})
+	for _, reg := range regs {
+		addresses[reg.opts.Contract] = struct{}{}
+		for topic := range reg.opts.LogsWithTopics {
+			topics[topic] = struct{}{}
+		}
+	}
+	var query ethereum.FilterQuery
+	for address := range addresses {
+		query.Addresses = append(query.Addresses, address)
+	}
+	query.Topics = [][]common.Hash{{}}
+	for topic := range topics {
+		query.Topics[0] = append(query.Topics[0], topic)
+	}
+
+	start := time.Now()
+	batchSize := int64(b.config.EvmLogBackfillBatchSize())
+	for from := req.FromBlock; from <= toBlock; from += batchSize {
+		to := from + batchSize - 1
+		if to > toBlock {
+			to = toBlock
+		}
+		query.FromBlock = big.NewInt(from)
+		query.ToBlock = big.NewInt(to)
+
+		logs, err := b.ethSubscriber.fetchLogBatch(ctx, query, start)
+		if err != nil {
+			return errors.Wrapf(err, "fetching logs from block %v to %v", from, to)
+		}
+		consumed, err := b.orm.FindConsumedLogs(from, to)
+		if err != nil {
+			return errors.Wrap(err, "fetching consumed logs")
+		}
+		broadcastsExisting := make(map[LogBroadcastAsKey]struct{})
+		for _, c := range consumed {
+			broadcastsExisting[c.AsKey()] = struct{}{}
+		}
+
+		var delivered []ReplayedLog
+		for _, log := range logs {
+			for _, reg := range regs {
+				if b.replayLog(log, reg, *latestHead, broadcastsExisting, req.DryRun) {
+					delivered = append(delivered, ReplayedLog{JobID: reg.listener.JobID(), Log: log})
+				}
+			}
+		}
+
+		b.replaysMu.Lock()
+		replay.LastBlock = null.Int64From(to)
+		replay.Delivered += len(delivered)
+		if req.DryRun {
+			replay.Logs = append(replay.Logs, delivered...)
+		}
+		b.replaysMu.Unlock()
+	}
+	return nil
+}
+
+// replayLog delivers the log to the listener if it matches its registration,
+// or only reports that it would on a dry run
+func (b *broadcaster) replayLog(log types.Log, reg registration, latestHead eth.Head, broadcastsExisting map[LogBroadcastAsKey]struct{}, dryRun bool) bool {
+	if log.Removed || log.Address != reg.opts.Contract || len(log.Topics) == 0 {
+		return false
+	}
+	filters, exists := reg.opts.LogsWithTopics[log.Topics[0]]
+	if !exists || !filtersContainValues(log.Topics[1:], filters) {
+		return false
+	}
+	if log.BlockNumber+reg.opts.NumConfirmations-1 > uint64(latestHead.Number) {
+		return false
+	}
+	if _, consumed := broadcastsExisting[NewLogBroadcastAsKey(log, reg.listener)]; consumed {
+		return false
+	}
+
+	logCopy := gethwrappers.DeepCopyLog(log)
+	var decodedLog generated.AbigenLog
+	if reg.opts.ParseLog != nil {
+		var err error
+		decodedLog, err = reg.opts.ParseLog(logCopy)
+		if err != nil {
+			b.logger.Errorw("LogBroadcaster: Could not parse replayed contract log", "err", err)
+			return false
+		}
+	}
+	if dryRun {
+		return true
+	}
+
+	b.logger.Debugw("LogBroadcaster: Replaying log", "jobID", reg.listener.JobID(),
+		"blockNumber", log.BlockNumber, "blockHash", log.BlockHash, "address", log.Address)
+	reg.listener.HandleLog(&broadcast{
+		uint64(latestHead.Number),
+		latestHead.Hash,
+		decodedLog,
+		logCopy,
+		reg.listener.JobID(),
+		b.evmChainID,
+	})
+	return true
+}
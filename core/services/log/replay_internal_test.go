@@ -0,0 +1,110 @@
+package log
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	ethmocks "github.com/smartcontractkit/chainlink/core/services/eth/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type jobListener struct {
+	jobID int32
+	logs  []Broadcast
+}
+
+func (l *jobListener) HandleLog(b Broadcast) {
+	l.logs = append(l.logs, b)
+}
+
+func (l *jobListener) JobID() int32 {
+	return l.jobID
+}
+
+func TestReplayRequest_Validate(t *testing.T) {
+	jobID := int32(1)
+	assert.Error(t, ReplayRequest{FromBlock: 1}.Validate())
+	assert.Error(t, ReplayRequest{JobID: &jobID, FromBlock: -1}.Validate())
+	assert.Error(t, ReplayRequest{JobID: &jobID, FromBlock: 2, ToBlock: null.Int64From(1)}.Validate())
+	assert.NoError(t, ReplayRequest{JobID: &jobID, FromBlock: 1, ToBlock: null.Int64From(1)}.Validate())
+}
+
+func TestRegistrations_ListenersFor(t *testing.T) {
+	r := newRegistrations(logger.TestLogger(t), *big.NewInt(0))
+	addr1, addr2 := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	topic1, topic2 := utils.NewHash(), utils.NewHash()
+	l1, l2 := &jobListener{jobID: 1}, &jobListener{jobID: 2}
+
+	r.addSubscriber(registration{l1, ListenerOpts{Contract: addr1, LogsWithTopics: map[common.Hash][][]Topic{topic1: nil, topic2: nil}}})
+	r.addSubscriber(registration{l2, ListenerOpts{Contract: addr1, LogsWithTopics: map[common.Hash][][]Topic{topic1: nil}, NumConfirmations: 3}})
+	r.addSubscriber(registration{l2, ListenerOpts{Contract: addr2, LogsWithTopics: map[common.Hash][][]Topic{topic1: nil}}})
+
+	jobID := int32(2)
+	assert.Len(t, r.listenersFor(&jobID, nil), 2)
+	assert.Len(t, r.listenersFor(nil, &addr1), 2)
+	regs := r.listenersFor(&jobID, &addr2)
+	require.Len(t, regs, 1)
+	assert.Equal(t, l2, regs[0].listener)
+	assert.Equal(t, addr2, regs[0].opts.Contract)
+	// Listeners registered with no confirmations need one
+	assert.Equal(t, uint64(1), regs[0].opts.NumConfirmations)
+
+	jobID = 1
+	assert.Empty(t, r.listenersFor(&jobID, &addr2))
+}
+
+func TestBroadcaster_ReplayOnlyDeliversToScopedListeners(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	orm := NewORM(db, *big.NewInt(0))
+
+	ethClient := new(ethmocks.Client)
+	ethClient.Test(t)
+	defer ethClient.AssertExpectations(t)
+	ethClient.On("ChainID").Return(big.NewInt(0))
+
+	b := NewBroadcaster(orm, ethClient, tc{}, logger.TestLogger(t), nil)
+	addr := common.HexToAddress("0xf0d54349aDdcf704F77AE15b96510dEA15cb7952")
+	topic, otherTopic := utils.NewHash(), utils.NewHash()
+	l1, l2 := &jobListener{jobID: 1}, &jobListener{jobID: 2}
+	b.registrations.addSubscriber(registration{l1, ListenerOpts{Contract: addr, LogsWithTopics: map[common.Hash][][]Topic{topic: nil}}})
+	b.registrations.addSubscriber(registration{l2, ListenerOpts{Contract: addr, LogsWithTopics: map[common.Hash][][]Topic{topic: nil}}})
+
+	log5 := types.Log{Address: addr, BlockNumber: 5, BlockHash: utils.NewHash(), Topics: []common.Hash{topic}}
+	log6 := types.Log{Address: addr, BlockNumber: 6, BlockHash: utils.NewHash(), Topics: []common.Hash{otherTopic}}
+
+	jobID := int32(1)
+	for _, dryRun := range []bool{true, false} {
+		// The test config's batch size is 1, so each block is fetched separately
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&eth.Head{Number: 10, Hash: utils.NewHash()}, nil).Once()
+		ethClient.On("FilterLogs", mock.Anything, fromBlock(5)).Return([]types.Log{log5}, nil).Once()
+		ethClient.On("FilterLogs", mock.Anything, fromBlock(6)).Return([]types.Log{log6}, nil).Once()
+
+		replay := &Replay{Request: ReplayRequest{JobID: &jobID, FromBlock: 5, ToBlock: null.Int64From(6), DryRun: dryRun}}
+		require.NoError(t, b.replay(context.Background(), replay, b.registrations.listenersFor(&jobID, nil)))
+
+		assert.Equal(t, null.Int64From(6), replay.ToBlock)
+		assert.Equal(t, null.Int64From(6), replay.LastBlock)
+		assert.Equal(t, 1, replay.Delivered)
+		if dryRun {
+			assert.Equal(t, []ReplayedLog{{JobID: 1, Log: log5}}, replay.Logs)
+			assert.Empty(t, l1.logs)
+		} else {
+			assert.Empty(t, replay.Logs)
+			require.Len(t, l1.logs, 1)
+			assert.Equal(t, log5, l1.logs[0].RawLog())
+			assert.Equal(t, int32(1), l1.logs[0].JobID())
+		}
+		assert.Empty(t, l2.logs)
+	}
+}
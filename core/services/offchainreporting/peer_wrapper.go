@@ -2,6 +2,7 @@ package offchainreporting
 
 import (
 	"net"
+	"sync"
 	"time"
 
 	p2ppeer "github.com/libp2p/go-libp2p-core/peer"
@@ -58,6 +59,8 @@ type (
 		PeerID        p2pkey.PeerID
 		Peer          peer
 
+		// mu is held while the peer is started, closed or restarted
+		mu sync.Mutex
 		utils.StartStopOnce
 	}
 )
@@ -79,92 +82,130 @@ func (p *SingletonPeerWrapper) IsStarted() bool {
 }
 
 func (p *SingletonPeerWrapper) Start() error {
-	return p.StartOnce("SingletonPeerWrapper", func() (err error) {
-		p2pkeys, err := p.keyStore.P2P().GetAll()
-		if err != nil {
-			return err
-		}
-		listenPort := p.config.P2PListenPort()
-		if listenPort == 0 {
-			return errors.New("failed to instantiate oracle or bootstrapper service. If FEATURE_OFFCHAIN_REPORTING is on, then P2P_LISTEN_PORT is required and must be set to a non-zero value")
-		}
-
-		if len(p2pkeys) == 0 {
-			p.lggr.Warn("No P2P keys found in keystore. Peer wrapper will not be fully initialized")
-			return nil
-		}
-
-		key, err := p.keyStore.P2P().GetOrFirst(p.config.P2PPeerID().Raw())
-		if err != nil {
-			return errors.Wrap(err, "while fetching configured key")
-		}
-
-		p.PeerID = key.PeerID()
-		if p.PeerID == "" {
-			return errors.Wrap(err, "could not get peer ID")
-		}
-		p.pstoreWrapper, err = NewPeerstoreWrapper(p.db, p.config.P2PPeerstoreWriteInterval(), p.PeerID, p.lggr)
-		if err != nil {
-			return errors.Wrap(err, "could not make new pstorewrapper")
-		}
-		sqlDB, err := p.db.DB()
-		if err != nil {
-			return err
-		}
-		discovererDB := NewDiscovererDatabase(sqlDB, p2ppeer.ID(p.PeerID))
-
-		// If the P2PAnnounceIP is set we must also set the P2PAnnouncePort
-		// Fallback to P2PListenPort if it wasn't made explicit
-		var announcePort uint16
-		if p.config.P2PAnnounceIP() != nil && p.config.P2PAnnouncePort() != 0 {
-			announcePort = p.config.P2PAnnouncePort()
-		} else if p.config.P2PAnnounceIP() != nil {
-			announcePort = listenPort
-		}
-
-		peerLogger := logger.NewOCRWrapper(p.lggr, p.config.OCRTraceLogging(), func(string) {})
-
-		p.Peer, err = ocrnetworking.NewPeer(ocrnetworking.PeerConfig{
-			NetworkingStack:      p.config.P2PNetworkingStack(),
-			PrivKey:              key.PrivKey,
-			V1ListenIP:           p.config.P2PListenIP(),
-			V1ListenPort:         listenPort,
-			V1AnnounceIP:         p.config.P2PAnnounceIP(),
-			V1AnnouncePort:       announcePort,
-			Logger:               peerLogger,
-			V1Peerstore:          p.pstoreWrapper.Peerstore,
-			V2ListenAddresses:    p.config.P2PV2ListenAddresses(),
-			V2AnnounceAddresses:  p.config.P2PV2AnnounceAddresses(),
-			V2DeltaReconcile:     p.config.P2PV2DeltaReconcile().Duration(),
-			V2DeltaDial:          p.config.P2PV2DeltaDial().Duration(),
-			V2DiscovererDatabase: discovererDB,
-			EndpointConfig: ocrnetworking.EndpointConfig{
-				IncomingMessageBufferSize: p.config.OCRIncomingMessageBufferSize(),
-				OutgoingMessageBufferSize: p.config.OCROutgoingMessageBufferSize(),
-				NewStreamTimeout:          p.config.OCRNewStreamTimeout(),
-				DHTLookupInterval:         p.config.OCRDHTLookupInterval(),
-				BootstrapCheckInterval:    p.config.OCRBootstrapCheckInterval(),
-			},
-			V1DHTAnnouncementCounterUserPrefix: p.config.P2PDHTAnnouncementCounterUserPrefix(),
-		})
-		if err != nil {
-			return errors.Wrap(err, "error calling NewPeer")
-		}
-		return p.pstoreWrapper.Start()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.StartOnce("SingletonPeerWrapper", p.start)
+}
+
+func (p *SingletonPeerWrapper) start() (err error) {
+	p2pkeys, err := p.keyStore.P2P().GetAll()
+	if err != nil {
+		return err
+	}
+	listenPort := p.config.P2PListenPort()
+	if listenPort == 0 {
+		return errors.New("failed to instantiate oracle or bootstrapper service. If FEATURE_OFFCHAIN_REPORTING is on, then P2P_LISTEN_PORT is required and must be set to a non-zero value")
+	}
+
+	if len(p2pkeys) == 0 {
+		p.lggr.Warn("No P2P keys found in keystore. Peer wrapper will not be fully initialized")
+		return nil
+	}
+
+	key, err := p.keyStore.P2P().GetOrFirst(p.config.P2PPeerID().Raw())
+	if err != nil {
+		return errors.Wrap(err, "while fetching configured key")
+	}
+
+	p.PeerID = key.PeerID()
+	if p.PeerID == "" {
+		return errors.Wrap(err, "could not get peer ID")
+	}
+	p.pstoreWrapper, err = NewPeerstoreWrapper(p.db, p.config.P2PPeerstoreWriteInterval(), p.PeerID, p.lggr)
+	if err != nil {
+		return errors.Wrap(err, "could not make new pstorewrapper")
+	}
+	sqlDB, err := p.db.DB()
+	if err != nil {
+		return err
+	}
+	discovererDB := NewDiscovererDatabase(sqlDB, p2ppeer.ID(p.PeerID))
+
+	// If the P2PAnnounceIP is set we must also set the P2PAnnouncePort
+	// Fallback to P2PListenPort if it wasn't made explicit
+	var announcePort uint16
+	if p.config.P2PAnnounceIP() != nil && p.config.P2PAnnouncePort() != 0 {
+		announcePort = p.config.P2PAnnouncePort()
+	} else if p.config.P2PAnnounceIP() != nil {
+		announcePort = listenPort
+	}
+
+	peerLogger := logger.NewOCRWrapper(p.lggr, p.config.OCRTraceLogging(), func(string) {})
+
+	p.Peer, err = ocrnetworking.NewPeer(ocrnetworking.PeerConfig{
+		NetworkingStack:      p.config.P2PNetworkingStack(),
+		PrivKey:              key.PrivKey,
+		V1ListenIP:           p.config.P2PListenIP(),
+		V1ListenPort:         listenPort,
+		V1AnnounceIP:         p.config.P2PAnnounceIP(),
+		V1AnnouncePort:       announcePort,
+		Logger:               peerLogger,
+		V1Peerstore:          p.pstoreWrapper.Peerstore,
+		V2ListenAddresses:    p.config.P2PV2ListenAddresses(),
+		V2AnnounceAddresses:  p.config.P2PV2AnnounceAddresses(),
+		V2DeltaReconcile:     p.config.P2PV2DeltaReconcile().Duration(),
+		V2DeltaDial:          p.config.P2PV2DeltaDial().Duration(),
+		V2DiscovererDatabase: discovererDB,
+		EndpointConfig: ocrnetworking.EndpointConfig{
+			IncomingMessageBufferSize: p.config.OCRIncomingMessageBufferSize(),
+			OutgoingMessageBufferSize: p.config.OCROutgoingMessageBufferSize(),
+			NewStreamTimeout:          p.config.OCRNewStreamTimeout(),
+			DHTLookupInterval:         p.config.OCRDHTLookupInterval(),
+			BootstrapCheckInterval:    p.config.OCRBootstrapCheckInterval(),
+		},
+		V1DHTAnnouncementCounterUserPrefix: p.config.P2PDHTAnnouncementCounterUserPrefix(),
 	})
+	if err != nil {
+		return errors.Wrap(err, "error calling NewPeer")
+	}
+	return p.pstoreWrapper.Start()
 }
 
 // Close closes the peer and peerstore
 func (p *SingletonPeerWrapper) Close() error {
-	return p.StopOnce("SingletonPeerWrapper", func() (err error) {
-		if p.Peer != nil {
-			err = p.Peer.Close()
-		}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.StopOnce("SingletonPeerWrapper", p.close)
+}
 
-		if p.pstoreWrapper != nil {
-			err = multierr.Combine(err, p.pstoreWrapper.Close())
-		}
+func (p *SingletonPeerWrapper) close() (err error) {
+	if p.Peer != nil {
+		err = p.Peer.Close()
+	}
 
-		return err
-	})
+	if p.pstoreWrapper != nil {
+		err = multierr.Combine(err, p.pstoreWrapper.Close())
+	}
+
+	return err
+}
+
+// Restart closes the peer and starts it again with the configured P2P key,
+// e.g. after it was rotated. The OCR services using the peer must be stopped
+// beforehand, and started again afterwards. If the peer fails to start, the
+// wrapper is left stopped.
+func (p *SingletonPeerWrapper) Restart() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.IsStarted() {
+		return errors.New("cannot restart a peer which is not started")
+	}
+	if err := p.close(); err != nil {
+		p.lggr.Errorw("Error closing peer for restart", "err", err)
+	}
+	p.Peer = nil
+	p.pstoreWrapper = nil
+	p.PeerID = ""
+	if err := p.start(); err != nil {
+		// Leave the wrapper stopped, so that OCR jobs are not started without
+		// a peer. The peerstore is not closed, as it is only running if start
+		// succeeded.
+		return multierr.Combine(err, p.StopOnce("SingletonPeerWrapper", func() error {
+			if p.Peer == nil {
+				return nil
+			}
+			return p.Peer.Close()
+		}))
+	}
+	return nil
 }
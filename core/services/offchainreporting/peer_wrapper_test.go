@@ -85,3 +85,39 @@ func Test_SingletonPeerWrapper_Start(t *testing.T) {
 		require.Contains(t, pw.Start().Error(), fmt.Sprintf("unable to find P2P key with id %s", cltest.DefaultP2PPeerID.Raw()))
 	})
 }
+
+func Test_SingletonPeerWrapper_Restart(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	db := pgtest.NewGormDB(t)
+
+	require.NoError(t, db.Exec(`DELETE FROM encrypted_key_rings`).Error)
+
+	keyStore := cltest.NewKeyStore(t, db)
+	k, err := keyStore.P2P().Create()
+	require.NoError(t, err)
+	peerID := k.PeerID()
+	cfg.Overrides.P2PPeerID = &peerID
+
+	pw := offchainreporting.NewSingletonPeerWrapper(keyStore, cfg, db, logger.TestLogger(t))
+	require.EqualError(t, pw.Restart(), "cannot restart a peer which is not started")
+	require.NoError(t, pw.Start())
+
+	t.Run("restarts the peer with the configured key", func(t *testing.T) {
+		require.NoError(t, pw.Restart())
+		require.True(t, pw.IsStarted())
+		require.Equal(t, k.PeerID(), pw.PeerID)
+	})
+
+	t.Run("leaves the peer stopped if it fails to start", func(t *testing.T) {
+		cfg.Overrides.P2PPeerID = &cltest.DefaultP2PPeerID
+
+		err := pw.Restart()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), fmt.Sprintf("unable to find P2P key with id %s", cltest.DefaultP2PPeerID.Raw()))
+		require.False(t, pw.IsStarted())
+
+		require.EqualError(t, pw.Restart(), "cannot restart a peer which is not started")
+	})
+}
@@ -0,0 +1,130 @@
+package pipeline
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// archivedRun is the representation of a run in an archive. Unlike Run it
+// includes the IDs needed to restore it.
+type archivedRun struct {
+	ID             int64            `json:"id"`
+	PipelineSpecID int32            `json:"pipelineSpecID"`
+	JobID          null.Int         `json:"jobID"`
+	Meta           JSONSerializable `json:"meta"`
+	AllErrors      RunErrors        `json:"allErrors"`
+	FatalErrors    RunErrors        `json:"fatalErrors"`
+	Inputs         JSONSerializable `json:"inputs"`
+	Outputs        JSONSerializable `json:"outputs"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	FinishedAt     null.Time        `json:"finishedAt"`
+	State          RunStatus        `json:"state"`
+	TaskRuns       []TaskRun        `json:"taskRuns"`
+}
+
+// runArchiveWriter writes runs to a gzip-compressed file with one JSON
+// encoded run per line
+type runArchiveWriter struct {
+	path string
+	f    *os.File
+	gz   *gzip.Writer
+	enc  *json.Encoder
+}
+
+func newRunArchiveWriter(dir, label string) (*runArchiveWriter, error) {
+	if err := utils.EnsureDirAndMaxPerms(dir, os.FileMode(0700)); err != nil {
+		return nil, errors.Wrap(err, "failed to create archive directory")
+	}
+	name := fmt.Sprintf("pipeline_runs_%s_%s.jsonl.gz", label, time.Now().UTC().Format("20060102T150405.000000000Z"))
+	path := filepath.Join(dir, name)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create archive")
+	}
+	gz := gzip.NewWriter(f)
+	return &runArchiveWriter{path, f, gz, json.NewEncoder(gz)}, nil
+}
+
+// Write appends the runs to the archive and syncs it to disk, so that the
+// runs can be safely deleted afterwards
+func (w *runArchiveWriter) Write(runs []Run, jobID null.Int) error {
+	for _, run := range runs {
+		ar := archivedRun{
+			ID:             run.ID,
+			PipelineSpecID: run.PipelineSpecID,
+			JobID:          jobID,
+			Meta:           run.Meta,
+			AllErrors:      run.AllErrors,
+			FatalErrors:    run.FatalErrors,
+			Inputs:         run.Inputs,
+			Outputs:        run.Outputs,
+			CreatedAt:      run.CreatedAt,
+			FinishedAt:     run.FinishedAt,
+			State:          run.State,
+			TaskRuns:       run.PipelineTaskRuns,
+		}
+		if err := w.enc.Encode(ar); err != nil {
+			return errors.Wrapf(err, "failed to write run %d to archive %s", run.ID, w.path)
+		}
+	}
+	if err := w.gz.Flush(); err != nil {
+		return errors.Wrapf(err, "failed to flush archive %s", w.path)
+	}
+	return errors.Wrapf(w.f.Sync(), "failed to sync archive %s", w.path)
+}
+
+func (w *runArchiveWriter) Close() error {
+	err := w.gz.Close()
+	if cerr := w.f.Close(); err == nil {
+		err = cerr
+	}
+	return errors.Wrapf(err, "failed to close archive %s", w.path)
+}
+
+// ReadRunArchive reads the runs from an archive written by the run reaper
+func ReadRunArchive(r io.Reader) ([]Run, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "archive is not gzip-compressed")
+	}
+	defer gz.Close()
+
+	var runs []Run
+	scanner := bufio.NewScanner(gz)
+	// Runs with large outputs can exceed the default line limit
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		var ar archivedRun
+		if err := json.Unmarshal(scanner.Bytes(), &ar); err != nil {
+			return nil, errors.Wrapf(err, "invalid run on line %d", line)
+		}
+		for i := range ar.TaskRuns {
+			ar.TaskRuns[i].PipelineRunID = ar.ID
+		}
+		runs = append(runs, Run{
+			ID:               ar.ID,
+			PipelineSpecID:   ar.PipelineSpecID,
+			Meta:             ar.Meta,
+			AllErrors:        ar.AllErrors,
+			FatalErrors:      ar.FatalErrors,
+			Inputs:           ar.Inputs,
+			Outputs:          ar.Outputs,
+			CreatedAt:        ar.CreatedAt,
+			FinishedAt:       ar.FinishedAt,
+			State:            ar.State,
+			PipelineTaskRuns: ar.TaskRuns,
+		})
+	}
+	return runs, errors.Wrap(scanner.Err(), "failed to read archive")
+}
@@ -0,0 +1,250 @@
+package pipeline
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+)
+
+// ErrCircuitOpen is returned by bridge tasks that fail fast because their
+// bridge's circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// maxBridgeCacheEntries bounds the number of responses cached per bridge
+const maxBridgeCacheEntries = 1000
+
+var (
+	promBridgeCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_bridge_cache_hits",
+		Help: "The number of bridge task responses served from the cache, by whether they were fresh or stale",
+	},
+		[]string{"bridge", "kind"},
+	)
+	promBridgeCircuitOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_bridge_circuit_open",
+		Help: "Whether the bridge's circuit breaker is open (1) or closed (0)",
+	},
+		[]string{"bridge"},
+	)
+)
+
+// bridgeGuards holds the state that enforces each bridge's limits. Tasks are
+// created anew for every run, so this lives on the runner and is shared by
+// all runs.
+type bridgeGuards struct {
+	mu     sync.Mutex
+	guards map[bridges.TaskType]*bridgeGuard
+}
+
+func newBridgeGuards() *bridgeGuards {
+	return &bridgeGuards{guards: make(map[bridges.TaskType]*bridgeGuard)}
+}
+
+// get returns the guard for the bridge, or nil if the bridge has no limits.
+// Changing a bridge's limits or URL resets its guard, including its cache.
+func (g *bridgeGuards) get(bt bridges.BridgeType) *bridgeGuard {
+	if g == nil {
+		return nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if bt.BridgeLimits == (bridges.BridgeLimits{}) {
+		delete(g.guards, bt.Name)
+		return nil
+	}
+	guard, exists := g.guards[bt.Name]
+	if !exists || guard.limits != bt.BridgeLimits || guard.url != bt.URL.String() {
+		guard = newBridgeGuard(bt)
+		g.guards[bt.Name] = guard
+	}
+	return guard
+}
+
+type bridgeCacheEntry struct {
+	value    string
+	storedAt time.Time
+}
+
+// bridgeGuard limits the concurrency and rate of requests to a bridge, caches
+// its responses, and stops sending requests while it is failing
+type bridgeGuard struct {
+	name   string
+	url    string
+	limits bridges.BridgeLimits
+
+	sem     *semaphore.Weighted // nil if concurrency is unlimited
+	limiter *rate.Limiter       // nil if the rate is unlimited
+
+	mu    sync.Mutex
+	cache map[string]bridgeCacheEntry
+	// failures is the number of consecutive failed requests
+	failures uint32
+	// openedAt is when the circuit breaker opened, or zero if it is closed
+	openedAt time.Time
+	// probing is true while a single request tests whether a bridge with an
+	// open circuit breaker has recovered
+	probing bool
+}
+
+func newBridgeGuard(bt bridges.BridgeType) *bridgeGuard {
+	guard := &bridgeGuard{
+		name:   bt.Name.String(),
+		url:    bt.URL.String(),
+		limits: bt.BridgeLimits,
+		cache:  make(map[string]bridgeCacheEntry),
+	}
+	if bt.MaxConcurrency > 0 {
+		guard.sem = semaphore.NewWeighted(int64(bt.MaxConcurrency))
+	}
+	if bt.RequestsPerSecond > 0 {
+		burst := int(math.Ceil(bt.RequestsPerSecond))
+		guard.limiter = rate.NewLimiter(rate.Limit(bt.RequestsPerSecond), burst)
+	}
+	promBridgeCircuitOpen.WithLabelValues(guard.name).Set(0)
+	return guard
+}
+
+// fresh returns the cached response to the request if it is younger than
+// the cache TTL
+func (g *bridgeGuard) fresh(key string) (string, bool) {
+	return g.cached(key, g.limits.CacheTTL.Duration(), "fresh")
+}
+
+// stale returns the cached response to the request if it is younger than
+// the maximum staleness
+func (g *bridgeGuard) stale(key string) (string, bool) {
+	return g.cached(key, g.limits.MaxStaleness.Duration(), "stale")
+}
+
+func (g *bridgeGuard) cached(key string, maxAge time.Duration, kind string) (string, bool) {
+	if maxAge == 0 {
+		return "", false
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	entry, exists := g.cache[key]
+	if !exists || time.Since(entry.storedAt) > maxAge {
+		return "", false
+	}
+	promBridgeCacheHits.WithLabelValues(g.name, kind).Inc()
+	return entry.value, true
+}
+
+// allow returns false if the circuit breaker is open. Once the breaker has
+// been open for the timeout, a single request is allowed through to test the
+// bridge.
+func (g *bridgeGuard) allow() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.openedAt.IsZero() {
+		return true
+	}
+	if g.probing || time.Since(g.openedAt) < g.limits.CircuitBreakerTimeout.Duration() {
+		return false
+	}
+	g.probing = true
+	return true
+}
+
+// acquire waits until a request may be sent without exceeding the bridge's
+// concurrency and rate limits. The returned func must be called once the
+// request is done.
+func (g *bridgeGuard) acquire(ctx context.Context) (release func(), err error) {
+	if g.sem != nil {
+		if err = g.sem.Acquire(ctx, 1); err != nil {
+			return nil, errors.Wrap(err, "waiting for bridge concurrency limit")
+		}
+	}
+	release = func() {
+		if g.sem != nil {
+			g.sem.Release(1)
+		}
+	}
+	if g.limiter != nil {
+		if err = g.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, errors.Wrap(err, "waiting for bridge rate limit")
+		}
+	}
+	return release, nil
+}
+
+// abandon is called instead of succeeded or failed if a request allowed by
+// allow was never sent
+func (g *bridgeGuard) abandon() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.probing = false
+}
+
+// succeeded closes the circuit breaker and caches the response, if key is set
+func (g *bridgeGuard) succeeded(key string, value string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.failures = 0
+	g.probing = false
+	if !g.openedAt.IsZero() {
+		g.openedAt = time.Time{}
+		promBridgeCircuitOpen.WithLabelValues(g.name).Set(0)
+	}
+
+	if key == "" || (g.limits.CacheTTL == 0 && g.limits.MaxStaleness == 0) {
+		return
+	}
+	if _, exists := g.cache[key]; !exists && len(g.cache) >= maxBridgeCacheEntries {
+		g.evict()
+	}
+	g.cache[key] = bridgeCacheEntry{value: value, storedAt: time.Now()}
+}
+
+// evict removes expired entries from the cache, or the oldest entry if none
+// have expired
+func (g *bridgeGuard) evict() {
+	maxAge := g.limits.CacheTTL.Duration()
+	if staleness := g.limits.MaxStaleness.Duration(); staleness > maxAge {
+		maxAge = staleness
+	}
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range g.cache {
+		if time.Since(entry.storedAt) > maxAge {
+			delete(g.cache, key)
+		} else if oldestKey == "" || entry.storedAt.Before(oldest) {
+			oldestKey, oldest = key, entry.storedAt
+		}
+	}
+	if len(g.cache) >= maxBridgeCacheEntries {
+		delete(g.cache, oldestKey)
+	}
+}
+
+// failed opens the circuit breaker if the bridge has failed too many times in
+// a row, or if the request was testing whether it had recovered
+func (g *bridgeGuard) failed() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.failures++
+	threshold := g.limits.CircuitBreakerThreshold
+	if threshold == 0 {
+		return
+	}
+	if g.probing || g.failures >= threshold {
+		if g.openedAt.IsZero() {
+			promBridgeCircuitOpen.WithLabelValues(g.name).Set(1)
+		}
+		g.openedAt = time.Now()
+		g.probing = false
+	}
+}
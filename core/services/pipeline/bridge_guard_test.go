@@ -0,0 +1,160 @@
+package pipeline
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func newTestBridge(t *testing.T, limits bridges.BridgeLimits) bridges.BridgeType {
+	u, err := url.Parse("http://example.com/adapter")
+	require.NoError(t, err)
+	return bridges.BridgeType{Name: "adapter", URL: models.WebURL(*u), BridgeLimits: limits}
+}
+
+func Test_BridgeGuards_Get(t *testing.T) {
+	t.Parallel()
+
+	var nilGuards *bridgeGuards
+	assert.Nil(t, nilGuards.get(newTestBridge(t, bridges.BridgeLimits{MaxConcurrency: 1})))
+
+	guards := newBridgeGuards()
+	assert.Nil(t, guards.get(newTestBridge(t, bridges.BridgeLimits{})))
+
+	bt := newTestBridge(t, bridges.BridgeLimits{CacheTTL: models.Interval(time.Minute)})
+	guard := guards.get(bt)
+	require.NotNil(t, guard)
+	assert.Same(t, guard, guards.get(bt))
+
+	guard.succeeded("key", "value")
+	bt.CacheTTL = models.Interval(time.Hour)
+	changed := guards.get(bt)
+	assert.NotSame(t, guard, changed)
+	_, ok := changed.fresh("key")
+	assert.False(t, ok, "changing the limits resets the cache")
+
+	bt.BridgeLimits = bridges.BridgeLimits{}
+	assert.Nil(t, guards.get(bt))
+}
+
+func Test_BridgeGuard_Cache(t *testing.T) {
+	t.Parallel()
+
+	guard := newBridgeGuard(newTestBridge(t, bridges.BridgeLimits{
+		CacheTTL:     models.Interval(time.Minute),
+		MaxStaleness: models.Interval(time.Hour),
+	}))
+
+	_, ok := guard.fresh("key")
+	assert.False(t, ok)
+
+	guard.succeeded("key", "value")
+	value, ok := guard.fresh("key")
+	require.True(t, ok)
+	assert.Equal(t, "value", value)
+
+	// Age the entry past the TTL, but not the maximum staleness
+	guard.cache["key"] = bridgeCacheEntry{value: "value", storedAt: time.Now().Add(-2 * time.Minute)}
+	_, ok = guard.fresh("key")
+	assert.False(t, ok)
+	value, ok = guard.stale("key")
+	require.True(t, ok)
+	assert.Equal(t, "value", value)
+
+	guard.cache["key"] = bridgeCacheEntry{value: "value", storedAt: time.Now().Add(-2 * time.Hour)}
+	_, ok = guard.stale("key")
+	assert.False(t, ok)
+
+	// Responses to requests without a key are not cached
+	guard.succeeded("", "value")
+	assert.Len(t, guard.cache, 1)
+}
+
+func Test_BridgeGuard_CacheEviction(t *testing.T) {
+	t.Parallel()
+
+	guard := newBridgeGuard(newTestBridge(t, bridges.BridgeLimits{CacheTTL: models.Interval(time.Minute)}))
+	for i := 0; i < maxBridgeCacheEntries; i++ {
+		guard.cache[string(rune(i))] = bridgeCacheEntry{storedAt: time.Now().Add(time.Duration(-i) * time.Millisecond)}
+	}
+	guard.cache["expired"] = bridgeCacheEntry{storedAt: time.Now().Add(-time.Hour)}
+
+	guard.succeeded("new", "value")
+	assert.Len(t, guard.cache, maxBridgeCacheEntries)
+	assert.NotContains(t, guard.cache, "expired")
+
+	guard.succeeded("newer", "value")
+	assert.Len(t, guard.cache, maxBridgeCacheEntries)
+	assert.NotContains(t, guard.cache, string(rune(maxBridgeCacheEntries-1)), "the oldest entry is evicted")
+}
+
+func Test_BridgeGuard_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	guard := newBridgeGuard(newTestBridge(t, bridges.BridgeLimits{
+		CircuitBreakerThreshold: 2,
+		CircuitBreakerTimeout:   models.Interval(time.Minute),
+	}))
+
+	require.True(t, guard.allow())
+	guard.failed()
+	require.True(t, guard.allow())
+	guard.succeeded("", "")
+	guard.failed()
+	require.True(t, guard.allow(), "only consecutive failures open the breaker")
+	guard.failed()
+	assert.False(t, guard.allow())
+
+	// Once the timeout has passed, a single request tests the bridge
+	guard.openedAt = time.Now().Add(-2 * time.Minute)
+	assert.True(t, guard.allow())
+	assert.False(t, guard.allow())
+
+	// The test failed, so the breaker opens again
+	guard.failed()
+	assert.False(t, guard.allow())
+
+	guard.openedAt = time.Now().Add(-2 * time.Minute)
+	require.True(t, guard.allow())
+	guard.abandon()
+	require.True(t, guard.allow())
+	guard.succeeded("", "")
+	assert.True(t, guard.allow())
+	assert.True(t, guard.allow())
+}
+
+func Test_BridgeGuard_Acquire(t *testing.T) {
+	t.Parallel()
+
+	guard := newBridgeGuard(newTestBridge(t, bridges.BridgeLimits{MaxConcurrency: 1}))
+
+	release, err := guard.acquire(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = guard.acquire(ctx)
+	assert.Error(t, err, "a second request must wait for the first")
+
+	release()
+	release, err = guard.acquire(context.Background())
+	require.NoError(t, err)
+	release()
+
+	guard = newBridgeGuard(newTestBridge(t, bridges.BridgeLimits{RequestsPerSecond: 1}))
+	release, err = guard.acquire(context.Background())
+	require.NoError(t, err)
+	release()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = guard.acquire(ctx)
+	assert.Error(t, err, "a second request within a second exceeds the rate limit")
+}
@@ -54,6 +54,8 @@ type (
 		DefaultHTTPAllowUnrestrictedNetworkAccess() bool
 		TriggerFallbackDBPollInterval() time.Duration
 		JobPipelineMaxRunDuration() time.Duration
+		JobPipelineArchiveDir() string
+		JobPipelineReaperBatchSize() uint32
 		JobPipelineReaperInterval() time.Duration
 		JobPipelineReaperThreshold() time.Duration
 	}
@@ -78,6 +80,8 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// IsSkipped marks the task, and every task downstream of it, as skipped
+	IsSkipped bool
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -169,6 +173,9 @@ type TaskRunResult struct {
 	Attempts   uint
 	CreatedAt  time.Time
 	FinishedAt null.Time
+	// Skipped is true if the task, or one of its inputs, was skipped by a
+	// conditional task
+	Skipped bool
 	// runInfo is never persisted
 	runInfo RunInfo
 }
@@ -319,6 +326,8 @@ const (
 	TaskTypeETHABIDecode     TaskType = "ethabidecode"
 	TaskTypeETHABIDecodeLog  TaskType = "ethabidecodelog"
 	TaskTypeMerge            TaskType = "merge"
+	TaskTypeConditional      TaskType = "conditional"
+	TaskTypeExpr             TaskType = "expr"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -395,6 +404,10 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &FailTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMerge:
 		task = &MergeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeConditional:
+		task = &ConditionalTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpr:
+		task = &ExprTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, errors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -0,0 +1,626 @@
+package pipeline
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Expressions are evaluated over pipeline.Vars by the conditional and expr
+// tasks. They support:
+//
+//   - literals: decimal numbers, "strings" or 'strings', true, false and null
+//   - variables: $(keypath), resolved like task params
+//   - arithmetic: + - * / % and unary -
+//   - functions: abs, ceil, floor, max, min, pctchange and round
+//   - comparisons: == != < <= > >=
+//   - logic: ! && || and parentheses
+//
+// Values are decimals, strings, booleans or null. Arithmetic converts its
+// operands to decimals like DecimalParam does, so numeric strings may be used.
+// Comparing a number with a string converts the string to a decimal; other
+// comparisons between different types are errors. Only numbers can be
+// ordered.
+
+var ErrBadExpression = errors.New("bad expression")
+
+type exprTokenKind int
+
+const (
+	exprTokenEOF exprTokenKind = iota
+	exprTokenNumber
+	exprTokenString
+	exprTokenIdent
+	exprTokenVar
+	exprTokenOp
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	pos  int
+}
+
+// exprOperators are matched longest first
+var exprOperators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", "+", "-", "*", "/", "%", ","}
+
+func tokenizeExpression(s string) ([]exprToken, error) {
+	var tokens []exprToken
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '$':
+			end := strings.IndexByte(s[i:], ')')
+			if !strings.HasPrefix(s[i:], "$(") || end == -1 {
+				return nil, errors.Wrapf(ErrBadExpression, "unterminated variable at position %d", i)
+			}
+			tokens = append(tokens, exprToken{exprTokenVar, strings.TrimSpace(s[i+2 : i+end]), i})
+			i += end + 1
+
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(s[i+1:], c)
+			if end == -1 {
+				return nil, errors.Wrapf(ErrBadExpression, "unterminated string at position %d", i)
+			}
+			tokens = append(tokens, exprToken{exprTokenString, s[i+1 : i+1+end], i})
+			i += end + 2
+
+		case c == '.' || (c >= '0' && c <= '9'):
+			start := i
+			for i < len(s) && (s[i] == '.' || (s[i] >= '0' && s[i] <= '9')) {
+				i++
+			}
+			tokens = append(tokens, exprToken{exprTokenNumber, s[start:i], start})
+
+		case c == '_' || unicode.IsLetter(rune(c)):
+			start := i
+			for i < len(s) && (s[i] == '_' || unicode.IsLetter(rune(s[i])) || unicode.IsDigit(rune(s[i]))) {
+				i++
+			}
+			tokens = append(tokens, exprToken{exprTokenIdent, s[start:i], start})
+
+		default:
+			var op string
+			for _, candidate := range exprOperators {
+				if strings.HasPrefix(s[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, errors.Wrapf(ErrBadExpression, "unexpected character %q at position %d", c, i)
+			}
+			tokens = append(tokens, exprToken{exprTokenOp, op, i})
+			i += len(op)
+		}
+	}
+	return append(tokens, exprToken{exprTokenEOF, "", len(s)}), nil
+}
+
+type exprNode interface {
+	eval(vars Vars) (interface{}, error)
+}
+
+// parseExpression parses s into a tree that can be evaluated repeatedly
+func parseExpression(s string) (exprNode, error) {
+	tokens, err := tokenizeExpression(s)
+	if err != nil {
+		return nil, err
+	}
+	p := exprParser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != exprTokenEOF {
+		return nil, errors.Wrapf(ErrBadExpression, "unexpected %q at position %d", tok.text, tok.pos)
+	}
+	return node, nil
+}
+
+// evaluateExpression parses and evaluates s, returning a decimal.Decimal,
+// string, bool or nil
+func evaluateExpression(s string, vars Vars) (interface{}, error) {
+	node, err := parseExpression(s)
+	if err != nil {
+		return nil, err
+	}
+	return node.eval(vars)
+}
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != exprTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// accept consumes the next token if it is one of the given operators
+func (p *exprParser) accept(ops ...string) (string, bool) {
+	tok := p.peek()
+	if tok.kind != exprTokenOp {
+		return "", false
+	}
+	for _, op := range ops {
+		if tok.text == op {
+			p.next()
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.accept("||"); !ok {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &exprLogical{op: "||", left: left, right: right}
+	}
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.accept("&&"); !ok {
+			return left, nil
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &exprLogical{op: "&&", left: left, right: right}
+	}
+}
+
+func (p *exprParser) parseNot() (exprNode, error) {
+	if _, ok := p.accept("!"); ok {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &exprNot{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *exprParser) parseComparison() (exprNode, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	op, ok := p.accept("==", "!=", "<=", ">=", "<", ">")
+	if !ok {
+		return left, nil
+	}
+	right, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	return &exprComparison{op: op, left: left, right: right}, nil
+}
+
+func (p *exprParser) parseAdditive() (exprNode, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept("+", "-")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &exprArithmetic{op: op, left: left, right: right}
+	}
+}
+
+func (p *exprParser) parseMultiplicative() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept("*", "/", "%")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &exprArithmetic{op: op, left: left, right: right}
+	}
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if _, ok := p.accept("-"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &exprNegate{operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+// parseCall parses the arguments of a call to the named function, after the
+// opening parenthesis
+func (p *exprParser) parseCall(name exprToken) (exprNode, error) {
+	fn, exists := exprFunctions[name.text]
+	if !exists {
+		return nil, errors.Wrapf(ErrBadExpression, "unknown function %q at position %d", name.text, name.pos)
+	}
+	var args []exprNode
+	if _, ok := p.accept(")"); !ok {
+		for {
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if _, ok := p.accept(","); ok {
+				continue
+			}
+			if _, ok := p.accept(")"); !ok {
+				return nil, errors.Wrapf(ErrBadExpression, "missing ) at position %d", p.peek().pos)
+			}
+			break
+		}
+	}
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, errors.Wrapf(ErrBadExpression, "wrong number of arguments to %s at position %d", name.text, name.pos)
+	}
+	return &exprCall{name: name.text, fn: fn, args: args}, nil
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case exprTokenNumber:
+		d, err := decimal.NewFromString(tok.text)
+		if err != nil {
+			return nil, errors.Wrapf(ErrBadExpression, "invalid number %q at position %d", tok.text, tok.pos)
+		}
+		return &exprLiteral{value: d}, nil
+	case exprTokenString:
+		return &exprLiteral{value: tok.text}, nil
+	case exprTokenVar:
+		return &exprVar{keypath: tok.text}, nil
+	case exprTokenIdent:
+		switch tok.text {
+		case "true":
+			return &exprLiteral{value: true}, nil
+		case "false":
+			return &exprLiteral{value: false}, nil
+		case "null":
+			return &exprLiteral{value: nil}, nil
+		}
+		if _, ok := p.accept("("); ok {
+			return p.parseCall(tok)
+		}
+		return nil, errors.Wrapf(ErrBadExpression, "unknown identifier %q at position %d", tok.text, tok.pos)
+	case exprTokenOp:
+		if tok.text == "(" {
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := p.accept(")"); !ok {
+				return nil, errors.Wrapf(ErrBadExpression, "missing ) at position %d", p.peek().pos)
+			}
+			return node, nil
+		}
+	case exprTokenEOF:
+		return nil, errors.Wrap(ErrBadExpression, "unexpected end of expression")
+	}
+	return nil, errors.Wrapf(ErrBadExpression, "unexpected %q at position %d", tok.text, tok.pos)
+}
+
+type exprLiteral struct {
+	value interface{}
+}
+
+func (e *exprLiteral) eval(Vars) (interface{}, error) {
+	return e.value, nil
+}
+
+type exprVar struct {
+	keypath string
+}
+
+func (e *exprVar) eval(vars Vars) (interface{}, error) {
+	val, err := VarExpr("$("+e.keypath+")", vars)()
+	if err != nil {
+		return nil, errors.Wrapf(err, "$(%s)", e.keypath)
+	}
+	var obj ObjectParam
+	if err = obj.UnmarshalPipelineParam(val); err != nil {
+		return nil, errors.Wrapf(err, "$(%s)", e.keypath)
+	}
+	switch obj.Type {
+	case NilType:
+		return nil, nil
+	case BoolType:
+		return bool(obj.BoolValue), nil
+	case DecimalType:
+		return obj.DecimalValue.Decimal(), nil
+	case StringType:
+		return string(obj.StringValue), nil
+	}
+	return nil, errors.Wrapf(ErrBadInput, "$(%s) is a %T, not a number, string or boolean", e.keypath, val)
+}
+
+type exprNot struct {
+	operand exprNode
+}
+
+func (e *exprNot) eval(vars Vars) (interface{}, error) {
+	b, err := evalBool(e.operand, vars)
+	if err != nil {
+		return nil, err
+	}
+	return !b, nil
+}
+
+type exprLogical struct {
+	op          string
+	left, right exprNode
+}
+
+func (e *exprLogical) eval(vars Vars) (interface{}, error) {
+	left, err := evalBool(e.left, vars)
+	if err != nil {
+		return nil, err
+	}
+	// Short-circuit so that the right side may guard against missing vars
+	if (e.op == "&&" && !left) || (e.op == "||" && left) {
+		return left, nil
+	}
+	return evalBool(e.right, vars)
+}
+
+type exprComparison struct {
+	op          string
+	left, right exprNode
+}
+
+func (e *exprComparison) eval(vars Vars) (interface{}, error) {
+	left, err := e.left.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	right, err := e.right.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	_, leftIsDecimal := left.(decimal.Decimal)
+	_, rightIsDecimal := right.(decimal.Decimal)
+	if leftIsDecimal || rightIsDecimal {
+		var l, r DecimalParam
+		if left != nil && right != nil {
+			if err = l.UnmarshalPipelineParam(left); err != nil {
+				return nil, errors.Wrapf(err, "cannot compare %v with a number", left)
+			}
+			if err = r.UnmarshalPipelineParam(right); err != nil {
+				return nil, errors.Wrapf(err, "cannot compare %v with a number", right)
+			}
+			cmp := l.Decimal().Cmp(r.Decimal())
+			switch e.op {
+			case "==":
+				return cmp == 0, nil
+			case "!=":
+				return cmp != 0, nil
+			case "<":
+				return cmp < 0, nil
+			case "<=":
+				return cmp <= 0, nil
+			case ">":
+				return cmp > 0, nil
+			case ">=":
+				return cmp >= 0, nil
+			}
+		}
+	}
+
+	if e.op != "==" && e.op != "!=" {
+		return nil, errors.Wrapf(ErrBadInput, "cannot order %T and %T with %s", left, right, e.op)
+	}
+	equal := left == right
+	if !equal && left != nil && right != nil {
+		switch left.(type) {
+		case string, bool:
+			if !sameExprType(left, right) {
+				return nil, errors.Wrapf(ErrBadInput, "cannot compare %T with %T", left, right)
+			}
+		}
+	}
+	if e.op == "==" {
+		return equal, nil
+	}
+	return !equal, nil
+}
+
+type exprNegate struct {
+	operand exprNode
+}
+
+func (e *exprNegate) eval(vars Vars) (interface{}, error) {
+	d, err := evalDecimal(e.operand, vars)
+	if err != nil {
+		return nil, err
+	}
+	return d.Neg(), nil
+}
+
+type exprArithmetic struct {
+	op          string
+	left, right exprNode
+}
+
+func (e *exprArithmetic) eval(vars Vars) (interface{}, error) {
+	left, err := evalDecimal(e.left, vars)
+	if err != nil {
+		return nil, err
+	}
+	right, err := evalDecimal(e.right, vars)
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case "+":
+		return left.Add(right), nil
+	case "-":
+		return left.Sub(right), nil
+	case "*":
+		return left.Mul(right), nil
+	case "/":
+		if right.IsZero() {
+			return nil, errors.Wrap(ErrBadInput, "division by zero")
+		}
+		// Like the divide task, this rounds to 16 decimal places
+		return left.Div(right), nil
+	case "%":
+		if right.IsZero() {
+			return nil, errors.Wrap(ErrBadInput, "division by zero")
+		}
+		return left.Mod(right), nil
+	}
+	return nil, errors.Wrapf(ErrBadExpression, "unknown operator %s", e.op)
+}
+
+type exprFunction struct {
+	// maxArgs is -1 for variadic functions
+	minArgs, maxArgs int
+	call             func(args []decimal.Decimal) (decimal.Decimal, error)
+}
+
+var exprFunctions = map[string]exprFunction{
+	"abs": {1, 1, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		return args[0].Abs(), nil
+	}},
+	"ceil": {1, 1, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		return args[0].Ceil(), nil
+	}},
+	"floor": {1, 1, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		return args[0].Floor(), nil
+	}},
+	"max": {1, -1, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		return decimal.Max(args[0], args[1:]...), nil
+	}},
+	"min": {1, -1, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		return decimal.Min(args[0], args[1:]...), nil
+	}},
+	// pctchange(from, to) is the change from one value to another as a
+	// percentage of the first, e.g. pctchange(200, 150) is -25
+	"pctchange": {2, 2, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		from, to := args[0], args[1]
+		if from.IsZero() {
+			return decimal.Decimal{}, errors.Wrap(ErrBadInput, "percentage change from zero")
+		}
+		return to.Sub(from).Div(from.Abs()).Mul(decimal.NewFromInt(100)), nil
+	}},
+	// round(x) rounds half away from zero to an integer; round(x, places)
+	// rounds to the given number of decimal places
+	"round": {1, 2, func(args []decimal.Decimal) (decimal.Decimal, error) {
+		var places int32
+		if len(args) == 2 {
+			if !args[1].Equal(args[1].Truncate(0)) {
+				return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "round: places must be an integer, got %v", args[1])
+			}
+			places = int32(args[1].IntPart())
+		}
+		return args[0].Round(places), nil
+	}},
+}
+
+type exprCall struct {
+	name string
+	fn   exprFunction
+	args []exprNode
+}
+
+func (e *exprCall) eval(vars Vars) (interface{}, error) {
+	args := make([]decimal.Decimal, len(e.args))
+	for i, arg := range e.args {
+		d, err := evalDecimal(arg, vars)
+		if err != nil {
+			return nil, errors.Wrap(err, e.name)
+		}
+		args[i] = d
+	}
+	result, err := e.fn.call(args)
+	if err != nil {
+		return nil, errors.Wrap(err, e.name)
+	}
+	return result, nil
+}
+
+func sameExprType(a, b interface{}) bool {
+	switch a.(type) {
+	case string:
+		_, ok := b.(string)
+		return ok
+	case bool:
+		_, ok := b.(bool)
+		return ok
+	}
+	return false
+}
+
+func evalDecimal(node exprNode, vars Vars) (decimal.Decimal, error) {
+	val, err := node.eval(vars)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if _, isBool := val.(bool); isBool || val == nil {
+		return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "expected a number, got %v", val)
+	}
+	var d DecimalParam
+	if err = d.UnmarshalPipelineParam(val); err != nil {
+		return decimal.Decimal{}, errors.Wrapf(err, "expected a number, got %v", val)
+	}
+	return d.Decimal(), nil
+}
+
+func evalBool(node exprNode, vars Vars) (bool, error) {
+	val, err := node.eval(vars)
+	if err != nil {
+		return false, err
+	}
+	var b BoolParam
+	if err = b.UnmarshalPipelineParam(val); err != nil {
+		return false, errors.Wrapf(ErrBadInput, "expected a boolean, got %v", val)
+	}
+	return bool(b), nil
+}
@@ -1,6 +1,8 @@
 package pipeline
 
 import (
+	"context"
+
 	uuid "github.com/satori/go.uuid"
 	"gorm.io/gorm"
 
@@ -37,6 +39,14 @@ func (t *BridgeTask) HelperSetDependencies(config Config, db *gorm.DB, id uuid.U
 	t.uuid = id
 }
 
+func (t *BridgeTask) HelperSetGuards() {
+	t.guards = newBridgeGuards()
+}
+
+func (t *BridgeTask) HelperSetGuardsFrom(other *BridgeTask) {
+	t.guards = other.guards
+}
+
 func (t *HTTPTask) HelperSetDependencies(config Config) {
 	t.config = config
 }
@@ -51,3 +61,7 @@ func (t *ETHTxTask) HelperSetDependencies(db *gorm.DB, cc evm.ChainSet, keyStore
 	t.chainSet = cc
 	t.keyStore = keyStore
 }
+
+func (r *runner) ReapRuns(ctx context.Context) error {
+	return r.reapRuns(ctx)
+}
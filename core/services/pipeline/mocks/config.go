@@ -116,6 +116,20 @@ func (_m *Config) DefaultMaxHTTPAttempts() uint {
 	return r0
 }
 
+// JobPipelineArchiveDir provides a mock function with given fields:
+func (_m *Config) JobPipelineArchiveDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *Config) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -130,6 +144,20 @@ func (_m *Config) JobPipelineMaxRunDuration() time.Duration {
 	return r0
 }
 
+// JobPipelineReaperBatchSize provides a mock function with given fields:
+func (_m *Config) JobPipelineReaperBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperInterval provides a mock function with given fields:
 func (_m *Config) JobPipelineReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	common "github.com/ethereum/go-ethereum/common"
+	keystore "github.com/smartcontractkit/chainlink/core/services/keystore"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -12,19 +13,20 @@ type ETHKeyStore struct {
 	mock.Mock
 }
 
-// GetRoundRobinAddress provides a mock function with given fields: addrs
-func (_m *ETHKeyStore) GetRoundRobinAddress(addrs ...common.Address) (common.Address, error) {
+// GetLoadBalancedAddress provides a mock function with given fields: load, addrs
+func (_m *ETHKeyStore) GetLoadBalancedAddress(load keystore.KeyLoad, addrs ...common.Address) (common.Address, error) {
 	_va := make([]interface{}, len(addrs))
 	for _i := range addrs {
 		_va[_i] = addrs[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, load)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 common.Address
-	if rf, ok := ret.Get(0).(func(...common.Address) common.Address); ok {
-		r0 = rf(addrs...)
+	if rf, ok := ret.Get(0).(func(keystore.KeyLoad, ...common.Address) common.Address); ok {
+		r0 = rf(load, addrs...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(common.Address)
@@ -32,8 +34,8 @@ func (_m *ETHKeyStore) GetRoundRobinAddress(addrs ...common.Address) (common.Add
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(...common.Address) error); ok {
-		r1 = rf(addrs...)
+	if rf, ok := ret.Get(1).(func(keystore.KeyLoad, ...common.Address) error); ok {
+		r1 = rf(load, addrs...)
 	} else {
 		r1 = ret.Error(1)
 	}
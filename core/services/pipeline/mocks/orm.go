@@ -76,8 +76,8 @@ func (_m *ORM) DB() *gorm.DB {
 	return r0
 }
 
-// DeleteRun provides a mock function with given fields: id
-func (_m *ORM) DeleteRun(id int64) error {
+// DeleteRetentionPolicy provides a mock function with given fields: id
+func (_m *ORM) DeleteRetentionPolicy(id int64) error {
 	ret := _m.Called(id)
 
 	var r0 error
@@ -90,13 +90,13 @@ func (_m *ORM) DeleteRun(id int64) error {
 	return r0
 }
 
-// DeleteRunsOlderThan provides a mock function with given fields: _a0, _a1
-func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error {
-	ret := _m.Called(_a0, _a1)
+// DeleteRun provides a mock function with given fields: id
+func (_m *ORM) DeleteRun(id int64) error {
+	ret := _m.Called(id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -104,6 +104,27 @@ func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error
 	return r0
 }
 
+// DeleteRunsByIDs provides a mock function with given fields: ctx, ids
+func (_m *ORM) DeleteRunsByIDs(ctx context.Context, ids []int64) (int64, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) int64); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindRun provides a mock function with given fields: id
 func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	ret := _m.Called(id)
@@ -125,6 +146,29 @@ func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	return r0, r1
 }
 
+// FindRunsByIDs provides a mock function with given fields: ctx, ids
+func (_m *ORM) FindRunsByIDs(ctx context.Context, ids []int64) ([]pipeline.Run, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []pipeline.Run
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []pipeline.Run); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllRuns provides a mock function with given fields:
 func (_m *ORM) GetAllRuns() ([]pipeline.Run, error) {
 	ret := _m.Called()
@@ -183,6 +227,96 @@ func (_m *ORM) InsertFinishedRun(db postgres.Queryer, run pipeline.Run, saveSucc
 	return r0, r1
 }
 
+// ListRetentionPolicies provides a mock function with given fields:
+func (_m *ORM) ListRetentionPolicies() ([]pipeline.RetentionPolicy, error) {
+	ret := _m.Called()
+
+	var r0 []pipeline.RetentionPolicy
+	if rf, ok := ret.Get(0).(func() []pipeline.RetentionPolicy); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.RetentionPolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReapableRunIDs provides a mock function with given fields: ctx, criteria
+func (_m *ORM) ReapableRunIDs(ctx context.Context, criteria pipeline.ReapCriteria) ([]int64, error) {
+	ret := _m.Called(ctx, criteria)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.ReapCriteria) []int64); ok {
+		r0 = rf(ctx, criteria)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.ReapCriteria) error); ok {
+		r1 = rf(ctx, criteria)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreRuns provides a mock function with given fields: runs, archive
+func (_m *ORM) RestoreRuns(runs []pipeline.Run, archive string) (int, error) {
+	ret := _m.Called(runs, archive)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func([]pipeline.Run, string) int); ok {
+		r0 = rf(runs, archive)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]pipeline.Run, string) error); ok {
+		r1 = rf(runs, archive)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetentionTargets provides a mock function with given fields: ctx
+func (_m *ORM) RetentionTargets(ctx context.Context) ([]pipeline.RetentionTarget, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []pipeline.RetentionTarget
+	if rf, ok := ret.Get(0).(func(context.Context) []pipeline.RetentionTarget); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.RetentionTarget)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreRun provides a mock function with given fields: db, run
 func (_m *ORM) StoreRun(db postgres.Queryer, run *pipeline.Run) (bool, error) {
 	ret := _m.Called(db, run)
@@ -231,3 +365,17 @@ func (_m *ORM) UpdateTaskRunResult(taskID uuid.UUID, result pipeline.Result) (pi
 
 	return r0, r1, r2
 }
+
+// UpsertRetentionPolicy provides a mock function with given fields: policy
+func (_m *ORM) UpsertRetentionPolicy(policy *pipeline.RetentionPolicy) error {
+	ret := _m.Called(policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*pipeline.RetentionPolicy) error); ok {
+		r0 = rf(policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -168,6 +168,8 @@ type TaskRun struct {
 	FinishedAt    null.Time        `json:"finishedAt"`
 	Index         int32            `json:"index"`
 	DotID         string           `json:"dotId"`
+	// Skipped is true if the task was skipped by a conditional task
+	Skipped bool `json:"skipped"`
 
 	// Used internally for sorting completed results
 	task Task
@@ -2,8 +2,10 @@ package pipeline
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/sqlx"
@@ -26,10 +28,19 @@ type ORM interface {
 	StoreRun(db postgres.Queryer, run *Run) (restart bool, err error)
 	UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error)
 	InsertFinishedRun(db postgres.Queryer, run Run, saveSuccessfulTaskRuns bool) (runID int64, err error)
-	DeleteRunsOlderThan(context.Context, time.Duration) error
 	FindRun(id int64) (Run, error)
 	GetAllRuns() ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
+
+	ListRetentionPolicies() ([]RetentionPolicy, error)
+	UpsertRetentionPolicy(policy *RetentionPolicy) error
+	DeleteRetentionPolicy(id int64) error
+	RetentionTargets(ctx context.Context) ([]RetentionTarget, error)
+	ReapableRunIDs(ctx context.Context, criteria ReapCriteria) ([]int64, error)
+	FindRunsByIDs(ctx context.Context, ids []int64) ([]Run, error)
+	DeleteRunsByIDs(ctx context.Context, ids []int64) (int64, error)
+	RestoreRuns(runs []Run, archive string) (restored int, err error)
+
 	DB() *gorm.DB
 }
 
@@ -151,10 +162,10 @@ func (o *orm) StoreRun(tx postgres.Queryer, run *Run) (restart bool, err error)
 	}
 
 	sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, skipped)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :skipped)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
-		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
+		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at, skipped = EXCLUDED.skipped
 		RETURNING *;
 		`
 
@@ -266,22 +277,14 @@ func (o *orm) InsertFinishedRun(db postgres.Queryer, run Run, saveSuccessfulTask
 		}
 
 		sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, skipped)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :skipped);`
 		_, err = tx.NamedExecContext(ctx, sql, run.PipelineTaskRuns)
 		return err
 	})
 	return run.ID, err
 }
 
-func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration) error {
-	return o.db.
-		WithContext(ctx).
-		Exec(
-			`DELETE FROM pipeline_runs WHERE finished_at < ?`, time.Now().Add(-threshold),
-		).Error
-}
-
 func (o *orm) FindRun(id int64) (Run, error) {
 	var run = Run{ID: id}
 	err := o.db.
@@ -340,3 +343,163 @@ func (o *orm) GetUnfinishedRuns(ctx context.Context, now time.Time, fn func(run
 func (o *orm) DB() *gorm.DB {
 	return o.db
 }
+
+func (o *orm) ListRetentionPolicies() (policies []RetentionPolicy, err error) {
+	db := postgres.UnwrapGormDB(o.db)
+	err = db.Select(&policies, `SELECT * FROM pipeline_run_retention_policies ORDER BY id ASC`)
+	return policies, errors.Wrap(err, "ListRetentionPolicies failed")
+}
+
+// UpsertRetentionPolicy creates the policy, or replaces the existing policy
+// for the same job or job type
+func (o *orm) UpsertRetentionPolicy(policy *RetentionPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	conflict := `(job_id) WHERE job_id IS NOT NULL`
+	if policy.JobType.Valid {
+		conflict = `(job_type) WHERE job_type IS NOT NULL`
+	}
+	sql := `INSERT INTO pipeline_run_retention_policies (job_id, job_type, keep_last, max_age, errored_max_age, archive, created_at, updated_at)
+	VALUES (:job_id, :job_type, :keep_last, :max_age, :errored_max_age, :archive, NOW(), NOW())
+	ON CONFLICT ` + conflict + ` DO UPDATE SET
+	keep_last = EXCLUDED.keep_last, max_age = EXCLUDED.max_age, errored_max_age = EXCLUDED.errored_max_age, archive = EXCLUDED.archive, updated_at = NOW()
+	RETURNING *`
+	db := postgres.UnwrapGormDB(o.db)
+	query, args, err := db.BindNamed(sql, policy)
+	if err != nil {
+		return errors.Wrap(err, "UpsertRetentionPolicy failed")
+	}
+	return errors.Wrap(db.Get(policy, query, args...), "UpsertRetentionPolicy failed")
+}
+
+func (o *orm) DeleteRetentionPolicy(id int64) error {
+	db := postgres.UnwrapGormDB(o.db)
+	res, err := db.Exec(`DELETE FROM pipeline_run_retention_policies WHERE id = $1`, id)
+	if err != nil {
+		return errors.Wrap(err, "DeleteRetentionPolicy failed")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "DeleteRetentionPolicy failed")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// RetentionTargets returns a target for the runs of every job
+func (o *orm) RetentionTargets(ctx context.Context) (targets []RetentionTarget, err error) {
+	db := postgres.UnwrapGormDB(o.db)
+	err = db.SelectContext(ctx, &targets, `SELECT id AS job_id, type AS job_type FROM jobs ORDER BY id ASC`)
+	return targets, errors.Wrap(err, "RetentionTargets failed")
+}
+
+// ReapableRunIDs returns the IDs of the oldest finished runs matching the
+// criteria, up to criteria.Limit. Restored runs are never returned. The runs
+// of a job include those of the pipeline specs it had before it was updated.
+// If criteria.JobID is not set, it matches runs whose pipeline spec does not
+// belong to a job.
+func (o *orm) ReapableRunIDs(ctx context.Context, criteria ReapCriteria) (ids []int64, err error) {
+	db := postgres.UnwrapGormDB(o.db)
+	sql := `SELECT pr.id FROM pipeline_runs pr
+	JOIN pipeline_specs ps ON ps.id = pr.pipeline_spec_id
+	WHERE pr.finished_at IS NOT NULL
+	AND ((pr.state = 'errored' AND pr.finished_at < $1) OR (pr.state <> 'errored' AND pr.finished_at < $2))
+	AND NOT EXISTS (SELECT 1 FROM pipeline_run_restorations WHERE pipeline_run_id = pr.id)
+	`
+	args := []interface{}{criteria.ErroredBefore, criteria.CompletedBefore, criteria.Limit}
+	if criteria.JobID.Valid {
+		sql += `AND ps.job_id = $4
+	AND pr.id NOT IN (
+		SELECT pipeline_runs.id FROM pipeline_runs
+		JOIN pipeline_specs ON pipeline_specs.id = pipeline_runs.pipeline_spec_id
+		WHERE pipeline_specs.job_id = $4 ORDER BY pipeline_runs.id DESC LIMIT $5
+	)
+	`
+		args = append(args, criteria.JobID, criteria.KeepLast)
+	} else {
+		sql += `AND ps.job_id IS NULL
+	`
+	}
+	sql += `ORDER BY pr.id ASC LIMIT $3`
+	err = db.SelectContext(ctx, &ids, sql, args...)
+	return ids, errors.Wrap(err, "ReapableRunIDs failed")
+}
+
+// FindRunsByIDs loads the runs with their task runs
+func (o *orm) FindRunsByIDs(ctx context.Context, ids []int64) (runs []Run, err error) {
+	db := postgres.UnwrapGormDB(o.db)
+	if err = db.SelectContext(ctx, &runs, `SELECT * FROM pipeline_runs WHERE id = ANY($1) ORDER BY id ASC`, pq.Array(ids)); err != nil {
+		return nil, errors.Wrap(err, "FindRunsByIDs failed to load runs")
+	}
+	var taskRuns []TaskRun
+	if err = db.SelectContext(ctx, &taskRuns, `SELECT * FROM pipeline_task_runs WHERE pipeline_run_id = ANY($1) ORDER BY created_at ASC, id ASC`, pq.Array(ids)); err != nil {
+		return nil, errors.Wrap(err, "FindRunsByIDs failed to load task runs")
+	}
+	idx := make(map[int64]int, len(runs))
+	for i, run := range runs {
+		idx[run.ID] = i
+	}
+	for _, tr := range taskRuns {
+		i := idx[tr.PipelineRunID]
+		runs[i].PipelineTaskRuns = append(runs[i].PipelineTaskRuns, tr)
+	}
+	return runs, nil
+}
+
+func (o *orm) DeleteRunsByIDs(ctx context.Context, ids []int64) (int64, error) {
+	db := postgres.UnwrapGormDB(o.db)
+	// NOTE: this will cascade and wipe pipeline_task_runs too
+	res, err := db.ExecContext(ctx, `DELETE FROM pipeline_runs WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return 0, errors.Wrap(err, "DeleteRunsByIDs failed")
+	}
+	return res.RowsAffected()
+}
+
+// RestoreRuns re-inserts archived runs with their original IDs. Runs that
+// still exist, or whose pipeline spec has since been deleted, are skipped.
+// Restored runs are exempt from retention policies.
+func (o *orm) RestoreRuns(runs []Run, archive string) (restored int, err error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	err = postgres.SqlxTransaction(ctx, postgres.UnwrapGormDB(o.db), func(tx *sqlx.Tx) error {
+		for _, run := range runs {
+			var specExists bool
+			if err = tx.GetContext(ctx, &specExists, `SELECT EXISTS (SELECT 1 FROM pipeline_specs WHERE id = $1)`, run.PipelineSpecID); err != nil {
+				return errors.Wrap(err, "failed to check pipeline spec")
+			}
+			if !specExists {
+				continue
+			}
+
+			sql := `INSERT INTO pipeline_runs (id, pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
+			VALUES (:id, :pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
+			ON CONFLICT (id) DO NOTHING`
+			res, err := tx.NamedExecContext(ctx, sql, run)
+			if err != nil {
+				return errors.Wrapf(err, "failed to restore run %d", run.ID)
+			}
+			if n, _ := res.RowsAffected(); n == 0 {
+				continue
+			}
+
+			if len(run.PipelineTaskRuns) > 0 {
+				sql = `INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, skipped)
+				VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :skipped)`
+				if _, err = tx.NamedExecContext(ctx, sql, run.PipelineTaskRuns); err != nil {
+					return errors.Wrapf(err, "failed to restore task runs of run %d", run.ID)
+				}
+			}
+
+			if _, err = tx.ExecContext(ctx, `INSERT INTO pipeline_run_restorations (pipeline_run_id, archive, restored_at) VALUES ($1, $2, NOW())`, run.ID, archive); err != nil {
+				return errors.Wrapf(err, "failed to record restoration of run %d", run.ID)
+			}
+			restored++
+		}
+		return nil
+	})
+	return restored, err
+}
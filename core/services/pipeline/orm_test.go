@@ -2,10 +2,12 @@ package pipeline_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
 	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
@@ -353,3 +355,110 @@ func Test_PipelineORM_DeleteRun(t *testing.T) {
 	_, err = orm.FindRun(run.ID)
 	require.Error(t, err, "not found")
 }
+
+func mustInsertFinishedRun(t *testing.T, db *gorm.DB, specID int32, state pipeline.RunStatus, finishedAt null.Time) int64 {
+	t.Helper()
+
+	var id int64
+	err := postgres.UnwrapGormDB(db).Get(&id, `INSERT INTO pipeline_runs (pipeline_spec_id, state, outputs, all_errors, fatal_errors, created_at, finished_at)
+	VALUES ($1, $2, '[1]', '[null]', '[null]', $3, $3) RETURNING id`, specID, state, finishedAt)
+	require.NoError(t, err)
+	return id
+}
+
+func Test_PipelineORM_ReapAndRestoreRuns(t *testing.T) {
+	db, orm := setupORM(t)
+	ctx := context.Background()
+
+	p, err := pipeline.Parse(`ds1 [type=memo value=1]`)
+	require.NoError(t, err)
+	specID, err := orm.CreateSpec(ctx, db, *p, models.Interval(time.Minute))
+	require.NoError(t, err)
+
+	now := time.Now()
+	oldCompleted := mustInsertFinishedRun(t, db, specID, pipeline.RunStatusCompleted, null.TimeFrom(now.Add(-2*time.Hour)))
+	recentErrored := mustInsertFinishedRun(t, db, specID, pipeline.RunStatusErrored, null.TimeFrom(now.Add(-30*time.Minute)))
+	mustInsertFinishedRun(t, db, specID, pipeline.RunStatusCompleted, null.TimeFrom(now.Add(-30*time.Minute)))
+	mustInsertFinishedRun(t, db, specID, pipeline.RunStatusRunning, null.Time{})
+
+	criteria := pipeline.ReapCriteria{
+		CompletedBefore: now.Add(-time.Hour),
+		ErroredBefore:   now.Add(-10 * time.Minute),
+		Limit:           10,
+	}
+
+	t.Run("selects runs of specs without a job", func(t *testing.T) {
+		ids, err := orm.ReapableRunIDs(ctx, criteria)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{oldCompleted, recentErrored}, ids)
+	})
+
+	t.Run("keeps the most recent runs of a job across its pipeline specs", func(t *testing.T) {
+		jb, _ := cltest.MustInsertWebhookSpec(t, db)
+		oldSpecRun := mustInsertFinishedRun(t, db, jb.PipelineSpecID, pipeline.RunStatusCompleted, null.TimeFrom(now.Add(-3*time.Hour)))
+
+		// Updating a job points it at a new pipeline spec
+		newSpecID, err := orm.CreateSpec(ctx, db, *p, models.Interval(time.Minute))
+		require.NoError(t, err)
+		require.NoError(t, db.Exec(`UPDATE jobs SET pipeline_spec_id = ? WHERE id = ?`, newSpecID, jb.ID).Error)
+		mustInsertFinishedRun(t, db, newSpecID, pipeline.RunStatusCompleted, null.TimeFrom(now.Add(-2*time.Hour)))
+		mustInsertFinishedRun(t, db, newSpecID, pipeline.RunStatusCompleted, null.TimeFrom(now.Add(-2*time.Hour)))
+
+		c := criteria
+		c.JobID = null.IntFrom(int64(jb.ID))
+		c.KeepLast = 2
+		ids, err := orm.ReapableRunIDs(ctx, c)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{oldSpecRun}, ids)
+	})
+
+	t.Run("deletes and restores runs", func(t *testing.T) {
+		runs, err := orm.FindRunsByIDs(ctx, []int64{oldCompleted})
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+
+		n, err := orm.DeleteRunsByIDs(ctx, []int64{oldCompleted})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+
+		restored, err := orm.RestoreRuns(runs, "archive.jsonl.gz")
+		require.NoError(t, err)
+		assert.Equal(t, 1, restored)
+
+		// Restoring twice is a no-op
+		restored, err = orm.RestoreRuns(runs, "archive.jsonl.gz")
+		require.NoError(t, err)
+		assert.Equal(t, 0, restored)
+
+		// Restored runs are never reaped
+		ids, err := orm.ReapableRunIDs(ctx, criteria)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{recentErrored}, ids)
+	})
+}
+
+func Test_PipelineORM_RetentionPolicies(t *testing.T) {
+	_, orm := setupORM(t)
+
+	policy := pipeline.RetentionPolicy{JobType: null.StringFrom("cron"), KeepLast: null.IntFrom(10)}
+	require.NoError(t, orm.UpsertRetentionPolicy(&policy))
+	assert.NotZero(t, policy.ID)
+
+	// Setting the policy for the same job type replaces it
+	maxAge := models.Interval(time.Hour)
+	replacement := pipeline.RetentionPolicy{JobType: null.StringFrom("cron"), MaxAge: &maxAge, Archive: true}
+	require.NoError(t, orm.UpsertRetentionPolicy(&replacement))
+	assert.Equal(t, policy.ID, replacement.ID)
+
+	policies, err := orm.ListRetentionPolicies()
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.False(t, policies[0].KeepLast.Valid)
+	assert.Equal(t, &maxAge, policies[0].MaxAge)
+	assert.True(t, policies[0].Archive)
+
+	require.Error(t, orm.UpsertRetentionPolicy(&pipeline.RetentionPolicy{}))
+
+	require.NoError(t, orm.DeleteRetentionPolicy(policy.ID))
+	assert.Equal(t, sql.ErrNoRows, orm.DeleteRetentionPolicy(policy.ID))
+}
@@ -0,0 +1,256 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+var (
+	promPipelineRunsReaped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_runs_reaped",
+		Help: "The total number of pipeline runs deleted by the run reaper",
+	},
+		[]string{"job_type"},
+	)
+	promPipelineRunsArchived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_runs_archived",
+		Help: "The total number of pipeline runs archived by the run reaper before deletion",
+	},
+		[]string{"job_type"},
+	)
+	promPipelineRunReaperDuration = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pipeline_run_reaper_duration",
+		Help: "How long the last pipeline run reaper cycle took (in seconds)",
+	})
+)
+
+// RetentionPolicy controls how long the finished runs of a job are kept. A
+// policy applies either to a single job or to every job of a type. A job's
+// own policy takes precedence over the policy for its type, and jobs with
+// neither keep runs for JOB_PIPELINE_REAPER_THRESHOLD.
+type RetentionPolicy struct {
+	ID      int64       `json:"-"`
+	JobID   null.Int    `json:"jobID"`
+	JobType null.String `json:"jobType"`
+	// KeepLast is the number of most recent runs that are never deleted,
+	// regardless of age
+	KeepLast null.Int `json:"keepLast"`
+	// MaxAge is how long successful runs are kept. Defaults to
+	// JOB_PIPELINE_REAPER_THRESHOLD.
+	MaxAge *models.Interval `json:"maxAge"`
+	// ErroredMaxAge is how long errored runs are kept. Defaults to MaxAge.
+	ErroredMaxAge *models.Interval `json:"erroredMaxAge"`
+	// Archive writes runs to JOB_PIPELINE_ARCHIVE_DIR before deleting them
+	Archive   bool      `json:"archive"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetID returns the ID of this structure for jsonapi serialization.
+func (p RetentionPolicy) GetID() string {
+	return fmt.Sprintf("%v", p.ID)
+}
+
+// SetID is used to set the ID of this structure when deserializing from jsonapi documents.
+func (p *RetentionPolicy) SetID(value string) error {
+	ID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return err
+	}
+	p.ID = ID
+	return nil
+}
+
+// Validate checks that the policy targets exactly one of a job or a job type
+func (p RetentionPolicy) Validate() error {
+	if p.JobID.Valid == p.JobType.Valid {
+		return errors.New("retention policy must specify exactly one of jobID or jobType")
+	}
+	if p.KeepLast.Valid && p.KeepLast.Int64 < 0 {
+		return errors.New("keepLast must not be negative")
+	}
+	if p.MaxAge != nil && p.MaxAge.Duration() < 0 {
+		return errors.New("maxAge must not be negative")
+	}
+	if p.ErroredMaxAge != nil && p.ErroredMaxAge.Duration() < 0 {
+		return errors.New("erroredMaxAge must not be negative")
+	}
+	return nil
+}
+
+// RetentionTarget is a set of runs that share a retention policy, i.e. the
+// runs of a single job. A target without a job covers all runs whose pipeline
+// spec no longer belongs to a job.
+type RetentionTarget struct {
+	JobID   null.Int
+	JobType string
+}
+
+func (t RetentionTarget) label() string {
+	if t.JobID.Valid {
+		return fmt.Sprintf("job_%d", t.JobID.Int64)
+	}
+	return "no_job"
+}
+
+func (t RetentionTarget) jobTypeLabel() string {
+	if t.JobType == "" {
+		return "none"
+	}
+	return t.JobType
+}
+
+// ReapCriteria selects the finished runs of a RetentionTarget that are due
+// for deletion
+type ReapCriteria struct {
+	JobID           null.Int
+	KeepLast        int64
+	CompletedBefore time.Time
+	ErroredBefore   time.Time
+	Limit           uint32
+}
+
+// retention is the effective retention for a target after falling back to
+// defaults
+type retention struct {
+	keepLast      int64
+	maxAge        time.Duration
+	erroredMaxAge time.Duration
+	archive       bool
+}
+
+func resolveRetention(target RetentionTarget, policies []RetentionPolicy, defaultMaxAge time.Duration) retention {
+	var policy *RetentionPolicy
+	for i, p := range policies {
+		if target.JobID.Valid && p.JobID.Valid && p.JobID.Int64 == target.JobID.Int64 {
+			policy = &policies[i]
+			break
+		}
+		if p.JobType.Valid && p.JobType.String == target.JobType && target.JobType != "" {
+			policy = &policies[i]
+		}
+	}
+
+	r := retention{maxAge: defaultMaxAge, erroredMaxAge: defaultMaxAge}
+	if policy == nil {
+		return r
+	}
+	if policy.KeepLast.Valid {
+		r.keepLast = policy.KeepLast.Int64
+	}
+	if policy.MaxAge != nil {
+		r.maxAge = policy.MaxAge.Duration()
+		r.erroredMaxAge = r.maxAge
+	}
+	if policy.ErroredMaxAge != nil {
+		r.erroredMaxAge = policy.ErroredMaxAge.Duration()
+	}
+	r.archive = policy.Archive
+	return r
+}
+
+// reapRuns deletes finished runs according to the retention policies, in
+// batches of JOB_PIPELINE_REAPER_BATCH_SIZE
+func (r *runner) reapRuns(ctx context.Context) error {
+	start := time.Now()
+	defer func() {
+		promPipelineRunReaperDuration.Set(time.Since(start).Seconds())
+	}()
+
+	policies, err := r.orm.ListRetentionPolicies()
+	if err != nil {
+		return errors.Wrap(err, "failed to load retention policies")
+	}
+	targets, err := r.orm.RetentionTargets(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to load retention targets")
+	}
+	// Runs that no longer belong to a job always use the default policy
+	targets = append(targets, RetentionTarget{})
+
+	var total int64
+	for _, target := range targets {
+		ret := resolveRetention(target, policies, r.config.JobPipelineReaperThreshold())
+		deleted, err := r.reapTarget(ctx, target, ret)
+		total += deleted
+		if ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			r.lggr.Errorw("Failed to reap pipeline runs", "jobID", target.JobID, "err", err)
+		}
+	}
+	if total > 0 {
+		r.lggr.Infow(fmt.Sprintf("Reaped %d pipeline runs", total), "n", total, "duration", time.Since(start))
+	}
+	return nil
+}
+
+func (r *runner) reapTarget(ctx context.Context, target RetentionTarget, ret retention) (deleted int64, err error) {
+	now := time.Now()
+	criteria := ReapCriteria{
+		JobID:           target.JobID,
+		KeepLast:        ret.keepLast,
+		CompletedBefore: now.Add(-ret.maxAge),
+		ErroredBefore:   now.Add(-ret.erroredMaxAge),
+		Limit:           r.config.JobPipelineReaperBatchSize(),
+	}
+	if criteria.Limit == 0 {
+		return 0, errors.New("JOB_PIPELINE_REAPER_BATCH_SIZE must be greater than 0")
+	}
+
+	var archive *runArchiveWriter
+	defer func() {
+		if archive != nil {
+			if cerr := archive.Close(); cerr != nil && err == nil {
+				err = cerr
+			}
+		}
+	}()
+
+	for {
+		ids, err := r.orm.ReapableRunIDs(ctx, criteria)
+		if err != nil {
+			return deleted, err
+		}
+		if len(ids) == 0 {
+			return deleted, nil
+		}
+
+		if ret.archive {
+			runs, err := r.orm.FindRunsByIDs(ctx, ids)
+			if err != nil {
+				return deleted, err
+			}
+			if archive == nil {
+				archive, err = newRunArchiveWriter(r.config.JobPipelineArchiveDir(), target.label())
+				if err != nil {
+					return deleted, err
+				}
+			}
+			if err = archive.Write(runs, target.JobID); err != nil {
+				return deleted, err
+			}
+			promPipelineRunsArchived.WithLabelValues(target.jobTypeLabel()).Add(float64(len(runs)))
+		}
+
+		n, err := r.orm.DeleteRunsByIDs(ctx, ids)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+		promPipelineRunsReaped.WithLabelValues(target.jobTypeLabel()).Add(float64(n))
+
+		if n == 0 || len(ids) < int(criteria.Limit) {
+			return deleted, nil
+		}
+	}
+}
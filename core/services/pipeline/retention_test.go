@@ -0,0 +1,102 @@
+package pipeline_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func interval(d time.Duration) *models.Interval {
+	i := models.Interval(d)
+	return &i
+}
+
+func TestRetentionPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, pipeline.RetentionPolicy{JobID: null.IntFrom(1)}.Validate())
+	assert.NoError(t, pipeline.RetentionPolicy{JobType: null.StringFrom("cron"), KeepLast: null.IntFrom(10), MaxAge: interval(time.Hour)}.Validate())
+
+	assert.EqualError(t, pipeline.RetentionPolicy{}.Validate(), "retention policy must specify exactly one of jobID or jobType")
+	assert.EqualError(t, pipeline.RetentionPolicy{JobID: null.IntFrom(1), JobType: null.StringFrom("cron")}.Validate(), "retention policy must specify exactly one of jobID or jobType")
+	assert.EqualError(t, pipeline.RetentionPolicy{JobID: null.IntFrom(1), KeepLast: null.IntFrom(-1)}.Validate(), "keepLast must not be negative")
+	assert.EqualError(t, pipeline.RetentionPolicy{JobID: null.IntFrom(1), ErroredMaxAge: interval(-time.Hour)}.Validate(), "erroredMaxAge must not be negative")
+}
+
+func Test_PipelineRunner_ReapRuns(t *testing.T) {
+	t.Parallel()
+
+	archiveDir := t.TempDir()
+	cfg := new(mocks.Config)
+	cfg.On("JobPipelineReaperThreshold").Return(24 * time.Hour)
+	cfg.On("JobPipelineReaperBatchSize").Return(uint32(2))
+	cfg.On("JobPipelineArchiveDir").Return(archiveDir)
+
+	orm := new(mocks.ORM)
+	orm.On("ListRetentionPolicies").Return([]pipeline.RetentionPolicy{
+		{JobType: null.StringFrom("fluxmonitor"), MaxAge: interval(2 * time.Hour)},
+		{JobID: null.IntFrom(1), KeepLast: null.IntFrom(5), MaxAge: interval(time.Hour), ErroredMaxAge: interval(time.Minute), Archive: true},
+	}, nil)
+	orm.On("RetentionTargets", mock.Anything).Return([]pipeline.RetentionTarget{
+		{JobID: null.IntFrom(1), JobType: "fluxmonitor"},
+		{JobID: null.IntFrom(2), JobType: "fluxmonitor"},
+		{JobID: null.IntFrom(3), JobType: "cron"},
+	}, nil)
+
+	// criteriaFor matches the criteria of a target, allowing for the time
+	// passed since the reaper started
+	criteriaFor := func(jobID null.Int, keepLast int64, maxAge, erroredMaxAge time.Duration) interface{} {
+		return mock.MatchedBy(func(c pipeline.ReapCriteria) bool {
+			return c.JobID == jobID && c.KeepLast == keepLast && c.Limit == 2 &&
+				time.Since(c.CompletedBefore)-maxAge < time.Minute &&
+				time.Since(c.ErroredBefore)-erroredMaxAge < time.Minute
+		})
+	}
+
+	// Job 1 uses its own policy and is reaped in two batches, with archiving
+	job1 := criteriaFor(null.IntFrom(1), 5, time.Hour, time.Minute)
+	orm.On("ReapableRunIDs", mock.Anything, job1).Return([]int64{1, 2}, nil).Once()
+	orm.On("ReapableRunIDs", mock.Anything, job1).Return([]int64{3}, nil).Once()
+	orm.On("FindRunsByIDs", mock.Anything, []int64{1, 2}).Return([]pipeline.Run{{ID: 1, PipelineSpecID: 10}, {ID: 2, PipelineSpecID: 10}}, nil)
+	orm.On("FindRunsByIDs", mock.Anything, []int64{3}).Return([]pipeline.Run{{ID: 3, PipelineSpecID: 10, PipelineTaskRuns: []pipeline.TaskRun{{DotID: "ds1"}}}}, nil)
+	orm.On("DeleteRunsByIDs", mock.Anything, []int64{1, 2}).Return(int64(2), nil)
+	orm.On("DeleteRunsByIDs", mock.Anything, []int64{3}).Return(int64(1), nil)
+
+	// Job 2 falls back to the policy for its type
+	orm.On("ReapableRunIDs", mock.Anything, criteriaFor(null.IntFrom(2), 0, 2*time.Hour, 2*time.Hour)).Return(nil, nil)
+	// Job 3 and runs without a job use the default threshold
+	orm.On("ReapableRunIDs", mock.Anything, criteriaFor(null.IntFrom(3), 0, 24*time.Hour, 24*time.Hour)).Return([]int64{7}, nil)
+	orm.On("DeleteRunsByIDs", mock.Anything, []int64{7}).Return(int64(1), nil)
+	orm.On("ReapableRunIDs", mock.Anything, criteriaFor(null.Int{}, 0, 24*time.Hour, 24*time.Hour)).Return(nil, nil)
+
+	r := pipeline.NewRunner(orm, cfg, nil, nil, nil, logger.TestLogger(t))
+	require.NoError(t, r.ReapRuns(context.Background()))
+	orm.AssertExpectations(t)
+
+	archives, err := filepath.Glob(filepath.Join(archiveDir, "pipeline_runs_job_1_*.jsonl.gz"))
+	require.NoError(t, err)
+	require.Len(t, archives, 1)
+
+	f, err := os.Open(archives[0])
+	require.NoError(t, err)
+	defer f.Close()
+	runs, err := pipeline.ReadRunArchive(f)
+	require.NoError(t, err)
+	require.Len(t, runs, 3)
+	assert.Equal(t, []int64{1, 2, 3}, []int64{runs[0].ID, runs[1].ID, runs[2].ID})
+	require.Len(t, runs[2].PipelineTaskRuns, 1)
+	assert.Equal(t, int64(3), runs[2].PipelineTaskRuns[0].PipelineRunID)
+	assert.Equal(t, "ds1", runs[2].PipelineTaskRuns[0].DotID)
+}
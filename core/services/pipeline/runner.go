@@ -37,6 +37,9 @@ type Runner interface {
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory like ExecuteRun, stubbing tasks with side effects according to opts.
+	// Nothing is persisted.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, opts SimulationOptions, l logger.Logger) (run Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	InsertFinishedRun(db postgres.Queryer, run Run, saveSuccessfulTaskRuns bool) (int64, error)
 
@@ -57,6 +60,7 @@ type runner struct {
 	chainSet        evm.ChainSet
 	ethKeyStore     ETHKeyStore
 	vrfKeyStore     VRFKeyStore
+	bridgeGuards    *bridgeGuards
 	runReaperWorker utils.SleeperTask
 	lggr            logger.Logger
 
@@ -100,15 +104,16 @@ var (
 
 func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger) *runner {
 	r := &runner{
-		orm:         orm,
-		config:      config,
-		chainSet:    chainSet,
-		ethKeyStore: ethks,
-		vrfKeyStore: vrfks,
-		chStop:      make(chan struct{}),
-		wgDone:      sync.WaitGroup{},
-		runFinished: func(*Run) {},
-		lggr:        lggr.Named("PipelineRunner"),
+		orm:          orm,
+		config:       config,
+		chainSet:     chainSet,
+		ethKeyStore:  ethks,
+		vrfKeyStore:  vrfks,
+		bridgeGuards: newBridgeGuards(),
+		chStop:       make(chan struct{}),
+		wgDone:       sync.WaitGroup{},
+		runFinished:  func(*Run) {},
+		lggr:         lggr.Named("PipelineRunner"),
 	}
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperTaskFuncWorker(r.runReaper),
@@ -161,6 +166,7 @@ type memoryTaskRun struct {
 	inputs   []Result // sorted by input index
 	vars     Vars
 	attempts uint
+	skipped  bool // one of the inputs was skipped
 }
 
 // When a task panics, we catch the panic and wrap it in an error for reporting to the scheduler.
@@ -229,6 +235,7 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).db = r.orm.DB()
+			task.(*BridgeTask).guards = r.bridgeGuards
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).chainSet = r.chainSet
 			task.(*ETHCallTask).config = r.config
@@ -321,6 +328,7 @@ func (r *runner) run(
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
+			Skipped:       result.Skipped,
 			task:          result.Task,
 		})
 
@@ -368,6 +376,17 @@ func (r *runner) run(
 
 func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
 	start := time.Now()
+	if taskRun.skipped {
+		l.Debugw("Pipeline task skipped", "taskName", taskRun.task.DotID(), "taskType", taskRun.task.Type())
+		return TaskRunResult{
+			ID:         taskRun.task.Base().uuid,
+			Task:       taskRun.task,
+			CreatedAt:  start,
+			FinishedAt: null.TimeFrom(start),
+			Skipped:    true,
+		}
+	}
+
 	loggerFields := []interface{}{
 		"taskName", taskRun.task.DotID(),
 		"taskType", taskRun.task.Type(),
@@ -413,6 +432,7 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		Result:     result,
 		CreatedAt:  start,
 		FinishedAt: finishedAt,
+		Skipped:    runInfo.IsSkipped,
 		runInfo:    runInfo,
 	}
 }
@@ -601,7 +621,7 @@ func (r *runner) runReaper() {
 	ctx, cancel := utils.CombinedContext(context.Background(), r.chStop)
 	defer cancel()
 
-	err := r.orm.DeleteRunsOlderThan(ctx, r.config.JobPipelineReaperThreshold())
+	err := r.reapRuns(ctx)
 	if ctx.Err() != nil {
 		return
 	} else if err != nil {
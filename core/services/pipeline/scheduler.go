@@ -27,6 +27,10 @@ func (s *scheduler) newMemoryTaskRun(task Task) *memoryTaskRun {
 		// if we're confident that indices are within range
 		for _, i := range task.Inputs() {
 			inputs = append(inputs, input{index: int32(i.OutputIndex()), result: s.results[i.ID()].Result})
+			// skipping a task skips everything downstream of it
+			if s.results[i.ID()].Skipped {
+				run.skipped = true
+			}
 		}
 		sort.Slice(inputs, func(i, j int) bool {
 			return inputs[i].index < inputs[j].index
@@ -135,6 +139,7 @@ func (s *scheduler) reconstructResults() {
 			Result:     result,
 			CreatedAt:  r.CreatedAt,
 			FinishedAt: r.FinishedAt,
+			Skipped:    r.Skipped,
 		}
 
 		// store the result in vars
@@ -0,0 +1,82 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// SimulationOptions controls which tasks are stubbed in a simulated run.
+// ethtx tasks are always stubbed.
+type SimulationOptions struct {
+	// StubNetwork stubs bridge and http tasks so that no requests are made
+	StubNetwork bool
+	// Stubs are the values returned by stubbed tasks, keyed by dot ID. Tasks
+	// of any type that have a stub value are stubbed. Note that http and
+	// bridge tasks normally return the response body as a string.
+	Stubs map[string]interface{}
+}
+
+func (o SimulationOptions) stub(task Task) (result Result, stubbed bool) {
+	if v, exists := o.Stubs[task.DotID()]; exists {
+		return Result{Value: v}, true
+	}
+	switch task.Type() {
+	case TaskTypeETHTx:
+		// A successful ethtx task has no output
+		return Result{}, true
+	case TaskTypeBridge, TaskTypeHTTP:
+		if o.StubNetwork {
+			return Result{Error: errors.Wrapf(ErrTaskRunFailed, "no stub value given for %s", task.DotID())}, true
+		}
+	}
+	return Result{}, false
+}
+
+// stubbedTask replaces a task in a simulated run, returning a fixed result
+// instead of running it. Like the ethtx, http and bridge tasks, it fails if
+// any of its inputs failed.
+type stubbedTask struct {
+	Task
+	result Result
+}
+
+func (t *stubbedTask) Run(_ context.Context, _ Vars, inputs []Result) (Result, RunInfo) {
+	if _, err := CheckInputs(inputs, -1, -1, 0); err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, RunInfo{}
+	}
+	return t.result, RunInfo{}
+}
+
+// IsStubbed returns true if the task was stubbed in a simulated run
+func IsStubbed(task Task) bool {
+	_, stubbed := task.(*stubbedTask)
+	return stubbed
+}
+
+func (r *runner) SimulateRun(
+	ctx context.Context,
+	spec Spec,
+	vars Vars,
+	opts SimulationOptions,
+	l logger.Logger,
+) (Run, TaskRunResults, error) {
+	run := NewRun(spec, vars)
+
+	pipeline, err := r.initializePipeline(&run)
+	if err != nil {
+		return run, nil, err
+	}
+	for i, task := range pipeline.Tasks {
+		if result, stubbed := opts.stub(task); stubbed {
+			pipeline.Tasks[i] = &stubbedTask{Task: task, result: result}
+		}
+	}
+
+	// Async tasks that were not stubbed leave the run suspended; it is
+	// returned as is since it cannot be resumed
+	taskRunResults, err := r.run(ctx, pipeline, &run, vars, l)
+	return run, taskRunResults, err
+}
@@ -0,0 +1,79 @@
+package pipeline_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("simulated run must not make requests")
+	}))
+	defer server.Close()
+
+	spec := pipeline.Spec{DotDagSource: `
+ds1          [type=http url="` + server.URL + `"];
+ds1_parse    [type=jsonparse path="price"];
+ds1_multiply [type=multiply input="$(ds1_parse)" times="$(factor)"];
+submit       [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="$(ds1_multiply)"];
+
+ds1 -> ds1_parse -> ds1_multiply -> submit;
+`}
+
+	orm := new(mocks.ORM)
+	orm.On("DB").Return((*gorm.DB)(nil))
+	r := pipeline.NewRunner(orm, new(mocks.Config), nil, nil, nil, logger.TestLogger(t))
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"factor": 2})
+
+	t.Run("stubs ethtx and tasks with stub values", func(t *testing.T) {
+		opts := pipeline.SimulationOptions{Stubs: map[string]interface{}{"ds1": `{"price": 100}`}}
+		run, trrs, err := r.SimulateRun(context.Background(), spec, vars, opts, logger.TestLogger(t))
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		require.Len(t, trrs, 4)
+
+		for _, trr := range trrs {
+			require.NoError(t, trr.Result.Error, trr.Task.DotID())
+			switch trr.Task.DotID() {
+			case "ds1", "submit":
+				assert.True(t, pipeline.IsStubbed(trr.Task), trr.Task.DotID())
+			case "ds1_multiply":
+				assert.False(t, pipeline.IsStubbed(trr.Task))
+				assert.Equal(t, decimal.NewFromInt(200).String(), trr.Result.Value.(decimal.Decimal).String())
+			default:
+				assert.False(t, pipeline.IsStubbed(trr.Task), trr.Task.DotID())
+			}
+		}
+	})
+
+	t.Run("fails network tasks without stub values", func(t *testing.T) {
+		opts := pipeline.SimulationOptions{StubNetwork: true}
+		run, trrs, err := r.SimulateRun(context.Background(), spec, vars, opts, logger.TestLogger(t))
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+
+		for _, trr := range trrs {
+			switch trr.Task.DotID() {
+			case "ds1":
+				assert.True(t, pipeline.IsStubbed(trr.Task))
+				assert.EqualError(t, trr.Result.Error, "no stub value given for ds1: task run failed")
+			case "submit":
+				assert.True(t, pipeline.IsStubbed(trr.Task))
+				assert.EqualError(t, trr.Result.Error, "task inputs: too many errors")
+			}
+		}
+	})
+}
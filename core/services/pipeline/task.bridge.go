@@ -12,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 //
@@ -28,6 +29,7 @@ type BridgeTask struct {
 
 	db     *gorm.DB
 	config Config
+	guards *bridgeGuards
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -58,10 +60,11 @@ func (t *BridgeTask) Run(ctx context.Context, vars Vars, inputs []Result) (resul
 		return Result{Error: err}, runInfo
 	}
 
-	url, err := t.getBridgeURLFromName(name)
+	bridge, err := t.getBridgeFromName(name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bridge.URL)
 
 	var metaMap MapParam
 
@@ -100,16 +103,59 @@ func (t *BridgeTask) Run(ctx context.Context, vars Vars, inputs []Result) (resul
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+
+	guard := t.guards.get(bridge)
+	var cacheKey string
+	if guard != nil && t.Async != "true" {
+		cacheKey, err = bridgeCacheKey(requestData)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+	if guard != nil {
+		if value, ok := guard.fresh(cacheKey); ok {
+			logger.Debugw("Bridge task: using cached response", "url", url.String(), "dotID", t.DotID())
+			return Result{Value: value}, runInfo
+		}
+		if !guard.allow() {
+			if value, ok := guard.stale(cacheKey); ok {
+				logger.Warnw("Bridge task: circuit breaker open, using stale cached response", "url", url.String(), "dotID", t.DotID())
+				return Result{Value: value}, runInfo
+			}
+			return Result{Error: errors.Wrapf(ErrCircuitOpen, "bridge %s", name)}, runInfo
+		}
+		release, err := guard.acquire(ctx)
+		if err != nil {
+			guard.abandon()
+			return Result{Error: err}, runInfo
+		}
+		defer release()
+	}
+
 	logger.Debugw("Bridge task: sending request",
 		"requestData", string(requestDataJSON),
 		"url", url.String(),
 	)
 
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(ctx, "POST", URLParam(url), requestData, allowUnrestrictedNetworkAccess, t.config)
+	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(ctx, "POST", url, requestData, allowUnrestrictedNetworkAccess, t.config)
 	if err != nil {
+		if guard != nil {
+			guard.failed()
+			if value, ok := guard.stale(cacheKey); ok {
+				logger.Warnw("Bridge task: request failed, using stale cached response", "url", url.String(), "dotID", t.DotID(), "err", err)
+				return Result{Value: value}, runInfo
+			}
+		}
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
 
+	// A pending response to an async request is a success too, and must
+	// close the circuit breaker, or a probe of the bridge would never finish.
+	// Async requests have no cache key, so their responses are not cached.
+	if guard != nil {
+		guard.succeeded(cacheKey, string(responseBytes))
+	}
+
 	if t.Async == "true" {
 		// Look for a `pending` flag. This check is case-insensitive because http.Header normalizes header names
 		if _, ok := headers["X-Chainlink-Pending"]; ok {
@@ -141,13 +187,29 @@ func (t *BridgeTask) Run(ctx context.Context, vars Vars, inputs []Result) (resul
 	return result, runInfo
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
+func (t BridgeTask) getBridgeFromName(name StringParam) (bridges.BridgeType, error) {
 	var bt bridges.BridgeType
 	err := t.db.First(&bt, "name = ?", string(name)).Error
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bt, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+	}
+	return bt, nil
+}
+
+// bridgeCacheKey identifies identical requests to a bridge. The run's meta is
+// excluded since it changes every run.
+func bridgeCacheKey(requestData MapParam) (string, error) {
+	keyData := make(map[string]interface{}, len(requestData))
+	for k, v := range requestData {
+		if k != "meta" {
+			keyData[k] = v
+		}
+	}
+	b, err := json.Marshal(keyData)
+	if err != nil {
+		return "", err
 	}
-	return URLParam(bt.URL), nil
+	return utils.Sha256(string(b))
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
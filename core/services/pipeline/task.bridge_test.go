@@ -10,12 +10,14 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
@@ -152,6 +154,146 @@ func TestBridgeTask_Happy(t *testing.T) {
 	require.Equal(t, decimal.NewFromInt(9700), x.Data.Result)
 }
 
+func TestBridgeTask_Limits(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	var requests atomic.Int32
+	var failing atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Inc()
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(`{"data":{"result":9700}}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	_, bridge := cltest.NewBridgeType(t, "limited", server.URL)
+	bridge.BridgeLimits = bridges.BridgeLimits{
+		MaxConcurrency:          2,
+		CacheTTL:                models.Interval(time.Minute),
+		CircuitBreakerThreshold: 1,
+		CircuitBreakerTimeout:   models.Interval(time.Hour),
+		MaxStaleness:            models.Interval(time.Hour),
+	}
+	require.NoError(t, db.Create(&bridge).Error)
+
+	newTask := func(requestData string) pipeline.BridgeTask {
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        "limited",
+			RequestData: requestData,
+		}
+		task.HelperSetDependencies(cfg, db, uuid.UUID{})
+		return task
+	}
+	guarded := newTask(btcUSDPairing)
+	guarded.HelperSetGuards()
+	run := func(requestData string) pipeline.Result {
+		task := newTask(requestData)
+		task.HelperSetGuardsFrom(&guarded)
+		result, _ := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	result := run(btcUSDPairing)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"data":{"result":9700}}`, result.Value)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// Identical requests are served from the cache
+	result = run(btcUSDPairing)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"data":{"result":9700}}`, result.Value)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// A failure opens the circuit breaker
+	failing.Store(true)
+	result = run(ethUSDPairing)
+	require.Error(t, result.Error)
+	assert.False(t, errors.Is(result.Error, pipeline.ErrCircuitOpen))
+	assert.Equal(t, int32(2), requests.Load())
+
+	result = run(ethUSDPairing)
+	require.Error(t, result.Error)
+	assert.True(t, errors.Is(result.Error, pipeline.ErrCircuitOpen))
+	assert.Equal(t, int32(2), requests.Load())
+
+	// Cached responses are still served while the circuit breaker is open
+	result = run(btcUSDPairing)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"data":{"result":9700}}`, result.Value)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestBridgeTask_AsyncCircuitBreakerProbe(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	var requests atomic.Int32
+	var failing atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Inc()
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("X-Chainlink-Pending", "true")
+	}))
+	defer server.Close()
+
+	const timeout = 100 * time.Millisecond
+	_, bridge := cltest.NewBridgeType(t, "limited-async", server.URL)
+	bridge.BridgeLimits = bridges.BridgeLimits{
+		CircuitBreakerThreshold: 1,
+		CircuitBreakerTimeout:   models.Interval(timeout),
+	}
+	require.NoError(t, db.Create(&bridge).Error)
+
+	guarded := pipeline.BridgeTask{}
+	guarded.HelperSetGuards()
+	run := func() (pipeline.Result, pipeline.RunInfo) {
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        "limited-async",
+			RequestData: ethUSDPairing,
+			Async:       "true",
+		}
+		task.HelperSetDependencies(cfg, db, uuid.NewV4())
+		task.HelperSetGuardsFrom(&guarded)
+		return task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+	}
+
+	// A failure opens the circuit breaker
+	failing.Store(true)
+	result, _ := run()
+	require.Error(t, result.Error)
+	result, _ = run()
+	assert.True(t, errors.Is(result.Error, pipeline.ErrCircuitOpen))
+	assert.Equal(t, int32(1), requests.Load())
+
+	// Once the timeout has elapsed, a pending response to the probe closes
+	// the circuit breaker
+	failing.Store(false)
+	time.Sleep(timeout)
+	result, runInfo := run()
+	require.NoError(t, result.Error)
+	assert.True(t, runInfo.IsPending)
+	assert.Equal(t, int32(2), requests.Load())
+
+	result, runInfo = run()
+	require.NoError(t, result.Error)
+	assert.True(t, runInfo.IsPending)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
 func TestBridgeTask_AsyncJobPendingState(t *testing.T) {
 	t.Parallel()
 
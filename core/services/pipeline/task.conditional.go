@@ -0,0 +1,53 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ConditionalTask evaluates a boolean expression over the pipeline vars. If it
+// is true, the task passes its input (or true, if it has none) through to the
+// tasks that depend on it. If it is false, the task and every task downstream
+// of it are skipped.
+//
+// For example, to only submit a transaction when the deviation threshold is
+// crossed:
+//
+//    check  [type=conditional condition="$(deviation) >= 0.5"];
+//    submit [type=ethtx ...];
+//    check -> submit;
+type ConditionalTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Condition string `json:"condition"`
+}
+
+var _ Task = (*ConditionalTask)(nil)
+
+func (t *ConditionalTask) Type() TaskType {
+	return TaskTypeConditional
+}
+
+func (t *ConditionalTask) Run(_ context.Context, vars Vars, inputs []Result) (Result, RunInfo) {
+	values, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, RunInfo{}
+	}
+
+	val, err := evaluateExpression(t.Condition, vars)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "condition")}, RunInfo{}
+	}
+	satisfied, ok := val.(bool)
+	if !ok {
+		return Result{Error: errors.Wrapf(ErrBadInput, "condition: expected a boolean, got %v", val)}, RunInfo{}
+	}
+
+	if !satisfied {
+		return Result{Value: false}, RunInfo{IsSkipped: true}
+	}
+	if len(values) == 1 {
+		return Result{Value: values[0]}, RunInfo{}
+	}
+	return Result{Value: true}, RunInfo{}
+}
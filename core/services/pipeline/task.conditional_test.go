@@ -0,0 +1,153 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func TestConditionalTask(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]interface{}{
+		"answer":    "1.5",
+		"deviation": 0.75,
+		"status":    "ok",
+		"enabled":   true,
+		"nothing":   nil,
+		"list":      []interface{}{1, 2},
+		"nested":    map[string]interface{}{"count": 3},
+	}
+
+	tests := []struct {
+		name      string
+		condition string
+		satisfied bool
+		wantErr   error
+	}{
+		{"true literal", "true", true, nil},
+		{"false literal", "false", false, nil},
+		{"number comparison", "$(deviation) >= 0.5", true, nil},
+		{"number comparison false", "$(deviation) < 0.5", false, nil},
+		{"string number comparison", "$(answer) == 1.5", true, nil},
+		{"nested var", "$(nested.count) > 2", true, nil},
+		{"string equality", `$(status) == "ok"`, true, nil},
+		{"string inequality", "$(status) != 'ok'", false, nil},
+		{"bool var", "$(enabled)", true, nil},
+		{"not", "!$(enabled)", false, nil},
+		{"and", "$(enabled) && $(deviation) > 1", false, nil},
+		{"or", "$(enabled) || $(deviation) > 1", true, nil},
+		{"precedence", "false && true || true", true, nil},
+		{"parentheses", "false && (true || true)", false, nil},
+		{"null", "$(nothing) == null", true, nil},
+		{"short circuit", "false && $(missing) > 1", false, nil},
+
+		{"missing var", "$(missing) > 1", false, pipeline.ErrKeypathNotFound},
+		{"unordered strings", `$(status) > "a"`, false, pipeline.ErrBadInput},
+		{"mismatched types", `$(enabled) == "true"`, false, pipeline.ErrBadInput},
+		{"not a boolean", "$(deviation)", false, pipeline.ErrBadInput},
+		{"array var", "$(list) == 1", false, pipeline.ErrBadInput},
+		{"unknown identifier", "maybe", false, pipeline.ErrBadExpression},
+		{"unterminated string", `$(status) == "ok`, false, pipeline.ErrBadExpression},
+		{"missing paren", "(true", false, pipeline.ErrBadExpression},
+		{"trailing tokens", "true true", false, pipeline.ErrBadExpression},
+		{"empty", "", false, pipeline.ErrBadExpression},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ConditionalTask{
+				BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Condition: test.condition,
+			}
+			result, runInfo := task.Run(context.Background(), pipeline.NewVarsFrom(vars), nil)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Error(t, result.Error)
+				assert.True(t, errors.Is(result.Error, test.wantErr), result.Error.Error())
+				return
+			}
+			require.NoError(t, result.Error)
+			assert.Equal(t, test.satisfied, result.Value)
+			assert.Equal(t, !test.satisfied, runInfo.IsSkipped)
+		})
+	}
+}
+
+func TestConditionalTask_Inputs(t *testing.T) {
+	t.Parallel()
+
+	task := pipeline.ConditionalTask{
+		BaseTask:  pipeline.NewBaseTask(0, "task", nil, nil, 0),
+		Condition: "true",
+	}
+
+	result, _ := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: "foo"}})
+	require.NoError(t, result.Error)
+	assert.Equal(t, "foo", result.Value)
+
+	result, _ = task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Error: errors.New("boom")}})
+	assert.Error(t, result.Error)
+
+	result, _ = task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: 1}, {Value: 2}})
+	assert.Error(t, result.Error)
+}
+
+func Test_PipelineRunner_ConditionalSkipsDownstream(t *testing.T) {
+	t.Parallel()
+
+	spec := pipeline.Spec{DotDagSource: `
+answer   [type=multiply input="$(price)" times=1];
+check    [type=conditional condition="$(answer) > $(threshold)"];
+multiply [type=multiply input="$(answer)" times=2];
+other    [type=multiply input="$(price)" times=3];
+
+answer -> check -> multiply;
+`}
+
+	orm := new(mocks.ORM)
+	orm.On("DB").Return((*gorm.DB)(nil))
+	r := pipeline.NewRunner(orm, new(mocks.Config), nil, nil, nil, logger.TestLogger(t))
+
+	t.Run("condition satisfied", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"price": 100, "threshold": 50})
+		run, trrs, err := r.ExecuteRun(context.Background(), spec, vars, logger.TestLogger(t))
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		for _, trr := range trrs {
+			assert.False(t, trr.Skipped, trr.Task.DotID())
+			require.NoError(t, trr.Result.Error, trr.Task.DotID())
+		}
+	})
+
+	t.Run("condition not satisfied", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"price": 10, "threshold": 50})
+		run, trrs, err := r.ExecuteRun(context.Background(), spec, vars, logger.TestLogger(t))
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		require.Len(t, trrs, 4)
+		for _, trr := range trrs {
+			require.NoError(t, trr.Result.Error, trr.Task.DotID())
+			assert.True(t, trr.FinishedAt.Valid, trr.Task.DotID())
+			switch trr.Task.DotID() {
+			case "check":
+				assert.True(t, trr.Skipped)
+				assert.Equal(t, false, trr.Result.Value)
+			case "multiply":
+				assert.True(t, trr.Skipped)
+				assert.Nil(t, trr.Result.Value)
+			default:
+				assert.False(t, trr.Skipped, trr.Task.DotID())
+			}
+		}
+	})
+}
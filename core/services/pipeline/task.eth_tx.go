@@ -15,6 +15,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 )
 
 //
@@ -31,6 +33,8 @@ type ETHTxTask struct {
 	MinConfirmations string `json:"minConfirmations"`
 	EVMChainID       string `json:"evmChainID" mapstructure:"evmChainID"`
 	Simulate         string `json:"simulate" mapstructure:"simulate"`
+	GasPriority      string `json:"gasPriority" mapstructure:"gasPriority"`
+	Multicall        string `json:"multicall"`
 
 	db       *gorm.DB
 	keyStore ETHKeyStore
@@ -41,7 +45,7 @@ type ETHTxTask struct {
 //go:generate mockery --name TxManager --output ./mocks/ --case=underscore
 
 type ETHKeyStore interface {
-	GetRoundRobinAddress(addrs ...common.Address) (common.Address, error)
+	GetLoadBalancedAddress(load keystore.KeyLoad, addrs ...common.Address) (common.Address, error)
 }
 
 type TxManager interface {
@@ -74,6 +78,8 @@ func (t *ETHTxTask) Run(_ context.Context, vars Vars, inputs []Result) (result R
 		txMetaMap             MapParam
 		maybeMinConfirmations MaybeUint64Param
 		simulate              BoolParam
+		gasPriorityParam      StringParam
+		multicall             StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -83,11 +89,27 @@ func (t *ETHTxTask) Run(_ context.Context, vars Vars, inputs []Result) (result R
 		errors.Wrap(ResolveParam(&txMetaMap, From(VarExpr(t.TxMeta, vars), JSONWithVarExprs(t.TxMeta, vars, false), MapParam{})), "txMeta"),
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(t.MinConfirmations)), "minConfirmations"),
 		errors.Wrap(ResolveParam(&simulate, From(VarExpr(t.Simulate, vars), NonemptyString(t.Simulate), false)), "simulate"),
+		errors.Wrap(ResolveParam(&gasPriorityParam, From(VarExpr(t.GasPriority, vars), NonemptyString(t.GasPriority), "")), "gasPriority"),
+		errors.Wrap(ResolveParam(&multicall, From(VarExpr(t.Multicall, vars), NonemptyString(t.Multicall), "")), "multicall"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
 
+	var gasPriority gas.Priority
+	if gasPriorityParam != "" {
+		gasPriority, err = gas.ParsePriority(string(gasPriorityParam))
+		if err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "gasPriority: %v", err)}, runInfo
+		}
+	}
+
+	if multicall != "" && !common.IsHexAddress(string(multicall)) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "multicall: %q is not an address", multicall)}, runInfo
+	} else if multicall != "" && common.HexToAddress(string(multicall)) == bulletprooftxmanager.PublicMulticall3Address {
+		return Result{Error: errors.Wrapf(ErrBadInput, "multicall: %s is the public Multicall3 contract, which anyone can call through; use an AuthorizedBatchForwarder", multicall)}, runInfo
+	}
+
 	var minConfirmations uint64
 	if min, isSet := maybeMinConfirmations.Uint64(); isSet {
 		minConfirmations = min
@@ -122,8 +144,41 @@ func (t *ETHTxTask) Run(_ context.Context, vars Vars, inputs []Result) (result R
 	if err != nil {
 		return Result{Error: errors.Wrapf(ErrBadInput, "txMeta: %v", err)}, runInfo
 	}
+	if txMeta.JobID == 0 {
+		// Attribute the transaction's fee to the job running the task
+		if jobID, err2 := vars.Get("jobSpec.databaseID"); err2 == nil {
+			if id, is := jobID.(int32); is {
+				txMeta.JobID = id
+			}
+		}
+	}
+
+	if len(fromAddrs) == 0 {
+		// Default to the pool of sending keys declared by the job, if any
+		if jobFromAddrs, err2 := vars.Get("jobSpec.fromAddresses"); err2 == nil {
+			if err2 = fromAddrs.UnmarshalPipelineParam(jobFromAddrs); err2 != nil {
+				return Result{Error: errors.Wrap(err2, "jobSpec.fromAddresses")}, runInfo
+			}
+		}
+	}
+
+	// The funding treasury key only sends top-ups to the other keys
+	treasury := common.HexToAddress(cfg.FundingTreasuryAddress())
+	for _, addr := range fromAddrs {
+		if treasury != (common.Address{}) && addr == treasury {
+			return Result{Error: errors.Wrapf(ErrBadInput, "from: %s is the funding treasury key and cannot be used by jobs", addr.Hex())}, runInfo
+		}
+	}
 
-	fromAddr, err := t.keyStore.GetRoundRobinAddress(fromAddrs...)
+	var balances bulletprooftxmanager.BalanceReader
+	if bm := chain.BalanceMonitor(); bm != nil {
+		balances = bm
+	}
+	load, err := bulletprooftxmanager.NewSendingKeyLoad(t.db, *chain.ID(), balances, cfg.EvmMinSendingKeyBalanceWei(), treasury)
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while counting pending transactions: %v", err)}, retryableRunInfo()
+	}
+	fromAddr, err := t.keyStore.GetLoadBalancedAddress(load, fromAddrs...)
 	if err != nil {
 		err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
 		logger.Error(err)
@@ -131,7 +186,13 @@ func (t *ETHTxTask) Run(_ context.Context, vars Vars, inputs []Result) (result R
 	}
 
 	// NOTE: This can be easily adjusted later to allow job specs to specify the details of which strategy they would like
-	strategy := bulletprooftxmanager.NewSendEveryStrategy(bool(simulate))
+	var strategy bulletprooftxmanager.TxStrategy
+	if multicall != "" {
+		// Opt in to merging with other queued transactions from the same key
+		strategy = bulletprooftxmanager.NewBatchingStrategy(common.HexToAddress(string(multicall)), bool(simulate))
+	} else {
+		strategy = bulletprooftxmanager.NewSendEveryStrategy(bool(simulate))
+	}
 
 	newTx := bulletprooftxmanager.NewTx{
 		FromAddress:    fromAddr,
@@ -140,6 +201,7 @@ func (t *ETHTxTask) Run(_ context.Context, vars Vars, inputs []Result) (result R
 		GasLimit:       uint64(gasLimit),
 		Meta:           &txMeta,
 		Strategy:       strategy,
+		GasPriority:    gasPriority,
 	}
 
 	if minConfirmations > 0 {
@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -12,13 +13,17 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	keystoremocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func TestETHTxTask(t *testing.T) {
@@ -55,7 +60,7 @@ func TestETHTxTask(t *testing.T) {
 				data := []byte("foobar")
 				gasLimit := uint64(12345)
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
-				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+				keyStore.On("GetLoadBalancedAddress", mock.Anything, from).Return(from, nil)
 				txManager.On("CreateEthTransaction", mock.Anything, bulletprooftxmanager.NewTx{
 					FromAddress:    from,
 					ToAddress:      to,
@@ -92,7 +97,7 @@ func TestETHTxTask(t *testing.T) {
 				data := []byte("foobar")
 				gasLimit := uint64(12345)
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
-				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+				keyStore.On("GetLoadBalancedAddress", mock.Anything, from).Return(from, nil)
 				txManager.On("CreateEthTransaction", mock.Anything, bulletprooftxmanager.NewTx{
 					FromAddress:    from,
 					ToAddress:      to,
@@ -131,7 +136,7 @@ func TestETHTxTask(t *testing.T) {
 				data := []byte("foobar")
 				gasLimit := uint64(12345)
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
-				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+				keyStore.On("GetLoadBalancedAddress", mock.Anything, from).Return(from, nil)
 				txManager.On("CreateEthTransaction", mock.Anything, bulletprooftxmanager.NewTx{
 					FromAddress:    from,
 					ToAddress:      to,
@@ -170,7 +175,7 @@ func TestETHTxTask(t *testing.T) {
 				data := []byte("foobar")
 				gasLimit := uint64(12345)
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
-				keyStore.On("GetRoundRobinAddress").Return(from, nil)
+				keyStore.On("GetLoadBalancedAddress", mock.Anything).Return(from, nil)
 				txManager.On("CreateEthTransaction", mock.Anything, bulletprooftxmanager.NewTx{
 					FromAddress:    from,
 					ToAddress:      to,
@@ -199,7 +204,7 @@ func TestETHTxTask(t *testing.T) {
 				data := []byte("foobar")
 				gasLimit := uint64(12345)
 				txMeta := &bulletprooftxmanager.EthTxMeta{}
-				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+				keyStore.On("GetLoadBalancedAddress", mock.Anything, from).Return(from, nil)
 				txManager.On("CreateEthTransaction", mock.Anything, bulletprooftxmanager.NewTx{
 					FromAddress:    from,
 					ToAddress:      to,
@@ -228,7 +233,7 @@ func TestETHTxTask(t *testing.T) {
 				data := []byte("foobar")
 				gasLimit := uint64(999)
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
-				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+				keyStore.On("GetLoadBalancedAddress", mock.Anything, from).Return(from, nil)
 				txManager.On("CreateEthTransaction", mock.Anything, bulletprooftxmanager.NewTx{
 					FromAddress:    from,
 					ToAddress:      to,
@@ -262,7 +267,7 @@ func TestETHTxTask(t *testing.T) {
 			nil,
 			func(config *configtest.TestGeneralConfig, keyStore *keystoremocks.Eth, txManager *bptxmmocks.TxManager) {
 				config.Overrides.GlobalEvmGasLimitDefault = null.IntFrom(999)
-				keyStore.On("GetRoundRobinAddress").Return(nil, errors.New("uh oh"))
+				keyStore.On("GetLoadBalancedAddress", mock.Anything).Return(nil, errors.New("uh oh"))
 			},
 			nil, pipeline.ErrTaskRunFailed, "while querying keystore", pipeline.RunInfo{IsRetryable: true},
 		},
@@ -283,7 +288,7 @@ func TestETHTxTask(t *testing.T) {
 				data := []byte("foobar")
 				gasLimit := uint64(12345)
 				txMeta := &bulletprooftxmanager.EthTxMeta{JobID: 321, RequestID: common.HexToHash("0x5198616554d738d9485d1a7cf53b2f33e09c3bbc8fe9ac0020bd672cd2bc15d2"), RequestTxHash: common.HexToHash("0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8")}
-				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+				keyStore.On("GetLoadBalancedAddress", mock.Anything, from).Return(from, nil)
 				txManager.On("CreateEthTransaction", mock.Anything, bulletprooftxmanager.NewTx{
 					FromAddress:    from,
 					ToAddress:      to,
@@ -367,7 +372,7 @@ func TestETHTxTask(t *testing.T) {
 			func(config *configtest.TestGeneralConfig, keyStore *keystoremocks.Eth, txManager *bptxmmocks.TxManager) {
 				config.Overrides.GlobalEvmGasLimitDefault = null.IntFrom(999)
 				from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
-				keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+				keyStore.On("GetLoadBalancedAddress", mock.Anything, from).Return(from, nil)
 				txManager.On("CreateEthTransaction", mock.Anything, mock.MatchedBy(func(tx bulletprooftxmanager.NewTx) bool {
 					return tx.MinConfirmations == clnull.Uint32From(3) && tx.PipelineTaskRunID != nil
 				})).Return(bulletprooftxmanager.EthTx{}, nil)
@@ -422,3 +427,200 @@ func TestETHTxTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHTxTask_GasPriority(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	for _, test := range []struct {
+		name        string
+		gasPriority string
+		expected    gas.Priority
+		expectedErr bool
+	}{
+		{"unset", "", "", false},
+		{"urgent", "urgent", gas.PriorityUrgent, false},
+		{"from vars", "$(priority)", gas.PrioritySlow, false},
+		{"unknown", "asap", "", true},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ETHTxTask{
+				BaseTask:    pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:        from.Hex(),
+				To:          to.Hex(),
+				Data:        "foobar",
+				GasLimit:    "12345",
+				GasPriority: test.gasPriority,
+			}
+
+			keyStore := new(keystoremocks.Eth)
+			keyStore.Test(t)
+			txManager := new(bptxmmocks.TxManager)
+			txManager.Test(t)
+			db := pgtest.NewGormDB(t)
+			cfg := configtest.NewTestGeneralConfig(t)
+			cfg.Overrides.GlobalMinRequiredOutgoingConfirmations = null.IntFrom(0)
+			cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+			task.HelperSetDependencies(db, cc, keyStore)
+
+			if !test.expectedErr {
+				keyStore.On("GetLoadBalancedAddress", mock.Anything, from).Return(from, nil)
+				txManager.On("CreateEthTransaction", mock.Anything, mock.MatchedBy(func(newTx bulletprooftxmanager.NewTx) bool {
+					return newTx.GasPriority == test.expected
+				})).Return(bulletprooftxmanager.EthTx{}, nil)
+			}
+
+			vars := pipeline.NewVarsFrom(map[string]interface{}{"priority": "slow"})
+			result, _ := task.Run(context.Background(), vars, nil)
+			if test.expectedErr {
+				require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+			} else {
+				require.NoError(t, result.Error)
+			}
+
+			keyStore.AssertExpectations(t)
+			txManager.AssertExpectations(t)
+		})
+	}
+}
+
+func TestETHTxTask_FundingTreasury(t *testing.T) {
+	t.Parallel()
+
+	treasury := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	sender := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	setup := func(t *testing.T, task *pipeline.ETHTxTask) (*keystoremocks.Eth, *bptxmmocks.TxManager) {
+		keyStore := new(keystoremocks.Eth)
+		keyStore.Test(t)
+		txManager := new(bptxmmocks.TxManager)
+		txManager.Test(t)
+		db := pgtest.NewGormDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalMinRequiredOutgoingConfirmations = null.IntFrom(0)
+		chainCfg := evmtypes.ChainCfg{
+			FundingTreasuryAddress: null.StringFrom(treasury.Hex()),
+			FundingDailyCapWei:     utils.NewBigI(1),
+		}
+		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, ChainCfg: chainCfg, TxManager: txManager, KeyStore: keyStore})
+		task.HelperSetDependencies(db, cc, keyStore)
+		return keyStore, txManager
+	}
+
+	t.Run("rejects the treasury key as from address", func(t *testing.T) {
+		task := pipeline.ETHTxTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:     fmt.Sprintf(`[ "%s", "%s" ]`, sender.Hex(), treasury.Hex()),
+			To:       to.Hex(),
+			Data:     "foobar",
+			GasLimit: "12345",
+		}
+		keyStore, txManager := setup(t, &task)
+
+		result, _ := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		assert.Contains(t, result.Error.Error(), "funding treasury key")
+
+		keyStore.AssertExpectations(t)
+		txManager.AssertExpectations(t)
+	})
+
+	t.Run("leaves the treasury key out of the default sending keys", func(t *testing.T) {
+		task := pipeline.ETHTxTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			To:       to.Hex(),
+			Data:     "foobar",
+			GasLimit: "12345",
+		}
+		keyStore, txManager := setup(t, &task)
+
+		keyStore.On("GetLoadBalancedAddress", mock.MatchedBy(func(load keystore.KeyLoad) bool {
+			_, treasuryUsable := load(treasury)
+			_, senderUsable := load(sender)
+			return !treasuryUsable && senderUsable
+		})).Return(sender, nil)
+		txManager.On("CreateEthTransaction", mock.Anything, mock.MatchedBy(func(newTx bulletprooftxmanager.NewTx) bool {
+			return newTx.FromAddress == sender
+		})).Return(bulletprooftxmanager.EthTx{}, nil)
+
+		result, _ := task.Run(context.Background(), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+
+		keyStore.AssertExpectations(t)
+		txManager.AssertExpectations(t)
+	})
+}
+
+func TestETHTxTask_Multicall(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	multicall := common.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+
+	for _, test := range []struct {
+		name        string
+		multicall   string
+		expected    *common.Address
+		expectedErr bool
+	}{
+		{"unset", "", nil, false},
+		{"address", multicall.Hex(), &multicall, false},
+		{"from vars", "$(multicall)", &multicall, false},
+		{"invalid", "0xfoo", nil, true},
+		{"public multicall3", bulletprooftxmanager.PublicMulticall3Address.Hex(), nil, true},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ETHTxTask{
+				BaseTask:  pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:      from.Hex(),
+				To:        to.Hex(),
+				Data:      "foobar",
+				GasLimit:  "12345",
+				Multicall: test.multicall,
+			}
+
+			keyStore := new(keystoremocks.Eth)
+			keyStore.Test(t)
+			txManager := new(bptxmmocks.TxManager)
+			txManager.Test(t)
+			db := pgtest.NewGormDB(t)
+			cfg := configtest.NewTestGeneralConfig(t)
+			cfg.Overrides.GlobalMinRequiredOutgoingConfirmations = null.IntFrom(0)
+			cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+			task.HelperSetDependencies(db, cc, keyStore)
+
+			if !test.expectedErr {
+				keyStore.On("GetLoadBalancedAddress", mock.Anything, from).Return(from, nil)
+				txManager.On("CreateEthTransaction", mock.Anything, mock.MatchedBy(func(newTx bulletprooftxmanager.NewTx) bool {
+					s, batching := newTx.Strategy.(bulletprooftxmanager.BatchingTxStrategy)
+					if test.expected == nil {
+						return !batching
+					}
+					return batching && s.MulticallAddress() == *test.expected
+				})).Return(bulletprooftxmanager.EthTx{}, nil)
+			}
+
+			vars := pipeline.NewVarsFrom(map[string]interface{}{"multicall": multicall.Hex()})
+			result, _ := task.Run(context.Background(), vars, nil)
+			if test.expectedErr {
+				require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+			} else {
+				require.NoError(t, result.Error)
+			}
+
+			keyStore.AssertExpectations(t)
+			txManager.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ExprTask evaluates an expression over the pipeline vars (see expression.go),
+// e.g. to check the spread between two sources:
+//
+//    spread [type=expr expression="abs(pctchange($(ds1_parse), $(ds2_parse)))"];
+//    check  [type=conditional condition="$(spread) < 1"];
+//
+// Return types:
+//    decimal.Decimal, bool, string or nil
+//
+type ExprTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+}
+
+var _ Task = (*ExprTask)(nil)
+
+func (t *ExprTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+func (t *ExprTask) Run(_ context.Context, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	val, err := evaluateExpression(t.Expression, vars)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expression")}, runInfo
+	}
+	return Result{Value: val}, runInfo
+}
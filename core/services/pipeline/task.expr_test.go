@@ -0,0 +1,164 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func TestExprTask(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]interface{}{
+		"a":      "200",
+		"b":      150,
+		"c":      1.25,
+		"flag":   true,
+		"name":   "eth",
+		"none":   nil,
+		"nested": map[string]interface{}{"price": "3.5"},
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		want       interface{}
+		wantErr    error
+	}{
+		{"number", "42", "42", nil},
+		{"var", "$(a)", "200", nil},
+		{"addition", "$(a) + $(b)", "350", nil},
+		{"subtraction", "$(b) - $(a)", "-50", nil},
+		{"multiplication", "$(c) * 4", "5", nil},
+		{"division", "$(a) / 8", "25", nil},
+		{"division precision", "1 / 3", "0.3333333333333333", nil},
+		{"modulo", "$(a) % 7", "4", nil},
+		{"unary minus", "-$(c)", "-1.25", nil},
+		{"double unary minus", "--$(c)", "1.25", nil},
+		{"precedence", "1 + 2 * 3", "7", nil},
+		{"parentheses", "(1 + 2) * 3", "9", nil},
+		{"left associative", "10 - 4 - 3", "3", nil},
+		{"nested var", "$(nested.price) * 2", "7", nil},
+		{"abs", "abs($(b) - $(a))", "50", nil},
+		{"min", "min($(a), $(b), 175)", "150", nil},
+		{"max", "max($(a), $(b), 175)", "200", nil},
+		{"max single", "max(3)", "3", nil},
+		{"round", "round(2.5)", "3", nil},
+		{"round negative", "round(-2.5)", "-3", nil},
+		{"round places", "round(1 / 3, 4)", "0.3333", nil},
+		{"floor", "floor(-1.5)", "-2", nil},
+		{"ceil", "ceil(1.2)", "2", nil},
+		{"pctchange", "pctchange($(a), $(b))", "-25", nil},
+		{"pctchange increase", "pctchange($(b), $(a))", "33.33333333333333", nil},
+		{"pctchange from negative", "pctchange(-100, -50)", "50", nil},
+		{"nested calls", "round(abs(pctchange($(a), $(b))), 1)", "25", nil},
+		{"comparison", "abs(pctchange($(a), $(b))) > 10", true, nil},
+		{"comparison with arithmetic", "$(a) - $(b) == 50", true, nil},
+		{"logic", "$(flag) && $(b) < $(a)", true, nil},
+		{"string", "$(name)", "eth", nil},
+		{"null", "$(none)", nil, nil},
+
+		{"division by zero", "$(a) / 0", nil, pipeline.ErrBadInput},
+		{"modulo by zero", "$(a) % 0", nil, pipeline.ErrBadInput},
+		{"pctchange from zero", "pctchange(0, 1)", nil, pipeline.ErrBadInput},
+		{"round fractional places", "round(1, 0.5)", nil, pipeline.ErrBadInput},
+		{"arithmetic on bool", "$(flag) + 1", nil, pipeline.ErrBadInput},
+		{"arithmetic on null", "$(none) + 1", nil, pipeline.ErrBadInput},
+		{"arithmetic on non-numeric string", "$(name) * 2", nil, pipeline.ErrBadInput},
+		{"missing var", "$(missing) + 1", nil, pipeline.ErrKeypathNotFound},
+		{"unknown function", "sqrt(4)", nil, pipeline.ErrBadExpression},
+		{"too few arguments", "pctchange(1)", nil, pipeline.ErrBadExpression},
+		{"too many arguments", "abs(1, 2)", nil, pipeline.ErrBadExpression},
+		{"no arguments", "min()", nil, pipeline.ErrBadExpression},
+		{"unclosed call", "abs(1", nil, pipeline.ErrBadExpression},
+		{"trailing operator", "1 +", nil, pipeline.ErrBadExpression},
+		{"unexpected character", "1 ^ 2", nil, pipeline.ErrBadExpression},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ExprTask{
+				BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expression: test.expression,
+			}
+			result, runInfo := task.Run(context.Background(), pipeline.NewVarsFrom(vars), nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Error(t, result.Error)
+				assert.True(t, errors.Is(result.Error, test.wantErr), result.Error.Error())
+				return
+			}
+			require.NoError(t, result.Error)
+			switch want := test.want.(type) {
+			case string:
+				if d, ok := result.Value.(decimal.Decimal); ok {
+					assert.Equal(t, want, d.String())
+				} else {
+					assert.Equal(t, want, result.Value)
+				}
+			default:
+				assert.Equal(t, want, result.Value)
+			}
+		})
+	}
+}
+
+func TestExprTask_ErroredInput(t *testing.T) {
+	t.Parallel()
+
+	task := pipeline.ExprTask{
+		BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+		Expression: "1 + 1",
+	}
+	result, _ := task.Run(context.Background(), pipeline.NewVarsFrom(nil), []pipeline.Result{{Error: errors.New("boom")}})
+	assert.Error(t, result.Error)
+}
+
+func Test_PipelineRunner_ExprSpreadCheck(t *testing.T) {
+	t.Parallel()
+
+	spec := pipeline.Spec{DotDagSource: `
+ds1    [type=expr expression="$(price1)"];
+ds2    [type=expr expression="$(price2)"];
+spread [type=expr expression="abs(pctchange($(ds1), $(ds2)))"];
+check  [type=conditional condition="$(spread) <= 1"];
+answer [type=expr expression="round(($(ds1) + $(ds2)) / 2, 2)"];
+
+ds1 -> spread;
+ds2 -> spread;
+spread -> check -> answer;
+`}
+
+	orm := new(mocks.ORM)
+	orm.On("DB").Return((*gorm.DB)(nil))
+	r := pipeline.NewRunner(orm, new(mocks.Config), nil, nil, nil, logger.TestLogger(t))
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"price1": "100", "price2": "100.5"})
+	run, trrs, err := r.ExecuteRun(context.Background(), spec, vars, logger.TestLogger(t))
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+	finals := trrs.FinalResult().Values
+	require.Len(t, finals, 1)
+	assert.Equal(t, "100.25", finals[0].(decimal.Decimal).String())
+
+	vars = pipeline.NewVarsFrom(map[string]interface{}{"price1": "100", "price2": "105"})
+	run, trrs, err = r.ExecuteRun(context.Background(), spec, vars, logger.TestLogger(t))
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+	for _, trr := range trrs {
+		if trr.Task.DotID() == "answer" {
+			assert.True(t, trr.Skipped)
+		}
+	}
+}
@@ -8,8 +8,8 @@ import (
 )
 
 // NewTestTelemetryIngressClient calls NewTelemetryIngressClient and injects telemClient.
-func NewTestTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, telemClient telemPb.TelemClient) TelemetryIngressClient {
-	tc := NewTelemetryIngressClient(url, serverPubKeyHex, ks, logging)
+func NewTestTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, cfg TelemetryIngressConfig, telemClient telemPb.TelemClient) TelemetryIngressClient {
+	tc := NewTelemetryIngressClient(url, serverPubKeyHex, ks, cfg)
 	tc.(*telemetryIngressClient).telemClient = telemClient
 	return tc
 }
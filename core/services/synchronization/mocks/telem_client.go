@@ -36,3 +36,26 @@ func (_m *TelemClient) Telem(ctx context.Context, in *telem.TelemRequest) (*tele
 
 	return r0, r1
 }
+
+// TelemBatch provides a mock function with given fields: ctx, in
+func (_m *TelemClient) TelemBatch(ctx context.Context, in *telem.TelemBatchRequest) (*telem.TelemResponse, error) {
+	ret := _m.Called(ctx, in)
+
+	var r0 *telem.TelemResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *telem.TelemBatchRequest) *telem.TelemResponse); ok {
+		r0 = rf(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*telem.TelemResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *telem.TelemBatchRequest) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -76,6 +76,108 @@ func (x *TelemRequest) GetAddress() string {
 	return ""
 }
 
+type TelemBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Compression string `protobuf:"bytes,1,opt,name=compression,proto3" json:"compression,omitempty"`
+	Payload     []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *TelemBatchRequest) Reset() {
+	*x = TelemBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_services_synchronization_telem_telem_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TelemBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelemBatchRequest) ProtoMessage() {}
+
+func (x *TelemBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_services_synchronization_telem_telem_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelemBatchRequest.ProtoReflect.Descriptor instead.
+func (*TelemBatchRequest) Descriptor() ([]byte, []int) {
+	return file_core_services_synchronization_telem_telem_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TelemBatchRequest) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *TelemBatchRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type TelemBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Telemetry []*TelemRequest `protobuf:"bytes,1,rep,name=telemetry,proto3" json:"telemetry,omitempty"`
+}
+
+func (x *TelemBatch) Reset() {
+	*x = TelemBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_services_synchronization_telem_telem_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TelemBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelemBatch) ProtoMessage() {}
+
+func (x *TelemBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_core_services_synchronization_telem_telem_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelemBatch.ProtoReflect.Descriptor instead.
+func (*TelemBatch) Descriptor() ([]byte, []int) {
+	return file_core_services_synchronization_telem_telem_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TelemBatch) GetTelemetry() []*TelemRequest {
+	if x != nil {
+		return x.Telemetry
+	}
+	return nil
+}
+
 type TelemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -87,7 +189,7 @@ type TelemResponse struct {
 func (x *TelemResponse) Reset() {
 	*x = TelemResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_services_synchronization_telem_telem_proto_msgTypes[1]
+		mi := &file_core_services_synchronization_telem_telem_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -100,7 +202,7 @@ func (x *TelemResponse) String() string {
 func (*TelemResponse) ProtoMessage() {}
 
 func (x *TelemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_core_services_synchronization_telem_telem_proto_msgTypes[1]
+	mi := &file_core_services_synchronization_telem_telem_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -113,7 +215,7 @@ func (x *TelemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TelemResponse.ProtoReflect.Descriptor instead.
 func (*TelemResponse) Descriptor() ([]byte, []int) {
-	return file_core_services_synchronization_telem_telem_proto_rawDescGZIP(), []int{1}
+	return file_core_services_synchronization_telem_telem_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TelemResponse) GetBody() string {
@@ -134,11 +236,24 @@ var file_core_services_synchronization_telem_telem_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x65, 0x6c,
 	0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x23, 0x0a, 0x0d, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x62, 0x6f, 0x64, 0x79, 0x32, 0x3b, 0x0a, 0x05, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x12, 0x32,
-	0x0a, 0x05, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x12, 0x13, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x2e,
-	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x74,
+	0x22, 0x4f, 0x0a, 0x11, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x22, 0x3f, 0x0a, 0x0a, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x31, 0x0a, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x79, 0x22, 0x23, 0x0a, 0x0d, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x32, 0x79, 0x0a, 0x05, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x12, 0x32, 0x0a, 0x05, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x12, 0x13, 0x2e, 0x74, 0x65, 0x6c, 0x65,
+	0x6d, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x18, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x74,
 	0x65, 0x6c, 0x65, 0x6d, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6b, 0x69,
@@ -160,19 +275,24 @@ func file_core_services_synchronization_telem_telem_proto_rawDescGZIP() []byte {
 	return file_core_services_synchronization_telem_telem_proto_rawDescData
 }
 
-var file_core_services_synchronization_telem_telem_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_core_services_synchronization_telem_telem_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_core_services_synchronization_telem_telem_proto_goTypes = []interface{}{
-	(*TelemRequest)(nil),  // 0: telem.TelemRequest
-	(*TelemResponse)(nil), // 1: telem.TelemResponse
+	(*TelemRequest)(nil),      // 0: telem.TelemRequest
+	(*TelemBatchRequest)(nil), // 1: telem.TelemBatchRequest
+	(*TelemBatch)(nil),        // 2: telem.TelemBatch
+	(*TelemResponse)(nil),     // 3: telem.TelemResponse
 }
 var file_core_services_synchronization_telem_telem_proto_depIdxs = []int32{
-	0, // 0: telem.Telem.Telem:input_type -> telem.TelemRequest
-	1, // 1: telem.Telem.Telem:output_type -> telem.TelemResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: telem.TelemBatch.telemetry:type_name -> telem.TelemRequest
+	0, // 1: telem.Telem.Telem:input_type -> telem.TelemRequest
+	1, // 2: telem.Telem.TelemBatch:input_type -> telem.TelemBatchRequest
+	3, // 3: telem.Telem.Telem:output_type -> telem.TelemResponse
+	3, // 4: telem.Telem.TelemBatch:output_type -> telem.TelemResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_core_services_synchronization_telem_telem_proto_init() }
@@ -194,6 +314,30 @@ func file_core_services_synchronization_telem_telem_proto_init() {
 			}
 		}
 		file_core_services_synchronization_telem_telem_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelemBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_services_synchronization_telem_telem_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelemBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_services_synchronization_telem_telem_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TelemResponse); i {
 			case 0:
 				return &v.state
@@ -212,7 +356,7 @@ func file_core_services_synchronization_telem_telem_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_core_services_synchronization_telem_telem_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -15,6 +15,7 @@ import (
 //
 type TelemClient interface {
 	Telem(ctx context.Context, in *TelemRequest) (*TelemResponse, error)
+	TelemBatch(ctx context.Context, in *TelemBatchRequest) (*TelemResponse, error)
 }
 
 type telemClient struct {
@@ -34,9 +35,19 @@ func (c *telemClient) Telem(ctx context.Context, in *TelemRequest) (*TelemRespon
 	return out, nil
 }
 
+func (c *telemClient) TelemBatch(ctx context.Context, in *TelemBatchRequest) (*TelemResponse, error) {
+	out := new(TelemResponse)
+	err := c.cc.Invoke(ctx, "TelemBatch", in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TelemServer is the server API for Telem service.
 type TelemServer interface {
 	Telem(context.Context, *TelemRequest) (*TelemResponse, error)
+	TelemBatch(context.Context, *TelemBatchRequest) (*TelemResponse, error)
 }
 
 func RegisterTelemServer(s wsrpc.ServiceRegistrar, srv TelemServer) {
@@ -51,6 +62,14 @@ func _Telem_Telem_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return srv.(TelemServer).Telem(ctx, in)
 }
 
+func _Telem_TelemBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+	in := new(TelemBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	return srv.(TelemServer).TelemBatch(ctx, in)
+}
+
 // Telem_ServiceDesc is the wsrpc.ServiceDesc for Telem service.
 // It's only intended for direct use with wsrpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -62,5 +81,9 @@ var Telem_ServiceDesc = wsrpc.ServiceDesc{
 			MethodName: "Telem",
 			Handler:    _Telem_Telem_Handler,
 		},
+		{
+			MethodName: "TelemBatch",
+			Handler:    _Telem_TelemBatch_Handler,
+		},
 	},
 }
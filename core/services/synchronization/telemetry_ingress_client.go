@@ -1,12 +1,19 @@
 package synchronization
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
+	"io/ioutil"
 	"net/url"
 	"sync"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -21,8 +28,37 @@ import (
 
 //go:generate mockery --dir ./telem --name TelemClient --output ./mocks/ --case=underscore
 
-// SendIngressBufferSize is the number of messages to keep in the buffer before dropping additional ones
-const SendIngressBufferSize = 100
+// maxSpoolReplaysPerInterval is the number of spooled batches resent each
+// send interval once the ingress server is reachable again
+const maxSpoolReplaysPerInterval = 10
+
+var (
+	promTelemetryMessagesSent = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "telemetry_ingress_messages_sent",
+		Help: "The number of telemetry messages sent to the ingress server, including spooled messages",
+	})
+	promTelemetryMessagesSpooled = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "telemetry_ingress_messages_spooled",
+		Help: "The number of telemetry messages written to the spool because they could not be sent",
+	})
+	promTelemetryMessagesDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "telemetry_ingress_messages_dropped",
+		Help: "The number of telemetry messages dropped, by reason",
+	},
+		[]string{"reason"},
+	)
+)
+
+// TelemetryIngressConfig configures the TelemetryIngressClient
+type TelemetryIngressConfig interface {
+	TelemetryIngressBufferSize() uint
+	TelemetryIngressLogging() bool
+	TelemetryIngressMaxBatchSize() uint
+	TelemetryIngressSendInterval() time.Duration
+	TelemetryIngressSpoolDir() string
+	TelemetryIngressSpoolMaxSize() uint64
+	TelemetryIngressUseBatchSend() bool
+}
 
 // TelemetryIngressClient encapsulates all the functionality needed to
 // send telemetry to the ingress server using wsrpc
@@ -47,8 +83,15 @@ type telemetryIngressClient struct {
 	ks              keystore.CSA
 	serverPubKeyHex string
 
-	telemClient telemPb.TelemClient
-	logging     bool
+	telemClient  telemPb.TelemClient
+	logging      bool
+	useBatchSend bool
+	maxBatchSize int
+	sendInterval time.Duration
+	spoolDir     string
+	spoolMaxSize uint64
+	// spool is nil if spooling is disabled
+	spool *telemetrySpool
 
 	wgDone           sync.WaitGroup
 	chDone           chan struct{}
@@ -64,13 +107,22 @@ type TelemPayload struct {
 
 // NewTelemetryIngressClient returns a client backed by wsrpc that
 // can send telemetry to the telemetry ingress server
-func NewTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool) TelemetryIngressClient {
+func NewTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, cfg TelemetryIngressConfig) TelemetryIngressClient {
+	maxBatchSize := int(cfg.TelemetryIngressMaxBatchSize())
+	if maxBatchSize < 1 {
+		maxBatchSize = 1
+	}
 	return &telemetryIngressClient{
 		url:             url,
 		ks:              ks,
 		serverPubKeyHex: serverPubKeyHex,
-		logging:         logging,
-		chTelemetry:     make(chan TelemPayload, SendIngressBufferSize),
+		logging:         cfg.TelemetryIngressLogging(),
+		useBatchSend:    cfg.TelemetryIngressUseBatchSend(),
+		maxBatchSize:    maxBatchSize,
+		sendInterval:    cfg.TelemetryIngressSendInterval(),
+		spoolDir:        cfg.TelemetryIngressSpoolDir(),
+		spoolMaxSize:    cfg.TelemetryIngressSpoolMaxSize(),
+		chTelemetry:     make(chan TelemPayload, cfg.TelemetryIngressBufferSize()),
 		chDone:          make(chan struct{}),
 	}
 }
@@ -83,6 +135,16 @@ func (tc *telemetryIngressClient) Start() error {
 			return err
 		}
 
+		if tc.spoolMaxSize > 0 {
+			tc.spool, err = newTelemetrySpool(tc.spoolDir, int64(tc.spoolMaxSize))
+			if err != nil {
+				return err
+			}
+			if n := tc.spool.len(); n > 0 {
+				logger.Infow("Telemetry ingress client: found spooled telemetry, it will be sent once connected", "batches", n)
+			}
+		}
+
 		tc.connect(privkey)
 
 		return nil
@@ -119,35 +181,207 @@ func (tc *telemetryIngressClient) connect(clientPrivKey []byte) {
 			tc.telemClient = telemPb.NewTelemClient(conn)
 		}
 
-		// Start handler for telemetry
+		// Handle telemetry until close
 		tc.handleTelemetry()
-
-		// Wait for close
-		<-tc.chDone
-
 	}()
 }
 
+// handleTelemetry collects telemetry into batches, which are sent when they
+// are full or every send interval. Batches that can't be sent are spooled,
+// and resent once the ingress server can be reached again.
 func (tc *telemetryIngressClient) handleTelemetry() {
-	go func() {
-		for {
-			select {
-			case p := <-tc.chTelemetry:
-				// Send telemetry to the ingress server, log any errors
-				telemReq := &telemPb.TelemRequest{Telemetry: p.Telemetry, Address: p.ContractAddress.String()}
-				_, err := tc.telemClient.Telem(p.Ctx, telemReq)
-				if err != nil {
-					logger.Errorf("Could not send telemetry: %v", err)
-					continue
-				}
-				if tc.logging {
-					logger.Debugw("successfully sent telemetry to ingress server", "contractAddress", p.ContractAddress.String(), "telemetry", p.Telemetry)
-				}
-			case <-tc.chDone:
+	ticker := time.NewTicker(tc.sendInterval)
+	defer ticker.Stop()
+
+	var batch []*telemPb.TelemRequest
+	for {
+		select {
+		case p := <-tc.chTelemetry:
+			batch = append(batch, newTelemRequest(p))
+			if len(batch) >= tc.maxBatchSize {
+				tc.flush(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				tc.flush(batch)
+				batch = nil
+			}
+			tc.replaySpool()
+		case <-tc.chDone:
+			// Keep what hasn't been sent for the next run
+			batch = append(batch, tc.drainBuffer()...)
+			if len(batch) > 0 {
+				tc.spoolBatch(batch)
+			}
+			return
+		}
+	}
+}
+
+// drainBuffer returns the telemetry waiting in the buffer
+func (tc *telemetryIngressClient) drainBuffer() (reqs []*telemPb.TelemRequest) {
+	for {
+		select {
+		case p := <-tc.chTelemetry:
+			reqs = append(reqs, newTelemRequest(p))
+		default:
+			return reqs
+		}
+	}
+}
+
+func newTelemRequest(p TelemPayload) *telemPb.TelemRequest {
+	return &telemPb.TelemRequest{Telemetry: p.Telemetry, Address: p.ContractAddress.String()}
+}
+
+// flush sends the batch, spooling whatever could not be sent
+func (tc *telemetryIngressClient) flush(batch []*telemPb.TelemRequest) {
+	sent, err := tc.sendBatch(batch)
+	promTelemetryMessagesSent.Add(float64(sent))
+	if err != nil {
+		logger.Errorf("Could not send telemetry: %v", err)
+		tc.spoolBatch(batch[sent:])
+		return
+	}
+	if tc.logging {
+		for _, req := range batch {
+			logger.Debugw("successfully sent telemetry to ingress server", "contractAddress", req.Address, "telemetry", req.Telemetry)
+		}
+	}
+}
+
+// sendBatch sends the batch with the TelemBatch RPC, or one message at a
+// time if batch sends are disabled. It returns the number of messages sent.
+func (tc *telemetryIngressClient) sendBatch(batch []*telemPb.TelemRequest) (int, error) {
+	if !tc.useBatchSend {
+		for i, req := range batch {
+			if _, err := tc.telemClient.Telem(context.Background(), req); err != nil {
+				return i, err
+			}
+		}
+		return len(batch), nil
+	}
+
+	payload, err := compressBatch(batch)
+	if err != nil {
+		return 0, err
+	}
+	req := &telemPb.TelemBatchRequest{Compression: "gzip", Payload: payload}
+	if _, err = tc.telemClient.TelemBatch(context.Background(), req); err != nil {
+		return 0, err
+	}
+	return len(batch), nil
+}
+
+// spoolBatch writes the batch to the spool, or drops it if spooling is
+// disabled
+func (tc *telemetryIngressClient) spoolBatch(batch []*telemPb.TelemRequest) {
+	if tc.spool == nil {
+		promTelemetryMessagesDropped.WithLabelValues("spool_disabled").Add(float64(len(batch)))
+		return
+	}
+	b, err := proto.Marshal(&telemPb.TelemBatch{Telemetry: batch})
+	if err == nil {
+		var dropped int
+		dropped, err = tc.spool.push(b, len(batch))
+		if dropped > 0 {
+			logger.Warnw("Telemetry spool full, dropping oldest telemetry", "droppedCount", dropped)
+			promTelemetryMessagesDropped.WithLabelValues("spool_full").Add(float64(dropped))
+		}
+	}
+	if err != nil {
+		logger.Errorw("Could not spool telemetry", "err", err)
+		promTelemetryMessagesDropped.WithLabelValues("spool_error").Add(float64(len(batch)))
+		return
+	}
+	promTelemetryMessagesSpooled.Add(float64(len(batch)))
+}
+
+// replaySpool resends spooled batches, oldest first, until one fails
+func (tc *telemetryIngressClient) replaySpool() {
+	if tc.spool == nil {
+		return
+	}
+	for i := 0; i < maxSpoolReplaysPerInterval; i++ {
+		b, count, err := tc.spool.peek()
+		if err != nil {
+			logger.Errorw("Could not read spooled telemetry", "err", err)
+			return
+		}
+		if b == nil {
+			return
+		}
+		var batch telemPb.TelemBatch
+		if err = proto.Unmarshal(b, &batch); err != nil {
+			logger.Errorw("Dropping corrupt spooled telemetry", "err", err)
+			promTelemetryMessagesDropped.WithLabelValues("spool_error").Add(float64(count))
+			if err = tc.spool.pop(); err != nil {
+				logger.Errorw("Could not remove spooled telemetry", "err", err)
 				return
 			}
+			continue
 		}
-	}()
+		sent, err := tc.sendBatch(batch.Telemetry)
+		promTelemetryMessagesSent.Add(float64(sent))
+		if err != nil {
+			if sent > 0 {
+				// Avoid resending what was sent by spooling the remainder
+				// as a new batch
+				if err = tc.spool.pop(); err != nil {
+					logger.Errorw("Could not remove spooled telemetry", "err", err)
+					return
+				}
+				tc.spoolBatch(batch.Telemetry[sent:])
+			}
+			return
+		}
+		if err = tc.spool.pop(); err != nil {
+			logger.Errorw("Could not remove spooled telemetry", "err", err)
+			return
+		}
+	}
+}
+
+// compressBatch serializes and gzips the batch
+func compressBatch(batch []*telemPb.TelemRequest) ([]byte, error) {
+	b, err := proto.Marshal(&telemPb.TelemBatch{Telemetry: batch})
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err = w.Write(b); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecompressBatch reverses compressBatch. It is used by tests and by ingress
+// servers implemented in Go.
+func DecompressBatch(req *telemPb.TelemBatchRequest) ([]*telemPb.TelemRequest, error) {
+	b := req.Payload
+	switch req.Compression {
+	case "":
+	case "gzip":
+		r, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		if b, err = ioutil.ReadAll(r); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("unsupported compression " + req.Compression)
+	}
+	var batch telemPb.TelemBatch
+	if err := proto.Unmarshal(b, &batch); err != nil {
+		return nil, err
+	}
+	return batch.Telemetry, nil
 }
 
 // logBufferFullWithExpBackoff logs messages at
@@ -193,6 +427,7 @@ func (tc *telemetryIngressClient) Send(payload TelemPayload) {
 	case <-payload.Ctx.Done():
 		return
 	default:
+		promTelemetryMessagesDropped.WithLabelValues("buffer_full").Inc()
 		tc.logBufferFullWithExpBackoff(payload)
 	}
 }
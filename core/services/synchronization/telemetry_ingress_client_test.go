@@ -2,8 +2,11 @@ package synchronization_test
 
 import (
 	"context"
+	"errors"
+	"io/ioutil"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/onsi/gomega"
@@ -33,7 +36,8 @@ func TestTelemetryIngressClient_Send_HappyPath(t *testing.T) {
 	// Wire up the telem ingress client
 	url := &url.URL{}
 	serverPubKeyHex := "33333333333"
-	telemIngressClient := synchronization.NewTestTelemetryIngressClient(url, serverPubKeyHex, csaKeystore, false, telemClient)
+	cfg := testIngressConfig{bufferSize: 100, maxBatchSize: 1, sendInterval: time.Millisecond}
+	telemIngressClient := synchronization.NewTestTelemetryIngressClient(url, serverPubKeyHex, csaKeystore, cfg, telemClient)
 	require.NoError(t, telemIngressClient.Start())
 	defer telemIngressClient.Close()
 
@@ -63,3 +67,110 @@ func TestTelemetryIngressClient_Send_HappyPath(t *testing.T) {
 		return called.IsSet()
 	}).Should(gomega.BeTrue())
 }
+
+type testIngressConfig struct {
+	bufferSize   uint
+	maxBatchSize uint
+	sendInterval time.Duration
+	spoolDir     string
+	spoolMaxSize uint64
+	useBatchSend bool
+}
+
+func (c testIngressConfig) TelemetryIngressBufferSize() uint            { return c.bufferSize }
+func (c testIngressConfig) TelemetryIngressLogging() bool               { return false }
+func (c testIngressConfig) TelemetryIngressMaxBatchSize() uint          { return c.maxBatchSize }
+func (c testIngressConfig) TelemetryIngressSendInterval() time.Duration { return c.sendInterval }
+func (c testIngressConfig) TelemetryIngressSpoolDir() string            { return c.spoolDir }
+func (c testIngressConfig) TelemetryIngressSpoolMaxSize() uint64        { return c.spoolMaxSize }
+func (c testIngressConfig) TelemetryIngressUseBatchSend() bool          { return c.useBatchSend }
+
+func newTestCSAKeystore() *ksmocks.CSA {
+	csaKeystore := new(ksmocks.CSA)
+	csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
+	return csaKeystore
+}
+
+func TestTelemetryIngressClient_Send_Batch(t *testing.T) {
+	telemClient := new(mocks.TelemClient)
+	cfg := testIngressConfig{bufferSize: 100, maxBatchSize: 2, sendInterval: time.Hour, useBatchSend: true}
+	telemIngressClient := synchronization.NewTestTelemetryIngressClient(&url.URL{}, "33333333333", newTestCSAKeystore(), cfg, telemClient)
+	require.NoError(t, telemIngressClient.Start())
+	defer telemIngressClient.Close()
+
+	chBatch := make(chan []*telemPb.TelemRequest, 1)
+	telemClient.On("TelemBatch", mock.Anything, mock.Anything).Return(nil, nil).Run(func(args mock.Arguments) {
+		req := args.Get(1).(*telemPb.TelemBatchRequest)
+		assert.Equal(t, "gzip", req.Compression)
+		batch, err := synchronization.DecompressBatch(req)
+		require.NoError(t, err)
+		chBatch <- batch
+	}).Once()
+
+	// The batch is sent as soon as it is full
+	telemIngressClient.Send(synchronization.TelemPayload{Ctx: context.Background(), Telemetry: []byte("a"), ContractAddress: common.HexToAddress("0xa")})
+	telemIngressClient.Send(synchronization.TelemPayload{Ctx: context.Background(), Telemetry: []byte("b"), ContractAddress: common.HexToAddress("0xb")})
+
+	select {
+	case batch := <-chBatch:
+		require.Len(t, batch, 2)
+		assert.Equal(t, []byte("a"), batch[0].Telemetry)
+		assert.Equal(t, common.HexToAddress("0xa").String(), batch[0].Address)
+		assert.Equal(t, []byte("b"), batch[1].Telemetry)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for batch")
+	}
+}
+
+func TestTelemetryIngressClient_Send_SpoolsWhenUnavailable(t *testing.T) {
+	telemClient := new(mocks.TelemClient)
+	spoolDir := t.TempDir()
+	cfg := testIngressConfig{bufferSize: 100, maxBatchSize: 10, sendInterval: 10 * time.Millisecond, useBatchSend: true, spoolDir: spoolDir, spoolMaxSize: 1024 * 1024}
+	telemIngressClient := synchronization.NewTestTelemetryIngressClient(&url.URL{}, "33333333333", newTestCSAKeystore(), cfg, telemClient)
+
+	available := abool.New()
+	var received [][]byte
+	chReceived := make(chan struct{}, 10)
+	telemClient.On("TelemBatch", mock.Anything, mock.Anything).Return(func(context.Context, *telemPb.TelemBatchRequest) *telemPb.TelemResponse {
+		return nil
+	}, func(_ context.Context, req *telemPb.TelemBatchRequest) error {
+		if !available.IsSet() {
+			return errors.New("connection is not ready")
+		}
+		batch, err := synchronization.DecompressBatch(req)
+		require.NoError(t, err)
+		for _, r := range batch {
+			received = append(received, r.Telemetry)
+		}
+		chReceived <- struct{}{}
+		return nil
+	})
+
+	require.NoError(t, telemIngressClient.Start())
+	defer telemIngressClient.Close()
+
+	telemIngressClient.Send(synchronization.TelemPayload{Ctx: context.Background(), Telemetry: []byte("a")})
+	telemIngressClient.Send(synchronization.TelemPayload{Ctx: context.Background(), Telemetry: []byte("b")})
+
+	// While the server is unavailable, the telemetry is written to disk
+	gomega.NewGomegaWithT(t).Eventually(func() int {
+		files, err := ioutil.ReadDir(spoolDir)
+		require.NoError(t, err)
+		return len(files)
+	}).Should(gomega.BeNumerically(">", 0))
+
+	// and sent once it is available again
+	available.Set()
+	select {
+	case <-chReceived:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for spooled telemetry")
+	}
+	gomega.NewGomegaWithT(t).Eventually(func() int {
+		files, err := ioutil.ReadDir(spoolDir)
+		require.NoError(t, err)
+		return len(files)
+	}).Should(gomega.Equal(0))
+	require.NoError(t, telemIngressClient.Close())
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b")}, received)
+}
@@ -0,0 +1,163 @@
+package synchronization
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const spoolFileExt = ".telem"
+
+// spoolFile is a batch of telemetry in the spool. Its name records the order
+// it was spooled in and the number of messages it holds, so that neither has
+// to be read from disk.
+type spoolFile struct {
+	name  string
+	seq   int64
+	count int
+	size  int64
+}
+
+// telemetrySpool is a bounded, on-disk FIFO queue of telemetry batches that
+// could not be sent. It survives restarts of the node. Once it is full, the
+// oldest batches are dropped to make room.
+type telemetrySpool struct {
+	dir     string
+	maxSize int64
+
+	mu    sync.Mutex
+	files []spoolFile // oldest first
+	size  int64
+	seq   int64
+}
+
+// newTelemetrySpool opens the spool in dir, creating the directory if needed
+func newTelemetrySpool(dir string, maxSize int64) (*telemetrySpool, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "failed to create telemetry spool directory")
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read telemetry spool directory")
+	}
+	s := &telemetrySpool{dir: dir, maxSize: maxSize}
+	for _, entry := range entries {
+		f, ok := parseSpoolFileName(entry.Name())
+		if !ok || entry.IsDir() {
+			continue
+		}
+		f.size = entry.Size()
+		s.files = append(s.files, f)
+		s.size += f.size
+		if f.seq > s.seq {
+			s.seq = f.seq
+		}
+	}
+	sort.Slice(s.files, func(i, j int) bool { return s.files[i].seq < s.files[j].seq })
+	return s, nil
+}
+
+func parseSpoolFileName(name string) (f spoolFile, ok bool) {
+	if !strings.HasSuffix(name, spoolFileExt) {
+		return f, false
+	}
+	parts := strings.Split(strings.TrimSuffix(name, spoolFileExt), "-")
+	if len(parts) != 2 {
+		return f, false
+	}
+	seq, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return f, false
+	}
+	count, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return f, false
+	}
+	return spoolFile{name: name, seq: seq, count: count}, true
+}
+
+// push adds a batch of count messages to the spool. It returns the number
+// of messages dropped to make room, which includes the batch itself if it
+// is larger than the spool.
+func (s *telemetrySpool) push(batch []byte, count int) (dropped int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	size := int64(len(batch))
+	if size > s.maxSize {
+		return count, nil
+	}
+	for len(s.files) > 0 && s.size+size > s.maxSize {
+		removed, err := s.removeOldest()
+		if err != nil {
+			return dropped, err
+		}
+		dropped += removed
+	}
+
+	// Sequence numbers start from the time so that they keep increasing
+	// across restarts
+	seq := time.Now().UnixNano()
+	if seq <= s.seq {
+		seq = s.seq + 1
+	}
+	s.seq = seq
+	f := spoolFile{name: fmt.Sprintf("%020d-%d%s", seq, count, spoolFileExt), seq: seq, count: count, size: size}
+	if err = ioutil.WriteFile(filepath.Join(s.dir, f.name), batch, 0600); err != nil {
+		return dropped, errors.Wrap(err, "failed to write telemetry spool file")
+	}
+	s.files = append(s.files, f)
+	s.size += size
+	return dropped, nil
+}
+
+// peek returns the oldest batch in the spool and the number of messages in
+// it, or nil if the spool is empty
+func (s *telemetrySpool) peek() (batch []byte, count int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.files) == 0 {
+		return nil, 0, nil
+	}
+	f := s.files[0]
+	batch, err = ioutil.ReadFile(filepath.Join(s.dir, f.name))
+	return batch, f.count, errors.Wrap(err, "failed to read telemetry spool file")
+}
+
+// pop removes the oldest batch from the spool
+func (s *telemetrySpool) pop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.files) == 0 {
+		return nil
+	}
+	_, err := s.removeOldest()
+	return err
+}
+
+// len returns the number of batches in the spool
+func (s *telemetrySpool) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.files)
+}
+
+// removeOldest deletes the oldest batch and returns the number of messages
+// in it
+func (s *telemetrySpool) removeOldest() (int, error) {
+	f := s.files[0]
+	if err := os.Remove(filepath.Join(s.dir, f.name)); err != nil && !os.IsNotExist(err) {
+		return 0, errors.Wrap(err, "failed to remove telemetry spool file")
+	}
+	s.files = s.files[1:]
+	s.size -= f.size
+	return f.count, nil
+}
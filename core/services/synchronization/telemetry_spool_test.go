@@ -0,0 +1,56 @@
+package synchronization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelemetrySpool(t *testing.T) {
+	dir := t.TempDir()
+	spool, err := newTelemetrySpool(dir, 10)
+	require.NoError(t, err)
+
+	b, _, err := spool.peek()
+	require.NoError(t, err)
+	assert.Nil(t, b)
+
+	dropped, err := spool.push([]byte("aaaa"), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, dropped)
+	dropped, err = spool.push([]byte("bbbb"), 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, dropped)
+
+	// Too big to ever fit
+	dropped, err = spool.push([]byte("ccccccccccc"), 3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, dropped)
+
+	// The oldest batch is dropped to make room
+	dropped, err = spool.push([]byte("dddd"), 4)
+	require.NoError(t, err)
+	assert.Equal(t, 1, dropped)
+	assert.Equal(t, 2, spool.len())
+
+	// The spool survives a restart
+	spool, err = newTelemetrySpool(dir, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, spool.len())
+
+	b, count, err := spool.peek()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("bbbb"), b)
+	assert.Equal(t, 2, count)
+	require.NoError(t, spool.pop())
+
+	b, count, err = spool.peek()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("dddd"), b)
+	assert.Equal(t, 4, count)
+	require.NoError(t, spool.pop())
+
+	assert.Equal(t, 0, spool.len())
+	assert.Equal(t, int64(0), spool.size)
+}
@@ -0,0 +1,156 @@
+package telemetry
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+	"go.uber.org/atomic"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// LocalAgentBufferSize is the number of telemetry messages to keep in the
+// buffer before dropping additional ones
+const LocalAgentBufferSize = 1000
+
+// localReapInterval is how often rounds older than the retention period are
+// deleted
+const localReapInterval = time.Hour
+
+type localTelemetry struct {
+	contract   common.Address
+	telemetry  []byte
+	receivedAt time.Time
+}
+
+// LocalAgent decodes OCR telemetry and stores a summary of each round in the
+// database, so that it can be inspected without a telemetry ingress server
+type LocalAgent struct {
+	utils.StartStopOnce
+	orm       ORM
+	retention time.Duration
+	logger    logger.Logger
+
+	chTelemetry      chan localTelemetry
+	chStop           chan struct{}
+	wgDone           sync.WaitGroup
+	dropMessageCount atomic.Uint32
+}
+
+// NewLocalAgent returns an agent that stores telemetry summaries with the
+// ORM, deleting them after the retention period. A zero retention period
+// keeps them forever.
+func NewLocalAgent(orm ORM, retention time.Duration, lggr logger.Logger) *LocalAgent {
+	return &LocalAgent{
+		orm:         orm,
+		retention:   retention,
+		logger:      lggr.Named("LocalTelemetry"),
+		chTelemetry: make(chan localTelemetry, LocalAgentBufferSize),
+		chStop:      make(chan struct{}),
+	}
+}
+
+// GenMonitoringEndpoint creates a monitoring endpoint for the contract's
+// telemetry
+func (a *LocalAgent) GenMonitoringEndpoint(addr common.Address) ocrtypes.MonitoringEndpoint {
+	return &localEndpoint{a, addr}
+}
+
+func (a *LocalAgent) Start() error {
+	return a.StartOnce("LocalTelemetryAgent", func() error {
+		a.wgDone.Add(1)
+		go a.run()
+		return nil
+	})
+}
+
+func (a *LocalAgent) Close() error {
+	return a.StopOnce("LocalTelemetryAgent", func() error {
+		close(a.chStop)
+		a.wgDone.Wait()
+		return nil
+	})
+}
+
+func (a *LocalAgent) run() {
+	defer a.wgDone.Done()
+
+	ticker := time.NewTicker(localReapInterval)
+	defer ticker.Stop()
+	a.reap()
+
+	for {
+		select {
+		case <-a.chStop:
+			return
+		case t := <-a.chTelemetry:
+			if err := a.record(t); err != nil {
+				a.logger.Errorw("Failed to record telemetry", "contractAddress", t.contract, "err", err)
+			}
+		case <-ticker.C:
+			a.reap()
+		}
+	}
+}
+
+func (a *LocalAgent) record(t localTelemetry) error {
+	ev, err := decodeOCRTelemetry(t.telemetry)
+	if err != nil {
+		return err
+	}
+	switch ev.kind {
+	case ocrEventRoundStarted:
+		startedAt := ev.time
+		if startedAt.IsZero() {
+			startedAt = t.receivedAt
+		}
+		return a.orm.RecordRoundStarted(t.contract, ev.configDigest, ev.epoch, ev.round, ev.leader, startedAt)
+	case ocrEventObservationSent:
+		return a.orm.RecordObservationSent(t.contract, ev.configDigest, ev.epoch, ev.round, t.receivedAt)
+	case ocrEventObservationReceived:
+		return a.orm.RecordObservationReceived(t.contract, ev.configDigest, ev.epoch, ev.round)
+	case ocrEventFinal:
+		return a.orm.RecordFinal(t.contract, ev.configDigest, ev.epoch, ev.round, t.receivedAt)
+	}
+	return nil
+}
+
+func (a *LocalAgent) reap() {
+	if a.retention == 0 {
+		return
+	}
+	deleted, err := a.orm.DeleteOCRRoundsBefore(time.Now().Add(-a.retention))
+	if err != nil {
+		a.logger.Errorw("Failed to delete old telemetry", "err", err)
+		return
+	}
+	if deleted > 0 {
+		a.logger.Debugw("Deleted old telemetry", "rounds", deleted)
+	}
+}
+
+// send buffers the telemetry, dropping it if the buffer is full
+func (a *LocalAgent) send(t localTelemetry) {
+	select {
+	case a.chTelemetry <- t:
+		a.dropMessageCount.Store(0)
+	default:
+		count := a.dropMessageCount.Inc()
+		if count > 0 && (count%100 == 0 || count&(count-1) == 0) {
+			a.logger.Warnw("Local telemetry buffer full, dropping message", "contractAddress", t.contract, "droppedCount", count)
+		}
+	}
+}
+
+type localEndpoint struct {
+	agent    *LocalAgent
+	contract common.Address
+}
+
+// SendLog queues a telemetry log to be stored
+func (e *localEndpoint) SendLog(telemetry []byte) {
+	e.agent.send(localTelemetry{e.contract, telemetry, time.Now()})
+}
@@ -0,0 +1,116 @@
+package telemetry_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/telemetry"
+	"github.com/smartcontractkit/chainlink/core/services/telemetry/mocks"
+)
+
+// The following build OCR telemetry in the wire format of libocr's
+// TelemetryWrapper
+
+func message(fields ...func([]byte) []byte) []byte {
+	var b []byte
+	for _, field := range fields {
+		b = field(b)
+	}
+	return b
+}
+
+func varint(num protowire.Number, v uint64) func([]byte) []byte {
+	return func(b []byte) []byte {
+		b = protowire.AppendTag(b, num, protowire.VarintType)
+		return protowire.AppendVarint(b, v)
+	}
+}
+
+func bytes(num protowire.Number, v []byte) func([]byte) []byte {
+	return func(b []byte) []byte {
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		return protowire.AppendBytes(b, v)
+	}
+}
+
+var configDigest = []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+func roundStarted(epoch, round, leader uint64, at time.Time) []byte {
+	return message(bytes(5, message(
+		bytes(1, configDigest),
+		varint(2, epoch),
+		varint(3, round),
+		varint(4, leader),
+		varint(5, uint64(at.UnixNano())),
+	)))
+}
+
+// wrapped returns a TelemetryMessageReceived (1), TelemetryMessageBroadcast
+// (2) or TelemetryMessageSent (3) containing the message
+func wrapped(kind protowire.Number, msgField protowire.Number, epoch, round uint64) []byte {
+	msg := message(varint(1, epoch), varint(2, round), bytes(3, []byte("report")))
+	return message(bytes(kind, message(
+		bytes(1, configDigest),
+		bytes(2, message(bytes(msgField, msg))),
+		varint(3, 2),
+	)))
+}
+
+func TestLocalAgent(t *testing.T) {
+	orm := new(mocks.ORM)
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000123")
+	startedAt := time.Unix(0, 1630000000123456789)
+
+	done := make(chan struct{})
+	orm.On("DeleteOCRRoundsBefore", mock.Anything).Return(int64(0), nil).Once()
+	orm.On("RecordRoundStarted", contract, configDigest, uint64(3), uint64(1), uint64(2), mock.MatchedBy(startedAt.Equal)).Return(nil).Once()
+	orm.On("RecordObservationSent", contract, configDigest, uint64(3), uint64(1), mock.Anything).Return(nil).Once()
+	orm.On("RecordObservationReceived", contract, configDigest, uint64(3), uint64(2)).Return(nil).Once()
+	orm.On("RecordFinal", contract, configDigest, uint64(3), uint64(1), mock.Anything).Return(nil).Once()
+	orm.On("RecordFinal", contract, configDigest, uint64(4), uint64(1), mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { close(done) })
+
+	agent := telemetry.NewLocalAgent(orm, time.Hour, logger.TestLogger(t))
+	require.NoError(t, agent.Start())
+	defer func() { require.NoError(t, agent.Close()) }()
+
+	endpoint := agent.GenMonitoringEndpoint(contract)
+	endpoint.SendLog(roundStarted(3, 1, 2, startedAt))
+	endpoint.SendLog(wrapped(3, 4, 3, 1))      // observation sent to the leader
+	endpoint.SendLog(wrapped(1, 4, 3, 2))      // observation received as leader
+	endpoint.SendLog(wrapped(1, 5, 3, 2))      // report request, ignored
+	endpoint.SendLog([]byte{0xff, 0xff, 0xff}) // invalid, logged and ignored
+	endpoint.SendLog(wrapped(1, 7, 3, 1))      // final received
+	endpoint.SendLog(wrapped(2, 7, 4, 1))      // final broadcast as leader
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for telemetry to be recorded")
+	}
+	orm.AssertExpectations(t)
+}
+
+type recordingEndpoint struct{ logs [][]byte }
+
+func (r *recordingEndpoint) SendLog(log []byte) { r.logs = append(r.logs, log) }
+
+type recordingAgent struct{ endpoint *recordingEndpoint }
+
+func (r recordingAgent) GenMonitoringEndpoint(common.Address) ocrtypes.MonitoringEndpoint {
+	return r.endpoint
+}
+
+func TestMultiAgent(t *testing.T) {
+	a, b := recordingAgent{&recordingEndpoint{}}, recordingAgent{&recordingEndpoint{}}
+	endpoint := telemetry.NewMultiAgent(a, b).GenMonitoringEndpoint(common.Address{})
+	endpoint.SendLog([]byte("foo"))
+	require.Equal(t, [][]byte{[]byte("foo")}, a.endpoint.logs)
+	require.Equal(t, [][]byte{[]byte("foo")}, b.endpoint.logs)
+}
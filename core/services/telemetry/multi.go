@@ -0,0 +1,35 @@
+package telemetry
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+)
+
+// MultiAgent sends telemetry to several agents, e.g. to the ingress server
+// and the local agent
+type MultiAgent struct {
+	generators []MonitoringEndpointGenerator
+}
+
+func NewMultiAgent(generators ...MonitoringEndpointGenerator) *MultiAgent {
+	return &MultiAgent{generators}
+}
+
+// GenMonitoringEndpoint creates a monitoring endpoint that sends telemetry to
+// the endpoint of each agent
+func (t *MultiAgent) GenMonitoringEndpoint(addr common.Address) ocrtypes.MonitoringEndpoint {
+	endpoints := make(multiEndpoint, len(t.generators))
+	for i, gen := range t.generators {
+		endpoints[i] = gen.GenMonitoringEndpoint(addr)
+	}
+	return endpoints
+}
+
+type multiEndpoint []ocrtypes.MonitoringEndpoint
+
+// SendLog sends the telemetry log to every endpoint
+func (m multiEndpoint) SendLog(log []byte) {
+	for _, endpoint := range m {
+		endpoint.SendLog(log)
+	}
+}
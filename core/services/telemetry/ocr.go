@@ -0,0 +1,182 @@
+package telemetry
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The generated types for OCR telemetry are internal to libocr, so the wire
+// format is decoded by hand. Field numbers are from
+// offchainreporting/internal/serialization/protobuf in libocr.
+const (
+	// TelemetryWrapper
+	fieldTelemMessageReceived  = 1
+	fieldTelemMessageBroadcast = 2
+	fieldTelemMessageSent      = 3
+	fieldTelemRoundStarted     = 5
+
+	// TelemetryMessageReceived, TelemetryMessageBroadcast and TelemetryMessageSent
+	fieldMessageConfigDigest = 1
+	fieldMessageMsg          = 2
+
+	// TelemetryRoundStarted
+	fieldRoundStartedConfigDigest = 1
+	fieldRoundStartedEpoch        = 2
+	fieldRoundStartedRound        = 3
+	fieldRoundStartedLeader       = 4
+	fieldRoundStartedTime         = 5
+
+	// MessageWrapper
+	fieldMsgObserve = 4
+	fieldMsgFinal   = 7
+
+	// MessageObserve and MessageFinal
+	fieldMsgEpoch = 1
+	fieldMsgRound = 2
+)
+
+// ocrEventKind is the kind of OCR telemetry that the local agent records
+type ocrEventKind int
+
+const (
+	ocrEventIgnored ocrEventKind = iota
+	// ocrEventRoundStarted is sent by every oracle when a round starts
+	ocrEventRoundStarted
+	// ocrEventObservationSent is sent when this oracle sends its observation
+	// to the leader
+	ocrEventObservationSent
+	// ocrEventObservationReceived is sent when this oracle, as leader,
+	// receives another oracle's observation
+	ocrEventObservationReceived
+	// ocrEventFinal is sent when this oracle receives or, as leader,
+	// broadcasts the final report of a round
+	ocrEventFinal
+)
+
+// ocrEvent is the part of an OCR telemetry message that the local agent
+// records
+type ocrEvent struct {
+	kind         ocrEventKind
+	configDigest []byte
+	epoch        uint64
+	round        uint64
+	// leader is only set for ocrEventRoundStarted
+	leader uint64
+	// time is when the event happened, if the telemetry records it
+	time time.Time
+}
+
+// decodeOCRTelemetry decodes a serialized libocr TelemetryWrapper. Telemetry
+// that the local agent does not record is returned with kind
+// ocrEventIgnored.
+func decodeOCRTelemetry(b []byte) (ev ocrEvent, err error) {
+	err = forEachField(b, func(num protowire.Number, typ protowire.Type, v []byte, _ uint64) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case fieldTelemRoundStarted:
+			return decodeRoundStarted(v, &ev)
+		case fieldTelemMessageReceived:
+			return decodeMessage(v, &ev, true)
+		case fieldTelemMessageBroadcast, fieldTelemMessageSent:
+			return decodeMessage(v, &ev, false)
+		}
+		return nil
+	})
+	return ev, errors.Wrap(err, "invalid OCR telemetry")
+}
+
+func decodeRoundStarted(b []byte, ev *ocrEvent) error {
+	ev.kind = ocrEventRoundStarted
+	return forEachField(b, func(num protowire.Number, _ protowire.Type, v []byte, n uint64) error {
+		switch num {
+		case fieldRoundStartedConfigDigest:
+			ev.configDigest = v
+		case fieldRoundStartedEpoch:
+			ev.epoch = n
+		case fieldRoundStartedRound:
+			ev.round = n
+		case fieldRoundStartedLeader:
+			ev.leader = n
+		case fieldRoundStartedTime:
+			ev.time = time.Unix(0, int64(n))
+		}
+		return nil
+	})
+}
+
+func decodeMessage(b []byte, ev *ocrEvent, received bool) error {
+	var digest, msg []byte
+	err := forEachField(b, func(num protowire.Number, _ protowire.Type, v []byte, _ uint64) error {
+		switch num {
+		case fieldMessageConfigDigest:
+			digest = v
+		case fieldMessageMsg:
+			msg = v
+		}
+		return nil
+	})
+	if err != nil || msg == nil {
+		return err
+	}
+	return forEachField(msg, func(num protowire.Number, typ protowire.Type, v []byte, _ uint64) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case fieldMsgObserve:
+			if received {
+				ev.kind = ocrEventObservationReceived
+			} else {
+				ev.kind = ocrEventObservationSent
+			}
+		case fieldMsgFinal:
+			ev.kind = ocrEventFinal
+		default:
+			return nil
+		}
+		ev.configDigest = digest
+		return forEachField(v, func(num protowire.Number, _ protowire.Type, _ []byte, n uint64) error {
+			switch num {
+			case fieldMsgEpoch:
+				ev.epoch = n
+			case fieldMsgRound:
+				ev.round = n
+			}
+			return nil
+		})
+	})
+}
+
+// forEachField calls fn with each field of the protobuf message b. Varint
+// fields are passed as n, length-delimited fields as v.
+func forEachField(b []byte, fn func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error) error {
+	for len(b) > 0 {
+		num, typ, l := protowire.ConsumeTag(b)
+		if l < 0 {
+			return protowire.ParseError(l)
+		}
+		b = b[l:]
+		var v []byte
+		var n uint64
+		switch typ {
+		case protowire.VarintType:
+			n, l = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			v, l = protowire.ConsumeBytes(b)
+		default:
+			l = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if l < 0 {
+			return protowire.ParseError(l)
+		}
+		b = b[l:]
+		if err := fn(num, typ, v, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
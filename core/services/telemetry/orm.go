@@ -0,0 +1,141 @@
+package telemetry
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
+)
+
+// OCRRound is the local summary of an OCR round, built from the telemetry
+// this node sent and received during the round
+type OCRRound struct {
+	ContractAddress common.Address
+	ConfigDigest    []byte
+	Epoch           uint32
+	Round           uint8
+	// Leader is the oracle ID of the round's leader
+	Leader null.Int
+	// StartedAt is when this node started the round
+	StartedAt null.Time
+	// ObservedAt is when this node sent its observation to the leader
+	ObservedAt null.Time
+	// ObservationsReceived is the number of observations this node received
+	// while leading the round
+	ObservationsReceived int
+	// FinalizedAt is when this node received or broadcast the final report
+	FinalizedAt null.Time
+	CreatedAt   time.Time
+}
+
+// OCRFeedSummary summarizes this node's participation in the rounds of an
+// OCR feed
+type OCRFeedSummary struct {
+	ContractAddress common.Address
+	Rounds          int
+	RoundsObserved  int
+	RoundsLed       int
+	RoundsFinalized int
+	// AvgObservationLatency and AvgFinalizationLatency are in seconds, from
+	// the start of the round
+	AvgObservationLatency  null.Float
+	AvgFinalizationLatency null.Float
+	LastRoundAt            null.Time
+}
+
+//go:generate mockery --name ORM --output ./mocks/ --case=underscore
+
+// ORM stores OCR telemetry summaries
+type ORM interface {
+	RecordRoundStarted(contract common.Address, configDigest []byte, epoch, round, leader uint64, startedAt time.Time) error
+	RecordObservationSent(contract common.Address, configDigest []byte, epoch, round uint64, at time.Time) error
+	RecordObservationReceived(contract common.Address, configDigest []byte, epoch, round uint64) error
+	RecordFinal(contract common.Address, configDigest []byte, epoch, round uint64, at time.Time) error
+	// OCRFeedSummaries summarizes the rounds of each feed created since the
+	// given time
+	OCRFeedSummaries(since time.Time) ([]OCRFeedSummary, error)
+	OCRRounds(contract common.Address, offset, limit int) ([]OCRRound, int, error)
+	// DeleteOCRRoundsBefore deletes rounds created before the given time and
+	// returns the number deleted
+	DeleteOCRRoundsBefore(t time.Time) (int64, error)
+}
+
+type orm struct {
+	db *sqlx.DB
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB) ORM {
+	return &orm{db}
+}
+
+// upsertRound inserts the round if it is new, and sets the column otherwise.
+// Columns that record the first time something happened are never
+// overwritten.
+func (o *orm) upsertRound(contract common.Address, configDigest []byte, epoch, round uint64, column, update string, value interface{}) error {
+	sql := fmt.Sprintf(`INSERT INTO ocr_telemetry_rounds (contract_address, config_digest, epoch, round, created_at, %[1]s)
+	VALUES ($1, $2, $3, $4, now(), $5)
+	ON CONFLICT (contract_address, config_digest, epoch, round) DO UPDATE SET %[1]s = %[2]s`, column, update)
+	_, err := o.db.Exec(sql, contract, configDigest, epoch, round, value)
+	return err
+}
+
+func (o *orm) RecordRoundStarted(contract common.Address, configDigest []byte, epoch, round, leader uint64, startedAt time.Time) error {
+	sql := `INSERT INTO ocr_telemetry_rounds (contract_address, config_digest, epoch, round, created_at, leader, started_at)
+	VALUES ($1, $2, $3, $4, now(), $5, $6)
+	ON CONFLICT (contract_address, config_digest, epoch, round) DO UPDATE SET
+		leader = EXCLUDED.leader,
+		started_at = COALESCE(ocr_telemetry_rounds.started_at, EXCLUDED.started_at)`
+	_, err := o.db.Exec(sql, contract, configDigest, epoch, round, leader, startedAt)
+	return err
+}
+
+func (o *orm) RecordObservationSent(contract common.Address, configDigest []byte, epoch, round uint64, at time.Time) error {
+	return o.upsertRound(contract, configDigest, epoch, round, "observed_at", "COALESCE(ocr_telemetry_rounds.observed_at, EXCLUDED.observed_at)", at)
+}
+
+func (o *orm) RecordObservationReceived(contract common.Address, configDigest []byte, epoch, round uint64) error {
+	return o.upsertRound(contract, configDigest, epoch, round, "observations_received", "ocr_telemetry_rounds.observations_received + 1", 1)
+}
+
+func (o *orm) RecordFinal(contract common.Address, configDigest []byte, epoch, round uint64, at time.Time) error {
+	return o.upsertRound(contract, configDigest, epoch, round, "finalized_at", "COALESCE(ocr_telemetry_rounds.finalized_at, EXCLUDED.finalized_at)", at)
+}
+
+func (o *orm) OCRFeedSummaries(since time.Time) (summaries []OCRFeedSummary, err error) {
+	sql := `SELECT contract_address,
+		COUNT(*) AS rounds,
+		COUNT(observed_at) AS rounds_observed,
+		COUNT(*) FILTER (WHERE observations_received > 0) AS rounds_led,
+		COUNT(finalized_at) AS rounds_finalized,
+		AVG(EXTRACT(EPOCH FROM observed_at - started_at)) AS avg_observation_latency,
+		AVG(EXTRACT(EPOCH FROM finalized_at - started_at)) AS avg_finalization_latency,
+		MAX(started_at) AS last_round_at
+	FROM ocr_telemetry_rounds
+	WHERE created_at >= $1
+	GROUP BY contract_address
+	ORDER BY contract_address`
+	err = o.db.Select(&summaries, sql, since)
+	return summaries, err
+}
+
+func (o *orm) OCRRounds(contract common.Address, offset, limit int) (rounds []OCRRound, count int, err error) {
+	if err = o.db.Get(&count, `SELECT COUNT(*) FROM ocr_telemetry_rounds WHERE contract_address = $1`, contract); err != nil {
+		return
+	}
+	sql := `SELECT * FROM ocr_telemetry_rounds WHERE contract_address = $1
+	ORDER BY created_at DESC, epoch DESC, round DESC LIMIT $2 OFFSET $3`
+	err = o.db.Select(&rounds, sql, contract, limit, offset)
+	return
+}
+
+func (o *orm) DeleteOCRRoundsBefore(t time.Time) (int64, error) {
+	result, err := o.db.Exec(`DELETE FROM ocr_telemetry_rounds WHERE created_at < $1`, t)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
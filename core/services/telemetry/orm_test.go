@@ -0,0 +1,61 @@
+package telemetry_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/telemetry"
+)
+
+func TestORM_OCRRounds(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	orm := telemetry.NewORM(db)
+
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	other := common.HexToAddress("0x0000000000000000000000000000000000000def")
+	digest := []byte{1, 2, 3}
+	startedAt := time.Now().Add(-time.Minute).Truncate(time.Microsecond)
+
+	// Telemetry can arrive in any order
+	require.NoError(t, orm.RecordObservationReceived(contract, digest, 1, 1))
+	require.NoError(t, orm.RecordRoundStarted(contract, digest, 1, 1, 2, startedAt))
+	require.NoError(t, orm.RecordObservationReceived(contract, digest, 1, 1))
+	require.NoError(t, orm.RecordObservationSent(contract, digest, 1, 1, startedAt.Add(time.Second)))
+	require.NoError(t, orm.RecordFinal(contract, digest, 1, 1, startedAt.Add(2*time.Second)))
+	require.NoError(t, orm.RecordFinal(contract, digest, 1, 1, startedAt.Add(5*time.Second)))
+	require.NoError(t, orm.RecordRoundStarted(other, digest, 1, 1, 0, startedAt))
+
+	rounds, count, err := orm.OCRRounds(contract, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	round := rounds[0]
+	assert.Equal(t, contract, round.ContractAddress)
+	assert.Equal(t, digest, round.ConfigDigest)
+	assert.Equal(t, uint32(1), round.Epoch)
+	assert.Equal(t, uint8(1), round.Round)
+	assert.Equal(t, int64(2), round.Leader.Int64)
+	assert.Equal(t, 2, round.ObservationsReceived)
+	assert.True(t, startedAt.Equal(round.StartedAt.Time))
+	assert.True(t, startedAt.Add(2*time.Second).Equal(round.FinalizedAt.Time), "first final is kept")
+
+	summaries, err := orm.OCRFeedSummaries(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, contract, summaries[0].ContractAddress)
+	assert.Equal(t, 1, summaries[0].Rounds)
+	assert.Equal(t, 1, summaries[0].RoundsObserved)
+	assert.Equal(t, 1, summaries[0].RoundsLed)
+	assert.Equal(t, 1, summaries[0].RoundsFinalized)
+	assert.InDelta(t, 1, summaries[0].AvgObservationLatency.Float64, 0.001)
+	assert.InDelta(t, 2, summaries[0].AvgFinalizationLatency.Float64, 0.001)
+	assert.False(t, summaries[1].AvgFinalizationLatency.Valid)
+
+	deleted, err := orm.DeleteOCRRoundsBefore(time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+}
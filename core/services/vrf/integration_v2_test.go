@@ -494,6 +494,21 @@ func TestIntegrationVRFV2(t *testing.T) {
 	fulfillReceipt, err := uni.backend.TransactionReceipt(context.Background(), rf[0].Raw.TxHash)
 	require.NoError(t, err)
 
+	// The fee paid for the fulfillment is attributed to the job
+	var spend []bulletprooftxmanager.GasSpend
+	gomega.NewGomegaWithT(t).Eventually(func() bool {
+		uni.backend.Commit()
+		spend, err = app.BPTXMORM().GasSpend(bulletprooftxmanager.GasSpendRequest{
+			GroupBy: bulletprooftxmanager.GasSpendByJob,
+			From:    time.Now().Add(-time.Hour),
+			To:      time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+		return len(spend) == 1
+	}, 10*time.Second, 500*time.Millisecond).Should(gomega.BeTrue())
+	assert.Equal(t, int64(jb.ID), spend[0].JobID.Int64)
+	assert.Equal(t, int64(fulfillReceipt.GasUsed), spend[0].GasUsed)
+
 	// Assert all the random words received by the consumer are different and non-zero.
 	seen := make(map[string]struct{})
 	var rw *big.Int
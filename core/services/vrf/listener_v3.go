@@ -314,6 +314,7 @@ func (lsn *listenerV2) processRequestsPerSub(fromAddress common.Address, startBa
 				EncodedPayload: hexutil.MustDecode(payload),
 				GasLimit:       gaslimit,
 				Meta: &bulletprooftxmanager.EthTxMeta{
+					JobID:     lsn.job.ID,
 					RequestID: common.BytesToHash(req.req.RequestId.Bytes()),
 					MaxLink:   bi.String(),
 				},
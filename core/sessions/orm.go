@@ -1,7 +1,6 @@
 package sessions
 
 import (
-	"crypto/subtle"
 	"database/sql"
 	"encoding/json"
 	"strings"
@@ -18,13 +17,16 @@ import (
 )
 
 type ORM interface {
-	FindUser() (User, error)
+	FindUser(email string) (User, error)
+	FindUserByAPIToken(apiToken string) (User, error)
+	ListUsers() ([]User, error)
 	AuthorizedUserWithSession(sessionID string) (User, error)
-	DeleteUser() error
+	DeleteUser(email string) error
 	DeleteUserSession(sessionID string) error
 	CreateSession(sr SessionRequest) (string, error)
 	ClearNonCurrentSessions(sessionID string) error
 	CreateUser(user *User) error
+	UpdateRole(email string, newRole UserRole) (User, error)
 	SetAuthToken(user *User, token *auth.Token) error
 	DeleteAuthToken(user *User) error
 	SetPassword(user *User, newPassword string) error
@@ -46,53 +48,71 @@ func NewORM(db *sqlx.DB, sessionDuration time.Duration) ORM {
 	return &orm{db, sessionDuration}
 }
 
-// FindUser will return the one API user, or an error.
-func (o *orm) FindUser() (User, error) {
-	return o.findUser()
+// FindUser will attempt to return an API user by email.
+func (o *orm) FindUser(email string) (user User, err error) {
+	sql := "SELECT * FROM users WHERE lower(email) = lower($1)"
+	err = o.db.Get(&user, sql, email)
+	return
+}
+
+// FindUserByAPIToken will attempt to return an API user by the access key of
+// their API token.
+func (o *orm) FindUserByAPIToken(apiToken string) (user User, err error) {
+	sql := "SELECT * FROM users WHERE token_key = $1"
+	err = o.db.Get(&user, sql, apiToken)
+	return
 }
 
-func (o *orm) findUser() (user User, err error) {
-	sql := "SELECT * FROM users ORDER BY created_at desc LIMIT 1"
-	err = o.db.Get(&user, sql)
+// ListUsers will load and return all user rows from the db.
+func (o *orm) ListUsers() (users []User, err error) {
+	sql := "SELECT * FROM users ORDER BY email ASC"
+	err = o.db.Select(&users, sql)
 	return
 }
 
-// AuthorizedUserWithSession will return the one API user if the Session ID exists
-// and hasn't expired, and update session's LastUsed field.
+// AuthorizedUserWithSession will return the API user that owns the Session ID
+// if it exists and hasn't expired, and update session's LastUsed field.
 func (o *orm) AuthorizedUserWithSession(sessionID string) (User, error) {
 	if len(sessionID) == 0 {
 		return User{}, errors.New("Session ID cannot be empty")
 	}
 
-	result, err := o.db.Exec("UPDATE sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now()", sessionID, o.sessionDuration)
+	var email string
+	err := o.db.Get(&email, "UPDATE sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now() RETURNING email", sessionID, o.sessionDuration)
 	if err != nil {
 		return User{}, err
 	}
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return User{}, err
-	}
-	if rowsAffected == 0 {
-		return User{}, sql.ErrNoRows
-	}
-	return o.FindUser()
+	return o.FindUser(email)
 }
 
-// DeleteUser will delete the API User in the db.
-func (o *orm) DeleteUser() error {
+// DeleteUser will delete an API User and their sessions and MFA tokens.
+func (o *orm) DeleteUser(email string) error {
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
 	return postgres.SqlxTransaction(ctx, o.db, func(tx *sqlx.Tx) error {
-		if _, err := tx.Exec("DELETE FROM users"); err != nil {
+		if _, err := tx.Exec("DELETE FROM web_authns WHERE lower(email) = lower($1)", email); err != nil {
+			return err
+		}
+		if _, err := tx.Exec("DELETE FROM sessions WHERE lower(email) = lower($1)", email); err != nil {
 			return err
 		}
 
-		_, err := tx.Exec("DELETE FROM sessions")
-		return err
+		result, err := tx.Exec("DELETE FROM users WHERE lower(email) = lower($1)", email)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return sql.ErrNoRows
+		}
+		return nil
 	})
 }
 
-// DeleteUserSession will erase the session ID for the sole API User.
+// DeleteUserSession will erase the session ID.
 func (o *orm) DeleteUserSession(sessionID string) error {
 	_, err := o.db.Exec("DELETE FROM sessions WHERE id = $1", sessionID)
 	return err
@@ -114,21 +134,19 @@ func (o *orm) GetUserWebAuthn(email string) ([]WebAuthn, error) {
 }
 
 // CreateSession will check the password in the SessionRequest against
-// the hashed API User password in the db. Also will check WebAuthn if it's
-// enabled for that user.
+// the hashed password of the API User with that email in the db. Also will
+// check WebAuthn if it's enabled for that user.
 func (o *orm) CreateSession(sr SessionRequest) (string, error) {
-	user, err := o.FindUser()
-	if err != nil {
+	user, err := o.FindUser(sr.Email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", errors.New("Invalid email")
+	} else if err != nil {
 		return "", err
 	}
-	logger.Debugw("Found user", "user", user)
+	logger.Debugw("Found user", "user", user.Email)
 
-	// Do email and password check first to prevent extra database look up
+	// Do password check first to prevent extra database look up
 	// for MFA tokens leaking if an account has MFA tokens or not.
-	if !constantTimeEmailCompare(sr.Email, user.Email) {
-		return "", errors.New("Invalid email")
-	}
-
 	if !utils.CheckPasswordHash(sr.Password, user.HashedPassword) {
 		return "", errors.New("Invalid password")
 	}
@@ -144,9 +162,7 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 	// No webauthn tokens registered for the current user, so normal authentication is now complete
 	if len(uwas) == 0 {
 		logger.Infof("No MFA for user. Creating Session")
-		session := NewSession()
-		_, err = o.db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
-		return session.ID, err
+		return o.insertSession(user)
 	}
 
 	// Next check if this session request includes the required WebAuthn challenge data
@@ -182,32 +198,40 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 
 	logger.Infof("User passed MFA authentication and login will proceed")
 	// This is a success so we can create the sessions
-	session := NewSession()
-	_, err = o.db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
-	return session.ID, err
+	return o.insertSession(user)
 }
 
-const constantTimeEmailLength = 256
-
-func constantTimeEmailCompare(left, right string) bool {
-	length := utils.MaxInt(constantTimeEmailLength, len(left), len(right))
-	leftBytes := make([]byte, length)
-	rightBytes := make([]byte, length)
-	copy(leftBytes, left)
-	copy(rightBytes, right)
-	return subtle.ConstantTimeCompare(leftBytes, rightBytes) == 1
+func (o *orm) insertSession(user User) (string, error) {
+	session := NewSession(user.Email)
+	_, err := o.db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, session.Email)
+	return session.ID, err
 }
 
-// ClearNonCurrentSessions removes all sessions but the id passed in.
+// ClearNonCurrentSessions removes all sessions belonging to the same user as
+// the session passed in, except that session itself.
 func (o *orm) ClearNonCurrentSessions(sessionID string) error {
-	_, err := o.db.Exec("DELETE FROM sessions where id != $1", sessionID)
+	_, err := o.db.Exec("DELETE FROM sessions WHERE email = (SELECT email FROM sessions WHERE id = $1) AND id != $1", sessionID)
 	return err
 }
 
-// Creates creates the user.
+// CreateUser creates the user.
 func (o *orm) CreateUser(user *User) error {
-	sql := "INSERT INTO users (email, hashed_password, created_at, updated_at) VALUES ($1, $2, now(), now()) RETURNING *"
-	return o.db.Get(user, sql, user.Email, user.HashedPassword)
+	if _, err := GetUserRole(string(user.Role)); err != nil {
+		return err
+	}
+	sql := "INSERT INTO users (email, hashed_password, role, created_at, updated_at) VALUES ($1, $2, $3, now(), now()) RETURNING *"
+	return o.db.Get(user, sql, user.Email, user.HashedPassword, user.Role)
+}
+
+// UpdateRole changes the role of the user with the given email.
+func (o *orm) UpdateRole(email string, newRole UserRole) (User, error) {
+	var user User
+	if _, err := GetUserRole(string(newRole)); err != nil {
+		return user, err
+	}
+	sql := "UPDATE users SET role = $1, updated_at = now() WHERE lower(email) = lower($2) RETURNING *"
+	err := o.db.Get(&user, sql, newRole, email)
+	return user, err
 }
 
 // SetAuthToken updates the user to use the given Authentication Token.
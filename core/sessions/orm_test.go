@@ -25,19 +25,71 @@ func setupORM(t *testing.T) (*sqlx.DB, sessions.ORM) {
 func TestORM_FindUser(t *testing.T) {
 	t.Parallel()
 
-	db, orm := setupORM(t)
+	_, orm := setupORM(t)
 	user1 := cltest.MustNewUser(t, "test1@email1.net", "password1")
 	user2 := cltest.MustNewUser(t, "test2@email2.net", "password2")
 
 	require.NoError(t, orm.CreateUser(&user1))
 	require.NoError(t, orm.CreateUser(&user2))
-	_, err := db.Exec("UPDATE users SET created_at = now() - interval '1 day' WHERE email = $1", user2.Email)
-	require.NoError(t, err)
 
-	actual, err := orm.FindUser()
+	actual, err := orm.FindUser(user1.Email)
 	require.NoError(t, err)
 	assert.Equal(t, user1.Email, actual.Email)
 	assert.Equal(t, user1.HashedPassword, actual.HashedPassword)
+	assert.Equal(t, sessions.UserRoleAdmin, actual.Role)
+
+	actual, err = orm.FindUser("TEST2@email2.net")
+	require.NoError(t, err)
+	assert.Equal(t, user2.Email, actual.Email)
+
+	_, err = orm.FindUser("nobody@email.net")
+	require.Error(t, err)
+}
+
+func TestORM_ListUsers(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+	user := cltest.MustNewUser(t, "viewer@email.net", "password1", sessions.UserRoleView)
+	require.NoError(t, orm.CreateUser(&user))
+
+	users, err := orm.ListUsers()
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, cltest.APIEmail, users[0].Email)
+	assert.Equal(t, user.Email, users[1].Email)
+	assert.Equal(t, sessions.UserRoleView, users[1].Role)
+}
+
+func TestORM_UpdateRole(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+	user := cltest.MustNewUser(t, "viewer@email.net", "password1", sessions.UserRoleView)
+	require.NoError(t, orm.CreateUser(&user))
+
+	updated, err := orm.UpdateRole(user.Email, sessions.UserRoleEdit)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, updated.Role)
+
+	_, err = orm.UpdateRole(user.Email, sessions.UserRole("superuser"))
+	require.Error(t, err)
+
+	_, err = orm.UpdateRole("nobody@email.net", sessions.UserRoleEdit)
+	require.Error(t, err)
+}
+
+func TestORM_FindUserByAPIToken(t *testing.T) {
+	t.Parallel()
+
+	_, orm := setupORM(t)
+
+	user, err := orm.FindUserByAPIToken(cltest.APIKey)
+	require.NoError(t, err)
+	assert.Equal(t, cltest.APIEmail, user.Email)
+
+	_, err = orm.FindUserByAPIToken("bogus")
+	require.Error(t, err)
 }
 
 func TestORM_AuthorizedUserWithSession(t *testing.T) {
@@ -66,7 +118,7 @@ func TestORM_AuthorizedUserWithSession(t *testing.T) {
 
 			prevSession := cltest.NewSession("correctID")
 			prevSession.LastUsed = time.Now().Add(-cltest.MustParseDuration(t, "2m"))
-			_, err := db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, $2, now())", prevSession.ID, prevSession.LastUsed)
+			_, err := db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, $3, now())", prevSession.ID, user.Email, prevSession.LastUsed)
 			require.NoError(t, err)
 
 			expectedTime := utils.ISO8601UTC(time.Now())
@@ -89,13 +141,23 @@ func TestORM_DeleteUser(t *testing.T) {
 	t.Parallel()
 	_, orm := setupORM(t)
 
-	_, err := orm.FindUser()
+	_, err := orm.FindUser(cltest.APIEmail)
+	require.NoError(t, err)
+
+	_, err = orm.CreateSession(sessions.SessionRequest{Email: cltest.APIEmail, Password: cltest.Password})
 	require.NoError(t, err)
 
-	err = orm.DeleteUser()
+	err = orm.DeleteUser(cltest.APIEmail)
+	require.NoError(t, err)
+
+	_, err = orm.FindUser(cltest.APIEmail)
+	require.Error(t, err)
+
+	sessions, err := orm.Sessions(0, 10)
 	require.NoError(t, err)
+	assert.Empty(t, sessions)
 
-	_, err = orm.FindUser()
+	err = orm.DeleteUser(cltest.APIEmail)
 	require.Error(t, err)
 }
 
@@ -104,14 +166,14 @@ func TestORM_DeleteUserSession(t *testing.T) {
 
 	db, orm := setupORM(t)
 
-	session := sessions.NewSession()
-	_, err := db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
+	session := sessions.NewSession(cltest.APIEmail)
+	_, err := db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, session.Email)
 	require.NoError(t, err)
 
 	err = orm.DeleteUserSession(session.ID)
 	require.NoError(t, err)
 
-	_, err = orm.FindUser()
+	_, err = orm.FindUser(cltest.APIEmail)
 	require.NoError(t, err)
 
 	sessions, err := orm.Sessions(0, 10)
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -52,7 +53,7 @@ func TestSessionReaper_ReapSessions(t *testing.T) {
 				clearSessions(t, db.DB)
 			})
 
-			_, err := db.Exec("INSERT INTO sessions (last_used, id, created_at) VALUES ($1, $2, now())", test.lastUsed, test.name)
+			_, err := db.Exec("INSERT INTO sessions (last_used, id, email, created_at) VALUES ($1, $2, $3, now())", test.lastUsed, test.name, cltest.APIEmail)
 			require.NoError(t, err)
 
 			r.WakeUp()
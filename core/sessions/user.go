@@ -23,6 +23,47 @@ type User struct {
 	TokenSalt         null.String
 	TokenHashedSecret null.String
 	UpdatedAt         time.Time
+	Role              UserRole
+}
+
+// UserRole determines which API actions a User is permitted to take. Roles
+// are ordered: each role may do everything the roles below it may do.
+type UserRole string
+
+const (
+	// UserRoleAdmin may do everything, including managing users and keys and
+	// changing the node's configuration
+	UserRoleAdmin UserRole = "admin"
+	// UserRoleEdit may create, update and delete jobs, bridges, chains and
+	// other node resources
+	UserRoleEdit UserRole = "edit"
+	// UserRoleRun may trigger job runs in addition to viewing
+	UserRoleRun UserRole = "run"
+	// UserRoleView may only view resources
+	UserRoleView UserRole = "view"
+)
+
+var userRoleRanks = map[UserRole]int{
+	UserRoleView:  1,
+	UserRoleRun:   2,
+	UserRoleEdit:  3,
+	UserRoleAdmin: 4,
+}
+
+// GetUserRole parses a UserRole from its string representation.
+func GetUserRole(role string) (UserRole, error) {
+	r := UserRole(role)
+	if _, ok := userRoleRanks[r]; !ok {
+		return "", fmt.Errorf("invalid role %q, must be one of admin, edit, run or view", role)
+	}
+	return r, nil
+}
+
+// Permits returns true if a user with role r is allowed to take actions that
+// require the given role.
+func (r UserRole) Permits(required UserRole) bool {
+	rank, ok := userRoleRanks[r]
+	return ok && rank >= userRoleRanks[required]
 }
 
 // https://davidcel.is/posts/stop-validating-email-addresses-with-regex/
@@ -33,8 +74,9 @@ const (
 	MaxBcryptPasswordLength = 50
 )
 
-// NewUser creates a new user by hashing the passed plainPwd with bcrypt.
-func NewUser(email, plainPwd string) (User, error) {
+// NewUser creates a new user with the given role by hashing the passed
+// plainPwd with bcrypt.
+func NewUser(email, plainPwd string, role UserRole) (User, error) {
 	if len(email) == 0 {
 		return User{}, errors.New("Must enter an email")
 	}
@@ -47,6 +89,10 @@ func NewUser(email, plainPwd string) (User, error) {
 		return User{}, fmt.Errorf("must enter a password with 8 - %v characters", MaxBcryptPasswordLength)
 	}
 
+	if _, err := GetUserRole(string(role)); err != nil {
+		return User{}, err
+	}
+
 	pwd, err := utils.HashPassword(plainPwd)
 	if err != nil {
 		return User{}, err
@@ -55,6 +101,7 @@ func NewUser(email, plainPwd string) (User, error) {
 	return User{
 		Email:          email,
 		HashedPassword: pwd,
+		Role:           role,
 	}, nil
 }
 
@@ -72,15 +119,17 @@ type SessionRequest struct {
 // Session holds the unique id for the authenticated session.
 type Session struct {
 	ID        string    `json:"id" gorm:"primary_key"`
+	Email     string    `json:"email"`
 	LastUsed  time.Time `json:"lastUsed" gorm:"index"`
 	CreatedAt time.Time `json:"createdAt" gorm:"index"`
 }
 
-// NewSession returns a session instance with ID set to a random ID and
-// LastUsed to to now.
-func NewSession() Session {
+// NewSession returns a session instance for the given user with ID set to a
+// random ID and LastUsed to to now.
+func NewSession(email string) Session {
 	return Session{
 		ID:       utils.NewBytes32ID(),
+		Email:    email,
 		LastUsed: time.Now(),
 	}
 }
@@ -28,9 +28,14 @@ func TestNewUser(t *testing.T) {
 		{"good@email.com", "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa51", true},
 	}
 
+	t.Run("invalid role", func(t *testing.T) {
+		_, err := sessions.NewUser("good@email.com", "goodpassword", sessions.UserRole("superuser"))
+		assert.Error(t, err)
+	})
+
 	for _, test := range tests {
 		t.Run(test.email, func(t *testing.T) {
-			user, err := sessions.NewUser(test.email, test.pwd)
+			user, err := sessions.NewUser(test.email, test.pwd, sessions.UserRoleView)
 			if test.wantError {
 				assert.Error(t, err)
 			} else {
@@ -44,6 +49,29 @@ func TestNewUser(t *testing.T) {
 	}
 }
 
+func TestUserRole_Permits(t *testing.T) {
+	t.Parallel()
+
+	roles := []sessions.UserRole{sessions.UserRoleView, sessions.UserRoleRun, sessions.UserRoleEdit, sessions.UserRoleAdmin}
+	for i, role := range roles {
+		for j, required := range roles {
+			assert.Equal(t, i >= j, role.Permits(required), "%s permits %s", role, required)
+		}
+	}
+	assert.False(t, sessions.UserRole("").Permits(sessions.UserRoleView))
+}
+
+func TestGetUserRole(t *testing.T) {
+	t.Parallel()
+
+	role, err := sessions.GetUserRole("edit")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, role)
+
+	_, err = sessions.GetUserRole("superuser")
+	assert.Error(t, err)
+}
+
 func TestUserGenerateAuthToken(t *testing.T) {
 	var user sessions.User
 	token, err := user.GenerateAuthToken()
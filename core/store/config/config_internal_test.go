@@ -51,6 +51,15 @@ func TestConfig_readFromFile(t *testing.T) {
 	assert.Equal(t, config.TLSPort(), uint16(0))
 }
 
+func TestGeneralConfig_Validate(t *testing.T) {
+	v := viper.New()
+	config := newGeneralConfigWithViper(v)
+	require.NoError(t, config.Validate())
+
+	v.Set("TELEMETRY_INGRESS_SEND_INTERVAL", "0s")
+	require.EqualError(t, config.Validate(), "TELEMETRY_INGRESS_SEND_INTERVAL must be greater than zero, got 0s")
+}
+
 func TestStore_addressParser(t *testing.T) {
 	zero := &common.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 	fifteen := &common.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 15}
@@ -74,6 +74,8 @@ type GeneralOnlyConfig interface {
 	DefaultHTTPTimeout() models.Duration
 	DefaultMaxHTTPAttempts() uint
 	Dev() bool
+	EthExternalSignerTimeout() time.Duration
+	EthExternalSignerURL() *url.URL
 	EthereumDisabled() bool
 	EthereumHTTPURL() *url.URL
 	EthereumSecondaryURLs() []url.URL
@@ -95,6 +97,8 @@ type GeneralOnlyConfig interface {
 	InsecureSkipVerify() bool
 	JSONConsole() bool
 	JobPipelineMaxRunDuration() time.Duration
+	JobPipelineArchiveDir() string
+	JobPipelineReaperBatchSize() uint32
 	JobPipelineReaperInterval() time.Duration
 	JobPipelineReaperThreshold() time.Duration
 	JobPipelineResultWriteQueueDepth() uint64
@@ -107,6 +111,7 @@ type GeneralOnlyConfig interface {
 	KeeperRegistryPerformGasOverhead() uint64
 	KeeperRegistrySyncInterval() time.Duration
 	KeeperRegistrySyncUpkeepQueueSize() uint32
+	KeyRotationGracePeriod() time.Duration
 	KeyFile() string
 	LogLevel() zapcore.Level
 	LogSQLMigrations() bool
@@ -167,9 +172,17 @@ type GeneralOnlyConfig interface {
 	SetLogSQLStatements(ctx context.Context, sqlEnabled bool) error
 	SetDialect(dialects.DialectName)
 	StatsPusherLogging() bool
+	TelemetryIngressBufferSize() uint
 	TelemetryIngressLogging() bool
+	TelemetryIngressMaxBatchSize() uint
+	TelemetryIngressSendInterval() time.Duration
 	TelemetryIngressServerPubKey() string
+	TelemetryIngressSpoolDir() string
+	TelemetryIngressSpoolMaxSize() uint64
 	TelemetryIngressURL() *url.URL
+	TelemetryIngressUseBatchSend() bool
+	TelemetryLocalEnabled() bool
+	TelemetryLocalRetention() time.Duration
 	TLSCertPath() string
 	TLSDir() string
 	TLSHost() string
@@ -211,13 +224,19 @@ type GlobalConfig interface {
 	GlobalEvmHeadTrackerHistoryDepth() (uint32, bool)
 	GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool)
 	GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool)
+	GlobalEvmHTTPPollInterval() (time.Duration, bool)
 	GlobalEvmLogBackfillBatchSize() (uint32, bool)
+	GlobalEvmLogPollInterval() (time.Duration, bool)
+	GlobalEvmLogPollerEnabled() (bool, bool)
 	GlobalEvmMaxGasPriceWei() (*big.Int, bool)
 	GlobalEvmMaxInFlightTransactions() (uint32, bool)
 	GlobalEvmMaxQueuedTransactions() (uint64, bool)
+	GlobalEvmMaxTxBatchSize() (uint32, bool)
 	GlobalEvmMinGasPriceWei() (*big.Int, bool)
+	GlobalEvmMinSendingKeyBalanceWei() (*big.Int, bool)
 	GlobalEvmNonceAutoSync() (bool, bool)
 	GlobalEvmRPCDefaultBatchSize() (uint32, bool)
+	GlobalFeeHistoryEstimatorBlockCount() (uint16, bool)
 	GlobalFlagsContractAddress() (string, bool)
 	GlobalGasEstimatorMode() (string, bool)
 	GlobalChainType() (string, bool)
@@ -321,6 +340,9 @@ func (c *generalConfig) Validate() error {
 	if ct, set := c.GlobalChainType(); set && !chains.ChainType(ct).IsValid() {
 		return errors.Errorf("CHAIN_TYPE is invalid: %s", ct)
 	}
+	if c.TelemetryIngressSendInterval() <= 0 {
+		return errors.Errorf("TELEMETRY_INGRESS_SEND_INTERVAL must be greater than zero, got %v", c.TelemetryIngressSendInterval())
+	}
 
 	if !c.UseLegacyEthEnvVars() {
 		if c.EthereumURL() != "" {
@@ -582,6 +604,27 @@ func (c *generalConfig) EthereumSecondaryURLs() []url.URL {
 	return urls
 }
 
+// EthExternalSignerURL is the URL of the JSON-RPC API of the external signer
+// which signs transactions for the ETH keys whose private keys are not held by
+// the node, or nil
+func (c *generalConfig) EthExternalSignerURL() *url.URL {
+	rval := c.getWithFallback("EthExternalSignerURL", ParseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		panic(fmt.Sprintf("invariant: EthExternalSignerURL returned as type %T", rval))
+	}
+}
+
+// EthExternalSignerTimeout is how long to wait for the external signer to
+// sign a transaction
+func (c *generalConfig) EthExternalSignerTimeout() time.Duration {
+	return c.getWithFallback("EthExternalSignerTimeout", ParseDuration).(time.Duration)
+}
+
 // EthereumDisabled will substitute null Eth clients if set
 func (c *generalConfig) EthereumDisabled() bool {
 	return c.viper.GetBool(EnvVarName("EthereumDisabled"))
@@ -620,6 +663,24 @@ func (c *generalConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return c.getWithFallback("JobPipelineResultWriteQueueDepth", ParseUint64).(uint64)
 }
 
+// JobPipelineArchiveDir is the directory that pipeline runs are archived to
+// before being deleted, for retention policies that enable archiving
+func (c *generalConfig) JobPipelineArchiveDir() string {
+	fieldName := "JobPipelineArchiveDir"
+	dir := c.viper.GetString(EnvVarName(fieldName))
+	defaultValue, _ := defaultValue(fieldName)
+	if dir == defaultValue {
+		return filepath.Join(c.RootDir(), "pipeline_run_archives")
+	}
+	return dir
+}
+
+// JobPipelineReaperBatchSize is the maximum number of pipeline runs deleted
+// in a single statement by the run reaper
+func (c *generalConfig) JobPipelineReaperBatchSize() uint32 {
+	return c.getWithFallback("JobPipelineReaperBatchSize", ParseUint32).(uint32)
+}
+
 func (c *generalConfig) JobPipelineReaperInterval() time.Duration {
 	return c.getWithFallback("JobPipelineReaperInterval", ParseDuration).(time.Duration)
 }
@@ -681,6 +742,12 @@ func (c *generalConfig) KeeperRegistrySyncUpkeepQueueSize() uint32 {
 	return c.getWithFallback("KeeperRegistrySyncUpkeepQueueSize", ParseUint32).(uint32)
 }
 
+// KeyRotationGracePeriod is how long a rotated CSA, OCR or P2P key is kept in
+// the keystore after being replaced, before it is deleted
+func (c *generalConfig) KeyRotationGracePeriod() time.Duration {
+	return c.getWithFallback("KeyRotationGracePeriod", ParseDuration).(time.Duration)
+}
+
 // JSONConsole when set to true causes logging to be made in JSON format
 // If set to false, logs in console format
 func (c *generalConfig) JSONConsole() bool {
@@ -733,6 +800,61 @@ func (c *generalConfig) TelemetryIngressLogging() bool {
 	return c.getWithFallback("TelemetryIngressLogging", ParseBool).(bool)
 }
 
+// TelemetryIngressUseBatchSend toggles sending telemetry to the ingress
+// server in batches, with the TelemBatch RPC
+func (c *generalConfig) TelemetryIngressUseBatchSend() bool {
+	return c.getWithFallback("TelemetryIngressUseBatchSend", ParseBool).(bool)
+}
+
+// TelemetryIngressBufferSize is the number of telemetry messages to buffer
+// before dropping new ones
+func (c *generalConfig) TelemetryIngressBufferSize() uint {
+	return uint(c.getWithFallback("TelemetryIngressBufferSize", ParseUint64).(uint64))
+}
+
+// TelemetryIngressMaxBatchSize is the maximum number of messages sent to the
+// ingress server in a single batch
+func (c *generalConfig) TelemetryIngressMaxBatchSize() uint {
+	return uint(c.getWithFallback("TelemetryIngressMaxBatchSize", ParseUint64).(uint64))
+}
+
+// TelemetryIngressSendInterval is how often buffered telemetry is sent to
+// the ingress server, and spooled telemetry is retried
+func (c *generalConfig) TelemetryIngressSendInterval() time.Duration {
+	return c.getWithFallback("TelemetryIngressSendInterval", ParseDuration).(time.Duration)
+}
+
+// TelemetryIngressSpoolDir is the directory that telemetry which could not be
+// sent is written to, until it can be sent
+func (c *generalConfig) TelemetryIngressSpoolDir() string {
+	fieldName := "TelemetryIngressSpoolDir"
+	dir := c.viper.GetString(EnvVarName(fieldName))
+	defaultValue, _ := defaultValue(fieldName)
+	if dir == defaultValue {
+		return filepath.Join(c.RootDir(), "telemetry_spool")
+	}
+	return dir
+}
+
+// TelemetryIngressSpoolMaxSize is the maximum size in bytes of the telemetry
+// spool. The oldest telemetry is dropped once it is full. Zero disables
+// spooling.
+func (c *generalConfig) TelemetryIngressSpoolMaxSize() uint64 {
+	return c.getWithFallback("TelemetryIngressSpoolMaxSize", ParseUint64).(uint64)
+}
+
+// TelemetryLocalEnabled enables storing summaries of OCR telemetry in the
+// database, in addition to sending it to the explorer or ingress server
+func (c *generalConfig) TelemetryLocalEnabled() bool {
+	return c.getWithFallback("TelemetryLocalEnabled", ParseBool).(bool)
+}
+
+// TelemetryLocalRetention is how long locally stored telemetry is kept. Zero
+// keeps it forever.
+func (c *generalConfig) TelemetryLocalRetention() time.Duration {
+	return c.getWithFallback("TelemetryLocalRetention", ParseDuration).(time.Duration)
+}
+
 // FIXME: Add comments to all of these
 func (c *generalConfig) OCRBootstrapCheckInterval() time.Duration {
 	return c.getWithFallback("OCRBootstrapCheckInterval", ParseDuration).(time.Duration)
@@ -1427,6 +1549,13 @@ func (*generalConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, boo
 	}
 	return val.(time.Duration), ok
 }
+func (*generalConfig) GlobalEvmHTTPPollInterval() (time.Duration, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmHTTPPollInterval"), ParseDuration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
 func (*generalConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmLogBackfillBatchSize"), ParseUint32)
 	if val == nil {
@@ -1434,6 +1563,20 @@ func (*generalConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (*generalConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmLogPollInterval"), ParseDuration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
+func (*generalConfig) GlobalEvmLogPollerEnabled() (bool, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmLogPollerEnabled"), ParseBool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
 func (*generalConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmMaxGasPriceWei"), ParseBigInt)
 	if val == nil {
@@ -1448,6 +1591,13 @@ func (*generalConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (*generalConfig) GlobalEvmMaxTxBatchSize() (uint32, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmMaxTxBatchSize"), ParseUint32)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint32), ok
+}
 func (*generalConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmMaxQueuedTransactions"), ParseUint64)
 	if val == nil {
@@ -1462,6 +1612,13 @@ func (*generalConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	}
 	return val.(*big.Int), ok
 }
+func (*generalConfig) GlobalEvmMinSendingKeyBalanceWei() (*big.Int, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmMinSendingKeyBalanceWei"), ParseBigInt)
+	if val == nil {
+		return nil, false
+	}
+	return val.(*big.Int), ok
+}
 func (*generalConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmNonceAutoSync"), ParseBool)
 	if val == nil {
@@ -1476,6 +1633,13 @@ func (*generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (*generalConfig) GlobalFeeHistoryEstimatorBlockCount() (uint16, bool) {
+	val, ok := lookupEnv(EnvVarName("FeeHistoryEstimatorBlockCount"), ParseUint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
 func (*generalConfig) GlobalFlagsContractAddress() (string, bool) {
 	val, ok := lookupEnv(EnvVarName("FlagsContractAddress"), ParseString)
 	if val == nil {
@@ -49,6 +49,8 @@ type ConfigSchema struct {
 	DefaultMaxHTTPAttempts                     uint                          `env:"MAX_HTTP_ATTEMPTS" default:"5"`
 	Dev                                        bool                          `env:"CHAINLINK_DEV" default:"false"`
 	EVMDisabled                                bool                          `env:"EVM_DISABLED" default:"false"`
+	EthExternalSignerTimeout                   time.Duration                 `env:"ETH_EXTERNAL_SIGNER_TIMEOUT" default:"10s"`
+	EthExternalSignerURL                       *url.URL                      `env:"ETH_EXTERNAL_SIGNER_URL"`
 	EthTxReaperInterval                        time.Duration                 `env:"ETH_TX_REAPER_INTERVAL"`
 	EthTxReaperThreshold                       time.Duration                 `env:"ETH_TX_REAPER_THRESHOLD"`
 	EthTxResendAfterThreshold                  time.Duration                 `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
@@ -73,16 +75,22 @@ type ConfigSchema struct {
 	EvmHeadTrackerHistoryDepth                 uint                          `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
 	EvmHeadTrackerMaxBufferSize                uint                          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
 	EvmHeadTrackerSamplingInterval             time.Duration                 `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL"`
+	EvmHTTPPollInterval                        time.Duration                 `env:"ETH_HTTP_POLL_INTERVAL"`
 	EvmLogBackfillBatchSize                    uint32                        `env:"ETH_LOG_BACKFILL_BATCH_SIZE"`
+	EvmLogPollInterval                         time.Duration                 `env:"ETH_LOG_POLL_INTERVAL"`
+	EvmLogPollerEnabled                        bool                          `env:"ETH_LOG_POLLER_ENABLED"`
 	EvmMaxGasPriceWei                          *big.Int                      `env:"ETH_MAX_GAS_PRICE_WEI"`
 	EvmMaxInFlightTransactions                 uint32                        `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
 	EvmMaxQueuedTransactions                   uint64                        `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
+	EvmMaxTxBatchSize                          uint32                        `env:"ETH_MAX_TX_BATCH_SIZE"`
 	EvmMinGasPriceWei                          *big.Int                      `env:"ETH_MIN_GAS_PRICE_WEI"`
+	EvmMinSendingKeyBalanceWei                 *big.Int                      `env:"ETH_MIN_SENDING_KEY_BALANCE_WEI"`
 	EvmNonceAutoSync                           bool                          `env:"ETH_NONCE_AUTO_SYNC"`
 	EvmRPCDefaultBatchSize                     uint32                        `env:"ETH_RPC_DEFAULT_BATCH_SIZE"`
 	ExplorerAccessKey                          string                        `env:"EXPLORER_ACCESS_KEY"`
 	ExplorerSecret                             string                        `env:"EXPLORER_SECRET"`
 	ExplorerURL                                *url.URL                      `env:"EXPLORER_URL"`
+	FeeHistoryEstimatorBlockCount              uint16                        `env:"FEE_HISTORY_ESTIMATOR_BLOCK_COUNT"`
 	FMDefaultTransactionQueueDepth             uint32                        `env:"FM_DEFAULT_TRANSACTION_QUEUE_DEPTH" default:"1"`
 	FMSimulateTransactions                     bool                          `env:"FM_SIMULATE_TRANSACTIONS" default:"false"`
 	FeatureExternalInitiators                  bool                          `env:"FEATURE_EXTERNAL_INITIATORS" default:"false"`
@@ -96,7 +104,9 @@ type ConfigSchema struct {
 	InsecureFastScrypt                         bool                          `env:"INSECURE_FAST_SCRYPT" default:"false"`
 	InsecureSkipVerify                         bool                          `env:"INSECURE_SKIP_VERIFY" default:"false"`
 	JSONConsole                                bool                          `env:"JSON_CONSOLE" default:"false"`
+	JobPipelineArchiveDir                      string                        `env:"JOB_PIPELINE_ARCHIVE_DIR" default:"$ROOT/pipeline_run_archives"`
 	JobPipelineMaxRunDuration                  time.Duration                 `env:"JOB_PIPELINE_MAX_RUN_DURATION" default:"10m"`
+	JobPipelineReaperBatchSize                 uint32                        `env:"JOB_PIPELINE_REAPER_BATCH_SIZE" default:"1000"`
 	JobPipelineReaperInterval                  time.Duration                 `env:"JOB_PIPELINE_REAPER_INTERVAL" default:"1h"`
 	JobPipelineReaperThreshold                 time.Duration                 `env:"JOB_PIPELINE_REAPER_THRESHOLD" default:"24h"`
 	JobPipelineResultWriteQueueDepth           uint64                        `env:"JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH" default:"100"`
@@ -109,6 +119,7 @@ type ConfigSchema struct {
 	KeeperRegistryPerformGasOverhead           uint64                        `env:"KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD" default:"150000"`
 	KeeperRegistrySyncInterval                 time.Duration                 `env:"KEEPER_REGISTRY_SYNC_INTERVAL" default:"30m"`
 	KeeperRegistrySyncUpkeepQueueSize          uint32                        `env:"KEEPER_REGISTRY_SYNC_UPKEEP_QUEUE_SIZE" default:"10"`
+	KeyRotationGracePeriod                     time.Duration                 `env:"KEY_ROTATION_GRACE_PERIOD" default:"24h"`
 	LinkContractAddress                        string                        `env:"LINK_CONTRACT_ADDRESS"`
 	LogLevel                                   LogLevel                      `env:"LOG_LEVEL"`
 	LogSQLMigrations                           bool                          `env:"LOG_SQL_MIGRATIONS" default:"true"`
@@ -167,9 +178,17 @@ type ConfigSchema struct {
 	TLSKeyPath                                 string                        `env:"TLS_KEY_PATH" `
 	TLSPort                                    uint16                        `env:"CHAINLINK_TLS_PORT" default:"6689"`
 	TLSRedirect                                bool                          `env:"CHAINLINK_TLS_REDIRECT" default:"false"`
+	TelemetryIngressBufferSize                 uint                          `env:"TELEMETRY_INGRESS_BUFFER_SIZE" default:"100"`
 	TelemetryIngressLogging                    bool                          `env:"TELEMETRY_INGRESS_LOGGING" default:"false"`
+	TelemetryIngressMaxBatchSize               uint                          `env:"TELEMETRY_INGRESS_MAX_BATCH_SIZE" default:"50"`
+	TelemetryIngressSendInterval               time.Duration                 `env:"TELEMETRY_INGRESS_SEND_INTERVAL" default:"500ms"`
 	TelemetryIngressServerPubKey               string                        `env:"TELEMETRY_INGRESS_SERVER_PUB_KEY"`
+	TelemetryIngressSpoolDir                   string                        `env:"TELEMETRY_INGRESS_SPOOL_DIR" default:"$ROOT/telemetry_spool"`
+	TelemetryIngressSpoolMaxSize               uint64                        `env:"TELEMETRY_INGRESS_SPOOL_MAX_SIZE" default:"104857600"`
 	TelemetryIngressURL                        *url.URL                      `env:"TELEMETRY_INGRESS_URL"`
+	TelemetryIngressUseBatchSend               bool                          `env:"TELEMETRY_INGRESS_USE_BATCH_SEND" default:"false"`
+	TelemetryLocalEnabled                      bool                          `env:"TELEMETRY_LOCAL_ENABLED" default:"false"`
+	TelemetryLocalRetention                    time.Duration                 `env:"TELEMETRY_LOCAL_RETENTION" default:"168h"`
 	TriggerFallbackDBPollInterval              time.Duration                 `env:"TRIGGER_FALLBACK_DB_POLL_INTERVAL" default:"30s"`
 	UnAuthenticatedRateLimit                   int64                         `env:"UNAUTHENTICATED_RATE_LIMIT" default:"5"`
 	UnAuthenticatedRateLimitPeriod             time.Duration                 `env:"UNAUTHENTICATED_RATE_LIMIT_PERIOD" default:"20s"`
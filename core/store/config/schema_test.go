@@ -42,6 +42,8 @@ func TestConfigSchema(t *testing.T) {
 		"DefaultMaxHTTPAttempts":                     "MAX_HTTP_ATTEMPTS",
 		"Dev":                                        "CHAINLINK_DEV",
 		"EVMDisabled":                                "EVM_DISABLED",
+		"EthExternalSignerTimeout":                   "ETH_EXTERNAL_SIGNER_TIMEOUT",
+		"EthExternalSignerURL":                       "ETH_EXTERNAL_SIGNER_URL",
 		"EthTxReaperInterval":                        "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                       "ETH_TX_REAPER_THRESHOLD",
 		"EthTxResendAfterThreshold":                  "ETH_TX_RESEND_AFTER_THRESHOLD",
@@ -67,16 +69,22 @@ func TestConfigSchema(t *testing.T) {
 		"EvmHeadTrackerHistoryDepth":                 "ETH_HEAD_TRACKER_HISTORY_DEPTH",
 		"EvmHeadTrackerMaxBufferSize":                "ETH_HEAD_TRACKER_MAX_BUFFER_SIZE",
 		"EvmHeadTrackerSamplingInterval":             "ETH_HEAD_TRACKER_SAMPLING_INTERVAL",
+		"EvmHTTPPollInterval":                        "ETH_HTTP_POLL_INTERVAL",
 		"EvmLogBackfillBatchSize":                    "ETH_LOG_BACKFILL_BATCH_SIZE",
+		"EvmLogPollInterval":                         "ETH_LOG_POLL_INTERVAL",
+		"EvmLogPollerEnabled":                        "ETH_LOG_POLLER_ENABLED",
 		"EvmMaxGasPriceWei":                          "ETH_MAX_GAS_PRICE_WEI",
 		"EvmMaxInFlightTransactions":                 "ETH_MAX_IN_FLIGHT_TRANSACTIONS",
 		"EvmMaxQueuedTransactions":                   "ETH_MAX_QUEUED_TRANSACTIONS",
+		"EvmMaxTxBatchSize":                          "ETH_MAX_TX_BATCH_SIZE",
 		"EvmMinGasPriceWei":                          "ETH_MIN_GAS_PRICE_WEI",
+		"EvmMinSendingKeyBalanceWei":                 "ETH_MIN_SENDING_KEY_BALANCE_WEI",
 		"EvmNonceAutoSync":                           "ETH_NONCE_AUTO_SYNC",
 		"EvmRPCDefaultBatchSize":                     "ETH_RPC_DEFAULT_BATCH_SIZE",
 		"ExplorerAccessKey":                          "EXPLORER_ACCESS_KEY",
 		"ExplorerSecret":                             "EXPLORER_SECRET",
 		"ExplorerURL":                                "EXPLORER_URL",
+		"FeeHistoryEstimatorBlockCount":              "FEE_HISTORY_ESTIMATOR_BLOCK_COUNT",
 		"FMDefaultTransactionQueueDepth":             "FM_DEFAULT_TRANSACTION_QUEUE_DEPTH",
 		"FMSimulateTransactions":                     "FM_SIMULATE_TRANSACTIONS",
 		"FeatureExternalInitiators":                  "FEATURE_EXTERNAL_INITIATORS",
@@ -95,7 +103,9 @@ func TestConfigSchema(t *testing.T) {
 		"InsecureFastScrypt":                         "INSECURE_FAST_SCRYPT",
 		"InsecureSkipVerify":                         "INSECURE_SKIP_VERIFY",
 		"JSONConsole":                                "JSON_CONSOLE",
+		"JobPipelineArchiveDir":                      "JOB_PIPELINE_ARCHIVE_DIR",
 		"JobPipelineMaxRunDuration":                  "JOB_PIPELINE_MAX_RUN_DURATION",
+		"JobPipelineReaperBatchSize":                 "JOB_PIPELINE_REAPER_BATCH_SIZE",
 		"JobPipelineReaperInterval":                  "JOB_PIPELINE_REAPER_INTERVAL",
 		"JobPipelineReaperThreshold":                 "JOB_PIPELINE_REAPER_THRESHOLD",
 		"JobPipelineResultWriteQueueDepth":           "JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH",
@@ -108,6 +118,7 @@ func TestConfigSchema(t *testing.T) {
 		"KeeperRegistryPerformGasOverhead":           "KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD",
 		"KeeperRegistrySyncInterval":                 "KEEPER_REGISTRY_SYNC_INTERVAL",
 		"KeeperRegistrySyncUpkeepQueueSize":          "KEEPER_REGISTRY_SYNC_UPKEEP_QUEUE_SIZE",
+		"KeyRotationGracePeriod":                     "KEY_ROTATION_GRACE_PERIOD",
 		"LinkContractAddress":                        "LINK_CONTRACT_ADDRESS",
 		"LogLevel":                                   "LOG_LEVEL",
 		"LogSQLMigrations":                           "LOG_SQL_MIGRATIONS",
@@ -165,9 +176,17 @@ func TestConfigSchema(t *testing.T) {
 		"SecureCookies":                              "SECURE_COOKIES",
 		"SessionTimeout":                             "SESSION_TIMEOUT",
 		"StatsPusherLogging":                         "STATS_PUSHER_LOGGING",
+		"TelemetryIngressBufferSize":                 "TELEMETRY_INGRESS_BUFFER_SIZE",
 		"TelemetryIngressLogging":                    "TELEMETRY_INGRESS_LOGGING",
+		"TelemetryIngressMaxBatchSize":               "TELEMETRY_INGRESS_MAX_BATCH_SIZE",
+		"TelemetryIngressSendInterval":               "TELEMETRY_INGRESS_SEND_INTERVAL",
 		"TelemetryIngressServerPubKey":               "TELEMETRY_INGRESS_SERVER_PUB_KEY",
+		"TelemetryIngressSpoolDir":                   "TELEMETRY_INGRESS_SPOOL_DIR",
+		"TelemetryIngressSpoolMaxSize":               "TELEMETRY_INGRESS_SPOOL_MAX_SIZE",
 		"TelemetryIngressURL":                        "TELEMETRY_INGRESS_URL",
+		"TelemetryIngressUseBatchSend":               "TELEMETRY_INGRESS_USE_BATCH_SEND",
+		"TelemetryLocalEnabled":                      "TELEMETRY_LOCAL_ENABLED",
+		"TelemetryLocalRetention":                    "TELEMETRY_LOCAL_RETENTION",
 		"TLSCertPath":                                "TLS_CERT_PATH",
 		"TLSHost":                                    "CHAINLINK_TLS_HOST",
 		"TLSKeyPath":                                 "TLS_KEY_PATH",
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TYPE user_roles AS ENUM ('admin', 'edit', 'run', 'view');
+ALTER TABLE users ADD COLUMN role user_roles NOT NULL DEFAULT 'view';
+-- Until now there has only ever been one user, who could do everything
+UPDATE users SET role = 'admin';
+
+CREATE UNIQUE INDEX idx_users_unique_lower_email ON users (lower(email));
+CREATE UNIQUE INDEX idx_users_unique_token_key ON users (token_key) WHERE token_key IS NOT NULL AND token_key != '';
+
+-- Existing sessions cannot be attributed to a user, so everyone has to log in again
+DELETE FROM sessions;
+ALTER TABLE sessions ADD COLUMN email text NOT NULL REFERENCES users (email) ON DELETE CASCADE;
+CREATE INDEX idx_sessions_email ON sessions (email);
+
+-- +goose Down
+ALTER TABLE sessions DROP COLUMN email;
+DROP INDEX idx_users_unique_token_key;
+DROP INDEX idx_users_unique_lower_email;
+ALTER TABLE users DROP COLUMN role;
+DROP TYPE user_roles;
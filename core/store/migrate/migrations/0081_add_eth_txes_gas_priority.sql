@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN gas_priority text NOT NULL DEFAULT 'standard';
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN gas_priority;
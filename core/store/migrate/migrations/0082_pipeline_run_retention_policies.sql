@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE pipeline_run_retention_policies (
+    id BIGSERIAL PRIMARY KEY,
+    job_id integer REFERENCES jobs(id) ON DELETE CASCADE DEFERRABLE,
+    job_type text,
+    keep_last integer CHECK (keep_last >= 0),
+    max_age bigint CHECK (max_age >= 0),
+    errored_max_age bigint CHECK (errored_max_age >= 0),
+    archive boolean NOT NULL DEFAULT FALSE,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT chk_pipeline_run_retention_policies_target CHECK (num_nonnulls(job_id, job_type) = 1)
+);
+
+CREATE UNIQUE INDEX idx_pipeline_run_retention_policies_job_id ON pipeline_run_retention_policies (job_id) WHERE job_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_pipeline_run_retention_policies_job_type ON pipeline_run_retention_policies (job_type) WHERE job_type IS NOT NULL;
+
+CREATE TABLE pipeline_run_restorations (
+    pipeline_run_id bigint PRIMARY KEY REFERENCES pipeline_runs(id) ON DELETE CASCADE DEFERRABLE,
+    archive text NOT NULL,
+    restored_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE pipeline_run_restorations;
+DROP TABLE pipeline_run_retention_policies;
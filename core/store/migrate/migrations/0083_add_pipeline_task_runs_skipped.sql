@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE pipeline_task_runs ADD COLUMN skipped boolean NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE pipeline_task_runs DROP COLUMN skipped;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN max_concurrency integer NOT NULL DEFAULT 0 CHECK (max_concurrency >= 0),
+    ADD COLUMN requests_per_second double precision NOT NULL DEFAULT 0 CHECK (requests_per_second >= 0),
+    ADD COLUMN cache_ttl bigint NOT NULL DEFAULT 0 CHECK (cache_ttl >= 0),
+    ADD COLUMN circuit_breaker_threshold integer NOT NULL DEFAULT 0 CHECK (circuit_breaker_threshold >= 0),
+    ADD COLUMN circuit_breaker_timeout bigint NOT NULL DEFAULT 0 CHECK (circuit_breaker_timeout >= 0),
+    ADD COLUMN max_staleness bigint NOT NULL DEFAULT 0 CHECK (max_staleness >= 0);
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN max_concurrency,
+    DROP COLUMN requests_per_second,
+    DROP COLUMN cache_ttl,
+    DROP COLUMN circuit_breaker_threshold,
+    DROP COLUMN circuit_breaker_timeout,
+    DROP COLUMN max_staleness;
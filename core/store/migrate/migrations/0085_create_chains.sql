@@ -0,0 +1,15 @@
+-- +goose Up
+-- chains holds the config of chains of every family except EVM, which uses
+-- evm_chains and nodes
+CREATE TABLE chains (
+    family text NOT NULL CHECK (family <> 'evm' AND family <> ''),
+    id text NOT NULL CHECK (id <> ''),
+    cfg jsonb NOT NULL DEFAULT '{}',
+    enabled boolean NOT NULL DEFAULT TRUE,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (family, id)
+);
+
+-- +goose Down
+DROP TABLE chains;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE ocr_telemetry_rounds (
+    contract_address bytea NOT NULL CHECK (octet_length(contract_address) = 20),
+    config_digest bytea NOT NULL,
+    epoch bigint NOT NULL,
+    round bigint NOT NULL,
+    leader bigint,
+    started_at timestamptz,
+    observed_at timestamptz,
+    observations_received integer NOT NULL DEFAULT 0,
+    finalized_at timestamptz,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (contract_address, config_digest, epoch, round)
+);
+
+CREATE INDEX idx_ocr_telemetry_rounds_created_at ON ocr_telemetry_rounds (created_at);
+
+-- +goose Down
+DROP TABLE ocr_telemetry_rounds;
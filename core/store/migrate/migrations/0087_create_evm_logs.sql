@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE evm_logs (
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    block_hash bytea NOT NULL CHECK (octet_length(block_hash) = 32),
+    block_number bigint NOT NULL CHECK (block_number >= 0),
+    log_index bigint NOT NULL,
+    address bytea NOT NULL CHECK (octet_length(address) = 20),
+    topics bytea[] NOT NULL,
+    data bytea NOT NULL,
+    tx_hash bytea NOT NULL CHECK (octet_length(tx_hash) = 32),
+    tx_index bigint NOT NULL,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (evm_chain_id, block_hash, log_index)
+);
+
+CREATE INDEX idx_evm_logs_block_number ON evm_logs (evm_chain_id, block_number);
+
+CREATE TABLE evm_log_poller_blocks (
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    block_number bigint NOT NULL CHECK (block_number >= 0),
+    block_hash bytea NOT NULL CHECK (octet_length(block_hash) = 32),
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (evm_chain_id, block_number)
+);
+
+-- +goose Down
+DROP TABLE evm_log_poller_blocks;
+DROP TABLE evm_logs;
@@ -0,0 +1,16 @@
+-- +goose Up
+ALTER TABLE nodes DROP CONSTRAINT primary_or_sendonly;
+ALTER TABLE nodes ADD CONSTRAINT primary_or_sendonly CHECK (
+    (send_only AND ws_url IS NULL AND http_url IS NOT NULL)
+    OR
+    (NOT send_only AND (ws_url IS NOT NULL OR http_url IS NOT NULL))
+);
+
+-- +goose Down
+DELETE FROM nodes WHERE NOT send_only AND ws_url IS NULL;
+ALTER TABLE nodes DROP CONSTRAINT primary_or_sendonly;
+ALTER TABLE nodes ADD CONSTRAINT primary_or_sendonly CHECK (
+    (send_only AND ws_url IS NULL AND http_url IS NOT NULL)
+    OR
+    (NOT send_only AND ws_url IS NOT NULL)
+);
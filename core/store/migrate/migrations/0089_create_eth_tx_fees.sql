@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE eth_tx_fees (
+    eth_tx_id bigint PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL,
+    job_id integer,
+    from_address bytea NOT NULL CHECK (octet_length(from_address) = 20),
+    tx_hash bytea NOT NULL CHECK (octet_length(tx_hash) = 32),
+    block_number bigint NOT NULL,
+    gas_used bigint NOT NULL,
+    effective_gas_price numeric(78,0) NOT NULL,
+    l1_fee numeric(78,0) NOT NULL DEFAULT 0,
+    fee numeric(78,0) NOT NULL,
+    confirmed_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_eth_tx_fees_job_id_confirmed_at ON eth_tx_fees (job_id, confirmed_at);
+CREATE INDEX idx_eth_tx_fees_from_address_confirmed_at ON eth_tx_fees (evm_chain_id, from_address, confirmed_at);
+CREATE INDEX idx_eth_tx_fees_confirmed_at ON eth_tx_fees (confirmed_at);
+
+-- +goose Down
+DROP TABLE eth_tx_fees;
@@ -0,0 +1,51 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- A new enum value cannot be used in the transaction that adds it
+ALTER TYPE eth_txes_state ADD VALUE IF NOT EXISTS 'batched' AFTER 'unstarted';
+
+ALTER TABLE eth_txes
+    ADD COLUMN multicall_address bytea CHECK (octet_length(multicall_address) = 20),
+    ADD COLUMN batch_eth_tx_id bigint REFERENCES eth_txes (id) ON DELETE CASCADE;
+
+CREATE INDEX idx_eth_txes_batch_eth_tx_id ON eth_txes (batch_eth_tx_id) WHERE batch_eth_tx_id IS NOT NULL;
+CREATE INDEX idx_eth_txes_unstarted_multicall_address ON eth_txes (evm_chain_id, from_address, multicall_address) WHERE state = 'unstarted' AND multicall_address IS NOT NULL;
+
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+    OR
+    state = 'batched'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND batch_eth_tx_id IS NOT NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+);
+
+-- +goose Down
+-- Postgres cannot drop an enum value, so 'batched' is left in place unused
+UPDATE eth_txes SET state = 'fatal_error', error = 'batched transaction discarded by down migration' WHERE state = 'batched';
+
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+);
+
+DROP INDEX idx_eth_txes_unstarted_multicall_address;
+DROP INDEX idx_eth_txes_batch_eth_tx_id;
+ALTER TABLE eth_txes DROP COLUMN multicall_address, DROP COLUMN batch_eth_tx_id;
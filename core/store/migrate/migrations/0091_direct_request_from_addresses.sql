@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE direct_request_specs ADD COLUMN from_addresses TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE direct_request_specs DROP COLUMN from_addresses;
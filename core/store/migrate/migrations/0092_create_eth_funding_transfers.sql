@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE eth_funding_transfers (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) DEFERRABLE INITIALLY IMMEDIATE,
+    eth_tx_id bigint REFERENCES eth_txes (id) ON DELETE SET NULL,
+    from_address bytea NOT NULL CHECK (octet_length(from_address) = 20),
+    to_address bytea NOT NULL CHECK (octet_length(to_address) = 20),
+    amount numeric(78,0) NOT NULL CHECK (amount > 0),
+    balance numeric(78,0) NOT NULL,
+    min_balance numeric(78,0) NOT NULL,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_eth_funding_transfers_created_at ON eth_funding_transfers (evm_chain_id, created_at);
+CREATE INDEX idx_eth_funding_transfers_to_address ON eth_funding_transfers (evm_chain_id, to_address);
+CREATE INDEX idx_eth_funding_transfers_eth_tx_id ON eth_funding_transfers (eth_tx_id);
+
+-- +goose Down
+DROP TABLE eth_funding_transfers;
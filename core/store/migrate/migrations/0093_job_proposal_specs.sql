@@ -0,0 +1,81 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TYPE job_proposal_spec_status AS ENUM ('pending', 'approved', 'rejected', 'cancelled', 'revoked');
+
+CREATE TABLE job_proposal_specs (
+	id BIGSERIAL PRIMARY KEY,
+	definition TEXT NOT NULL,
+	version INTEGER NOT NULL,
+	status job_proposal_spec_status NOT NULL,
+	job_proposal_id BIGINT NOT NULL REFERENCES job_proposals (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+	status_updated_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	CONSTRAINT chk_job_proposal_specs_version CHECK (version > 0)
+);
+CREATE UNIQUE INDEX idx_job_proposal_specs_job_proposal_id_version ON job_proposal_specs (job_proposal_id, version);
+
+-- Every existing proposal becomes version 1 of itself
+INSERT INTO job_proposal_specs (definition, version, status, job_proposal_id, status_updated_at, created_at, updated_at)
+SELECT spec, 1, status::text::job_proposal_spec_status, id, updated_at, proposed_at, updated_at
+FROM job_proposals;
+
+-- We must remove the old contraint to add enum values to support Postgres v11
+ALTER TABLE job_proposals
+DROP CONSTRAINT chk_job_proposals_status_fsm;
+
+ALTER TYPE job_proposal_status RENAME TO job_proposal_status_old;
+CREATE TYPE job_proposal_status AS ENUM('pending', 'approved', 'rejected', 'cancelled', 'revoked', 'deleted');
+
+ALTER TABLE job_proposals ALTER COLUMN status TYPE job_proposal_status USING status::text::job_proposal_status;
+
+DROP TYPE job_proposal_status_old;
+
+-- pending_update is set when the feeds manager has proposed a new version of
+-- an approved job, or requested its deletion, and the node operator has yet
+-- to act on it
+ALTER TABLE job_proposals ADD COLUMN pending_update BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- A deleted proposal keeps its job until the node operator cancels it
+ALTER TABLE job_proposals
+ADD CONSTRAINT chk_job_proposals_status_fsm CHECK (
+	(status = 'pending' AND external_job_id IS NULL) OR
+	(status = 'approved' AND external_job_id IS NOT NULL) OR
+	(status = 'rejected' AND external_job_id IS NULL) OR
+	(status = 'cancelled' AND external_job_id IS NULL) OR
+	(status = 'revoked' AND external_job_id IS NULL) OR
+	(status = 'deleted')
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE job_proposals
+DROP CONSTRAINT chk_job_proposals_status_fsm;
+
+-- This will fail if any records are using the 'revoked' or 'deleted' enums.
+-- Manually update these as we cannot decide what you want to do with them.
+ALTER TYPE job_proposal_status RENAME TO job_proposal_status_old;
+CREATE TYPE job_proposal_status AS ENUM('pending', 'approved', 'rejected', 'cancelled');
+
+ALTER TABLE job_proposals ALTER COLUMN status TYPE job_proposal_status USING status::text::job_proposal_status;
+
+DROP TYPE job_proposal_status_old;
+
+ALTER TABLE job_proposals DROP COLUMN pending_update;
+
+ALTER TABLE job_proposals
+ADD CONSTRAINT chk_job_proposals_status_fsm CHECK (
+	(status = 'pending' AND external_job_id IS NULL) OR
+	(status = 'approved' AND external_job_id IS NOT NULL) OR
+	(status = 'rejected' AND external_job_id IS NULL) OR
+	(status = 'cancelled' AND external_job_id IS NULL)
+);
+
+DROP TABLE job_proposal_specs;
+DROP TYPE job_proposal_spec_status;
+
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE feeds_manager_approval_policies (
+	id BIGSERIAL PRIMARY KEY,
+	feeds_manager_id BIGINT NOT NULL REFERENCES feeds_managers (id) ON DELETE CASCADE,
+	auto_approve_job_types TEXT[] NOT NULL DEFAULT '{}',
+	auto_approve_update_fields TEXT[] NOT NULL DEFAULT '{}',
+	required_approvals INTEGER NOT NULL DEFAULT 1,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	CONSTRAINT chk_feeds_manager_approval_policies_required_approvals CHECK (required_approvals > 0)
+);
+CREATE UNIQUE INDEX idx_feeds_manager_approval_policies_feeds_manager_id ON feeds_manager_approval_policies (feeds_manager_id);
+
+-- Approvers are kept by email rather than referencing users, so that the
+-- approvals of a deleted user still count
+CREATE TABLE job_proposal_spec_approvals (
+	id BIGSERIAL PRIMARY KEY,
+	job_proposal_spec_id BIGINT NOT NULL REFERENCES job_proposal_specs (id) ON DELETE CASCADE,
+	approver TEXT NOT NULL,
+	created_at timestamp with time zone NOT NULL
+);
+CREATE UNIQUE INDEX idx_job_proposal_spec_approvals_spec_id_approver ON job_proposal_spec_approvals (job_proposal_spec_id, approver);
+
+CREATE TYPE approval_policy_decision AS ENUM ('auto_approved', 'auto_approval_failed', 'manual_approval_required', 'approval_recorded', 'approved');
+
+CREATE TABLE approval_policy_decisions (
+	id BIGSERIAL PRIMARY KEY,
+	job_proposal_spec_id BIGINT NOT NULL REFERENCES job_proposal_specs (id) ON DELETE CASCADE,
+	decision approval_policy_decision NOT NULL,
+	reason TEXT NOT NULL,
+	approver TEXT,
+	created_at timestamp with time zone NOT NULL
+);
+CREATE INDEX idx_approval_policy_decisions_job_proposal_spec_id ON approval_policy_decisions (job_proposal_spec_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE approval_policy_decisions;
+DROP TYPE approval_policy_decision;
+DROP TABLE job_proposal_spec_approvals;
+DROP TABLE feeds_manager_approval_policies;
+
+-- +goose StatementEnd
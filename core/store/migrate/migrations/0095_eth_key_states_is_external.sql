@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_key_states ADD COLUMN is_external BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE eth_key_states DROP COLUMN is_external;
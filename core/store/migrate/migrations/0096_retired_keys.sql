@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Keys replaced by a rotation are kept until they expire, so that the services
+-- still using them can switch to their replacement
+CREATE TABLE retired_keys (
+	key_id TEXT PRIMARY KEY,
+	key_type TEXT NOT NULL,
+	replaced_by TEXT NOT NULL,
+	retired_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	CONSTRAINT chk_retired_keys_key_type CHECK (key_type IN ('CSA', 'OCR', 'P2P'))
+);
+CREATE INDEX idx_retired_keys_expires_at ON retired_keys (expires_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE retired_keys;
+
+-- +goose StatementEnd
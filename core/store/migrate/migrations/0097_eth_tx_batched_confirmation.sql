@@ -0,0 +1,39 @@
+-- +goose Up
+-- Batched eth_txes are confirmed along with the eth_tx carrying them, but keep
+-- a null nonce since the nonce used on-chain is their carrier's
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+    OR
+    state = 'batched'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND batch_eth_tx_id IS NOT NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND (nonce IS NOT NULL OR batch_eth_tx_id IS NOT NULL) AND error IS NULL AND broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND (nonce IS NOT NULL OR batch_eth_tx_id IS NOT NULL) AND error IS NULL AND broadcast_at IS NOT NULL
+);
+
+-- +goose Down
+UPDATE eth_txes SET state = 'batched', broadcast_at = NULL WHERE batch_eth_tx_id IS NOT NULL AND nonce IS NULL AND state IN ('confirmed', 'confirmed_missing_receipt');
+
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+    OR
+    state = 'batched'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND batch_eth_tx_id IS NOT NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+);
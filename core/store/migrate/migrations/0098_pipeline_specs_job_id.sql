@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Updating a job creates a new pipeline spec rather than rewriting the one its
+-- existing runs reference, so a job can own several pipeline specs. job_id
+-- links each of them back to the job.
+ALTER TABLE pipeline_specs ADD COLUMN job_id INT REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE;
+UPDATE pipeline_specs SET job_id = jobs.id FROM jobs WHERE jobs.pipeline_spec_id = pipeline_specs.id;
+CREATE INDEX idx_pipeline_specs_job_id ON pipeline_specs (job_id);
+
+CREATE OR REPLACE FUNCTION public.setpipelinespecjobid() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+        BEGIN
+		UPDATE pipeline_specs SET job_id = NEW.id WHERE id = NEW.pipeline_spec_id;
+		RETURN NULL;
+        END
+        $$;
+
+CREATE TRIGGER set_pipeline_spec_job_id AFTER INSERT OR UPDATE OF pipeline_spec_id ON public.jobs FOR EACH ROW EXECUTE PROCEDURE public.setpipelinespecjobid();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TRIGGER set_pipeline_spec_job_id ON public.jobs;
+DROP FUNCTION public.setpipelinespecjobid();
+ALTER TABLE pipeline_specs DROP COLUMN job_id;
+
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- The chains table was added for non-EVM chain families, but no family other
+-- than EVM is supported yet
+DROP TABLE chains;
+
+-- +goose Down
+CREATE TABLE chains (
+    family text NOT NULL CHECK (family <> 'evm' AND family <> ''),
+    id text NOT NULL CHECK (id <> ''),
+    cfg jsonb NOT NULL DEFAULT '{}',
+    enabled boolean NOT NULL DEFAULT TRUE,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (family, id)
+);
@@ -44,6 +44,8 @@ type EnvPrinter struct {
 	DefaultHTTPLimit                           int64           `json:"DEFAULT_HTTP_LIMIT"`
 	DefaultHTTPTimeout                         models.Duration `json:"DEFAULT_HTTP_TIMEOUT"`
 	Dev                                        bool            `json:"CHAINLINK_DEV"`
+	EthExternalSignerTimeout                   time.Duration   `json:"ETH_EXTERNAL_SIGNER_TIMEOUT"`
+	EthExternalSignerURL                       string          `json:"ETH_EXTERNAL_SIGNER_URL"`
 	EthereumDisabled                           bool            `json:"ETH_DISABLED"`
 	EthereumHTTPURL                            string          `json:"ETH_HTTP_URL"`
 	EthereumSecondaryURLs                      []string        `json:"ETH_SECONDARY_URLS"`
@@ -55,6 +57,7 @@ type EnvPrinter struct {
 	GasEstimatorMode                           string          `json:"GAS_ESTIMATOR_MODE"`
 	InsecureFastScrypt                         bool            `json:"INSECURE_FAST_SCRYPT"`
 	JSONConsole                                bool            `json:"JSON_CONSOLE"`
+	JobPipelineReaperBatchSize                 uint32          `json:"JOB_PIPELINE_REAPER_BATCH_SIZE"`
 	JobPipelineReaperInterval                  time.Duration   `json:"JOB_PIPELINE_REAPER_INTERVAL"`
 	JobPipelineReaperThreshold                 time.Duration   `json:"JOB_PIPELINE_REAPER_THRESHOLD"`
 	KeeperDefaultTransactionQueueDepth         uint32          `json:"KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH"`
@@ -66,6 +69,7 @@ type EnvPrinter struct {
 	KeeperRegistryPerformGasOverhead           uint64          `json:"KEEPER_REGISTRY_PERFORM_GAS_OVERHEAD"`
 	KeeperRegistrySyncInterval                 time.Duration   `json:"KEEPER_REGISTRY_SYNC_INTERVAL"`
 	KeeperRegistrySyncUpkeepQueueSize          uint32          `json:"KEEPER_REGISTRY_SYNC_UPKEEP_QUEUE_SIZE"`
+	KeyRotationGracePeriod                     time.Duration   `json:"KEY_ROTATION_GRACE_PERIOD"`
 	LinkContractAddress                        string          `json:"LINK_CONTRACT_ADDRESS"`
 	FlagsContractAddress                       string          `json:"FLAGS_CONTRACT_ADDRESS"`
 	LogLevel                                   config.LogLevel `json:"LOG_LEVEL"`
@@ -98,9 +102,17 @@ type EnvPrinter struct {
 	RootDir                                    string          `json:"ROOT"`
 	SecureCookies                              bool            `json:"SECURE_COOKIES"`
 	SessionTimeout                             models.Duration `json:"SESSION_TIMEOUT"`
+	TelemetryIngressBufferSize                 uint            `json:"TELEMETRY_INGRESS_BUFFER_SIZE"`
 	TelemetryIngressLogging                    bool            `json:"TELEMETRY_INGRESS_LOGGING"`
+	TelemetryIngressMaxBatchSize               uint            `json:"TELEMETRY_INGRESS_MAX_BATCH_SIZE"`
+	TelemetryIngressSendInterval               time.Duration   `json:"TELEMETRY_INGRESS_SEND_INTERVAL"`
 	TelemetryIngressServerPubKey               string          `json:"TELEMETRY_INGRESS_SERVER_PUB_KEY"`
+	TelemetryIngressSpoolDir                   string          `json:"TELEMETRY_INGRESS_SPOOL_DIR"`
+	TelemetryIngressSpoolMaxSize               uint64          `json:"TELEMETRY_INGRESS_SPOOL_MAX_SIZE"`
 	TelemetryIngressURL                        string          `json:"TELEMETRY_INGRESS_URL"`
+	TelemetryIngressUseBatchSend               bool            `json:"TELEMETRY_INGRESS_USE_BATCH_SEND"`
+	TelemetryLocalEnabled                      bool            `json:"TELEMETRY_LOCAL_ENABLED"`
+	TelemetryLocalRetention                    time.Duration   `json:"TELEMETRY_LOCAL_RETENTION"`
 	TLSHost                                    string          `json:"CHAINLINK_TLS_HOST"`
 	TLSPort                                    uint16          `json:"CHAINLINK_TLS_PORT"`
 	TLSRedirect                                bool            `json:"CHAINLINK_TLS_REDIRECT"`
@@ -117,6 +129,10 @@ func NewConfigPrinter(cfg config.GeneralConfig) (ConfigPrinter, error) {
 	if cfg.EthereumHTTPURL() != nil {
 		ethereumHTTPURL = cfg.EthereumHTTPURL().String()
 	}
+	ethExternalSignerURL := ""
+	if cfg.EthExternalSignerURL() != nil {
+		ethExternalSignerURL = cfg.EthExternalSignerURL().String()
+	}
 	telemetryIngressURL := ""
 	if cfg.TelemetryIngressURL() != nil {
 		telemetryIngressURL = cfg.TelemetryIngressURL().String()
@@ -135,6 +151,8 @@ func NewConfigPrinter(cfg config.GeneralConfig) (ConfigPrinter, error) {
 			DefaultHTTPLimit:                      cfg.DefaultHTTPLimit(),
 			DefaultHTTPTimeout:                    cfg.DefaultHTTPTimeout(),
 			Dev:                                   cfg.Dev(),
+			EthExternalSignerTimeout:              cfg.EthExternalSignerTimeout(),
+			EthExternalSignerURL:                  ethExternalSignerURL,
 			EthereumDisabled:                      cfg.EthereumDisabled(),
 			EthereumHTTPURL:                       ethereumHTTPURL,
 			EthereumSecondaryURLs:                 mapToStringA(cfg.EthereumSecondaryURLs()),
@@ -145,11 +163,13 @@ func NewConfigPrinter(cfg config.GeneralConfig) (ConfigPrinter, error) {
 			FeatureOffchainReporting:              cfg.FeatureOffchainReporting(),
 			InsecureFastScrypt:                    cfg.InsecureFastScrypt(),
 			JSONConsole:                           cfg.JSONConsole(),
+			JobPipelineReaperBatchSize:            cfg.JobPipelineReaperBatchSize(),
 			JobPipelineReaperInterval:             cfg.JobPipelineReaperInterval(),
 			JobPipelineReaperThreshold:            cfg.JobPipelineReaperThreshold(),
 			KeeperDefaultTransactionQueueDepth:    cfg.KeeperDefaultTransactionQueueDepth(),
 			KeeperGasPriceBufferPercent:           cfg.KeeperGasPriceBufferPercent(),
 			KeeperGasTipCapBufferPercent:          cfg.KeeperGasTipCapBufferPercent(),
+			KeyRotationGracePeriod:                cfg.KeyRotationGracePeriod(),
 			LogLevel:                              config.LogLevel{Level: cfg.LogLevel()},
 			LogSQLMigrations:                      cfg.LogSQLMigrations(),
 			LogSQLStatements:                      cfg.LogSQLStatements(),
@@ -182,9 +202,17 @@ func NewConfigPrinter(cfg config.GeneralConfig) (ConfigPrinter, error) {
 			TLSHost:                               cfg.TLSHost(),
 			TLSPort:                               cfg.TLSPort(),
 			TLSRedirect:                           cfg.TLSRedirect(),
+			TelemetryIngressBufferSize:            cfg.TelemetryIngressBufferSize(),
 			TelemetryIngressLogging:               cfg.TelemetryIngressLogging(),
+			TelemetryIngressMaxBatchSize:          cfg.TelemetryIngressMaxBatchSize(),
+			TelemetryIngressSendInterval:          cfg.TelemetryIngressSendInterval(),
 			TelemetryIngressServerPubKey:          cfg.TelemetryIngressServerPubKey(),
+			TelemetryIngressSpoolDir:              cfg.TelemetryIngressSpoolDir(),
+			TelemetryIngressSpoolMaxSize:          cfg.TelemetryIngressSpoolMaxSize(),
 			TelemetryIngressURL:                   telemetryIngressURL,
+			TelemetryIngressUseBatchSend:          cfg.TelemetryIngressUseBatchSend(),
+			TelemetryLocalEnabled:                 cfg.TelemetryLocalEnabled(),
+			TelemetryLocalRetention:               cfg.TelemetryLocalRetention(),
 			TriggerFallbackDBPollInterval:         cfg.TriggerFallbackDBPollInterval(),
 		},
 	}, nil
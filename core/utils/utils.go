@@ -11,6 +11,7 @@ import (
 	"math/big"
 	mrand "math/rand"
 	"reflect"
+	"regexp"
 	"runtime"
 	"sort"
 	"strings"
@@ -28,6 +29,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/tevino/abool"
 	"go.uber.org/atomic"
+	"go.uber.org/multierr"
 	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/crypto/sha3"
 	null "gopkg.in/guregu/null.v4"
@@ -330,6 +332,62 @@ func CheckPasswordHash(password, hash string) bool {
 	return err == nil
 }
 
+// VerifyPasswordComplexity checks that a keystore password meets the password
+// policy, and returns every requirement it fails to meet
+func VerifyPasswordComplexity(password string) error {
+	// Password policy:
+	//
+	// Must be longer than 12 characters
+	// Must comprise at least 3 of:
+	//     lowercase characters
+	//     uppercase characters
+	//     numbers
+	//     symbols
+	// Must not comprise:
+	//     A user's API email
+	//     More than three identical consecutive characters
+
+	var (
+		lowercase = regexp.MustCompile("[a-z]")
+		uppercase = regexp.MustCompile("[A-Z]")
+		numbers   = regexp.MustCompile("[0-9]")
+		symbols   = regexp.MustCompile(`[!@#$%^&*()-=_+\[\]\\|;:'",<.>/?~` + "`]")
+	)
+
+	var merr error
+	if len(password) <= 12 {
+		merr = multierr.Append(merr, fmt.Errorf("must be longer than 12 characters"))
+	}
+	if len(lowercase.FindAllString(password, -1)) < 3 {
+		merr = multierr.Append(merr, fmt.Errorf("must contain at least 3 lowercase characters"))
+	}
+	if len(uppercase.FindAllString(password, -1)) < 3 {
+		merr = multierr.Append(merr, fmt.Errorf("must contain at least 3 uppercase characters"))
+	}
+	if len(numbers.FindAllString(password, -1)) < 3 {
+		merr = multierr.Append(merr, fmt.Errorf("must contain at least 3 numbers"))
+	}
+	if len(symbols.FindAllString(password, -1)) < 3 {
+		merr = multierr.Append(merr, fmt.Errorf("must contain at least 3 symbols"))
+	}
+	var c byte
+	var instances int
+	for i := 0; i < len(password); i++ {
+		if password[i] == c {
+			instances++
+		} else {
+			instances = 1
+		}
+		if instances > 3 {
+			merr = multierr.Append(merr, fmt.Errorf("must not contain more than 3 identical consecutive characters"))
+			break
+		}
+		c = password[i]
+	}
+
+	return merr
+}
+
 // Keccak256 is a simplified interface for the legacy SHA3 implementation that
 // Ethereum uses.
 func Keccak256(in []byte) ([]byte, error) {
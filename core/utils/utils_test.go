@@ -126,6 +126,36 @@ func TestKeccak256(t *testing.T) {
 	}
 }
 
+func TestUtils_VerifyPasswordComplexity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		password string
+		errors   []string
+	}{
+		{cltest.Password, nil},
+		{"abcABC123!@#", []string{"must be longer than 12 characters"}},
+		{"abcdABCD!@#$", []string{"must be longer than 12 characters", "must contain at least 3 numbers"}},
+		{"aaaaABC123!@#", []string{"must not contain more than 3 identical consecutive characters"}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.password, func(t *testing.T) {
+			err := utils.VerifyPasswordComplexity(test.password)
+			if test.errors == nil {
+				assert.NoError(t, err)
+				return
+			}
+			errs := multierr.Errors(err)
+			require.Len(t, errs, len(test.errors))
+			for i, e := range errs {
+				assert.EqualError(t, e, test.errors[i])
+			}
+		})
+	}
+}
+
 // From https://github.com/ethereum/EIPs/blob/master/EIPS/eip-55.md#test-cases
 var testAddresses = []string{
 	"0x52908400098527886E0F7030069857D2E4169EE7",
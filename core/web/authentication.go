@@ -2,6 +2,7 @@ package web
 
 import (
 	"database/sql"
+	"fmt"
 	"net/http"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
@@ -24,7 +25,7 @@ const (
 type AuthStorer interface {
 	AuthorizedUserWithSession(sessionID string) (clsessions.User, error)
 	FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error)
-	FindUser() (clsessions.User, error)
+	FindUserByAPIToken(apiToken string) (clsessions.User, error)
 }
 
 type authType func(store AuthStorer, ctx *gin.Context) error
@@ -37,6 +38,16 @@ func authenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	return obj.(*clsessions.User), ok
 }
 
+// currentUser reloads the authenticated user from the database, so that
+// changes made earlier in the request are visible.
+func currentUser(orm clsessions.ORM, c *gin.Context) (clsessions.User, error) {
+	user, ok := authenticatedUser(c)
+	if !ok {
+		return clsessions.User{}, errors.New("no authenticated user")
+	}
+	return orm.FindUser(user.Email)
+}
+
 func AuthenticateExternalInitiator(store AuthStorer, c *gin.Context) error {
 	eia := &auth.Token{
 		AccessKey: c.GetHeader(static.ExternalInitiatorAccessKeyHeader),
@@ -80,7 +91,7 @@ func AuthenticateByToken(store AuthStorer, c *gin.Context) error {
 		Secret:    c.GetHeader(APISecret),
 	}
 
-	user, err := store.FindUser()
+	user, err := store.FindUserByAPIToken(token.AccessKey)
 	if errors.Is(err, sql.ErrNoRows) {
 		return auth.ErrorAuthFailed
 	} else if err != nil {
@@ -133,3 +144,24 @@ func RequireAuth(store AuthStorer, methods ...authType) gin.HandlerFunc {
 		}
 	}
 }
+
+// RequireRole aborts the request unless the authenticated user has at least
+// the given role. It must be used after RequireAuth. Requests authenticated as
+// an external initiator are let through, since external initiators are only
+// ever granted access to the routes they need.
+func RequireRole(role clsessions.UserRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if user, ok := authenticatedUser(c); ok {
+			if !user.Role.Permits(role) {
+				c.Abort()
+				jsonAPIError(c, http.StatusForbidden, fmt.Errorf("this action requires the %s role", role))
+				return
+			}
+		} else if _, ok := authenticatedEI(c); !ok {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, auth.ErrorAuthFailed)
+			return
+		}
+		c.Next()
+	}
+}
@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"database/sql"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -33,7 +34,7 @@ type userFindFailer struct {
 	err error
 }
 
-func (u userFindFailer) FindUser() (sessions.User, error) {
+func (u userFindFailer) FindUserByAPIToken(string) (sessions.User, error) {
 	return sessions.User{}, u.err
 }
 
@@ -42,7 +43,10 @@ type userFindSuccesser struct {
 	user sessions.User
 }
 
-func (u userFindSuccesser) FindUser() (sessions.User, error) {
+func (u userFindSuccesser) FindUserByAPIToken(apiToken string) (sessions.User, error) {
+	if apiToken != u.user.TokenKey.ValueOrZero() {
+		return sessions.User{}, sql.ErrNoRows
+	}
 	return u.user, nil
 }
 
@@ -163,3 +167,65 @@ func TestRequireAuth_Error(t *testing.T) {
 	assert.False(t, called)
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
+
+func TestRequireRole(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		role     sessions.UserRole
+		required sessions.UserRole
+		wantCode int
+	}{
+		{"view user on view route", sessions.UserRoleView, sessions.UserRoleView, http.StatusOK},
+		{"view user on run route", sessions.UserRoleView, sessions.UserRoleRun, http.StatusForbidden},
+		{"run user on run route", sessions.UserRoleRun, sessions.UserRoleRun, http.StatusOK},
+		{"edit user on run route", sessions.UserRoleEdit, sessions.UserRoleRun, http.StatusOK},
+		{"edit user on admin route", sessions.UserRoleEdit, sessions.UserRoleAdmin, http.StatusForbidden},
+		{"admin user on admin route", sessions.UserRoleAdmin, sessions.UserRoleAdmin, http.StatusOK},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			user := cltest.MustRandomUser(t, test.role)
+			apiToken := auth.Token{AccessKey: cltest.APIKey, Secret: cltest.APISecret}
+			require.NoError(t, user.SetAuthToken(&apiToken))
+			store := userFindSuccesser{user: user}
+
+			called := false
+			router := gin.New()
+			router.Use(web.RequireAuth(store, web.AuthenticateByToken), web.RequireRole(test.required))
+			router.GET("/", func(c *gin.Context) {
+				called = true
+				c.String(http.StatusOK, "")
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/", nil)
+			req.Header.Set(web.APIKey, cltest.APIKey)
+			req.Header.Set(web.APISecret, cltest.APISecret)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, test.wantCode == http.StatusOK, called)
+			assert.Equal(t, http.StatusText(test.wantCode), http.StatusText(w.Code))
+		})
+	}
+}
+
+func TestRequireRole_Unauthenticated(t *testing.T) {
+	called := false
+	router := gin.New()
+	router.Use(web.RequireRole(sessions.UserRoleView))
+	router.GET("/", func(c *gin.Context) {
+		called = true
+		c.String(http.StatusOK, "")
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/", nil)
+	router.ServeHTTP(w, req)
+
+	assert.False(t, called)
+	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
+}
@@ -49,6 +49,15 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest, orm bridges.ORM) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if bt.RequestsPerSecond < 0 {
+		fe.Add("RequestsPerSecond must not be negative")
+	}
+	if bt.CacheTTL < 0 || bt.CircuitBreakerTimeout < 0 || bt.MaxStaleness < 0 {
+		fe.Add("CacheTTL, CircuitBreakerTimeout and MaxStaleness must not be negative")
+	}
+	if bt.CircuitBreakerThreshold > 0 && bt.CircuitBreakerTimeout == 0 {
+		fe.Add("CircuitBreakerTimeout must be set to use a circuit breaker")
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -100,6 +101,40 @@ func TestValidateBridgeType(t *testing.T) {
 				URL:  cltest.WebURL(t, "https://denergy.eth"),
 			},
 			nil,
+		},
+		{
+			"valid limits",
+			bridges.BridgeTypeRequest{
+				Name: "limitedadapter",
+				URL:  cltest.WebURL(t, "https://denergy.eth"),
+				BridgeLimits: bridges.BridgeLimits{
+					MaxConcurrency:          5,
+					RequestsPerSecond:       0.5,
+					CacheTTL:                models.Interval(10 * time.Second),
+					CircuitBreakerThreshold: 3,
+					CircuitBreakerTimeout:   models.Interval(time.Minute),
+					MaxStaleness:            models.Interval(5 * time.Minute),
+				},
+			},
+			nil,
+		},
+		{
+			"invalid negative RequestsPerSecond",
+			bridges.BridgeTypeRequest{
+				Name:         "limitedadapter",
+				URL:          cltest.WebURL(t, "https://denergy.eth"),
+				BridgeLimits: bridges.BridgeLimits{RequestsPerSecond: -1},
+			},
+			models.NewJSONAPIErrorsWith("RequestsPerSecond must not be negative"),
+		},
+		{
+			"invalid circuit breaker without timeout",
+			bridges.BridgeTypeRequest{
+				Name:         "limitedadapter",
+				URL:          cltest.WebURL(t, "https://denergy.eth"),
+				BridgeLimits: bridges.BridgeLimits{CircuitBreakerThreshold: 3},
+			},
+			models.NewJSONAPIErrorsWith("CircuitBreakerTimeout must be set to use a circuit breaker"),
 		}}
 
 	for _, test := range tests {
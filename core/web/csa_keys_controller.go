@@ -59,3 +59,23 @@ func (ctrl *CSAKeysController) Export(c *gin.Context) {
 	}
 	c.Data(http.StatusOK, MediaType, bytes)
 }
+
+// Rotate replaces a CSA key by a new key, and updates the jobs using it
+// Example:
+// "POST <application>/keys/csa/rotate/:keyID"
+func (ctrl *CSAKeysController) Rotate(c *gin.Context) {
+	id := c.Param("keyID")
+	if _, err := ctrl.App.GetKeyStore().CSA().Get(id); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	key, err := ctrl.App.GetKeyRotator().RotateCSAKey(c.Request.Context(), id)
+	if errors.Is(err, keystore.ErrKeyRetired) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewCSAKeyResource(key), "csaKeys")
+}
@@ -69,9 +69,11 @@ func (ekc *ETHKeysController) Index(c *gin.Context) {
 	jsonAPIResponse(c, resources, "keys")
 }
 
-// Create adds a new account
+// Create adds a new account, or an external account whose private key is held
+// by the external signer
 // Example:
 //  "<application>/keys/eth"
+//  "<application>/keys/eth?externalAddress=0x..."
 func (ekc *ETHKeysController) Create(c *gin.Context) {
 	ethKeyStore := ekc.App.GetKeyStore().Eth()
 
@@ -87,7 +89,16 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 		return
 	}
 
-	key, err := ethKeyStore.Create(chain.ID())
+	var key ethkey.KeyV2
+	if externalAddress := c.Query("externalAddress"); externalAddress != "" {
+		if !common.IsHexAddress(externalAddress) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid external address: %s", externalAddress))
+			return
+		}
+		key, err = ethKeyStore.AddExternal(common.HexToAddress(externalAddress), chain.ID())
+	} else {
+		key, err = ethKeyStore.Create(chain.ID())
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
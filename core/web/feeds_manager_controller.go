@@ -164,3 +164,85 @@ func (fmc *FeedsManagerController) Update(c *gin.Context) {
 		http.StatusOK,
 	)
 }
+
+// UpdateApprovalPolicyRequest represents a JSONAPI request for updating the
+// approval policy of a feeds manager
+type UpdateApprovalPolicyRequest struct {
+	AutoApproveJobTypes     []string `json:"autoApproveJobTypes"`
+	AutoApproveUpdateFields []string `json:"autoApproveUpdateFields"`
+	RequiredApprovals       int32    `json:"requiredApprovals"`
+}
+
+// ShowApprovalPolicy retrieves the approval policy of a feeds manager
+// Example:
+// "GET <application>/feeds_managers/<id>/approval_policy"
+func (fmc *FeedsManagerController) ShowApprovalPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	policy, err := fmc.App.GetFeedsService().GetApprovalPolicy(c.Request.Context(), id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewApprovalPolicyResource(*policy), "approval_policies")
+}
+
+// UpdateApprovalPolicy replaces the approval policy of a feeds manager
+// Example:
+// "PATCH <application>/feeds_managers/<id>/approval_policy"
+func (fmc *FeedsManagerController) UpdateApprovalPolicy(c *gin.Context) {
+	request := UpdateApprovalPolicyRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	policy := feeds.ApprovalPolicy{
+		FeedsManagerID:          id,
+		AutoApproveJobTypes:     request.AutoApproveJobTypes,
+		AutoApproveUpdateFields: request.AutoApproveUpdateFields,
+		RequiredApprovals:       request.RequiredApprovals,
+	}
+	if policy.AutoApproveJobTypes == nil {
+		policy.AutoApproveJobTypes = []string{}
+	}
+	if policy.AutoApproveUpdateFields == nil {
+		policy.AutoApproveUpdateFields = []string{}
+	}
+
+	feedsService := fmc.App.GetFeedsService()
+
+	err = feedsService.UpdateApprovalPolicy(c.Request.Context(), policy)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("feeds Manager not found"))
+			return
+		}
+
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	updated, err := feedsService.GetApprovalPolicy(c.Request.Context(), id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c,
+		presenters.NewApprovalPolicyResource(*updated),
+		"approval_policies",
+		http.StatusOK,
+	)
+}
@@ -186,6 +186,72 @@ func Test_FeedsManagersController_Show(t *testing.T) {
 	}
 }
 
+func Test_FeedsManagersController_ApprovalPolicy(t *testing.T) {
+	t.Parallel()
+
+	app, client := setupFeedsManagerTest(t)
+
+	pubKey, err := crypto.PublicKeyFromHex("3b0f149627adb7b6fafe1497a9dfc357f22295a5440786c3bc566dfdb0176808")
+	require.NoError(t, err)
+
+	fsvc := app.GetFeedsService()
+	msID, err := fsvc.RegisterManager(&feeds.FeedsManager{
+		Name:      "Chainlink FM",
+		URI:       "wss://127.0.0.1:2000",
+		JobTypes:  []string{"fluxmonitor"},
+		PublicKey: *pubKey,
+	})
+	require.NoError(t, err)
+	path := fmt.Sprintf("/v2/feeds_managers/%d/approval_policy", msID)
+
+	// Every version requires a single approval until a policy is set
+	resp, cleanup := client.Get(path)
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resource := presenters.ApprovalPolicyResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(int(msID)), resource.ID)
+	assert.Empty(t, resource.AutoApproveJobTypes)
+	assert.Equal(t, int32(1), resource.RequiredApprovals)
+
+	body, err := json.Marshal(web.UpdateApprovalPolicyRequest{
+		AutoApproveJobTypes:     []string{"fluxmonitor"},
+		AutoApproveUpdateFields: []string{"threshold"},
+		RequiredApprovals:       2,
+	})
+	require.NoError(t, err)
+
+	resp, cleanup = client.Patch(path, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resource = presenters.ApprovalPolicyResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fluxmonitor"}, resource.AutoApproveJobTypes)
+	assert.Equal(t, []string{"threshold"}, resource.AutoApproveUpdateFields)
+	assert.Equal(t, int32(2), resource.RequiredApprovals)
+
+	body, err = json.Marshal(web.UpdateApprovalPolicyRequest{
+		AutoApproveJobTypes: []string{"webhook"},
+		RequiredApprovals:   1,
+	})
+	require.NoError(t, err)
+
+	resp, cleanup = client.Patch(path, bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	body, err = json.Marshal(web.UpdateApprovalPolicyRequest{RequiredApprovals: 1})
+	require.NoError(t, err)
+
+	resp, cleanup = client.Patch("/v2/feeds_managers/999999999/approval_policy", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
 func setupFeedsManagerTest(t *testing.T) (*cltest.TestApplication, cltest.HTTPClientCleaner) {
 	app := cltest.NewApplication(t)
 	require.NoError(t, app.Start())
@@ -0,0 +1,23 @@
+package web
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/funding"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// FundingTransfersController displays the audit log of the top-ups sent by
+// treasury keys
+type FundingTransfersController struct {
+	App chainlink.Application
+}
+
+// Index returns paginated top-ups of every chain, most recent first
+// Example:
+// "GET <application>/funding_transfers"
+func (fc *FundingTransfersController) Index(c *gin.Context, size, page, offset int) {
+	transfers, count, err := funding.NewORM(fc.App.GetDB()).ListTransfers(offset, size)
+	paginatedResponse(c, "fundingTransfers", size, page, presenters.NewFundingTransferResources(transfers), count, err)
+}
@@ -0,0 +1,48 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/funding"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestFundingTransfersController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	_, treasury := cltest.MustInsertRandomKey(t, app.KeyStore.Eth())
+	to := cltest.NewAddress()
+	transfer := funding.Transfer{
+		EVMChainID:  *utils.NewBig(&cltest.FixtureChainID),
+		FromAddress: treasury,
+		ToAddress:   to,
+		Amount:      assets.NewEthValue(5000),
+		Balance:     assets.NewEthValue(999),
+		MinBalance:  assets.NewEthValue(1000),
+	}
+	require.NoError(t, funding.NewORM(app.GetDB()).CreateTransfer(&transfer, 21000))
+
+	resp, cleanup := client.Get("/v2/funding_transfers?size=10")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var transfers []presenters.FundingTransferResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &transfers))
+	require.Len(t, transfers, 1)
+	assert.Equal(t, treasury, transfers[0].FromAddress)
+	assert.Equal(t, to, transfers[0].ToAddress)
+	assert.Equal(t, "5000", transfers[0].Amount)
+	assert.Equal(t, "999", transfers[0].Balance)
+	assert.Equal(t, "unstarted", transfers[0].EthTxState.String)
+}
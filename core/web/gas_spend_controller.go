@@ -0,0 +1,91 @@
+package web
+
+import (
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// defaultGasSpendPeriod is how far back the gas spend report goes if the
+// request does not specify a start
+const defaultGasSpendPeriod = 30 * 24 * time.Hour
+
+// GasSpendController reports the fees paid by confirmed transactions
+type GasSpendController struct {
+	App chainlink.Application
+}
+
+// Index reports the fees paid per job, key or day, optionally of one chain
+// or job. from and to are RFC3339 times or dates, and default to the last 30
+// days.
+// Example:
+// "GET <application>/gas_spend?groupBy=job&evmChainID=1&from=2021-11-01&to=2021-12-01"
+func (gc *GasSpendController) Index(c *gin.Context) {
+	req := bulletprooftxmanager.GasSpendRequest{
+		GroupBy: bulletprooftxmanager.GasSpendGroupBy(c.DefaultQuery("groupBy", string(bulletprooftxmanager.GasSpendByJob))),
+		To:      time.Now(),
+	}
+	switch req.GroupBy {
+	case bulletprooftxmanager.GasSpendByJob, bulletprooftxmanager.GasSpendByKey, bulletprooftxmanager.GasSpendByDay:
+	default:
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid groupBy %q, must be one of: job, key, day", req.GroupBy))
+		return
+	}
+
+	var err error
+	if param := c.Query("to"); param != "" {
+		if req.To, err = parseReportTime(param); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid to"))
+			return
+		}
+	}
+	req.From = req.To.Add(-defaultGasSpendPeriod)
+	if param := c.Query("from"); param != "" {
+		if req.From, err = parseReportTime(param); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid from"))
+			return
+		}
+	}
+	if param := c.Query("evmChainID"); param != "" {
+		chainID, ok := new(big.Int).SetString(param, 10)
+		if !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, ErrInvalidChainID)
+			return
+		}
+		req.EVMChainID = utils.NewBig(chainID)
+	}
+	if param := c.Query("jobID"); param != "" {
+		jobID, err := strconv.ParseInt(param, 10, 32)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid jobID %q", param))
+			return
+		}
+		id := int32(jobID)
+		req.JobID = &id
+	}
+
+	spend, err := gc.App.BPTXMORM().GasSpend(req)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewGasSpendResources(req.GroupBy, spend), "gasSpend")
+}
+
+// parseReportTime parses an RFC3339 time, or a date as midnight UTC
+func parseReportTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", s)
+}
@@ -0,0 +1,52 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestGasSpendController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	from := cltest.NewAddress()
+	require.NoError(t, app.GetDB().Exec(`INSERT INTO eth_tx_fees (eth_tx_id, evm_chain_id, job_id, from_address, tx_hash, block_number, gas_used, effective_gas_price, fee, confirmed_at)
+		VALUES (1, 0, 7, ?, ?, 1, 21000, 1000000000, 21000000000000, ?)`, from, utils.NewHash(), time.Now().Add(-time.Hour)).Error)
+
+	resp, cleanup := client.Get("/v2/gas_spend?groupBy=month")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/gas_spend?from=yesterday")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/gas_spend?groupBy=job&evmChainID=0")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var spend []presenters.GasSpendResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &spend))
+	require.Len(t, spend, 1)
+	assert.Equal(t, "0/job/7", spend[0].ID)
+	assert.Equal(t, int64(1), spend[0].TxCount)
+	assert.Equal(t, "21000000000000", spend[0].TotalFee)
+	assert.Equal(t, "0.000021000000000000", spend[0].TotalFeeEth)
+
+	resp, cleanup = client.Get("/v2/gas_spend?groupBy=key&jobID=8")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var none []presenters.GasSpendResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &none))
+	assert.Len(t, none, 0)
+}
@@ -54,9 +54,55 @@ func (jpc *JobProposalsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobProposalResource(*jp), "job_proposals")
 }
 
-// Approve approves a job proposal.
+// Specs returns the versions of the spec of a JobProposal, latest first
 // Example:
-// "POST <application>/job_proposals/<id>/reject"
+//  "<application>/job_proposals/:id/specs
+func (jpc *JobProposalsController) Specs(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	feedsSvc := jpc.App.GetFeedsService()
+
+	specs, err := feedsSvc.ListJobProposalSpecs(c.Request.Context(), id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobProposalSpecResources(specs), "job_proposal_specs")
+}
+
+// PolicyDecisions returns the audit trail of the approval policy decisions
+// made on a JobProposal, latest first
+// Example:
+//  "<application>/job_proposals/:id/policy_decisions
+func (jpc *JobProposalsController) PolicyDecisions(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	feedsSvc := jpc.App.GetFeedsService()
+
+	decisions, err := feedsSvc.ListPolicyDecisions(c.Request.Context(), id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewApprovalPolicyDecisionResources(decisions), "approval_policy_decisions")
+}
+
+// Approve approves a version of a job proposal, which defaults to the latest
+// version, on behalf of the authenticated user. The version stays pending
+// until the number of approvals required by the approval policy of the feeds
+// manager is reached.
+// Example:
+// "POST <application>/job_proposals/<id>/approve?version=<version>"
 func (jpc *JobProposalsController) Approve(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
 	if err != nil {
@@ -64,9 +110,20 @@ func (jpc *JobProposalsController) Approve(c *gin.Context) {
 		return
 	}
 
+	version, err := parseSpecVersion(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var approver string
+	if user, ok := authenticatedUser(c); ok {
+		approver = user.Email
+	}
+
 	feedsSvc := jpc.App.GetFeedsService()
 
-	err = feedsSvc.ApproveJobProposal(c.Request.Context(), id)
+	err = feedsSvc.ApproveJobProposal(c.Request.Context(), id, version, approver)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 
@@ -96,9 +153,10 @@ func (jpc *JobProposalsController) Approve(c *gin.Context) {
 	)
 }
 
-// Reject rejects a job proposal.
+// Reject rejects a version of a job proposal, which defaults to the latest
+// version.
 // Example:
-// "POST <application>/job_proposals/<id>/reject"
+// "POST <application>/job_proposals/<id>/reject?version=<version>"
 func (jpc *JobProposalsController) Reject(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
 	if err != nil {
@@ -106,9 +164,15 @@ func (jpc *JobProposalsController) Reject(c *gin.Context) {
 		return
 	}
 
+	version, err := parseSpecVersion(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
 	feedsSvc := jpc.App.GetFeedsService()
 
-	err = feedsSvc.RejectJobProposal(c.Request.Context(), id)
+	err = feedsSvc.RejectJobProposal(c.Request.Context(), id, version)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			jsonAPIError(c, http.StatusNotFound, errors.New("job proposal not found"))
@@ -137,9 +201,10 @@ func (jpc *JobProposalsController) Reject(c *gin.Context) {
 	)
 }
 
-// Cancel cancels a job proposal and deletes its associated running job.
+// Cancel cancels the approved version of a job proposal and deletes its
+// associated running job.
 // Example:
-// "POST <application>/job_proposals/<id>/cancel"
+// "POST <application>/job_proposals/<id>/cancel?version=<version>"
 func (jpc *JobProposalsController) Cancel(c *gin.Context) {
 	logger.Debug("Cancelling Job Proposal")
 
@@ -149,9 +214,15 @@ func (jpc *JobProposalsController) Cancel(c *gin.Context) {
 		return
 	}
 
+	version, err := parseSpecVersion(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
 	feedsSvc := jpc.App.GetFeedsService()
 
-	err = feedsSvc.CancelJobProposal(c.Request.Context(), id)
+	err = feedsSvc.CancelJobProposal(c.Request.Context(), id, version)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			jsonAPIError(c, http.StatusNotFound, errors.New("job proposal not found"))
@@ -185,9 +256,10 @@ type UpdateSpecRequest struct {
 	Spec string `json:"spec"`
 }
 
-// UpdateSpec updates the spec of a job proposal
+// UpdateSpec updates the spec of a version of a job proposal, which defaults
+// to the latest version.
 // Example:
-// "PATCH <application>/job_proposals/<id>/spec"
+// "PATCH <application>/job_proposals/<id>/spec?version=<version>"
 func (jpc *JobProposalsController) UpdateSpec(c *gin.Context) {
 	request := UpdateSpecRequest{}
 	if err := c.ShouldBindJSON(&request); err != nil {
@@ -201,9 +273,15 @@ func (jpc *JobProposalsController) UpdateSpec(c *gin.Context) {
 		return
 	}
 
+	version, err := parseSpecVersion(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
 	feedsSvc := jpc.App.GetFeedsService()
 
-	err = feedsSvc.UpdateJobProposalSpec(c.Request.Context(), id, request.Spec)
+	err = feedsSvc.UpdateJobProposalSpec(c.Request.Context(), id, version, request.Spec)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			jsonAPIError(c, http.StatusNotFound, errors.New("job proposal not found"))
@@ -231,3 +309,19 @@ func (jpc *JobProposalsController) UpdateSpec(c *gin.Context) {
 		http.StatusOK,
 	)
 }
+
+// parseSpecVersion parses the optional version query param, returning 0 when
+// it is not set.
+func parseSpecVersion(c *gin.Context) (int32, error) {
+	v := c.Query("version")
+	if v == "" {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(version), nil
+}
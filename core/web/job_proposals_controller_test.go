@@ -137,6 +137,47 @@ func Test_JobProposalsController_Show(t *testing.T) {
 	}
 }
 
+func Test_JobProposalsController_Specs(t *testing.T) {
+	t.Parallel()
+
+	var (
+		spec = string(cltest.MustReadFile(t, "../testdata/tomlspecs/flux-monitor-spec.toml"))
+		jp   = feeds.JobProposal{
+			ID:             1,
+			RemoteUUID:     uuid.NewV4(),
+			Spec:           spec,
+			Status:         feeds.JobProposalStatusPending,
+			ExternalJobID:  uuid.NullUUID{},
+			FeedsManagerID: 10,
+		}
+	)
+
+	ctrl := setupJobProposalsTest(t)
+
+	// Propose two versions of the job
+	fsvc := ctrl.app.GetFeedsService()
+	id, err := fsvc.ProposeJob(&jp, 0)
+	require.NoError(t, err)
+	_, err = fsvc.ProposeJob(&jp, 3)
+	require.NoError(t, err)
+
+	resp, cleanup := ctrl.client.Get(fmt.Sprintf("/v2/job_proposals/%d/specs", id))
+	t.Cleanup(cleanup)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resources := []presenters.JobProposalSpecResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources)
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+
+	assert.Equal(t, int32(3), resources[0].Version)
+	assert.Equal(t, int32(1), resources[1].Version)
+	for _, r := range resources {
+		assert.Equal(t, spec, r.Definition)
+		assert.Equal(t, feeds.SpecStatusPending, r.Status)
+	}
+}
+
 func Test_JobProposalsController_Approve(t *testing.T) {
 	t.Parallel()
 
@@ -166,7 +207,7 @@ func Test_JobProposalsController_Approve(t *testing.T) {
 			before: func(t *testing.T, ctrl *TestJobProposalsController, id *string) {
 				fsvc := ctrl.app.GetFeedsService()
 
-				jp1ID, err := fsvc.CreateJobProposal(&jp1)
+				jp1ID, err := fsvc.ProposeJob(&jp1, 0)
 				require.NoError(t, err)
 
 				*id = strconv.Itoa(int(jp1ID))
@@ -174,7 +215,8 @@ func Test_JobProposalsController_Approve(t *testing.T) {
 				ctrl.connMgr.On("GetClient", jp1.FeedsManagerID).Return(rpcClient, nil)
 
 				rpcClient.On("ApprovedJob", mock.MatchedBy(func(c context.Context) bool { return true }), &pb.ApprovedJobRequest{
-					Uuid: jp1.RemoteUUID.String(),
+					Uuid:    jp1.RemoteUUID.String(),
+					Version: 1,
 				}).Return(&pb.ApprovedJobResponse{}, nil)
 			},
 			wantStatusCode: http.StatusOK,
@@ -254,7 +296,7 @@ func Test_JobProposalsController_Reject(t *testing.T) {
 			before: func(t *testing.T, ctrl *TestJobProposalsController, id *string) {
 				fsvc := ctrl.app.GetFeedsService()
 
-				jp1ID, err := fsvc.CreateJobProposal(&jp1)
+				jp1ID, err := fsvc.ProposeJob(&jp1, 0)
 				require.NoError(t, err)
 
 				ctrl.connMgr.On("GetClient", jp1.FeedsManagerID).Return(rpcClient, nil)
@@ -262,7 +304,8 @@ func Test_JobProposalsController_Reject(t *testing.T) {
 				*id = strconv.Itoa(int(jp1ID))
 
 				rpcClient.On("RejectedJob", mock.MatchedBy(func(c context.Context) bool { return true }), &pb.RejectedJobRequest{
-					Uuid: jp1.RemoteUUID.String(),
+					Uuid:    jp1.RemoteUUID.String(),
+					Version: 1,
 				}).Return(&pb.RejectedJobResponse{}, nil)
 			},
 			wantStatusCode: http.StatusOK,
@@ -341,16 +384,17 @@ func Test_JobProposalsController_Cancel(t *testing.T) {
 			before: func(t *testing.T, ctrl *TestJobProposalsController, id *string) {
 				fsvc := ctrl.app.GetFeedsService()
 
-				jp1ID, err := fsvc.CreateJobProposal(&jp1)
+				jp1ID, err := fsvc.ProposeJob(&jp1, 0)
 				require.NoError(t, err)
 				fmt.Println(jp1ID)
 
 				ctrl.connMgr.On("GetClient", jp1.FeedsManagerID).Return(rpcClient, nil)
 				rpcClient.On("ApprovedJob", mock.MatchedBy(func(c context.Context) bool { return true }), &pb.ApprovedJobRequest{
-					Uuid: jp1.RemoteUUID.String(),
+					Uuid:    jp1.RemoteUUID.String(),
+					Version: 1,
 				}).Return(&pb.ApprovedJobResponse{}, nil)
 
-				err = fsvc.ApproveJobProposal(context.Background(), jp1ID)
+				err = fsvc.ApproveJobProposal(context.Background(), jp1ID, 1, "")
 				require.NoError(t, err)
 
 				time.Sleep(5 * time.Second)
@@ -360,7 +404,8 @@ func Test_JobProposalsController_Cancel(t *testing.T) {
 				*id = strconv.Itoa(int(jp1ID))
 
 				rpcClient.On("CancelledJob", mock.MatchedBy(func(c context.Context) bool { return true }), &pb.CancelledJobRequest{
-					Uuid: jp1.RemoteUUID.String(),
+					Uuid:    jp1.RemoteUUID.String(),
+					Version: 1,
 				}).Return(&pb.CancelledJobResponse{}, nil)
 			},
 			wantStatusCode: http.StatusOK,
@@ -440,7 +485,7 @@ func Test_JobProposalsController_UpdateSpec(t *testing.T) {
 			before: func(t *testing.T, ctrl *TestJobProposalsController, id *string) {
 				fsvc := ctrl.app.GetFeedsService()
 
-				jp1ID, err := fsvc.CreateJobProposal(&jp1)
+				jp1ID, err := fsvc.ProposeJob(&jp1, 0)
 				require.NoError(t, err)
 
 				*id = strconv.Itoa(int(jp1ID))
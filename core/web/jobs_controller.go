@@ -91,53 +91,152 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
-	jobType, err := job.ValidateSpec(request.TOML)
+	jb, status, err := jc.validateJobSpec(request.TOML)
 	if err != nil {
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML"))
+		jsonAPIError(c, status, err)
 		return
 	}
 
-	var jb job.Job
+	jb, err = jc.App.AddJobV2(c.Request.Context(), jb, jb.Name)
+	if err != nil {
+		jsonAPIError(c, jobErrorStatus(err), err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// UpdateJobRequest represents a request to replace the spec of an existing
+// job (V2).
+type UpdateJobRequest struct {
+	TOML string `json:"toml"`
+}
+
+// Update validates a new spec and replaces the spec of an existing job with
+// it. The job keeps its ID and run history; its type cannot be changed.
+// Example:
+// "PATCH <application>/jobs/:ID"
+func (jc *JobsController) Update(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	request := UpdateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	jb, err = jc.App.UpdateJob(c.Request.Context(), j.ID, jb)
+	if errors.Cause(err) == gorm.ErrRecordNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, jobErrorStatus(err), err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// Pause stops a job's services without deleting it.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	jc.setPaused(c, true)
+}
+
+// Resume restarts the services of a paused job.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	jc.setPaused(c, false)
+}
+
+func (jc *JobsController) setPaused(c *gin.Context, paused bool) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var err error
+	if paused {
+		err = jc.App.PauseJob(c.Request.Context(), j.ID)
+	} else {
+		err = jc.App.UnpauseJob(c.Request.Context(), j.ID)
+	}
+	if errors.Cause(err) == gorm.ErrRecordNotFound {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jb, err := jc.App.JobORM().FindJobTx(j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// validateJobSpec parses and validates a TOML job spec, returning the HTTP
+// status to respond with if it is invalid
+func (jc *JobsController) validateJobSpec(tomlString string) (jb job.Job, status int, err error) {
+	jobType, err := job.ValidateSpec(tomlString)
+	if err != nil {
+		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
+	}
+
 	config := jc.App.GetConfig()
 	switch jobType {
 	case job.OffchainReporting:
-		jb, err = offchainreporting.ValidatedOracleSpecToml(jc.App.GetChainSet(), request.TOML)
+		jb, err = offchainreporting.ValidatedOracleSpecToml(jc.App.GetChainSet(), tomlString)
 		if !config.Dev() && !config.FeatureOffchainReporting() {
-			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration"))
-			return
+			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(request.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(tomlString)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetConfig(), request.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetConfig(), tomlString)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(request.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(tomlString)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(request.TOML)
+		jb, err = cron.ValidatedCronSpec(tomlString)
 	case job.VRF:
-		jb, err = vrf.ValidatedVRFSpec(request.TOML)
+		jb, err = vrf.ValidatedVRFSpec(tomlString)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(request.TOML, jc.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(tomlString, jc.App.GetExternalInitiatorManager())
 	default:
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType))
-		return
+		return jb, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType)
 	}
 	if err != nil {
-		jsonAPIError(c, http.StatusBadRequest, err)
-		return
+		return jb, http.StatusBadRequest, err
 	}
+	return jb, http.StatusOK, nil
+}
 
-	jb, err = jc.App.AddJobV2(c.Request.Context(), jb, jb.Name)
-	if err != nil {
-		if errors.Cause(err) == job.ErrNoSuchKeyBundle || errors.Cause(err) == job.ErrNoSuchPeerID || errors.Cause(err) == job.ErrNoSuchTransmitterAddress {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
-		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+// jobErrorStatus maps errors from creating or updating a job to an HTTP status
+func jobErrorStatus(err error) int {
+	switch errors.Cause(err) {
+	case job.ErrNoSuchKeyBundle, job.ErrNoSuchPeerID, job.ErrNoSuchTransmitterAddress, job.ErrJobTypeMismatch:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
 	}
-
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
 // Delete hard deletes a job spec.
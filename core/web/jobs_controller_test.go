@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -390,6 +391,73 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_Update_HappyPath(t *testing.T) {
+	app, client, _, _, ereJobSpecFromFile, jobID := setupJobSpecsControllerTestsWithJobs(t)
+
+	tomlStr := strings.Replace(
+		string(cltest.MustReadFile(t, "../testdata/tomlspecs/direct-request-spec.toml")),
+		`name                = "example eth request event spec"`,
+		`name                = "renamed eth request event spec"`,
+		1,
+	)
+	body, _ := json.Marshal(web.UpdateJobRequest{
+		TOML: tomlStr,
+	})
+	response, cleanup := client.Patch(fmt.Sprintf("/v2/jobs/%v", jobID), bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.JobResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%v", jobID), resource.ID)
+	assert.Equal(t, "renamed eth request event spec", resource.Name)
+	assert.Equal(t, ereJobSpecFromFile.ExternalJobID, resource.ExternalJobID)
+
+	jb, err := app.JobORM().FindJobTx(jobID)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed eth request event spec", jb.Name.ValueOrZero())
+}
+
+func TestJobsController_Update_TypeMismatch(t *testing.T) {
+	_, client, _, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+
+	body, _ := json.Marshal(web.UpdateJobRequest{
+		TOML: string(cltest.MustReadFile(t, "../testdata/tomlspecs/webhook-job-spec-no-body.toml")),
+	})
+	response, cleanup := client.Patch(fmt.Sprintf("/v2/jobs/%v", jobID), bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+}
+
+func TestJobsController_PauseResume(t *testing.T) {
+	app, client, _, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/pause", jobID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.JobResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.NotNil(t, resource.PausedAt)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%v/resume", jobID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource = presenters.JobResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.Nil(t, resource.PausedAt)
+	assert.Contains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	response, cleanup = client.Post("/v2/jobs/999999999/pause", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func runOCRJobSpecAssertions(t *testing.T, ocrJobSpecFromFileDB job.Job, ocrJobSpecFromServer presenters.JobResource) {
 	ocrJobSpecFromFile := ocrJobSpecFromFileDB.OffchainreportingOracleSpec
 	assert.Equal(t, ocrJobSpecFromFile.ContractAddress, ocrJobSpecFromServer.OffChainReportingSpec.ContractAddress)
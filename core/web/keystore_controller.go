@@ -0,0 +1,47 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+// KeystoreController manages the keystore
+type KeystoreController struct {
+	App chainlink.Application
+}
+
+// RotatePasswordRequest defines the request to re-encrypt every key of the
+// keystore with a new password
+type RotatePasswordRequest struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+}
+
+// RotatePassword re-encrypts every key of the keystore with a new password
+// Example:
+// "POST <application>/keys/rotate_password"
+func (ctrl *KeystoreController) RotatePassword(c *gin.Context) {
+	var request RotatePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := ctrl.App.GetKeyStore().RotatePassword(request.OldPassword, request.NewPassword)
+	if errors.Is(err, keystore.ErrWeakPassword) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if errors.Is(err, keystore.ErrWrongPassword) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
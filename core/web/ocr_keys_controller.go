@@ -1,12 +1,14 @@
 package web
 
 import (
+	"errors"
 	"io/ioutil"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -95,3 +97,23 @@ func (ocrkc *OCRKeysController) Export(c *gin.Context) {
 
 	c.Data(http.StatusOK, MediaType, bytes)
 }
+
+// Rotate replaces an OCR key bundle by a new bundle, and updates the jobs using it
+// Example:
+// "POST <application>/keys/ocr/rotate/:keyID"
+func (ocrkc *OCRKeysController) Rotate(c *gin.Context) {
+	id := c.Param("keyID")
+	if _, err := ocrkc.App.GetKeyStore().OCR().Get(id); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	key, err := ocrkc.App.GetKeyRotator().RotateOCRKey(c.Request.Context(), id)
+	if errors.Is(err, keystore.ErrKeyRetired) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewOCRKeysBundleResource(key), "offChainReportingKeyBundle")
+}
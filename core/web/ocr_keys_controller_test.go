@@ -82,6 +82,42 @@ func TestOCRKeysController_Delete_HappyPath(t *testing.T) {
 	assert.Equal(t, initialLength, len(keys))
 }
 
+func TestOCRKeysController_Rotate_HappyPath(t *testing.T) {
+	client, OCRKeyStore := setupOCRKeysControllerTests(t)
+
+	key, _ := OCRKeyStore.Create()
+
+	response, cleanup := client.Post("/v2/keys/ocr/rotate/"+key.ID(), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.OCRKeysBundleResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.NotEqual(t, key.ID(), resource.ID)
+
+	keys, _ := OCRKeyStore.GetAllActive()
+	var ids []string
+	for _, k := range keys {
+		ids = append(ids, k.ID())
+	}
+	assert.Contains(t, ids, resource.ID)
+	assert.NotContains(t, ids, key.ID())
+
+	response, cleanup = client.Post("/v2/keys/ocr/rotate/"+key.ID(), nil)
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+}
+
+func TestOCRKeysController_Rotate_NonExistentOCRKeyID(t *testing.T) {
+	client, _ := setupOCRKeysControllerTests(t)
+
+	nonExistentOCRKeyID := "eb81f4a35033ac8dd68b9d33a039a713d6fd639af6852b81f47ffeda1c95de54"
+	response, cleanup := client.Post("/v2/keys/ocr/rotate/"+nonExistentOCRKeyID, nil)
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
 func setupOCRKeysControllerTests(t *testing.T) (cltest.HTTPClientCleaner, keystore.OCR) {
 	t.Parallel()
 
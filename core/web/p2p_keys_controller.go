@@ -1,12 +1,14 @@
 package web
 
 import (
+	"errors"
 	"io/ioutil"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -95,3 +97,23 @@ func (p2pkc *P2PKeysController) Export(c *gin.Context) {
 
 	c.Data(http.StatusOK, MediaType, bytes)
 }
+
+// Rotate replaces a P2P key by a new key, and updates the jobs using it
+// Example:
+// "POST <application>/keys/p2p/rotate/:keyID"
+func (p2pkc *P2PKeysController) Rotate(c *gin.Context) {
+	id := c.Param("keyID")
+	if _, err := p2pkc.App.GetKeyStore().P2P().Get(id); err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	key, err := p2pkc.App.GetKeyRotator().RotateP2PKey(c.Request.Context(), id)
+	if errors.Is(err, keystore.ErrKeyRetired) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewP2PKeyResource(key), "p2pKey")
+}
@@ -94,6 +94,32 @@ func TestP2PKeysController_Delete_HappyPath(t *testing.T) {
 	assert.Equal(t, initialLength, len(keys))
 }
 
+func TestP2PKeysController_Rotate_HappyPath(t *testing.T) {
+	t.Parallel()
+
+	client, keyStore := setupP2PKeysControllerTests(t)
+
+	key, _ := keyStore.P2P().Create()
+
+	response, cleanup := client.Post(fmt.Sprintf("/v2/keys/p2p/rotate/%s", key.ID()), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	resource := presenters.P2PKeyResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	assert.NotEqual(t, key.PeerID().String(), resource.PeerID)
+
+	var peerID p2pkey.PeerID
+	require.NoError(t, peerID.UnmarshalText([]byte(resource.PeerID)))
+	_, err = keyStore.P2P().Get(peerID.Raw())
+	require.NoError(t, err)
+	retired, _ := keyStore.RetiredKeys()
+	require.Len(t, retired, 1)
+	assert.Equal(t, key.ID(), retired[0].KeyID)
+	assert.Equal(t, peerID.Raw(), retired[0].ReplacedBy)
+}
+
 func setupP2PKeysControllerTests(t *testing.T) (cltest.HTTPClientCleaner, keystore.Master) {
 	t.Helper()
 
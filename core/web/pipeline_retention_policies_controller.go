@@ -0,0 +1,98 @@
+package web
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// PipelineRetentionPoliciesController manages pipeline run retention policies
+type PipelineRetentionPoliciesController struct {
+	App chainlink.Application
+}
+
+// Index lists all retention policies.
+// Example:
+// "GET <application>/pipeline/retention_policies"
+func (prpc *PipelineRetentionPoliciesController) Index(c *gin.Context) {
+	policies, err := prpc.App.PipelineORM().ListRetentionPolicies()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewRetentionPolicyResources(policies), "retentionPolicies")
+}
+
+// Create sets the retention policy of a job or job type, replacing any
+// existing policy for it.
+// Example:
+// "POST <application>/pipeline/retention_policies"
+func (prpc *PipelineRetentionPoliciesController) Create(c *gin.Context) {
+	policy := pipeline.RetentionPolicy{}
+	if err := c.ShouldBindJSON(&policy); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := policy.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if policy.JobType.Valid && job.Type(policy.JobType.String).SchemaVersion() == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, fmt.Errorf("unknown job type %q", policy.JobType.String))
+		return
+	}
+	if policy.JobID.Valid {
+		_, err := prpc.App.JobORM().FindJob(c.Request.Context(), int32(policy.JobID.Int64))
+		if errors.Cause(err) == gorm.ErrRecordNotFound {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+			return
+		}
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	if err := prpc.App.PipelineORM().UpsertRetentionPolicy(&policy); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewRetentionPolicyResource(policy), "retentionPolicies")
+}
+
+// Delete removes a retention policy. Runs it covered fall back to the policy
+// for their job type, or the default threshold.
+// Example:
+// "DELETE <application>/pipeline/retention_policies/:ID"
+func (prpc *PipelineRetentionPoliciesController) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = prpc.App.PipelineORM().DeleteRetentionPolicy(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("retention policy not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "retentionPolicies", http.StatusNoContent)
+}
@@ -0,0 +1,70 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestPipelineRetentionPoliciesController_CreateListDelete(t *testing.T) {
+	app, client, _, jID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	body := fmt.Sprintf(`{"jobID":%d,"keepLast":10,"maxAge":"72h","archive":true}`, jID)
+	resp, cleanup := client.Post("/v2/pipeline/retention_policies", bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var created presenters.RetentionPolicyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &created))
+	assert.Equal(t, int64(jID), created.JobID.Int64)
+	assert.Equal(t, int64(10), created.KeepLast.Int64)
+	require.NotNil(t, created.MaxAge)
+	assert.Equal(t, "72h0m0s", created.MaxAge.Duration().String())
+	assert.True(t, created.Archive)
+
+	policies, err := app.PipelineORM().ListRetentionPolicies()
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+
+	resp, cleanup = client.Get("/v2/pipeline/retention_policies")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var listed []presenters.RetentionPolicyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &listed))
+	require.Len(t, listed, 1)
+	assert.Equal(t, created.ID, listed[0].ID)
+
+	resp, cleanup = client.Delete("/v2/pipeline/retention_policies/" + created.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/pipeline/retention_policies/" + created.ID)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestPipelineRetentionPoliciesController_Create_Invalid(t *testing.T) {
+	_, client, _, _, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	for _, body := range []string{
+		`{"keepLast":10}`,
+		`{"jobType":"cron","jobID":1}`,
+		`{"jobType":"nope"}`,
+		`{"jobType":"cron","maxAge":"forever"}`,
+	} {
+		resp, cleanup := client.Post("/v2/pipeline/retention_policies", bytes.NewBufferString(body))
+		defer cleanup()
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, body)
+	}
+
+	resp, cleanup := client.Post("/v2/pipeline/retention_policies", bytes.NewBufferString(`{"jobID":999999}`))
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,93 @@
+package web
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// PipelineSimulationsController runs pipelines in memory without persisting
+// anything
+type PipelineSimulationsController struct {
+	App chainlink.Application
+}
+
+// SimulatePipelineRequest represents a request to simulate a pipeline run.
+// Exactly one of TOML and DOT must be given.
+type SimulatePipelineRequest struct {
+	// TOML is a job spec whose pipeline will be simulated
+	TOML string `json:"toml"`
+	// DOT is a bare pipeline
+	DOT string `json:"dot"`
+	// Vars are the input variables of the run, e.g. jobRun.requestBody
+	Vars map[string]interface{} `json:"vars"`
+	// StubNetwork stubs bridge and http tasks so that no requests are made
+	StubNetwork bool `json:"stubNetwork"`
+	// Stubs are the values returned by stubbed tasks, keyed by dot ID
+	Stubs map[string]interface{} `json:"stubs"`
+}
+
+// Simulate executes the pipeline of a job spec, or a bare pipeline, in memory
+// and returns the result of every task. ethtx tasks are always stubbed.
+// Example:
+// "POST <application>/pipeline/simulate"
+func (psc *PipelineSimulationsController) Simulate(c *gin.Context) {
+	request := SimulatePipelineRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var spec pipeline.Spec
+	switch {
+	case request.TOML != "" && request.DOT != "":
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("must specify only one of toml or dot"))
+		return
+	case request.TOML != "":
+		jc := JobsController{psc.App}
+		jb, status, err := jc.validateJobSpec(request.TOML)
+		if err != nil {
+			jsonAPIError(c, status, err)
+			return
+		}
+		if jb.Pipeline.Source == "" {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("job has no pipeline to simulate"))
+			return
+		}
+		spec = pipeline.Spec{
+			DotDagSource:    jb.Pipeline.Source,
+			JobName:         jb.Name.ValueOrZero(),
+			MaxTaskDuration: jb.MaxTaskDuration,
+		}
+	case request.DOT != "":
+		if _, err := pipeline.Parse(request.DOT); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse pipeline"))
+			return
+		}
+		spec = pipeline.Spec{DotDagSource: request.DOT}
+	default:
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("must specify one of toml or dot"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), psc.App.GetConfig().JobPipelineMaxRunDuration())
+	defer cancel()
+
+	opts := pipeline.SimulationOptions{
+		StubNetwork: request.StubNetwork,
+		Stubs:       request.Stubs,
+	}
+	run, trrs, err := psc.App.SimulatePipelineRun(ctx, spec, request.Vars, opts)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineSimulationResource(run, trrs, psc.App.GetLogger()), "pipelineSimulations")
+}
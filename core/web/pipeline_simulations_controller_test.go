@@ -0,0 +1,82 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestPipelineSimulationsController_Simulate(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	t.Run("simulates a job spec without creating it", func(t *testing.T) {
+		body, err := json.Marshal(web.SimulatePipelineRequest{
+			TOML:        string(cltest.MustReadFile(t, "../testdata/tomlspecs/webhook-job-spec-no-body.toml")),
+			StubNetwork: true,
+			Stubs: map[string]interface{}{
+				"fetch":  `{"data":{"result":1.5}}`,
+				"submit": "ok",
+			},
+		})
+		require.NoError(t, err)
+
+		resp, cleanup := client.Post("/v2/pipeline/simulate", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var simulation presenters.PipelineSimulationResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &simulation))
+		assert.Equal(t, "completed", string(simulation.State))
+		require.Len(t, simulation.TaskRuns, 4)
+		assert.Equal(t, "fetch", simulation.TaskRuns[0].DotID)
+		assert.True(t, simulation.TaskRuns[0].Stubbed)
+		assert.Equal(t, []string{"parse_request"}, simulation.TaskRuns[0].Downstream)
+		assert.Equal(t, "multiply", simulation.TaskRuns[2].DotID)
+		assert.False(t, simulation.TaskRuns[2].Stubbed)
+		require.NotNil(t, simulation.TaskRuns[2].Output)
+		assert.Equal(t, `"150"`, *simulation.TaskRuns[2].Output)
+
+		jobs, _, err := app.JobORM().JobsV2(0, 10)
+		require.NoError(t, err)
+		assert.Len(t, jobs, 0)
+		runs, _, err := app.JobORM().PipelineRuns(0, 10)
+		require.NoError(t, err)
+		assert.Len(t, runs, 0)
+	})
+
+	t.Run("simulates a bare pipeline with vars", func(t *testing.T) {
+		body := `{"dot":"a [type=multiply input=\"$(x)\" times=3]","vars":{"x":2}}`
+		resp, cleanup := client.Post("/v2/pipeline/simulate", bytes.NewBufferString(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var simulation presenters.PipelineSimulationResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &simulation))
+		require.Len(t, simulation.Outputs, 1)
+		assert.Equal(t, "6", *simulation.Outputs[0])
+	})
+
+	t.Run("rejects invalid requests", func(t *testing.T) {
+		for _, body := range []string{
+			`{}`,
+			`{"dot":"a [type=memo value=1]","toml":"type = \"webhook\""}`,
+			`{"dot":"a [type=nope]"}`,
+		} {
+			resp, cleanup := client.Post("/v2/pipeline/simulate", bytes.NewBufferString(body))
+			t.Cleanup(cleanup)
+			assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, body)
+		}
+	})
+}
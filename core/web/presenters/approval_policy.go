@@ -0,0 +1,75 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/feeds"
+	"gopkg.in/guregu/null.v4"
+)
+
+// ApprovalPolicyResource represents the approval policy of a feeds manager
+// JSONAPI resource. It is identified by the id of the feeds manager.
+type ApprovalPolicyResource struct {
+	JAID
+	AutoApproveJobTypes     []string  `json:"autoApproveJobTypes"`
+	AutoApproveUpdateFields []string  `json:"autoApproveUpdateFields"`
+	RequiredApprovals       int32     `json:"requiredApprovals"`
+	UpdatedAt               time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ApprovalPolicyResource) GetName() string {
+	return "approval_policies"
+}
+
+// NewApprovalPolicyResource constructs a new ApprovalPolicyResource.
+func NewApprovalPolicyResource(policy feeds.ApprovalPolicy) *ApprovalPolicyResource {
+	return &ApprovalPolicyResource{
+		JAID:                    NewJAIDInt64(policy.FeedsManagerID),
+		AutoApproveJobTypes:     policy.AutoApproveJobTypes,
+		AutoApproveUpdateFields: policy.AutoApproveUpdateFields,
+		RequiredApprovals:       policy.RequiredApprovals,
+		UpdatedAt:               policy.UpdatedAt,
+	}
+}
+
+// ApprovalPolicyDecisionResource represents an approval policy decision made
+// on a version of a job proposal JSONAPI resource.
+type ApprovalPolicyDecisionResource struct {
+	JAID
+	Version   int32                            `json:"version"`
+	Decision  feeds.ApprovalPolicyDecisionType `json:"decision"`
+	Reason    string                           `json:"reason"`
+	Approver  null.String                      `json:"approver"`
+	CreatedAt time.Time                        `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ApprovalPolicyDecisionResource) GetName() string {
+	return "approval_policy_decisions"
+}
+
+// NewApprovalPolicyDecisionResource constructs a new
+// ApprovalPolicyDecisionResource.
+func NewApprovalPolicyDecisionResource(d feeds.ApprovalPolicyDecision) *ApprovalPolicyDecisionResource {
+	return &ApprovalPolicyDecisionResource{
+		JAID:      NewJAIDInt64(d.ID),
+		Version:   d.Version,
+		Decision:  d.Decision,
+		Reason:    d.Reason,
+		Approver:  d.Approver,
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+// NewApprovalPolicyDecisionResources initializes a slice of JSONAPI approval
+// policy decision resources
+func NewApprovalPolicyDecisionResources(decisions []feeds.ApprovalPolicyDecision) []ApprovalPolicyDecisionResource {
+	rs := []ApprovalPolicyDecisionResource{}
+
+	for _, d := range decisions {
+		rs = append(rs, *NewApprovalPolicyDecisionResource(d))
+	}
+
+	return rs
+}
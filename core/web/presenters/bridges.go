@@ -17,7 +17,8 @@ type BridgeResource struct {
 	IncomingToken          string       `json:"incomingToken,omitempty"`
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	bridges.BridgeLimits
+	CreatedAt time.Time `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -35,6 +36,7 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
+		BridgeLimits:           b.BridgeLimits,
 		CreatedAt:              b.CreatedAt,
 	}
 }
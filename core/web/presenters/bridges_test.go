@@ -26,7 +26,11 @@ func TestBridgeResource(t *testing.T) {
 		Confirmations:          1,
 		OutgoingToken:          "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 		MinimumContractPayment: assets.NewLinkFromJuels(1),
-		CreatedAt:              timestamp,
+		BridgeLimits: bridges.BridgeLimits{
+			MaxConcurrency: 5,
+			CacheTTL:       models.Interval(30 * time.Second),
+		},
+		CreatedAt: timestamp,
 	}
 
 	r := NewBridgeResource(bridge)
@@ -45,6 +49,12 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"maxConcurrency":5,
+			"requestsPerSecond":0,
+			"cacheTTL":"30s",
+			"circuitBreakerThreshold":0,
+			"circuitBreakerTimeout":"0s",
+			"maxStaleness":"0s",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -70,6 +80,12 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"maxConcurrency":5,
+			"requestsPerSecond":0,
+			"cacheTTL":"30s",
+			"circuitBreakerThreshold":0,
+			"circuitBreakerTimeout":"0s",
+			"maxStaleness":"0s",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -17,6 +17,7 @@ type ETHKeyResource struct {
 	EthBalance  *assets.Eth  `json:"ethBalance"`
 	LinkBalance *assets.Link `json:"linkBalance"`
 	IsFunding   bool         `json:"isFunding"`
+	IsExternal  bool         `json:"isExternal"`
 	CreatedAt   time.Time    `json:"createdAt"`
 	UpdatedAt   time.Time    `json:"updatedAt"`
 }
@@ -44,6 +45,7 @@ func NewETHKeyResource(k ethkey.KeyV2, state ethkey.State, opts ...NewETHKeyOpti
 		EthBalance:  nil,
 		LinkBalance: nil,
 		IsFunding:   state.IsFunding,
+		IsExternal:  state.IsExternal,
 		CreatedAt:   state.CreatedAt,
 		UpdatedAt:   state.UpdatedAt,
 	}
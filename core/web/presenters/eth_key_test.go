@@ -59,6 +59,7 @@ func TestETHKeyResource(t *testing.T) {
 			  "ethBalance":"1",
 			  "linkBalance":"1",
 			  "isFunding":true,
+			  "isExternal":false,
 			  "createdAt":"2000-01-01T00:00:00Z",
 			  "updatedAt":"2000-01-01T00:00:00Z"
 		   }
@@ -87,6 +88,7 @@ func TestETHKeyResource(t *testing.T) {
 				"ethBalance":"1",
 				"linkBalance":"1",
 				"isFunding":true,
+				"isExternal":false,
 				"createdAt":"2000-01-01T00:00:00Z",
 				"updatedAt":"2000-01-01T00:00:00Z"
 			}
@@ -0,0 +1,58 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/funding"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// FundingTransferResource is a top-up sent by the treasury key of a chain to
+// one of its sending keys. Amounts are in wei.
+type FundingTransferResource struct {
+	JAID
+	EVMChainID  utils.Big      `json:"evmChainID"`
+	EthTxID     null.Int       `json:"ethTxID"`
+	EthTxState  null.String    `json:"ethTxState"`
+	FromAddress common.Address `json:"fromAddress"`
+	ToAddress   common.Address `json:"toAddress"`
+	Amount      string         `json:"amount"`
+	Balance     string         `json:"balance"`
+	MinBalance  string         `json:"minBalance"`
+	CreatedAt   time.Time      `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r FundingTransferResource) GetName() string {
+	return "fundingTransfers"
+}
+
+// NewFundingTransferResource constructs a new FundingTransferResource
+func NewFundingTransferResource(t funding.Transfer) FundingTransferResource {
+	return FundingTransferResource{
+		JAID:        NewJAID(fmt.Sprint(t.ID)),
+		EVMChainID:  t.EVMChainID,
+		EthTxID:     t.EthTxID,
+		EthTxState:  t.EthTxState,
+		FromAddress: t.FromAddress,
+		ToAddress:   t.ToAddress,
+		Amount:      t.Amount.ToInt().String(),
+		Balance:     t.Balance.ToInt().String(),
+		MinBalance:  t.MinBalance.ToInt().String(),
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+// NewFundingTransferResources initializes a slice of JSONAPI funding
+// transfer resources
+func NewFundingTransferResources(transfers []funding.Transfer) []FundingTransferResource {
+	rs := []FundingTransferResource{}
+	for _, t := range transfers {
+		rs = append(rs, NewFundingTransferResource(t))
+	}
+	return rs
+}
@@ -0,0 +1,70 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// GasSpendResource is the total fee paid by the transactions of a job, key
+// or day on a chain
+type GasSpendResource struct {
+	JAID
+	EVMChainID utils.Big       `json:"evmChainID"`
+	JobID      null.Int        `json:"jobID"`
+	Address    *common.Address `json:"address"`
+	Day        null.Time       `json:"day"`
+	TxCount    int64           `json:"txCount"`
+	GasUsed    int64           `json:"gasUsed"`
+	// TotalFee is in wei, TotalFeeEth in ETH
+	TotalFee    string `json:"totalFee"`
+	TotalFeeEth string `json:"totalFeeEth"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r GasSpendResource) GetName() string {
+	return "gasSpend"
+}
+
+// NewGasSpendResource constructs a new GasSpendResource
+func NewGasSpendResource(groupBy bulletprooftxmanager.GasSpendGroupBy, spend bulletprooftxmanager.GasSpend) GasSpendResource {
+	var key string
+	switch groupBy {
+	case bulletprooftxmanager.GasSpendByJob:
+		key = "unattributed"
+		if spend.JobID.Valid {
+			key = fmt.Sprint(spend.JobID.Int64)
+		}
+	case bulletprooftxmanager.GasSpendByKey:
+		if spend.FromAddress != nil {
+			key = spend.FromAddress.Hex()
+		}
+	case bulletprooftxmanager.GasSpendByDay:
+		key = spend.Day.Time.Format("2006-01-02")
+	}
+	return GasSpendResource{
+		JAID:        NewJAID(fmt.Sprintf("%s/%s/%s", spend.EVMChainID.String(), groupBy, key)),
+		EVMChainID:  spend.EVMChainID,
+		JobID:       spend.JobID,
+		Address:     spend.FromAddress,
+		Day:         spend.Day,
+		TxCount:     spend.TxCount,
+		GasUsed:     spend.GasUsed,
+		TotalFee:    spend.TotalFee.String(),
+		TotalFeeEth: (*assets.Eth)(spend.TotalFee.ToInt()).String(),
+	}
+}
+
+// NewGasSpendResources initializes a slice of JSONAPI gas spend resources
+func NewGasSpendResources(groupBy bulletprooftxmanager.GasSpendGroupBy, spend []bulletprooftxmanager.GasSpend) []GasSpendResource {
+	rs := []GasSpendResource{}
+	for _, s := range spend {
+		rs = append(rs, NewGasSpendResource(groupBy, s))
+	}
+	return rs
+}
@@ -39,6 +39,7 @@ type DirectRequestSpec struct {
 	MinIncomingConfirmations clnull.Uint32            `json:"minIncomingConfirmations"`
 	MinContractPayment       *assets.Link             `json:"minContractPaymentLinkJuels"`
 	Requesters               models.AddressCollection `json:"requesters"`
+	FromAddresses            models.AddressCollection `json:"fromAddresses"`
 	Initiator                string                   `json:"initiator"`
 	CreatedAt                time.Time                `json:"createdAt"`
 	UpdatedAt                time.Time                `json:"updatedAt"`
@@ -52,6 +53,7 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 		MinIncomingConfirmations: spec.MinIncomingConfirmations,
 		MinContractPayment:       spec.MinContractPayment,
 		Requesters:               spec.Requesters,
+		FromAddresses:            spec.FromAddresses,
 		// This is hardcoded to runlog. When we support other intiators, we need
 		// to change this
 		Initiator: "runlog",
@@ -254,6 +256,7 @@ type JobResource struct {
 	SchemaVersion         uint32                 `json:"schemaVersion"`
 	MaxTaskDuration       models.Interval        `json:"maxTaskDuration"`
 	ExternalJobID         uuid.UUID              `json:"externalJobID"`
+	PausedAt              *time.Time             `json:"pausedAt"`
 	DirectRequestSpec     *DirectRequestSpec     `json:"directRequestSpec"`
 	FluxMonitorSpec       *FluxMonitorSpec       `json:"fluxMonitorSpec"`
 	CronSpec              *CronSpec              `json:"cronSpec"`
@@ -275,6 +278,7 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration: j.MaxTaskDuration,
 		PipelineSpec:    NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:   j.ExternalJobID,
+		PausedAt:        j.PausedAt.Ptr(),
 	}
 
 	switch j.Type {
@@ -15,6 +15,7 @@ type JobProposalResource struct {
 	ExternalJobID  *string                 `json:"external_job_id"`
 	FeedsManagerID string                  `json:"feeds_manager_id"`
 	Multiaddrs     []string                `json:"multiaddrs"`
+	PendingUpdate  bool                    `json:"pendingUpdate"`
 	ProposedAt     time.Time               `json:"proposedAt"`
 	CreatedAt      time.Time               `json:"createdAt"`
 }
@@ -32,6 +33,7 @@ func NewJobProposalResource(jp feeds.JobProposal) *JobProposalResource {
 		Spec:           jp.Spec,
 		FeedsManagerID: strconv.FormatInt(jp.FeedsManagerID, 10),
 		Multiaddrs:     jp.Multiaddrs,
+		PendingUpdate:  jp.PendingUpdate,
 		ProposedAt:     jp.ProposedAt,
 		CreatedAt:      jp.CreatedAt,
 	}
@@ -54,3 +56,43 @@ func NewJobProposalResources(jps []feeds.JobProposal) []JobProposalResource {
 
 	return rs
 }
+
+// JobProposalSpecResource represents a version of the spec of a job proposal
+// JSONAPI resource.
+type JobProposalSpecResource struct {
+	JAID
+	Definition      string           `json:"definition"`
+	Version         int32            `json:"version"`
+	Status          feeds.SpecStatus `json:"status"`
+	StatusUpdatedAt time.Time        `json:"statusUpdatedAt"`
+	CreatedAt       time.Time        `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobProposalSpecResource) GetName() string {
+	return "job_proposal_specs"
+}
+
+// NewJobProposalSpecResource constructs a new JobProposalSpecResource.
+func NewJobProposalSpecResource(spec feeds.JobProposalSpec) *JobProposalSpecResource {
+	return &JobProposalSpecResource{
+		JAID:            NewJAIDInt64(spec.ID),
+		Definition:      spec.Definition,
+		Version:         spec.Version,
+		Status:          spec.Status,
+		StatusUpdatedAt: spec.StatusUpdatedAt,
+		CreatedAt:       spec.CreatedAt,
+	}
+}
+
+// NewJobProposalSpecResources initializes a slice of JSONAPI job proposal spec
+// resources
+func NewJobProposalSpecResources(specs []feeds.JobProposalSpec) []JobProposalSpecResource {
+	rs := []JobProposalSpecResource{}
+
+	for _, spec := range specs {
+		rs = append(rs, *NewJobProposalSpecResource(spec))
+	}
+
+	return rs
+}
@@ -81,6 +81,7 @@ func TestJob(t *testing.T) {
 						"type": "directrequest",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
@@ -91,6 +92,7 @@ func TestJob(t *testing.T) {
 							"minIncomingConfirmations": null,
 							"minContractPaymentLinkJuels": null,
 							"requesters": null,
+							"fromAddresses": null,
 							"initiator": "runlog",
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
@@ -142,6 +144,7 @@ func TestJob(t *testing.T) {
 						"type": "fluxmonitor",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
@@ -213,6 +216,7 @@ func TestJob(t *testing.T) {
 						"type": "offchainreporting",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
@@ -275,6 +279,7 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
@@ -328,6 +333,7 @@ func TestJob(t *testing.T) {
                         "type": "cron",
                         "maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pausedAt": null,
                         "pipelineSpec": {
                             "id": 1,
                             "dotDagSource": "",
@@ -378,6 +384,7 @@ func TestJob(t *testing.T) {
 						"type": "webhook",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
@@ -439,6 +446,7 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pausedAt": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
@@ -0,0 +1,80 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/log"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// LogReplayResource represents the progress of a log replay scoped to a job
+// or contract JSONAPI resource.
+type LogReplayResource struct {
+	JAID
+	EVMChainID *utils.Big            `json:"evmChainID"`
+	JobID      *int32                `json:"jobID"`
+	Contract   *common.Address       `json:"contract"`
+	FromBlock  int64                 `json:"fromBlock"`
+	ToBlock    null.Int64            `json:"toBlock"`
+	DryRun     bool                  `json:"dryRun"`
+	State      log.ReplayState       `json:"state"`
+	LastBlock  null.Int64            `json:"lastBlock"`
+	Delivered  int                   `json:"delivered"`
+	Logs       []ReplayedLogResource `json:"logs,omitempty"`
+	Error      string                `json:"error,omitempty"`
+	CreatedAt  time.Time             `json:"createdAt"`
+	FinishedAt *time.Time            `json:"finishedAt"`
+}
+
+// ReplayedLogResource is a log that a dry run would deliver to a job
+type ReplayedLogResource struct {
+	JobID       int32          `json:"jobID"`
+	Address     common.Address `json:"address"`
+	Topics      []common.Hash  `json:"topics"`
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	TxHash      common.Hash    `json:"transactionHash"`
+	LogIndex    uint           `json:"logIndex"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r LogReplayResource) GetName() string {
+	return "logReplays"
+}
+
+// NewLogReplayResource constructs a new LogReplayResource
+func NewLogReplayResource(r log.Replay) *LogReplayResource {
+	resource := &LogReplayResource{
+		JAID:       NewJAID(r.ID),
+		EVMChainID: utils.NewBig(&r.EVMChainID),
+		JobID:      r.Request.JobID,
+		Contract:   r.Request.Contract,
+		FromBlock:  r.Request.FromBlock,
+		ToBlock:    r.ToBlock,
+		DryRun:     r.Request.DryRun,
+		State:      r.State,
+		LastBlock:  r.LastBlock,
+		Delivered:  r.Delivered,
+		Error:      r.Error,
+		CreatedAt:  r.CreatedAt,
+		FinishedAt: r.FinishedAt,
+	}
+	if !resource.ToBlock.Valid {
+		resource.ToBlock = r.Request.ToBlock
+	}
+	for _, l := range r.Logs {
+		resource.Logs = append(resource.Logs, ReplayedLogResource{
+			JobID:       l.JobID,
+			Address:     l.Log.Address,
+			Topics:      l.Log.Topics,
+			BlockNumber: l.Log.BlockNumber,
+			BlockHash:   l.Log.BlockHash,
+			TxHash:      l.Log.TxHash,
+			LogIndex:    l.Log.Index,
+		})
+	}
+	return resource
+}
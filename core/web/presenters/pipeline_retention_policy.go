@@ -0,0 +1,53 @@
+package presenters
+
+import (
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// RetentionPolicyResource represents a pipeline run retention policy JSONAPI
+// resource.
+type RetentionPolicyResource struct {
+	JAID
+	JobID         null.Int         `json:"jobID"`
+	JobType       null.String      `json:"jobType"`
+	KeepLast      null.Int         `json:"keepLast"`
+	MaxAge        *models.Interval `json:"maxAge"`
+	ErroredMaxAge *models.Interval `json:"erroredMaxAge"`
+	Archive       bool             `json:"archive"`
+	CreatedAt     time.Time        `json:"createdAt"`
+	UpdatedAt     time.Time        `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r RetentionPolicyResource) GetName() string {
+	return "retentionPolicies"
+}
+
+// NewRetentionPolicyResource constructs a new RetentionPolicyResource
+func NewRetentionPolicyResource(p pipeline.RetentionPolicy) *RetentionPolicyResource {
+	return &RetentionPolicyResource{
+		JAID:          NewJAID(p.GetID()),
+		JobID:         p.JobID,
+		JobType:       p.JobType,
+		KeepLast:      p.KeepLast,
+		MaxAge:        p.MaxAge,
+		ErroredMaxAge: p.ErroredMaxAge,
+		Archive:       p.Archive,
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+	}
+}
+
+// NewRetentionPolicyResources constructs a slice of RetentionPolicyResources
+func NewRetentionPolicyResources(ps []pipeline.RetentionPolicy) []RetentionPolicyResource {
+	rs := []RetentionPolicyResource{}
+	for _, p := range ps {
+		rs = append(rs, *NewRetentionPolicyResource(p))
+	}
+	return rs
+}
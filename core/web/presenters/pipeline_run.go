@@ -114,6 +114,7 @@ type PipelineTaskRunResource struct {
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
 	DotID      string            `json:"dotId"`
+	Skipped    bool              `json:"skipped"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -139,6 +140,7 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		Output:     output,
 		Error:      error,
 		DotID:      tr.GetDotID(),
+		Skipped:    tr.Skipped,
 	}
 }
 
@@ -0,0 +1,83 @@
+package presenters
+
+import (
+	"sort"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// PipelineSimulationResource represents the result of a simulated pipeline
+// run. Simulated runs are never persisted and have no ID.
+type PipelineSimulationResource struct {
+	JAID
+	State       pipeline.RunStatus         `json:"state"`
+	Outputs     []*string                  `json:"outputs"`
+	AllErrors   []*string                  `json:"allErrors"`
+	FatalErrors []*string                  `json:"fatalErrors"`
+	Inputs      pipeline.JSONSerializable  `json:"inputs"`
+	TaskRuns    []SimulatedTaskRunResource `json:"taskRuns"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineSimulationResource) GetName() string {
+	return "pipelineSimulations"
+}
+
+// SimulatedTaskRunResource is the result of a task in a simulated run, along
+// with its position in the pipeline
+type SimulatedTaskRunResource struct {
+	PipelineTaskRunResource
+	// Upstream and Downstream are the dot IDs of the task's inputs and outputs
+	Upstream   []string `json:"upstream"`
+	Downstream []string `json:"downstream"`
+	// Stubbed is true if the task was not actually run
+	Stubbed bool `json:"stubbed"`
+}
+
+// NewPipelineSimulationResource constructs a PipelineSimulationResource from
+// a simulated run and its task results. Tasks are listed in pipeline order.
+func NewPipelineSimulationResource(run pipeline.Run, trrs pipeline.TaskRunResults, lggr logger.Logger) PipelineSimulationResource {
+	pr := NewPipelineRunResource(run, lggr)
+
+	sorted := make(pipeline.TaskRunResults, len(trrs))
+	copy(sorted, trrs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Task.ID() < sorted[j].Task.ID()
+	})
+
+	trs := []SimulatedTaskRunResource{}
+	for _, trr := range sorted {
+		var upstream, downstream []string
+		for _, t := range trr.Task.Inputs() {
+			upstream = append(upstream, t.DotID())
+		}
+		for _, t := range trr.Task.Outputs() {
+			downstream = append(downstream, t.DotID())
+		}
+		trs = append(trs, SimulatedTaskRunResource{
+			PipelineTaskRunResource: NewPipelineTaskRunResource(pipeline.TaskRun{
+				Type:       trr.Task.Type(),
+				Output:     trr.Result.OutputDB(),
+				Error:      trr.Result.ErrorDB(),
+				DotID:      trr.Task.DotID(),
+				CreatedAt:  trr.CreatedAt,
+				FinishedAt: trr.FinishedAt,
+				Skipped:    trr.Skipped,
+			}),
+			Upstream:   upstream,
+			Downstream: downstream,
+			Stubbed:    pipeline.IsStubbed(trr.Task),
+		})
+	}
+
+	return PipelineSimulationResource{
+		JAID:        NewJAID(""),
+		State:       run.State,
+		Outputs:     pr.Outputs,
+		AllErrors:   pr.AllErrors,
+		FatalErrors: pr.FatalErrors,
+		Inputs:      run.Inputs,
+		TaskRuns:    trs,
+	}
+}
@@ -0,0 +1,114 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/telemetry"
+)
+
+// OCRTelemetryFeedResource summarizes the node's participation in the rounds
+// of an OCR feed
+type OCRTelemetryFeedResource struct {
+	JAID
+	Rounds          int `json:"rounds"`
+	RoundsObserved  int `json:"roundsObserved"`
+	RoundsLed       int `json:"roundsLed"`
+	RoundsFinalized int `json:"roundsFinalized"`
+	// Participation is the fraction of rounds the node sent an observation in
+	Participation            float64    `json:"participation"`
+	AvgObservationLatencyMs  null.Float `json:"avgObservationLatencyMs"`
+	AvgFinalizationLatencyMs null.Float `json:"avgFinalizationLatencyMs"`
+	LastRoundAt              null.Time  `json:"lastRoundAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r OCRTelemetryFeedResource) GetName() string {
+	return "ocrTelemetryFeeds"
+}
+
+// NewOCRTelemetryFeedResource constructs a new OCRTelemetryFeedResource
+func NewOCRTelemetryFeedResource(summary telemetry.OCRFeedSummary) OCRTelemetryFeedResource {
+	r := OCRTelemetryFeedResource{
+		JAID:                     NewJAID(summary.ContractAddress.Hex()),
+		Rounds:                   summary.Rounds,
+		RoundsObserved:           summary.RoundsObserved,
+		RoundsLed:                summary.RoundsLed,
+		RoundsFinalized:          summary.RoundsFinalized,
+		AvgObservationLatencyMs:  secondsToMs(summary.AvgObservationLatency),
+		AvgFinalizationLatencyMs: secondsToMs(summary.AvgFinalizationLatency),
+		LastRoundAt:              summary.LastRoundAt,
+	}
+	if summary.Rounds > 0 {
+		r.Participation = float64(summary.RoundsObserved) / float64(summary.Rounds)
+	}
+	return r
+}
+
+// NewOCRTelemetryFeedResources initializes a slice of JSONAPI OCR telemetry
+// feed resources
+func NewOCRTelemetryFeedResources(summaries []telemetry.OCRFeedSummary) []OCRTelemetryFeedResource {
+	rs := []OCRTelemetryFeedResource{}
+	for _, summary := range summaries {
+		rs = append(rs, NewOCRTelemetryFeedResource(summary))
+	}
+	return rs
+}
+
+func secondsToMs(seconds null.Float) null.Float {
+	if !seconds.Valid {
+		return seconds
+	}
+	return null.FloatFrom(seconds.Float64 * 1000)
+}
+
+// OCRTelemetryRoundResource is the node's view of an OCR round
+type OCRTelemetryRoundResource struct {
+	JAID
+	ContractAddress      string    `json:"contractAddress"`
+	ConfigDigest         string    `json:"configDigest"`
+	Epoch                uint32    `json:"epoch"`
+	Round                uint8     `json:"round"`
+	Leader               null.Int  `json:"leader"`
+	StartedAt            null.Time `json:"startedAt"`
+	ObservedAt           null.Time `json:"observedAt"`
+	ObservationsReceived int       `json:"observationsReceived"`
+	FinalizedAt          null.Time `json:"finalizedAt"`
+	CreatedAt            time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r OCRTelemetryRoundResource) GetName() string {
+	return "ocrTelemetryRounds"
+}
+
+// NewOCRTelemetryRoundResource constructs a new OCRTelemetryRoundResource
+func NewOCRTelemetryRoundResource(round telemetry.OCRRound) OCRTelemetryRoundResource {
+	digest := hexutil.Encode(round.ConfigDigest)
+	return OCRTelemetryRoundResource{
+		JAID:                 NewJAID(fmt.Sprintf("%s-%d-%d", digest, round.Epoch, round.Round)),
+		ContractAddress:      round.ContractAddress.Hex(),
+		ConfigDigest:         digest,
+		Epoch:                round.Epoch,
+		Round:                round.Round,
+		Leader:               round.Leader,
+		StartedAt:            round.StartedAt,
+		ObservedAt:           round.ObservedAt,
+		ObservationsReceived: round.ObservationsReceived,
+		FinalizedAt:          round.FinalizedAt,
+		CreatedAt:            round.CreatedAt,
+	}
+}
+
+// NewOCRTelemetryRoundResources initializes a slice of JSONAPI OCR telemetry
+// round resources
+func NewOCRTelemetryRoundResources(rounds []telemetry.OCRRound) []OCRTelemetryRoundResource {
+	rs := []OCRTelemetryRoundResource{}
+	for _, round := range rounds {
+		rs = append(rs, NewOCRTelemetryRoundResource(round))
+	}
+	return rs
+}
@@ -9,8 +9,11 @@ import (
 // UserResource represents a User JSONAPI resource.
 type UserResource struct {
 	JAID
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"createdAt"`
+	Email             string            `json:"email"`
+	Role              sessions.UserRole `json:"role"`
+	HasActiveAPIToken bool              `json:"hasActiveApiToken"`
+	CreatedAt         time.Time         `json:"createdAt"`
+	UpdatedAt         time.Time         `json:"updatedAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -23,8 +26,21 @@ func (r UserResource) GetName() string {
 // A User does not have an ID primary key, so we must use the email
 func NewUserResource(u sessions.User) *UserResource {
 	return &UserResource{
-		JAID:      NewJAID(u.Email),
-		Email:     u.Email,
-		CreatedAt: u.CreatedAt,
+		JAID:              NewJAID(u.Email),
+		Email:             u.Email,
+		Role:              u.Role,
+		HasActiveAPIToken: u.TokenKey.ValueOrZero() != "",
+		CreatedAt:         u.CreatedAt,
+		UpdatedAt:         u.UpdatedAt,
 	}
 }
+
+// NewUserResources initializes a slice of JSONAPI user resources
+func NewUserResources(users []sessions.User) []UserResource {
+	rs := []UserResource{}
+	for _, u := range users {
+		rs = append(rs, *NewUserResource(u))
+	}
+
+	return rs
+}
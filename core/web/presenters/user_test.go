@@ -17,7 +17,9 @@ func TestUserResource(t *testing.T) {
 
 	user := sessions.User{
 		Email:     "notreal@fakeemail.ch",
+		Role:      sessions.UserRoleView,
 		CreatedAt: ts,
+		UpdatedAt: ts,
 	}
 
 	r := NewUserResource(user)
@@ -32,7 +34,10 @@ func TestUserResource(t *testing.T) {
 		   "id": "notreal@fakeemail.ch",
 		   "attributes": {
 			  "email": "notreal@fakeemail.ch",
-			  "createdAt": "2000-01-01T00:00:00Z"
+			  "role": "view",
+			  "hasActiveApiToken": false,
+			  "createdAt": "2000-01-01T00:00:00Z",
+			  "updatedAt": "2000-01-01T00:00:00Z"
 		   }
 		}
 	 }
@@ -7,7 +7,9 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/log"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
 type ReplayController struct {
@@ -58,6 +60,63 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	jsonAPIResponse(c, &response, "response")
 }
 
+// Create replays historical logs to the listeners of a single job or
+// contract, without re-triggering other jobs. The replay runs in the
+// background; its progress can be queried with Show.
+// Example:
+//  "POST <application>/v2/replays"
+func (bdc *ReplayController) Create(c *gin.Context) {
+	var req log.ReplayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := req.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	chain, err := getChain(c, bdc.App.GetChainSet(), c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	replay, err := bdc.App.StartLogReplay(chain.ID(), req)
+	if errors.Is(err, log.ErrNoReplayListeners) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewLogReplayResource(replay), "logReplays", http.StatusCreated)
+}
+
+// Show returns the progress of a replay started by Create, and on a dry run
+// the logs it would deliver
+// Example:
+//  "GET <application>/v2/replays/:ID"
+func (bdc *ReplayController) Show(c *gin.Context) {
+	replay, err := bdc.App.GetLogReplay(c.Param("ID"))
+	if errors.Is(err, log.ErrReplayNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewLogReplayResource(replay), "logReplays")
+}
+
 type ReplayResponse struct {
 	Message    string     `json:"message"`
 	EVMChainID *utils.Big `json:"evmChainID"`
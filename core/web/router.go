@@ -26,6 +26,7 @@ import (
 	"github.com/gobuffalo/packr"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/config"
 	"github.com/ulule/limiter"
 	mgin "github.com/ulule/limiter/drivers/middleware/gin"
@@ -203,125 +204,172 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	unauthedv2.PATCH("/resume/:runID", prc.Resume)
 
 	authv2 := r.Group("/v2", RequireAuth(app.SessionORM(), AuthenticateByToken, AuthenticateBySession))
+	// Routes are grouped by the least privileged role that may use them. Every
+	// role may do everything the roles below it may do. Creating runs requires
+	// the run role, see runOrEI below.
+	viewv2 := authv2.Group("", RequireRole(clsessions.UserRoleView))
+	runv2 := authv2.Group("", RequireRole(clsessions.UserRoleRun))
+	editv2 := authv2.Group("", RequireRole(clsessions.UserRoleEdit))
+	adminv2 := authv2.Group("", RequireRole(clsessions.UserRoleAdmin))
 	{
 		uc := UserController{app}
-		authv2.PATCH("/user/password", uc.UpdatePassword)
-		authv2.POST("/user/token", uc.NewAPIToken)
-		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
+		viewv2.PATCH("/user/password", uc.UpdatePassword)
+		viewv2.POST("/user/token", uc.NewAPIToken)
+		viewv2.POST("/user/token/delete", uc.DeleteAPIToken)
+		adminv2.GET("/users", uc.Index)
+		adminv2.POST("/users", uc.Create)
+		adminv2.PATCH("/users", uc.UpdateRole)
+		adminv2.DELETE("/users/:email", uc.Delete)
 
 		wa := WebAuthnController{app, nil}
-		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
-		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
+		viewv2.GET("/enroll_webauthn", wa.BeginRegistration)
+		viewv2.POST("/enroll_webauthn", wa.FinishRegistration)
 
 		eia := ExternalInitiatorsController{app}
-		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", eia.Create)
-		authv2.DELETE("/external_initiators/:Name", eia.Destroy)
+		viewv2.GET("/external_initiators", paginatedRequest(eia.Index))
+		editv2.POST("/external_initiators", eia.Create)
+		editv2.DELETE("/external_initiators/:Name", eia.Destroy)
 
 		bt := BridgeTypesController{app}
-		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", bt.Create)
-		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", bt.Update)
-		authv2.DELETE("/bridge_types/:BridgeName", bt.Destroy)
+		viewv2.GET("/bridge_types", paginatedRequest(bt.Index))
+		editv2.POST("/bridge_types", bt.Create)
+		viewv2.GET("/bridge_types/:BridgeName", bt.Show)
+		editv2.PATCH("/bridge_types/:BridgeName", bt.Update)
+		editv2.DELETE("/bridge_types/:BridgeName", bt.Destroy)
 
 		ts := TransfersController{app}
-		authv2.POST("/transfers", ts.Create)
+		adminv2.POST("/transfers", ts.Create)
 
 		cc := ConfigController{app}
-		authv2.GET("/config", cc.Show)
-		authv2.PATCH("/config", cc.Patch)
+		viewv2.GET("/config", cc.Show)
+		adminv2.PATCH("/config", cc.Patch)
 
 		feedsMgrCtlr := FeedsManagerController{app}
-		authv2.GET("/feeds_managers", feedsMgrCtlr.List)
-		authv2.POST("/feeds_managers", feedsMgrCtlr.Create)
-		authv2.GET("/feeds_managers/:id", feedsMgrCtlr.Show)
-		authv2.PATCH("/feeds_managers/:id", feedsMgrCtlr.Update)
+		viewv2.GET("/feeds_managers", feedsMgrCtlr.List)
+		editv2.POST("/feeds_managers", feedsMgrCtlr.Create)
+		viewv2.GET("/feeds_managers/:id", feedsMgrCtlr.Show)
+		editv2.PATCH("/feeds_managers/:id", feedsMgrCtlr.Update)
+		viewv2.GET("/feeds_managers/:id/approval_policy", feedsMgrCtlr.ShowApprovalPolicy)
+		adminv2.PATCH("/feeds_managers/:id/approval_policy", feedsMgrCtlr.UpdateApprovalPolicy)
 
 		tas := TxAttemptsController{app}
-		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
+		viewv2.GET("/tx_attempts", paginatedRequest(tas.Index))
 
 		txs := TransactionsController{app}
-		authv2.GET("/transactions", paginatedRequest(txs.Index))
-		authv2.GET("/transactions/:TxHash", txs.Show)
+		viewv2.GET("/transactions", paginatedRequest(txs.Index))
+		viewv2.GET("/transactions/:TxHash", txs.Show)
+		adminv2.POST("/transactions/:TxHash/cancel", txs.Cancel)
+		adminv2.POST("/transactions/:TxHash/bump", txs.Bump)
+		adminv2.POST("/transactions/:TxHash/abandon", txs.Abandon)
+
+		gsc := GasSpendController{app}
+		viewv2.GET("/gas_spend", gsc.Index)
+
+		ftc := FundingTransfersController{app}
+		viewv2.GET("/funding_transfers", paginatedRequest(ftc.Index))
 
 		rc := ReplayController{app}
-		authv2.POST("/replay_from_block/:number", rc.ReplayFromBlock)
+		editv2.POST("/replay_from_block/:number", rc.ReplayFromBlock)
+		editv2.POST("/replays", rc.Create)
+		viewv2.GET("/replays/:ID", rc.Show)
 
 		ekc := ETHKeysController{app}
-		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", ekc.Create)
-		authv2.DELETE("/keys/eth/:keyID", ekc.Delete)
-		authv2.POST("/keys/eth/import", ekc.Import)
-		authv2.POST("/keys/eth/export/:address", ekc.Export)
+		viewv2.GET("/keys/eth", ekc.Index)
+		adminv2.POST("/keys/eth", ekc.Create)
+		adminv2.DELETE("/keys/eth/:keyID", ekc.Delete)
+		adminv2.POST("/keys/eth/import", ekc.Import)
+		adminv2.POST("/keys/eth/export/:address", ekc.Export)
 
 		ocrkc := OCRKeysController{app}
-		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", ocrkc.Create)
-		authv2.DELETE("/keys/ocr/:keyID", ocrkc.Delete)
-		authv2.POST("/keys/ocr/import", ocrkc.Import)
-		authv2.POST("/keys/ocr/export/:ID", ocrkc.Export)
+		viewv2.GET("/keys/ocr", ocrkc.Index)
+		adminv2.POST("/keys/ocr", ocrkc.Create)
+		adminv2.DELETE("/keys/ocr/:keyID", ocrkc.Delete)
+		adminv2.POST("/keys/ocr/import", ocrkc.Import)
+		adminv2.POST("/keys/ocr/export/:ID", ocrkc.Export)
+		adminv2.POST("/keys/ocr/rotate/:keyID", ocrkc.Rotate)
 
 		p2pkc := P2PKeysController{app}
-		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", p2pkc.Create)
-		authv2.DELETE("/keys/p2p/:keyID", p2pkc.Delete)
-		authv2.POST("/keys/p2p/import", p2pkc.Import)
-		authv2.POST("/keys/p2p/export/:ID", p2pkc.Export)
+		viewv2.GET("/keys/p2p", p2pkc.Index)
+		adminv2.POST("/keys/p2p", p2pkc.Create)
+		adminv2.DELETE("/keys/p2p/:keyID", p2pkc.Delete)
+		adminv2.POST("/keys/p2p/import", p2pkc.Import)
+		adminv2.POST("/keys/p2p/export/:ID", p2pkc.Export)
+		adminv2.POST("/keys/p2p/rotate/:keyID", p2pkc.Rotate)
 
 		csakc := CSAKeysController{app}
-		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", csakc.Create)
+		viewv2.GET("/keys/csa", csakc.Index)
+		adminv2.POST("/keys/csa", csakc.Create)
+		adminv2.POST("/keys/csa/rotate/:keyID", csakc.Rotate)
+
+		kc := KeystoreController{app}
+		adminv2.POST("/keys/rotate_password", kc.RotatePassword)
 
 		vrfkc := VRFKeysController{app}
-		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", vrfkc.Create)
-		authv2.DELETE("/keys/vrf/:keyID", vrfkc.Delete)
-		authv2.POST("/keys/vrf/import", vrfkc.Import)
-		authv2.POST("/keys/vrf/export/:keyID", vrfkc.Export)
+		viewv2.GET("/keys/vrf", vrfkc.Index)
+		adminv2.POST("/keys/vrf", vrfkc.Create)
+		adminv2.DELETE("/keys/vrf/:keyID", vrfkc.Delete)
+		adminv2.POST("/keys/vrf/import", vrfkc.Import)
+		adminv2.POST("/keys/vrf/export/:keyID", vrfkc.Export)
 
 		jc := JobsController{app}
-		authv2.GET("/jobs", paginatedRequest(jc.Index))
-		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", jc.Create)
-		authv2.DELETE("/jobs/:ID", jc.Delete)
+		viewv2.GET("/jobs", paginatedRequest(jc.Index))
+		viewv2.GET("/jobs/:ID", jc.Show)
+		editv2.POST("/jobs", jc.Create)
+		editv2.PATCH("/jobs/:ID", jc.Update)
+		editv2.DELETE("/jobs/:ID", jc.Delete)
+		editv2.POST("/jobs/:ID/pause", jc.Pause)
+		editv2.POST("/jobs/:ID/resume", jc.Resume)
 
 		jpc := JobProposalsController{app}
-		authv2.GET("/job_proposals", jpc.Index)
-		authv2.GET("/job_proposals/:id", jpc.Show)
-		authv2.POST("/job_proposals/:id/approve", jpc.Approve)
-		authv2.POST("/job_proposals/:id/cancel", jpc.Cancel)
-		authv2.POST("/job_proposals/:id/reject", jpc.Reject)
-		authv2.PATCH("/job_proposals/:id/spec", jpc.UpdateSpec)
+		viewv2.GET("/job_proposals", jpc.Index)
+		viewv2.GET("/job_proposals/:id", jpc.Show)
+		viewv2.GET("/job_proposals/:id/specs", jpc.Specs)
+		viewv2.GET("/job_proposals/:id/policy_decisions", jpc.PolicyDecisions)
+		editv2.POST("/job_proposals/:id/approve", jpc.Approve)
+		editv2.POST("/job_proposals/:id/cancel", jpc.Cancel)
+		editv2.POST("/job_proposals/:id/reject", jpc.Reject)
+		editv2.PATCH("/job_proposals/:id/spec", jpc.UpdateSpec)
 
 		// PipelineRunsController
-		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		viewv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
+		viewv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
+		viewv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
 		// FeaturesController
 		fc := FeaturesController{app}
-		authv2.GET("/features", fc.Index)
+		viewv2.GET("/features", fc.Index)
 
 		// PipelineJobSpecErrorsController
-		authv2.DELETE("/pipeline/job_spec_errors/:ID", psec.Destroy)
+		editv2.DELETE("/pipeline/job_spec_errors/:ID", psec.Destroy)
+
+		psc := PipelineSimulationsController{app}
+		runv2.POST("/pipeline/simulate", psc.Simulate)
+
+		prpc := PipelineRetentionPoliciesController{app}
+		viewv2.GET("/pipeline/retention_policies", prpc.Index)
+		editv2.POST("/pipeline/retention_policies", prpc.Create)
+		editv2.DELETE("/pipeline/retention_policies/:ID", prpc.Delete)
+
+		tc := TelemetryController{app}
+		viewv2.GET("/telemetry/ocr", tc.OCRFeeds)
+		viewv2.GET("/telemetry/ocr/:contractAddress/rounds", paginatedRequest(tc.OCRRounds))
 
 		lgc := LogController{app}
-		authv2.GET("/log", lgc.Get)
-		authv2.PATCH("/log", lgc.Patch)
+		viewv2.GET("/log", lgc.Get)
+		adminv2.PATCH("/log", lgc.Patch)
 
 		chc := ChainsController{app}
-		authv2.GET("/chains/evm", paginatedRequest(chc.Index))
-		authv2.POST("/chains/evm", chc.Create)
-		authv2.GET("/chains/evm/:ID", chc.Show)
-		authv2.PATCH("/chains/evm/:ID", chc.Update)
-		authv2.DELETE("/chains/evm/:ID", chc.Delete)
+		viewv2.GET("/chains/evm", paginatedRequest(chc.Index))
+		editv2.POST("/chains/evm", chc.Create)
+		viewv2.GET("/chains/evm/:ID", chc.Show)
+		editv2.PATCH("/chains/evm/:ID", chc.Update)
+		editv2.DELETE("/chains/evm/:ID", chc.Delete)
 
 		nc := NodesController{app}
-		authv2.GET("/nodes", paginatedRequest(nc.Index))
-		authv2.GET("/chains/evm/:ID/nodes", paginatedRequest(nc.Index))
-		authv2.POST("/nodes", nc.Create)
-		authv2.DELETE("/nodes/:ID", nc.Delete)
+		viewv2.GET("/nodes", paginatedRequest(nc.Index))
+		viewv2.GET("/chains/evm/:ID/nodes", paginatedRequest(nc.Index))
+		editv2.POST("/nodes", nc.Create)
+		editv2.DELETE("/nodes/:ID", nc.Delete)
 	}
 
 	ping := PingController{app}
@@ -331,7 +379,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		AuthenticateBySession,
 	))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", prc.Create)
+	runOrEI := userOrEI.Group("", RequireRole(clsessions.UserRoleRun))
+	runOrEI.POST("/jobs/:ID/runs", prc.Create)
 }
 
 // This is higher because it serves main.js and any static images. There are
@@ -107,7 +107,7 @@ func TestSessionsController_Destroy(t *testing.T) {
 	app := cltest.NewApplicationEVMDisabled(t)
 	require.NoError(t, app.Start())
 
-	correctSession := sessions.NewSession()
+	correctSession := sessions.NewSession(cltest.APIEmail)
 	require.NoError(t, app.GetDB().Save(&correctSession).Error)
 
 	config := app.GetConfig()
@@ -148,7 +148,7 @@ func TestSessionsController_Destroy_ReapSessions(t *testing.T) {
 	app := cltest.NewApplicationEVMDisabled(t)
 	require.NoError(t, app.Start())
 
-	correctSession := sessions.NewSession()
+	correctSession := sessions.NewSession(cltest.APIEmail)
 	require.NoError(t, app.GetDB().Save(&correctSession).Error)
 	cookie := cltest.MustGenerateSessionCookie(t, correctSession.ID)
 
@@ -0,0 +1,66 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// defaultTelemetryWindow is the period OCR feeds are summarized over if the
+// request does not specify one
+const defaultTelemetryWindow = 24 * time.Hour
+
+// TelemetryController serves the OCR telemetry stored by the local telemetry
+// agent
+type TelemetryController struct {
+	App chainlink.Application
+}
+
+// OCRFeeds summarizes the node's participation in each OCR feed over the
+// window, which defaults to 24h.
+// Example:
+// "GET <application>/telemetry/ocr?window=1h"
+func (tc *TelemetryController) OCRFeeds(c *gin.Context) {
+	window := defaultTelemetryWindow
+	if param := c.Query("window"); param != "" {
+		var err error
+		window, err = time.ParseDuration(param)
+		if err != nil || window <= 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid window %q, expected a positive duration such as 1h", param))
+			return
+		}
+	}
+
+	summaries, err := tc.App.TelemetryORM().OCRFeedSummaries(time.Now().Add(-window))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewOCRTelemetryFeedResources(summaries), "ocrTelemetryFeeds")
+}
+
+// OCRRounds lists the rounds of an OCR feed, most recent first.
+// Example:
+// "GET <application>/telemetry/ocr/:contractAddress/rounds"
+func (tc *TelemetryController) OCRRounds(c *gin.Context, size, page, offset int) {
+	param := c.Param("contractAddress")
+	if !common.IsHexAddress(param) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid contract address %q", param))
+		return
+	}
+
+	rounds, count, err := tc.App.TelemetryORM().OCRRounds(common.HexToAddress(param), offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	paginatedResponse(c, "ocrTelemetryRounds", size, page, presenters.NewOCRTelemetryRoundResources(rounds), count, err)
+}
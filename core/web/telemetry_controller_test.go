@@ -0,0 +1,65 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestTelemetryController_OCR(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	orm := app.TelemetryORM()
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000abc")
+	digest := []byte{1, 2, 3}
+	startedAt := time.Now().Add(-time.Minute)
+	require.NoError(t, orm.RecordRoundStarted(contract, digest, 1, 1, 0, startedAt))
+	require.NoError(t, orm.RecordObservationSent(contract, digest, 1, 1, startedAt.Add(time.Second)))
+	require.NoError(t, orm.RecordFinal(contract, digest, 1, 1, startedAt.Add(3*time.Second)))
+	require.NoError(t, orm.RecordRoundStarted(contract, digest, 1, 2, 0, startedAt))
+
+	resp, cleanup := client.Get("/v2/telemetry/ocr?window=forever")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/telemetry/ocr?window=1h")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var feeds []presenters.OCRTelemetryFeedResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &feeds))
+	require.Len(t, feeds, 1)
+	assert.Equal(t, contract.Hex(), feeds[0].ID)
+	assert.Equal(t, 2, feeds[0].Rounds)
+	assert.Equal(t, 1, feeds[0].RoundsObserved)
+	assert.Equal(t, 1, feeds[0].RoundsFinalized)
+	assert.Equal(t, 0.5, feeds[0].Participation)
+	assert.InDelta(t, 1000, feeds[0].AvgObservationLatencyMs.Float64, 1)
+	assert.InDelta(t, 3000, feeds[0].AvgFinalizationLatencyMs.Float64, 1)
+
+	resp, cleanup = client.Get("/v2/telemetry/ocr/nope/rounds")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	resp, cleanup = client.Get("/v2/telemetry/ocr/" + contract.Hex() + "/rounds?size=1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var links jsonapi.Links
+	var rounds []presenters.OCRTelemetryRoundResource
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &rounds, &links))
+	require.Len(t, rounds, 1)
+	assert.NotEmpty(t, links["next"].Href)
+	assert.Equal(t, "0x010203", rounds[0].ConfigDigest)
+}
@@ -1,10 +1,14 @@
 package web
 
 import (
+	"context"
 	"database/sql"
+	"math/big"
 	"net/http"
 
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -46,3 +50,95 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// BumpTransactionRequest is the optional body of a request to bump the gas of
+// a transaction. Without a gas price, it is bumped as the node would.
+type BumpTransactionRequest struct {
+	GasPriceWei *utils.Big `json:"gasPriceWei"`
+}
+
+// Cancel replaces an unconfirmed transaction with a zero-value send to itself
+// at the same nonce.
+// Example:
+//  "<application>/transactions/:TxHash/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	tc.modify(c, func(ctx context.Context, txm bulletprooftxmanager.TxManager, id int64) (bulletprooftxmanager.EthTx, error) {
+		return txm.CancelEthTx(ctx, id)
+	})
+}
+
+// Bump sends a new attempt of an unconfirmed transaction with a higher gas
+// price now.
+// Example:
+//  "<application>/transactions/:TxHash/bump"
+func (tc *TransactionsController) Bump(c *gin.Context) {
+	var req BumpTransactionRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+	var gasPriceWei *big.Int
+	if req.GasPriceWei != nil {
+		gasPriceWei = req.GasPriceWei.ToInt()
+	}
+	tc.modify(c, func(ctx context.Context, txm bulletprooftxmanager.TxManager, id int64) (bulletprooftxmanager.EthTx, error) {
+		return txm.BumpEthTxGas(ctx, id, gasPriceWei)
+	})
+}
+
+// Abandon marks a transaction that is stuck at its nonce as fatally errored,
+// releasing the nonce.
+// Example:
+//  "<application>/transactions/:TxHash/abandon"
+func (tc *TransactionsController) Abandon(c *gin.Context) {
+	tc.modify(c, func(ctx context.Context, txm bulletprooftxmanager.TxManager, id int64) (bulletprooftxmanager.EthTx, error) {
+		return txm.AbandonEthTx(ctx, id)
+	})
+}
+
+// modify runs an admin operation on the transaction of the attempt with the
+// given hash, through the transaction manager of its chain
+func (tc *TransactionsController) modify(c *gin.Context, fn func(ctx context.Context, txm bulletprooftxmanager.TxManager, id int64) (bulletprooftxmanager.EthTx, error)) {
+	hash := common.HexToHash(c.Param("TxHash"))
+
+	ethTxAttempt, err := tc.App.BPTXMORM().FindEthTxAttempt(hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chain, err := tc.App.GetChainSet().Get(ethTxAttempt.EthTx.EVMChainID.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "chain of the transaction is not enabled"))
+		return
+	}
+
+	etx, err := fn(c.Request.Context(), chain.TxManager(), ethTxAttempt.EthTxID)
+	if errors.Is(err, bulletprooftxmanager.ErrEthTxNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	} else if errors.Is(err, bulletprooftxmanager.ErrEthTxNotModifiable) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if len(etx.EthTxAttempts) == 0 {
+		// An abandoned transaction has no attempts left
+		resource := presenters.NewEthTxResource(etx)
+		resource.JAID = presenters.NewJAID(hash.Hex())
+		jsonAPIResponse(c, resource, "transaction")
+		return
+	}
+	attempt := etx.EthTxAttempts[0]
+	attempt.EthTx = etx
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(attempt), "transaction")
+}
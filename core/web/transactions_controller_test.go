@@ -123,3 +123,29 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+
+	db := app.GetDB()
+	client := app.NewHTTPClient()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+
+	t.Run("not found", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/transactions/"+utils.NewHash().Hex()+"/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+
+	t.Run("confirmed transactions cannot be cancelled", func(t *testing.T) {
+		tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 0, 1, from)
+		require.Len(t, tx.EthTxAttempts, 1)
+
+		resp, cleanup := client.Post("/v2/transactions/"+tx.EthTxAttempts[0].Hash.Hex()+"/cancel", nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+}
@@ -1,9 +1,11 @@
 package web
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
@@ -20,6 +22,116 @@ type UserController struct {
 	App chainlink.Application
 }
 
+// Index lists all API users.
+func (c *UserController) Index(ctx *gin.Context) {
+	users, err := c.App.SessionORM().ListUsers()
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to list users: %+v", err))
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewUserResources(users), "users")
+}
+
+// CreateUserRequest defines the request to create a new API user.
+type CreateUserRequest struct {
+	Email    string `json:"email"`
+	Role     string `json:"role"`
+	Password string `json:"password"`
+}
+
+// Create creates a new API user with the given role.
+func (c *UserController) Create(ctx *gin.Context) {
+	var request CreateUserRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := clsession.GetUserRole(request.Role)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	user, err := clsession.NewUser(request.Email, request.Password, role)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	if _, err = c.App.SessionORM().FindUser(request.Email); err == nil {
+		jsonAPIError(ctx, http.StatusConflict, fmt.Errorf("user with email %s already exists", request.Email))
+		return
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if err := c.App.SessionORM().CreateUser(&user); err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to create user: %+v", err))
+		return
+	}
+
+	jsonAPIResponseWithStatus(ctx, presenters.NewUserResource(user), "user", http.StatusCreated)
+}
+
+// UpdateRoleRequest defines the request to change the role of an API user.
+type UpdateRoleRequest struct {
+	Email   string `json:"email"`
+	NewRole string `json:"newRole"`
+}
+
+// UpdateRole changes the role of another API user.
+func (c *UserController) UpdateRole(ctx *gin.Context) {
+	var request UpdateRoleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if c.isCurrentUser(ctx, request.Email) {
+		jsonAPIError(ctx, http.StatusBadRequest, errors.New("you cannot change your own role"))
+		return
+	}
+	role, err := clsession.GetUserRole(request.NewRole)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	user, err := c.App.SessionORM().UpdateRole(request.Email, role)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusNotFound, fmt.Errorf("user with email %s not found", request.Email))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to update user role: %+v", err))
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
+}
+
+// Delete deletes another API user and their sessions.
+func (c *UserController) Delete(ctx *gin.Context) {
+	email := ctx.Param("email")
+	if c.isCurrentUser(ctx, email) {
+		jsonAPIError(ctx, http.StatusBadRequest, errors.New("you cannot delete yourself"))
+		return
+	}
+	err := c.App.SessionORM().DeleteUser(email)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(ctx, http.StatusNotFound, fmt.Errorf("user with email %s not found", email))
+		return
+	} else if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to delete user: %+v", err))
+		return
+	}
+
+	jsonAPIResponseWithStatus(ctx, nil, "user", http.StatusNoContent)
+}
+
+func (c *UserController) isCurrentUser(ctx *gin.Context, email string) bool {
+	user, ok := authenticatedUser(ctx)
+	return ok && strings.EqualFold(user.Email, email)
+}
+
 // UpdatePasswordRequest defines the request to set a new password for the
 // current session's User.
 type UpdatePasswordRequest struct {
@@ -35,7 +147,7 @@ func (c *UserController) UpdatePassword(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
+	user, err := currentUser(c.App.SessionORM(), ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -60,7 +172,7 @@ func (c *UserController) NewAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
+	user, err := currentUser(c.App.SessionORM(), ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -86,7 +198,7 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
+	user, err := currentUser(c.App.SessionORM(), ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
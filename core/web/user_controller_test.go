@@ -10,6 +10,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -137,3 +139,106 @@ func TestUserController_DeleteAPIKey_unauthorized(t *testing.T) {
 
 	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 }
+
+func TestUserController_ManageUsers(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	// Create a view-only user
+	body, err := json.Marshal(web.CreateUserRequest{Email: "viewer@chainlink.test", Role: "view", Password: cltest.Password})
+	require.NoError(t, err)
+	resp, cleanup := client.Post("/v2/users", bytes.NewBuffer(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	var created presenters.UserResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &created))
+	assert.Equal(t, "viewer@chainlink.test", created.Email)
+	assert.Equal(t, sessions.UserRoleView, created.Role)
+
+	// Creating it again conflicts
+	resp, cleanup = client.Post("/v2/users", bytes.NewBuffer(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusConflict)
+
+	// Invalid roles are rejected
+	body, err = json.Marshal(web.CreateUserRequest{Email: "super@chainlink.test", Role: "superuser", Password: cltest.Password})
+	require.NoError(t, err)
+	resp, cleanup = client.Post("/v2/users", bytes.NewBuffer(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	// List
+	resp, cleanup = client.Get("/v2/users")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var users []presenters.UserResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &users))
+	require.Len(t, users, 2)
+
+	// Change role
+	body, err = json.Marshal(web.UpdateRoleRequest{Email: "viewer@chainlink.test", NewRole: "edit"})
+	require.NoError(t, err)
+	resp, cleanup = client.Patch("/v2/users", bytes.NewBuffer(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	user, err := app.SessionORM().FindUser("viewer@chainlink.test")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, user.Role)
+
+	// Admins cannot demote or delete themselves
+	body, err = json.Marshal(web.UpdateRoleRequest{Email: cltest.APIEmail, NewRole: "view"})
+	require.NoError(t, err)
+	resp, cleanup = client.Patch("/v2/users", bytes.NewBuffer(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+	resp, cleanup = client.Delete("/v2/users/" + cltest.APIEmail)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	// Delete
+	resp, cleanup = client.Delete("/v2/users/viewer@chainlink.test")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+	_, err = app.SessionORM().FindUser("viewer@chainlink.test")
+	require.Error(t, err)
+
+	resp, cleanup = client.Delete("/v2/users/viewer@chainlink.test")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestUserController_RoleEnforcement(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+
+	viewer := app.NewHTTPClientWithRole(sessions.UserRoleView)
+	editor := app.NewHTTPClientWithRole(sessions.UserRoleEdit)
+
+	// Anyone may view
+	resp, cleanup := viewer.Get("/v2/jobs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	// Only editors may change jobs and bridges
+	resp, cleanup = viewer.Delete("/v2/jobs/1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+	resp, cleanup = editor.Delete("/v2/bridge_types/doesnotexist")
+	t.Cleanup(cleanup)
+	assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
+
+	// Only admins may export keys or manage users
+	resp, cleanup = editor.Post("/v2/keys/eth/export/0x0000000000000000000000000000000000000000", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+	resp, cleanup = editor.Get("/v2/users")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+}
@@ -29,7 +29,7 @@ func (c *WebAuthnController) BeginRegistration(ctx *gin.Context) {
 	}
 
 	orm := c.App.SessionORM()
-	user, err := orm.FindUser()
+	user, err := currentUser(orm, ctx)
 	if err != nil {
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
 		return
@@ -63,7 +63,7 @@ func (c *WebAuthnController) FinishRegistration(ctx *gin.Context) {
 	}
 
 	orm := c.App.SessionORM()
-	user, err := orm.FindUser()
+	user, err := currentUser(orm, ctx)
 	if err != nil {
 		logger.Errorf("error finding user: %s", err)
 		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
@@ -79,6 +79,12 @@ declare module 'core/store/models' {
     url: WebURL
     confirmations: number
     minimumContractPayment: Pointer<assets.Link>
+    maxConcurrency?: number
+    requestsPerSecond?: number
+    cacheTTL?: string
+    circuitBreakerThreshold?: number
+    circuitBreakerTimeout?: string
+    maxStaleness?: string
   }
 
   /**
@@ -103,6 +109,12 @@ declare module 'core/store/models' {
     confirmations: number
     outgoingToken: string
     minimumContractPayment: Pointer<assets.Link>
+    maxConcurrency?: number
+    requestsPerSecond?: number
+    cacheTTL?: string
+    circuitBreakerThreshold?: number
+    circuitBreakerTimeout?: string
+    maxStaleness?: string
   }
   //#endregion bridge_type.go
 
@@ -553,4 +565,5 @@ export interface PipelineTaskRun {
   output: PipelineTaskOutput
   dotId: string
   type: string
+  skipped: boolean
 }